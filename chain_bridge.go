@@ -10,6 +10,7 @@ import (
 	"github.com/lightninglabs/taproot-assets/rfq"
 	"github.com/lightninglabs/taproot-assets/tapgarden"
 	"github.com/lightningnetwork/lnd/chainntnfs"
+	"github.com/lightningnetwork/lnd/lnrpc/routerrpc"
 	"github.com/lightningnetwork/lnd/lnrpc/verrpc"
 	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 )
@@ -262,3 +263,14 @@ func (l *LndRouterClient) InterceptHtlcs(
 
 // Ensure LndRouterClient implements the rfq.HtlcInterceptor interface.
 var _ rfq.HtlcInterceptor = (*LndRouterClient)(nil)
+
+// SubscribeHtlcEvents subscribes to a stream of HTLC events from the router.
+func (l *LndRouterClient) SubscribeHtlcEvents(
+	ctx context.Context) (<-chan *routerrpc.HtlcEvent, <-chan error,
+	error) {
+
+	return l.lnd.Router.SubscribeHtlcEvents(ctx)
+}
+
+// Ensure LndRouterClient implements the rfq.HtlcEventSubscriber interface.
+var _ rfq.HtlcEventSubscriber = (*LndRouterClient)(nil)
@@ -1,12 +1,14 @@
 package main
 
 import (
+	"encoding/base64"
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"math"
 	"os"
 	"strconv"
+	"strings"
 
 	taprootassets "github.com/lightninglabs/taproot-assets"
 	"github.com/lightninglabs/taproot-assets/tapcfg"
@@ -50,10 +52,15 @@ var (
 	assetGroupKeyName            = "group_key"
 	assetGroupAnchorName         = "group_anchor"
 	batchKeyName                 = "batch_key"
+	batchNameName                = "batch_name"
+	updateGroupName              = "update_group"
 	groupByGroupName             = "by_group"
 	assetIDName                  = "asset_id"
 	shortResponseName            = "short"
 	feeRateName                  = "sat_per_vbyte"
+	groupWitnessName             = "group_witness"
+	psbtTemplateName             = "psbt_template"
+	signedBatchPsbtName          = "signed_batch_psbt"
 	assetAmountName              = "amount"
 	burnOverrideConfirmationName = "override_confirmation_destroy_assets"
 )
@@ -116,6 +123,17 @@ var mintAssetCommand = cli.Command{
 			Usage: "the other asset in this batch that the new " +
 				"asset be grouped with",
 		},
+		cli.StringFlag{
+			Name: batchNameName,
+			Usage: "the name of the pending batch to add the " +
+				"asset to; a new batch is created if no " +
+				"pending batch with this name exists",
+		},
+		cli.StringFlag{
+			Name: batchKeyName,
+			Usage: "the batch key of the pending batch to add " +
+				"the asset to",
+		},
 		cli.BoolFlag{
 			Name: shortResponseName,
 			Usage: "if true, then the current assets within the " +
@@ -127,8 +145,14 @@ var mintAssetCommand = cli.Command{
 	Action: mintAsset,
 	Subcommands: []cli.Command{
 		listBatchesCommand,
+		fundBatchCommand,
+		sealBatchCommand,
+		listGroupVirtualTxsCommand,
 		finalizeBatchCommand,
 		cancelBatchCommand,
+		removeSeedlingCommand,
+		updateSeedlingCommand,
+		bumpBatchFeeCommand,
 	},
 }
 
@@ -176,35 +200,20 @@ func parseMetaType(metaType string,
 	}
 }
 
-func mintAsset(ctx *cli.Context) error {
-	switch {
-	case ctx.String(assetTagName) == "":
-		fallthrough
-	case ctx.Int64(assetSupplyName) == 0:
-		return cli.ShowSubcommandHelp(ctx)
-	}
-
+// parseAssetMeta parses the optional asset meta given either as raw bytes or
+// as the path of a file on disk.
+func parseAssetMeta(ctx *cli.Context) (*taprpc.AssetMeta, error) {
 	var (
-		groupKey    []byte
+		assetMeta   *taprpc.AssetMeta
 		err         error
-		groupKeyStr = ctx.String(assetGroupKeyName)
+		metaTypeStr = ctx.String(assetMetaTypeName)
 	)
 
-	if len(groupKeyStr) != 0 {
-		groupKey, err = hex.DecodeString(groupKeyStr)
-		if err != nil {
-			return fmt.Errorf("invalid group key")
-		}
-	}
-
-	metaTypeStr := ctx.String(assetMetaTypeName)
-
 	// Both the meta bytes and the meta path can be set.
-	var assetMeta *taprpc.AssetMeta
 	switch {
 	case ctx.String(assetMetaBytesName) != "" &&
 		ctx.String(assetMetaFilePathName) != "":
-		return fmt.Errorf("meta bytes or meta file path cannot " +
+		return nil, fmt.Errorf("meta bytes or meta file path cannot " +
 			"be both set")
 
 	case ctx.String(assetMetaBytesName) != "":
@@ -214,7 +223,8 @@ func mintAsset(ctx *cli.Context) error {
 
 		assetMeta.Type, err = parseMetaType(metaTypeStr, assetMeta.Data)
 		if err != nil {
-			return fmt.Errorf("unable to parse meta type: %w", err)
+			return nil, fmt.Errorf("unable to parse meta type: %w",
+				err)
 		}
 
 	case ctx.String(assetMetaFilePathName) != "":
@@ -223,7 +233,8 @@ func mintAsset(ctx *cli.Context) error {
 		)
 		metaFileBytes, err := os.ReadFile(metaPath)
 		if err != nil {
-			return fmt.Errorf("unable to read meta file: %w", err)
+			return nil, fmt.Errorf("unable to read meta file: %w",
+				err)
 		}
 
 		assetMeta = &taprpc.AssetMeta{
@@ -232,10 +243,40 @@ func mintAsset(ctx *cli.Context) error {
 
 		assetMeta.Type, err = parseMetaType(metaTypeStr, assetMeta.Data)
 		if err != nil {
-			return fmt.Errorf("unable to parse meta type: %w", err)
+			return nil, fmt.Errorf("unable to parse meta type: %w",
+				err)
+		}
+	}
+
+	return assetMeta, nil
+}
+
+func mintAsset(ctx *cli.Context) error {
+	switch {
+	case ctx.String(assetTagName) == "":
+		fallthrough
+	case ctx.Int64(assetSupplyName) == 0:
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	var (
+		groupKey    []byte
+		err         error
+		groupKeyStr = ctx.String(assetGroupKeyName)
+	)
+
+	if len(groupKeyStr) != 0 {
+		groupKey, err = hex.DecodeString(groupKeyStr)
+		if err != nil {
+			return fmt.Errorf("invalid group key")
 		}
 	}
 
+	assetMeta, err := parseAssetMeta(ctx)
+	if err != nil {
+		return err
+	}
+
 	assetType, err := parseAssetType(ctx)
 	if err != nil {
 		return err
@@ -263,6 +304,11 @@ func mintAsset(ctx *cli.Context) error {
 		return fmt.Errorf("supply must be set for normal assets")
 	}
 
+	batchName, batchKey, err := parseBatchTarget(ctx)
+	if err != nil {
+		return err
+	}
+
 	ctxc := getContext()
 	client, cleanUp := getMintClient(ctx)
 	defer cleanUp()
@@ -282,6 +328,8 @@ func mintAsset(ctx *cli.Context) error {
 			),
 		},
 		ShortResponse: ctx.Bool(shortResponseName),
+		BatchName:     batchName,
+		BatchKey:      batchKey,
 	})
 	if err != nil {
 		return fmt.Errorf("unable to mint asset: %w", err)
@@ -291,6 +339,248 @@ func mintAsset(ctx *cli.Context) error {
 	return nil
 }
 
+// parseBatchTarget parses the optional name and hex encoded batch key of the
+// batch that a command targets.
+func parseBatchTarget(ctx *cli.Context) (string, []byte, error) {
+	var batchKey []byte
+	if ctx.IsSet(batchKeyName) {
+		var err error
+		batchKey, err = hex.DecodeString(ctx.String(batchKeyName))
+		if err != nil {
+			return "", nil, fmt.Errorf("invalid batch key")
+		}
+	}
+
+	return ctx.String(batchNameName), batchKey, nil
+}
+
+var fundBatchCommand = cli.Command{
+	Name:  "fund",
+	Usage: "fund a batch",
+	Description: "Attempt to fund the target pending batch, or create " +
+		"a new funded batch if there is no such pending batch.",
+	Flags: []cli.Flag{
+		cli.BoolFlag{
+			Name: shortResponseName,
+			Usage: "if true, then the current assets within the " +
+				"batch will not be returned in the response " +
+				"in order to avoid printing a large amount " +
+				"of data in case of large batches",
+		},
+		cli.Uint64Flag{
+			Name: feeRateName,
+			Usage: "if set, the fee rate in sat/vB to use for " +
+				"the minting transaction",
+		},
+		cli.StringFlag{
+			Name: psbtTemplateName,
+			Usage: "if set, the base64 encoded PSBT the minting " +
+				"transaction is built on top of; all its " +
+				"inputs are spent and all its outputs are " +
+				"created by the minting transaction",
+		},
+		cli.StringFlag{
+			Name: batchNameName,
+			Usage: "the name of the pending batch to fund; a new " +
+				"batch is created if no pending batch with " +
+				"this name exists",
+		},
+		cli.StringFlag{
+			Name:  batchKeyName,
+			Usage: "the batch key of the pending batch to fund",
+		},
+	},
+	Action: fundBatch,
+}
+
+func fundBatch(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getMintClient(ctx)
+	defer cleanUp()
+
+	feeRate, err := parseFeeRate(ctx)
+	if err != nil {
+		return err
+	}
+
+	var psbtTemplate []byte
+	if ctx.IsSet(psbtTemplateName) {
+		psbtTemplate, err = base64.StdEncoding.DecodeString(
+			ctx.String(psbtTemplateName),
+		)
+		if err != nil {
+			return fmt.Errorf("unable to decode psbt template: %w",
+				err)
+		}
+	}
+
+	batchName, batchKey, err := parseBatchTarget(ctx)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.FundBatch(ctxc, &mintrpc.FundBatchRequest{
+		ShortResponse: ctx.Bool(shortResponseName),
+		FeeRate:       feeRate,
+		PsbtTemplate:  psbtTemplate,
+		BatchName:     batchName,
+		BatchKey:      batchKey,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to fund batch: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var sealBatchCommand = cli.Command{
+	Name:  "seal",
+	Usage: "seal a batch",
+	Description: "Attempt to seal the pending batch by creating the " +
+		"group witnesses of all grouped assets in the batch. Group " +
+		"witnesses created externally can be provided, all other " +
+		"group witnesses are created by the daemon.",
+	Flags: []cli.Flag{
+		cli.BoolFlag{
+			Name: shortResponseName,
+			Usage: "if true, then the current assets within the " +
+				"batch will not be returned in the response " +
+				"in order to avoid printing a large amount " +
+				"of data in case of large batches",
+		},
+		cli.StringSliceFlag{
+			Name: groupWitnessName,
+			Usage: "a group witness created externally, in the " +
+				"format <asset_id>:<witness_elem>[:...] with " +
+				"all values hex encoded; can be specified " +
+				"multiple times",
+		},
+		cli.StringFlag{
+			Name:  batchNameName,
+			Usage: "the name of the pending batch to seal",
+		},
+		cli.StringFlag{
+			Name:  batchKeyName,
+			Usage: "the batch key of the pending batch to seal",
+		},
+	},
+	Action: sealBatch,
+}
+
+// parseGroupWitness parses a group witness given in the format
+// <asset_id>:<witness_elem>[:<witness_elem>...].
+func parseGroupWitness(groupWitness string) (*mintrpc.GroupWitness, error) {
+	parts := strings.Split(groupWitness, ":")
+	if len(parts) < 2 {
+		return nil, fmt.Errorf("group witness must contain an asset " +
+			"ID and at least one witness element")
+	}
+
+	assetID, err := hex.DecodeString(parts[0])
+	if err != nil {
+		return nil, fmt.Errorf("invalid asset ID: %w", err)
+	}
+
+	witness := make([][]byte, 0, len(parts)-1)
+	for _, part := range parts[1:] {
+		witnessElem, err := hex.DecodeString(part)
+		if err != nil {
+			return nil, fmt.Errorf("invalid witness element: %w",
+				err)
+		}
+
+		witness = append(witness, witnessElem)
+	}
+
+	return &mintrpc.GroupWitness{
+		AssetId: assetID,
+		Witness: witness,
+	}, nil
+}
+
+func sealBatch(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getMintClient(ctx)
+	defer cleanUp()
+
+	groupWitnessStrs := ctx.StringSlice(groupWitnessName)
+	groupWitnesses := make(
+		[]*mintrpc.GroupWitness, 0, len(groupWitnessStrs),
+	)
+	for _, groupWitnessStr := range groupWitnessStrs {
+		groupWitness, err := parseGroupWitness(groupWitnessStr)
+		if err != nil {
+			return err
+		}
+
+		groupWitnesses = append(groupWitnesses, groupWitness)
+	}
+
+	batchName, batchKey, err := parseBatchTarget(ctx)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.SealBatch(ctxc, &mintrpc.SealBatchRequest{
+		ShortResponse:  ctx.Bool(shortResponseName),
+		GroupWitnesses: groupWitnesses,
+		BatchName:      batchName,
+		BatchKey:       batchKey,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to seal batch: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var listGroupVirtualTxsCommand = cli.Command{
+	Name:  "groupvtxs",
+	Usage: "list the group virtual transactions of a batch",
+	Description: "List the group virtual transactions of the grouped " +
+		"assets in the funded pending batch, which must be signed to " +
+		"create the group witnesses of the batch.",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: batchNameName,
+			Usage: "the name of the pending batch to list the " +
+				"group virtual transactions for",
+		},
+		cli.StringFlag{
+			Name: batchKeyName,
+			Usage: "the batch key of the pending batch to list " +
+				"the group virtual transactions for",
+		},
+	},
+	Action: listGroupVirtualTxs,
+}
+
+func listGroupVirtualTxs(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getMintClient(ctx)
+	defer cleanUp()
+
+	batchName, batchKey, err := parseBatchTarget(ctx)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.ListGroupVirtualTxs(
+		ctxc, &mintrpc.ListGroupVirtualTxsRequest{
+			BatchName: batchName,
+			BatchKey:  batchKey,
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to list group virtual txs: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
 var finalizeBatchCommand = cli.Command{
 	Name:        "finalize",
 	ShortName:   "f",
@@ -309,6 +599,20 @@ var finalizeBatchCommand = cli.Command{
 			Usage: "if set, the fee rate in sat/vB to use for " +
 				"the minting transaction",
 		},
+		cli.StringFlag{
+			Name:  batchNameName,
+			Usage: "the name of the pending batch to finalize",
+		},
+		cli.StringFlag{
+			Name:  batchKeyName,
+			Usage: "the batch key of the pending batch to finalize",
+		},
+		cli.StringFlag{
+			Name: signedBatchPsbtName,
+			Usage: "if set, the base64 encoded batch PSBT in " +
+				"which all inputs added with the PSBT " +
+				"template are finalized",
+		},
 	},
 	Action: finalizeBatch,
 }
@@ -345,9 +649,28 @@ func finalizeBatch(ctx *cli.Context) error {
 		return err
 	}
 
+	batchName, batchKey, err := parseBatchTarget(ctx)
+	if err != nil {
+		return err
+	}
+
+	var signedPsbt []byte
+	if ctx.IsSet(signedBatchPsbtName) {
+		signedPsbt, err = base64.StdEncoding.DecodeString(
+			ctx.String(signedBatchPsbtName),
+		)
+		if err != nil {
+			return fmt.Errorf("unable to decode signed batch "+
+				"psbt: %w", err)
+		}
+	}
+
 	resp, err := client.FinalizeBatch(ctxc, &mintrpc.FinalizeBatchRequest{
-		ShortResponse: ctx.Bool(shortResponseName),
-		FeeRate:       feeRate,
+		ShortResponse:   ctx.Bool(shortResponseName),
+		FeeRate:         feeRate,
+		BatchName:       batchName,
+		BatchKey:        batchKey,
+		SignedBatchPsbt: signedPsbt,
 	})
 	if err != nil {
 		return fmt.Errorf("unable to finalize batch: %w", err)
@@ -358,11 +681,22 @@ func finalizeBatch(ctx *cli.Context) error {
 }
 
 var cancelBatchCommand = cli.Command{
-	Name:        "cancel",
-	ShortName:   "c",
-	Usage:       "cancel a batch",
-	Description: "Attempt to cancel a pending batch.",
-	Action:      cancelBatch,
+	Name:      "cancel",
+	ShortName: "c",
+	Usage:     "cancel a batch",
+	Description: "Attempt to cancel a pending batch. If no batch name " +
+		"or key is given, the only existing batch is cancelled.",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  batchNameName,
+			Usage: "the name of the pending batch to cancel",
+		},
+		cli.StringFlag{
+			Name:  batchKeyName,
+			Usage: "the batch key of the batch to cancel",
+		},
+	},
+	Action: cancelBatch,
 }
 
 func cancelBatch(ctx *cli.Context) error {
@@ -370,7 +704,15 @@ func cancelBatch(ctx *cli.Context) error {
 	client, cleanUp := getMintClient(ctx)
 	defer cleanUp()
 
-	resp, err := client.CancelBatch(ctxc, &mintrpc.CancelBatchRequest{})
+	batchName, batchKey, err := parseBatchTarget(ctx)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.CancelBatch(ctxc, &mintrpc.CancelBatchRequest{
+		BatchName: batchName,
+		BatchKey:  batchKey,
+	})
 	if err != nil {
 		return fmt.Errorf("unable to cancel batch: %w", err)
 	}
@@ -379,6 +721,244 @@ func cancelBatch(ctx *cli.Context) error {
 	return nil
 }
 
+var removeSeedlingCommand = cli.Command{
+	Name:      "remove",
+	Usage:     "remove an asset from a pending batch",
+	ArgsUsage: "--name <asset_name>",
+	Description: "Attempt to remove a single asset from a pending batch " +
+		"that isn't sealed yet. If no batch name or key is given, " +
+		"the default pending batch is used.",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  assetTagName,
+			Usage: "the name/tag of the asset to remove",
+		},
+		cli.StringFlag{
+			Name:  batchNameName,
+			Usage: "the name of the pending batch with the asset",
+		},
+		cli.StringFlag{
+			Name: batchKeyName,
+			Usage: "the batch key of the pending batch with the " +
+				"asset",
+		},
+	},
+	Action: removeSeedling,
+}
+
+func removeSeedling(ctx *cli.Context) error {
+	if ctx.String(assetTagName) == "" {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	batchName, batchKey, err := parseBatchTarget(ctx)
+	if err != nil {
+		return err
+	}
+
+	ctxc := getContext()
+	client, cleanUp := getMintClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.RemoveSeedling(ctxc, &mintrpc.RemoveSeedlingRequest{
+		AssetName: ctx.String(assetTagName),
+		BatchName: batchName,
+		BatchKey:  batchKey,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to remove asset: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var updateSeedlingCommand = cli.Command{
+	Name:      "update",
+	Usage:     "update an asset in a pending batch",
+	ArgsUsage: "--name <asset_name>",
+	Description: "Attempt to change the supply, metadata or group " +
+		"settings of a single asset in a pending batch that isn't " +
+		"sealed yet. Only the given settings are changed. If any of " +
+		"the group flags is given, all group settings of the asset " +
+		"are replaced. If no batch name or key is given, the default " +
+		"pending batch is used.",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  assetTagName,
+			Usage: "the name/tag of the asset to update",
+		},
+		cli.Uint64Flag{
+			Name:  assetSupplyName,
+			Usage: "the new target supply of the asset",
+		},
+		cli.StringFlag{
+			Name:  assetMetaBytesName,
+			Usage: "the new raw metadata associated with the asset",
+		},
+		cli.StringFlag{
+			Name: assetMetaFilePathName,
+			Usage: "a path to a file on disk that should be read " +
+				"and used as the new asset meta",
+		},
+		cli.StringFlag{
+			Name: assetMetaTypeName,
+			Usage: "the type of the new meta data for the asset, " +
+				"must be either: opaque or json",
+			Value: "opaque",
+		},
+		cli.BoolFlag{
+			Name: updateGroupName,
+			Usage: "if true, then the group settings of the " +
+				"asset are replaced, even if no other group " +
+				"flag is given; this can be used to remove " +
+				"the asset from a group",
+		},
+		cli.BoolFlag{
+			Name: assetNewGroupedAssetName,
+			Usage: "if true, then the asset supports on going " +
+				"emission",
+		},
+		cli.BoolFlag{
+			Name: assetGroupedAssetName,
+			Usage: "if true, then the asset is minted into a " +
+				"specific group",
+		},
+		cli.StringFlag{
+			Name: assetGroupKeyName,
+			Usage: "the specific group key to use to mint the " +
+				"asset",
+		},
+		cli.StringFlag{
+			Name: assetGroupAnchorName,
+			Usage: "the other asset in this batch that the asset " +
+				"be grouped with",
+		},
+		cli.StringFlag{
+			Name:  batchNameName,
+			Usage: "the name of the pending batch with the asset",
+		},
+		cli.StringFlag{
+			Name: batchKeyName,
+			Usage: "the batch key of the pending batch with the " +
+				"asset",
+		},
+	},
+	Action: updateSeedling,
+}
+
+func updateSeedling(ctx *cli.Context) error {
+	if ctx.String(assetTagName) == "" {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	var (
+		groupKey    []byte
+		err         error
+		groupKeyStr = ctx.String(assetGroupKeyName)
+	)
+
+	if len(groupKeyStr) != 0 {
+		groupKey, err = hex.DecodeString(groupKeyStr)
+		if err != nil {
+			return fmt.Errorf("invalid group key")
+		}
+	}
+
+	assetMeta, err := parseAssetMeta(ctx)
+	if err != nil {
+		return err
+	}
+
+	batchName, batchKey, err := parseBatchTarget(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Setting any of the group flags replaces all group settings of the
+	// asset.
+	updateGroup := ctx.Bool(updateGroupName) ||
+		ctx.IsSet(assetNewGroupedAssetName) ||
+		ctx.IsSet(assetGroupedAssetName) ||
+		ctx.IsSet(assetGroupKeyName) ||
+		ctx.IsSet(assetGroupAnchorName)
+
+	ctxc := getContext()
+	client, cleanUp := getMintClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.UpdateSeedling(ctxc, &mintrpc.UpdateSeedlingRequest{
+		AssetName:       ctx.String(assetTagName),
+		BatchName:       batchName,
+		BatchKey:        batchKey,
+		Amount:          ctx.Uint64(assetSupplyName),
+		AssetMeta:       assetMeta,
+		UpdateGroup:     updateGroup,
+		NewGroupedAsset: ctx.Bool(assetNewGroupedAssetName),
+		GroupedAsset:    ctx.Bool(assetGroupedAssetName),
+		GroupKey:        groupKey,
+		GroupAnchor:     ctx.String(assetGroupAnchorName),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to update asset: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var bumpBatchFeeCommand = cli.Command{
+	Name:      "bumpfee",
+	Usage:     "bump the fee of a broadcast batch",
+	ArgsUsage: "--batch_key <key> --sat_per_vbyte <fee_rate>",
+	Description: "Attempt to replace the minting transaction of a " +
+		"batch that was broadcast but isn't confirmed yet with one " +
+		"that pays a higher fee rate.",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  batchKeyName,
+			Usage: "the batch key of the batch to bump the fee of",
+		},
+		cli.Uint64Flag{
+			Name: feeRateName,
+			Usage: "the new fee rate in sat/vB to use for the " +
+				"minting transaction",
+		},
+	},
+	Action: bumpBatchFee,
+}
+
+func bumpBatchFee(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getMintClient(ctx)
+	defer cleanUp()
+
+	if !ctx.IsSet(batchKeyName) || !ctx.IsSet(feeRateName) {
+		return cli.ShowSubcommandHelp(ctx)
+	}
+
+	batchKey, err := hex.DecodeString(ctx.String(batchKeyName))
+	if err != nil {
+		return fmt.Errorf("invalid batch key")
+	}
+
+	feeRate, err := parseFeeRate(ctx)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.BumpBatchFee(ctxc, &mintrpc.BumpBatchFeeRequest{
+		BatchKey: batchKey,
+		FeeRate:  feeRate,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to bump batch fee: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
 var listBatchesCommand = cli.Command{
 	Name:        "batches",
 	ShortName:   "b",
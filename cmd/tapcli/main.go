@@ -335,6 +335,7 @@ func main() {
 	app.Commands = append(app.Commands, eventCommands...)
 	app.Commands = append(app.Commands, proofCommands...)
 	app.Commands = append(app.Commands, universeCommands...)
+	app.Commands = append(app.Commands, rfqCommands...)
 	app.Commands = append(app.Commands, devCommands...)
 
 	if err := app.Run(os.Args); err != nil {
@@ -0,0 +1,482 @@
+package main
+
+import (
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/taprpc/rfqrpc"
+	"github.com/urfave/cli"
+)
+
+// getRfqClient returns a client for the RFQ RPC service.
+func getRfqClient(ctx *cli.Context) (rfqrpc.RfqClient, func()) {
+	conn := getClientConn(ctx, false)
+
+	cleanUp := func() {
+		conn.Close()
+	}
+
+	return rfqrpc.NewRfqClient(conn), cleanUp
+}
+
+const (
+	rfqMinAmountName  = "min_amount"
+	rfqMaxAmountName  = "max_amount"
+	rfqMaxBidName     = "max_bid"
+	rfqMinAskName     = "min_ask"
+	rfqExpiryName     = "expiry"
+	rfqPeerPubKeyName = "peer_pub_key"
+	rfqMaxUnitsName   = "max_units"
+	rfqStartTimeName  = "start_time"
+	rfqEndTimeName    = "end_time"
+
+	// defaultRfqOrderLifetime is the lifetime of an order if no explicit
+	// expiry timestamp is given.
+	defaultRfqOrderLifetime = time.Hour
+)
+
+var rfqCommands = []cli.Command{
+	{
+		Name:      "rfq",
+		ShortName: "r",
+		Usage:     "Interact with the RFQ (request for quote) system.",
+		Category:  "RFQ",
+		Subcommands: []cli.Command{
+			rfqBuyOrderCommand,
+			rfqSellOrderCommand,
+			rfqSellOfferCommand,
+			rfqBuyOfferCommand,
+			rfqAcceptedQuotesCommand,
+			rfqEventsCommand,
+			rfqQuotesCommand,
+			rfqTradesCommand,
+		},
+	},
+}
+
+// rfqAssetSpecifierFlags are the flags used to specify the subject asset of an
+// RFQ order or offer.
+var rfqAssetSpecifierFlags = []cli.Flag{
+	cli.StringFlag{
+		Name:  assetIDName,
+		Usage: "the hex encoded ID of the asset",
+	},
+	cli.StringFlag{
+		Name: groupKeyName,
+		Usage: "the hex encoded group key of the asset; ignored if " +
+			"an asset ID is given",
+	},
+}
+
+// parseRfqAssetSpecifier parses the asset specifier flags of an RFQ command.
+func parseRfqAssetSpecifier(ctx *cli.Context) (*rfqrpc.AssetSpecifier,
+	error) {
+
+	switch {
+	case ctx.IsSet(assetIDName):
+		assetID, err := hex.DecodeString(ctx.String(assetIDName))
+		if err != nil {
+			return nil, fmt.Errorf("invalid asset ID: %w", err)
+		}
+
+		return &rfqrpc.AssetSpecifier{
+			Id: &rfqrpc.AssetSpecifier_AssetId{
+				AssetId: assetID,
+			},
+		}, nil
+
+	case ctx.IsSet(groupKeyName):
+		groupKey, err := hex.DecodeString(ctx.String(groupKeyName))
+		if err != nil {
+			return nil, fmt.Errorf("invalid group key: %w", err)
+		}
+
+		return &rfqrpc.AssetSpecifier{
+			Id: &rfqrpc.AssetSpecifier_GroupKey{
+				GroupKey: groupKey,
+			},
+		}, nil
+
+	default:
+		return nil, fmt.Errorf("either --%s or --%s must be set",
+			assetIDName, groupKeyName)
+	}
+}
+
+// parseRfqOrderFlags parses the expiry and peer flags that are common to buy
+// and sell orders.
+func parseRfqOrderFlags(ctx *cli.Context) (uint64, []byte, error) {
+	expiry := ctx.Uint64(rfqExpiryName)
+	if expiry == 0 {
+		expiry = uint64(
+			time.Now().Add(defaultRfqOrderLifetime).Unix(),
+		)
+	}
+
+	if !ctx.IsSet(rfqPeerPubKeyName) {
+		return 0, nil, fmt.Errorf("--%s must be set", rfqPeerPubKeyName)
+	}
+
+	peerPubKey, err := hex.DecodeString(ctx.String(rfqPeerPubKeyName))
+	if err != nil {
+		return 0, nil, fmt.Errorf("invalid peer public key: %w", err)
+	}
+
+	return expiry, peerPubKey, nil
+}
+
+// rfqOrderFlags are the flags that are common to buy and sell orders.
+var rfqOrderFlags = []cli.Flag{
+	cli.Uint64Flag{
+		Name: rfqExpiryName,
+		Usage: "the unix timestamp in seconds after which the order " +
+			"is no longer valid; defaults to one hour from now",
+	},
+	cli.StringFlag{
+		Name: rfqPeerPubKeyName,
+		Usage: "the hex encoded public key of the peer to request " +
+			"a quote from",
+	},
+}
+
+var rfqBuyOrderCommand = cli.Command{
+	Name:      "buyorder",
+	ShortName: "bo",
+	Usage:     "request a quote for buying an asset from a peer",
+	Description: "Add a buy order for an asset. A buy quote request is " +
+		"sent to the given peer. Use the acceptedquotes or events " +
+		"command to see whether the peer accepted the quote.",
+	Flags: append(append([]cli.Flag{
+		cli.Uint64Flag{
+			Name:  rfqMinAmountName,
+			Usage: "the minimum amount of the asset to buy",
+		},
+		cli.Uint64Flag{
+			Name: rfqMaxBidName,
+			Usage: "the maximum amount of BTC to spend in " +
+				"millisats",
+		},
+	}, rfqAssetSpecifierFlags...), rfqOrderFlags...),
+	Action: rfqBuyOrder,
+}
+
+func rfqBuyOrder(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getRfqClient(ctx)
+	defer cleanUp()
+
+	assetSpecifier, err := parseRfqAssetSpecifier(ctx)
+	if err != nil {
+		return err
+	}
+
+	expiry, peerPubKey, err := parseRfqOrderFlags(ctx)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.AddAssetBuyOrder(
+		ctxc, &rfqrpc.AddAssetBuyOrderRequest{
+			AssetSpecifier: assetSpecifier,
+			MinAssetAmount: ctx.Uint64(rfqMinAmountName),
+			MaxBid:         ctx.Uint64(rfqMaxBidName),
+			Expiry:         expiry,
+			PeerPubKey:     peerPubKey,
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to add buy order: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var rfqSellOrderCommand = cli.Command{
+	Name:      "sellorder",
+	ShortName: "so",
+	Usage:     "request a quote for selling an asset to a peer",
+	Description: "Add a sell order for an asset. A sell quote request " +
+		"is sent to the given peer. Use the acceptedquotes or " +
+		"events command to see whether the peer accepted the quote.",
+	Flags: append(append([]cli.Flag{
+		cli.Uint64Flag{
+			Name:  rfqMaxAmountName,
+			Usage: "the maximum amount of the asset to sell",
+		},
+		cli.Uint64Flag{
+			Name: rfqMinAskName,
+			Usage: "the minimum amount of BTC to accept in " +
+				"millisats",
+		},
+	}, rfqAssetSpecifierFlags...), rfqOrderFlags...),
+	Action: rfqSellOrder,
+}
+
+func rfqSellOrder(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getRfqClient(ctx)
+	defer cleanUp()
+
+	assetSpecifier, err := parseRfqAssetSpecifier(ctx)
+	if err != nil {
+		return err
+	}
+
+	expiry, peerPubKey, err := parseRfqOrderFlags(ctx)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.AddAssetSellOrder(
+		ctxc, &rfqrpc.AddAssetSellOrderRequest{
+			AssetSpecifier: assetSpecifier,
+			MaxAssetAmount: ctx.Uint64(rfqMaxAmountName),
+			MinAsk:         ctx.Uint64(rfqMinAskName),
+			Expiry:         expiry,
+			PeerPubKey:     peerPubKey,
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to add sell order: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var rfqSellOfferCommand = cli.Command{
+	Name:      "selloffer",
+	ShortName: "sof",
+	Usage:     "offer to sell an asset to peers",
+	Description: "Add a sell offer for an asset. Incoming buy quote " +
+		"requests for the asset are only considered if a sell " +
+		"offer exists for it.",
+	Flags: append([]cli.Flag{
+		cli.Uint64Flag{
+			Name:  rfqMaxUnitsName,
+			Usage: "the maximum amount of the asset to sell",
+		},
+	}, rfqAssetSpecifierFlags...),
+	Action: rfqSellOffer,
+}
+
+func rfqSellOffer(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getRfqClient(ctx)
+	defer cleanUp()
+
+	assetSpecifier, err := parseRfqAssetSpecifier(ctx)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.AddAssetSellOffer(
+		ctxc, &rfqrpc.AddAssetSellOfferRequest{
+			AssetSpecifier: assetSpecifier,
+			MaxUnits:       ctx.Uint64(rfqMaxUnitsName),
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to add sell offer: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var rfqBuyOfferCommand = cli.Command{
+	Name:      "buyoffer",
+	ShortName: "bof",
+	Usage:     "offer to buy an asset from peers",
+	Description: "Add a buy offer for an asset. Incoming sell quote " +
+		"requests for the asset are only considered if a buy " +
+		"offer exists for it.",
+	Flags: append([]cli.Flag{
+		cli.Uint64Flag{
+			Name:  rfqMaxUnitsName,
+			Usage: "the maximum amount of the asset to buy",
+		},
+	}, rfqAssetSpecifierFlags...),
+	Action: rfqBuyOffer,
+}
+
+func rfqBuyOffer(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getRfqClient(ctx)
+	defer cleanUp()
+
+	assetSpecifier, err := parseRfqAssetSpecifier(ctx)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.AddAssetBuyOffer(
+		ctxc, &rfqrpc.AddAssetBuyOfferRequest{
+			AssetSpecifier: assetSpecifier,
+			MaxUnits:       ctx.Uint64(rfqMaxUnitsName),
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to add buy offer: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var rfqAcceptedQuotesCommand = cli.Command{
+	Name:      "acceptedquotes",
+	ShortName: "q",
+	Usage:     "list the quotes that were accepted by our peers",
+	Description: "List the buy and sell quotes that were requested by " +
+		"this node and have been accepted by our peers.",
+	Action: rfqAcceptedQuotes,
+}
+
+func rfqAcceptedQuotes(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getRfqClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.QueryPeerAcceptedQuotes(
+		ctxc, &rfqrpc.QueryPeerAcceptedQuotesRequest{},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to query accepted quotes: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var rfqEventsCommand = cli.Command{
+	Name:      "events",
+	ShortName: "e",
+	Usage:     "subscribe to RFQ events",
+	Description: "Get live updates on accepted quotes and accepted " +
+		"HTLCs. This command will block until aborted manually by " +
+		"hitting Ctrl+C.",
+	Action: rfqEvents,
+}
+
+func rfqEvents(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getRfqClient(ctx)
+	defer cleanUp()
+
+	stream, err := client.SubscribeRfqEventNtfns(
+		ctxc, &rfqrpc.SubscribeRfqEventNtfnsRequest{},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to subscribe to RFQ events: %w", err)
+	}
+
+	for {
+		event, err := stream.Recv()
+		if err != nil {
+			return fmt.Errorf("unable to receive event: %w", err)
+		}
+
+		printRespJSON(event)
+	}
+}
+
+// rfqLedgerFlags are the filter flags of the quote ledger commands.
+var rfqLedgerFlags = []cli.Flag{
+	cli.StringFlag{
+		Name: rfqPeerPubKeyName,
+		Usage: "if set, only entries of the peer with the given hex " +
+			"encoded public key are listed",
+	},
+	cli.Int64Flag{
+		Name: rfqStartTimeName,
+		Usage: "if set, only entries created at or after the given " +
+			"unix timestamp in seconds are listed",
+	},
+	cli.Int64Flag{
+		Name: rfqEndTimeName,
+		Usage: "if set, only entries created before the given unix " +
+			"timestamp in seconds are listed",
+	},
+}
+
+// parseRfqLedgerFlags parses the optional peer filter of the quote ledger
+// commands.
+func parseRfqLedgerFlags(ctx *cli.Context) ([]byte, error) {
+	if !ctx.IsSet(rfqPeerPubKeyName) {
+		return nil, nil
+	}
+
+	peerPubKey, err := hex.DecodeString(ctx.String(rfqPeerPubKeyName))
+	if err != nil {
+		return nil, fmt.Errorf("invalid peer public key: %w", err)
+	}
+
+	return peerPubKey, nil
+}
+
+var rfqQuotesCommand = cli.Command{
+	Name:  "quotes",
+	Usage: "list the quote ledger",
+	Description: "List all quotes that were requested by this node or " +
+		"by its peers, together with their current status.",
+	Flags:  rfqLedgerFlags,
+	Action: rfqQuotes,
+}
+
+func rfqQuotes(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getRfqClient(ctx)
+	defer cleanUp()
+
+	peerPubKey, err := parseRfqLedgerFlags(ctx)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.ListQuotes(ctxc, &rfqrpc.ListQuotesRequest{
+		PeerPubKey:     peerPubKey,
+		StartTimestamp: ctx.Int64(rfqStartTimeName),
+		EndTimestamp:   ctx.Int64(rfqEndTimeName),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to list quotes: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var rfqTradesCommand = cli.Command{
+	Name:  "trades",
+	Usage: "list the HTLCs accepted against our quotes",
+	Description: "List the HTLCs that this node accepted against the " +
+		"quotes it issued to its peers.",
+	Flags:  rfqLedgerFlags,
+	Action: rfqTrades,
+}
+
+func rfqTrades(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getRfqClient(ctx)
+	defer cleanUp()
+
+	peerPubKey, err := parseRfqLedgerFlags(ctx)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.ListTrades(ctxc, &rfqrpc.ListTradesRequest{
+		PeerPubKey:     peerPubKey,
+		StartTimestamp: ctx.Int64(rfqStartTimeName),
+		EndTimestamp:   ctx.Int64(rfqEndTimeName),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to list trades: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
@@ -4,6 +4,9 @@ import (
 	"bytes"
 	"encoding/hex"
 	"fmt"
+	"io"
+	"os"
+	"strings"
 
 	"github.com/btcsuite/btcd/wire"
 	tap "github.com/lightninglabs/taproot-assets"
@@ -42,12 +45,17 @@ var universeCommands = []cli.Command{
 			universeRootsCommand,
 			universeDeleteRootCommand,
 			universeLeavesCommand,
+			universeSubscribeCommand,
+			universeExportCommand,
+			universeImportCommand,
 			universeKeysCommand,
 			universeProofCommand,
 			universeSyncCommand,
 			universeFederationCommand,
 			universeInfoCommand,
 			universeStatsCommand,
+			universeCommitmentsCommand,
+			universePolicyCommand,
 		},
 	},
 }
@@ -390,8 +398,259 @@ func universeLeaves(ctx *cli.Context) error {
 	return nil
 }
 
+const (
+	cursorName = "cursor"
+
+	existingName = "existing"
+)
+
+var universeSubscribeCommand = cli.Command{
+	Name:  "subscribe",
+	Usage: "subscribe to new leaves inserted into the Universe",
+	Description: `
+	Get live updates on the leaves that are inserted into the Universe,
+	optionally limited to an asset ID, group key or proof type. Each leaf
+	carries a cursor that can be passed back in together with --existing to
+	resume the subscription without missing any leaves. This command will
+	block until aborted manually by hitting Ctrl+C.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: assetIDName,
+			Usage: "if set, only leaves of the given asset ID " +
+				"are shown",
+		},
+		cli.StringFlag{
+			Name: groupKeyName,
+			Usage: "if set, only leaves of the universe of the " +
+				"given group key are shown",
+		},
+		cli.StringFlag{
+			Name: proofTypeName,
+			Usage: "if set, only leaves of the given proof " +
+				"type are shown, either 'issuance' or " +
+				"'transfer'",
+		},
+		cli.BoolFlag{
+			Name: existingName,
+			Usage: "if true, the leaves that were inserted before " +
+				"the subscription was started are shown first",
+		},
+		cli.Uint64Flag{
+			Name: cursorName,
+			Usage: "only show existing leaves that were inserted " +
+				"after the leaf with the given cursor",
+		},
+	},
+	Action: universeSubscribe,
+}
+
+func universeSubscribe(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getUniverseClient(ctx)
+	defer cleanUp()
+
+	req := &unirpc.SubscribeLeavesRequest{
+		AssetIdStr:      ctx.String(assetIDName),
+		GroupKeyStr:     ctx.String(groupKeyName),
+		DeliverExisting: ctx.Bool(existingName),
+		AfterCursor:     ctx.Uint64(cursorName),
+	}
+
+	if ctx.IsSet(proofTypeName) {
+		rpcProofType, err := parseProofType(ctx)
+		if err != nil {
+			return err
+		}
+		req.ProofType = *rpcProofType
+	}
+
+	stream, err := client.SubscribeLeaves(ctxc, req)
+	if err != nil {
+		return fmt.Errorf("unable to subscribe to leaves: %w", err)
+	}
+
+	for {
+		event, err := stream.Recv()
+		if err != nil {
+			return fmt.Errorf("unable to receive event: %w", err)
+		}
+
+		printRespJSON(event)
+	}
+}
+
+const (
+	outputFileName = "output_file"
+
+	inputFileName = "input_file"
+
+	// snapshotChunkSize is the size of the chunks a snapshot file is
+	// streamed to the server in.
+	snapshotChunkSize = 1024 * 1024
+)
+
+var universeExportCommand = cli.Command{
+	Name:  "export",
+	Usage: "export a snapshot of the Universe to a file",
+	Description: `
+	Export the roots, leaf keys and proofs of a single Universe, specified
+	by either --asset_id or --group_key and --proof_type, to a snapshot
+	file. If no Universe is specified, all known Universes are exported.
+	The snapshot can be loaded into another Universe server with the
+	import command.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  assetIDName,
+			Usage: "the asset ID of the universe to export",
+		},
+		cli.StringFlag{
+			Name:  groupKeyName,
+			Usage: "the group key of the universe to export",
+		},
+		cli.StringFlag{
+			Name: proofTypeName,
+			Usage: "the type of proof of the universe to export, " +
+				"either 'issuance' or 'transfer'",
+			Value: universe.ProofTypeIssuance.String(),
+		},
+		cli.StringFlag{
+			Name:  outputFileName,
+			Usage: "the file to write the snapshot to",
+		},
+	},
+	Action: universeExport,
+}
+
+func universeExport(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getUniverseClient(ctx)
+	defer cleanUp()
+
+	if ctx.String(outputFileName) == "" {
+		return fmt.Errorf("output file must be specified")
+	}
+
+	req := &unirpc.ExportSnapshotRequest{}
+	universeID, err := parseUniverseID(ctx, false)
+	if err != nil {
+		return err
+	}
+	if universeID != nil {
+		req.Universes = []*unirpc.ID{universeID}
+	}
+
+	stream, err := client.ExportSnapshot(ctxc, req)
+	if err != nil {
+		return fmt.Errorf("unable to export snapshot: %w", err)
+	}
+
+	filePath := lncfg.CleanAndExpandPath(ctx.String(outputFileName))
+	file, err := os.OpenFile(
+		filePath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, defaultFilePerms,
+	)
+	if err != nil {
+		return fmt.Errorf("unable to create file %v: %w", filePath, err)
+	}
+	defer file.Close()
+
+	var numBytes int
+	for {
+		chunk, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("unable to receive snapshot: %w", err)
+		}
+
+		if _, err := file.Write(chunk.Data); err != nil {
+			return fmt.Errorf("unable to write snapshot: %w", err)
+		}
+		numBytes += len(chunk.Data)
+	}
+
+	if err := file.Sync(); err != nil {
+		return fmt.Errorf("unable to write snapshot: %w", err)
+	}
+
+	fmt.Printf("Wrote %d bytes to %v\n", numBytes, filePath)
+
+	return nil
+}
+
+var universeImportCommand = cli.Command{
+	Name:  "import",
+	Usage: "import a snapshot of a Universe from a file",
+	Description: `
+	Import a snapshot file that was created by the export command. All
+	proofs are fully verified before they're inserted, and the resulting
+	root of each Universe must match the root recorded in the snapshot.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  inputFileName,
+			Usage: "the snapshot file to import",
+		},
+	},
+	Action: universeImport,
+}
+
+func universeImport(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getUniverseClient(ctx)
+	defer cleanUp()
+
+	if ctx.String(inputFileName) == "" {
+		return fmt.Errorf("input file must be specified")
+	}
+
+	filePath := lncfg.CleanAndExpandPath(ctx.String(inputFileName))
+	file, err := os.Open(filePath)
+	if err != nil {
+		return fmt.Errorf("unable to open file %v: %w", filePath, err)
+	}
+	defer file.Close()
+
+	stream, err := client.ImportSnapshot(ctxc)
+	if err != nil {
+		return fmt.Errorf("unable to import snapshot: %w", err)
+	}
+
+	buf := make([]byte, snapshotChunkSize)
+	for {
+		n, err := file.Read(buf)
+		if n > 0 {
+			err := stream.Send(&unirpc.SnapshotChunk{
+				Data: buf[:n],
+			})
+			if err != nil {
+				return fmt.Errorf("unable to send snapshot: %w",
+					err)
+			}
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("unable to read snapshot: %w", err)
+		}
+	}
+
+	resp, err := stream.CloseAndRecv()
+	if err != nil {
+		return fmt.Errorf("unable to import snapshot: %w", err)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
 const (
 	outpointName = "outpoint"
+
+	leafKeyName = "leaf_key"
 )
 
 var universeProofArgs = []cli.Flag{
@@ -431,11 +690,14 @@ var universeProofCommand = cli.Command{
 	on chain. Proofs are namespaced based on a top level assetID/groupKey,
 	so that must be specified for each command.
 
-	Two sub-commands are available: proof querying (query) and proof
-	insertion (insert).
+	Four sub-commands are available: proof querying (query), batched proof
+	querying with a single multi-proof (query-batch), querying proofs tied
+	to an on-chain commitment (committed) and proof insertion (insert).
 	`,
 	Subcommands: []cli.Command{
 		universeProofQueryCommand,
+		universeProofQueryBatchCommand,
+		universeProofCommittedCommand,
 		universeProofInsertInsert,
 	},
 }
@@ -461,6 +723,13 @@ func parseAssetKey(ctx *cli.Context) (*unirpc.AssetKey, error) {
 		return nil, err
 	}
 
+	return newAssetKey(*outpoint, ctx.String(scriptKeyName)), nil
+}
+
+// newAssetKey creates a universe leaf key from an outpoint and a script key.
+func newAssetKey(outpoint wire.OutPoint,
+	scriptKeyStr string) *unirpc.AssetKey {
+
 	return &unirpc.AssetKey{
 		Outpoint: &unirpc.AssetKey_Op{
 			Op: &unirpc.Outpoint{
@@ -469,9 +738,9 @@ func parseAssetKey(ctx *cli.Context) (*unirpc.AssetKey, error) {
 			},
 		},
 		ScriptKey: &unirpc.AssetKey_ScriptKeyStr{
-			ScriptKeyStr: ctx.String(scriptKeyName),
+			ScriptKeyStr: scriptKeyStr,
 		},
-	}, nil
+	}
 }
 
 func universeProofQuery(ctx *cli.Context) error {
@@ -500,6 +769,127 @@ func universeProofQuery(ctx *cli.Context) error {
 	return nil
 }
 
+var universeProofQueryBatchCommand = cli.Command{
+	Name:  "query-batch",
+	Usage: "query for a batch of universe proofs with a multi-proof",
+	Description: `
+	Attempt to query the target universe for the proofs of a batch of
+	leaves. All leaves are proven against the same universe root with a
+	single multi-proof. Leaf keys that aren't included in the universe are
+	returned without an asset leaf, and their non-inclusion is proven by
+	the multi-proof.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  assetIDName,
+			Usage: "the asset ID of the universe to query for",
+		},
+		cli.StringFlag{
+			Name:  groupKeyName,
+			Usage: "the group key of the universe to query for",
+		},
+		cli.StringFlag{
+			Name: proofTypeName,
+			Usage: "the type of proof to query for, either " +
+				"'issuance' or 'transfer'",
+			Value: universe.ProofTypeIssuance.String(),
+		},
+		cli.StringSliceFlag{
+			Name: leafKeyName,
+			Usage: "the leaf key to query a proof for, in the " +
+				"form of <outpoint>:<script_key>; can be " +
+				"specified multiple times",
+		},
+	},
+	Action: universeProofQueryBatch,
+}
+
+// parseLeafKeyStr parses a leaf key of the form <txid>:<index>:<script_key>.
+func parseLeafKeyStr(leafKeyStr string) (*unirpc.AssetKey, error) {
+	idx := strings.LastIndex(leafKeyStr, ":")
+	if idx == -1 {
+		return nil, fmt.Errorf("invalid leaf key %v, expected "+
+			"<outpoint>:<script_key>", leafKeyStr)
+	}
+
+	outpoint, err := wire.NewOutPointFromString(leafKeyStr[:idx])
+	if err != nil {
+		return nil, fmt.Errorf("invalid leaf key outpoint: %w", err)
+	}
+
+	return newAssetKey(*outpoint, leafKeyStr[idx+1:]), nil
+}
+
+func universeProofQueryBatch(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getUniverseClient(ctx)
+	defer cleanUp()
+
+	leafKeyStrs := ctx.StringSlice(leafKeyName)
+	if len(leafKeyStrs) == 0 {
+		return fmt.Errorf("at least one leaf key must be set")
+	}
+
+	leafKeys, err := fn.MapErr(leafKeyStrs, parseLeafKeyStr)
+	if err != nil {
+		return err
+	}
+
+	universeID, err := parseUniverseID(ctx, true)
+	if err != nil {
+		return err
+	}
+	resp, err := client.QueryProofs(ctxc, &unirpc.QueryProofsRequest{
+		Id:       universeID,
+		LeafKeys: leafKeys,
+	})
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var universeProofCommittedCommand = cli.Command{
+	Name:  "committed",
+	Usage: "query for an issuance proof tied to an on-chain commitment",
+	Description: `
+	Attempt to query the target universe for a given issuance proof, along
+	with the most recent on-chain commitment of the multiverse root that
+	includes the proof. The multiverse inclusion proof is relative to the
+	committed multiverse root.
+	`,
+	Flags:  universeProofArgs,
+	Action: universeProofCommitted,
+}
+
+func universeProofCommitted(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getUniverseClient(ctx)
+	defer cleanUp()
+
+	assetKey, err := parseAssetKey(ctx)
+	if err != nil {
+		return err
+	}
+
+	universeID, err := parseUniverseID(ctx, true)
+	if err != nil {
+		return err
+	}
+	resp, err := client.QueryCommittedProof(ctxc, &unirpc.UniverseKey{
+		Id:      universeID,
+		LeafKey: assetKey,
+	})
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
 var universeProofInsertInsert = cli.Command{
 	Name:  "insert",
 	Usage: "insert a new universe proof",
@@ -672,6 +1062,8 @@ var universeFederationCommand = cli.Command{
 		universeFederationAddCommand,
 		universeFederationDelCommand,
 		universeFederationConfigCommand,
+		universeFederationPushQueueCommand,
+		universeFederationDivergencesCommand,
 	},
 }
 
@@ -795,6 +1187,89 @@ func universeFederationDel(ctx *cli.Context) error {
 	return nil
 }
 
+var universeFederationPushQueueCommand = cli.Command{
+	Name:      "pushqueue",
+	ShortName: "q",
+	Description: `
+	List the proof pushes to Federation servers that haven't completed yet.
+	Failed pushes are retried in the background with an exponential backoff
+	until they either succeed or the maximum number of attempts is reached.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: universeHostName,
+			Usage: "if set, only the pushes to the server with " +
+				"the given host:port are listed",
+		},
+	},
+	Action: universeFederationPushQueue,
+}
+
+func universeFederationPushQueue(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getUniverseClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.ListFederationPushQueue(
+		ctxc, &unirpc.ListFederationPushQueueRequest{
+			ServerHost: ctx.String(universeHostName),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var universeFederationDivergencesCommand = cli.Command{
+	Name:      "divergences",
+	ShortName: "d",
+	Description: `
+	List the universes whose local root still didn't match the root of a
+	Federation server after syncing with that server, most recent first.
+	The keys of the leaves of the server that couldn't be synced are
+	included, which helps to identify equivocating or corrupted servers.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: universeHostName,
+			Usage: "if set, only the divergences for the server " +
+				"with the given host:port are listed",
+		},
+		cli.Int64Flag{
+			Name:  limitName,
+			Usage: "the max number of divergences returned",
+		},
+		cli.Int64Flag{
+			Name:  offsetName,
+			Usage: "the number of divergences to skip",
+		},
+	},
+	Action: universeFederationDivergences,
+}
+
+func universeFederationDivergences(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getUniverseClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.QueryDivergences(
+		ctxc, &unirpc.QueryDivergencesRequest{
+			ServerHost: ctx.String(universeHostName),
+			Offset:     int32(ctx.Int64(offsetName)),
+			Limit:      int32(ctx.Int64(limitName)),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
 var universeFederationConfigCommand = cli.Command{
 	Name:      "config",
 	ShortName: "c",
@@ -1427,3 +1902,205 @@ func universeEventStatsQueryCommand(ctx *cli.Context) error {
 	printRespJSON(resp)
 	return nil
 }
+
+var universeCommitmentsCommand = cli.Command{
+	Name:  "commitments",
+	Usage: "list the on-chain commitments of the multiverse root",
+	Description: `
+	List the on-chain commitments of the issuance multiverse root of the
+	target universe, most recent first.
+	`,
+	Action: universeCommitments,
+}
+
+func universeCommitments(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getUniverseClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.ListCommitments(
+		ctxc, &unirpc.ListCommitmentsRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var universePolicyCommand = cli.Command{
+	Name:  "policy",
+	Usage: "Manage the admission policy of the local Universe",
+	Description: `
+	Manage the admission policy for proofs that remote clients insert into
+	the local Universe, either directly or through the universe proof
+	courier. The allow and deny rules and the maximum proof size also apply
+	to proofs synced from the Federation or imported from a snapshot, while
+	the insertion rate only applies to remote clients.
+	`,
+	Subcommands: []cli.Command{
+		universePolicySetCommand,
+		universePolicyInfoCommand,
+	},
+}
+
+const (
+	allowAssetIDName        = "allow_asset_id"
+	denyAssetIDName         = "deny_asset_id"
+	allowGroupKeyName       = "allow_group_key"
+	denyGroupKeyName        = "deny_group_key"
+	maxProofSizeName        = "max_proof_size"
+	maxInsertsPerMinuteName = "max_inserts_per_minute"
+)
+
+var universePolicySetCommand = cli.Command{
+	Name:      "set",
+	ShortName: "s",
+	Usage:     "replace the admission policy",
+	Description: `
+	Replace the admission policy of the local Universe. If any asset ID or
+	group key is allowed, then only the proofs of allowed assets are
+	admitted. Denied assets are never admitted, even if they're also
+	allowed through their group. Calling set without any flags removes all
+	restrictions.
+	`,
+	Flags: []cli.Flag{
+		cli.StringSliceFlag{
+			Name: allowAssetIDName,
+			Usage: "the hex encoded ID of an asset to admit, can " +
+				"be specified multiple times",
+		},
+		cli.StringSliceFlag{
+			Name: denyAssetIDName,
+			Usage: "the hex encoded ID of an asset to reject, " +
+				"can be specified multiple times",
+		},
+		cli.StringSliceFlag{
+			Name: allowGroupKeyName,
+			Usage: "the hex encoded group key of an asset group " +
+				"to admit, can be specified multiple times",
+		},
+		cli.StringSliceFlag{
+			Name: denyGroupKeyName,
+			Usage: "the hex encoded group key of an asset group " +
+				"to reject, can be specified multiple times",
+		},
+		cli.Uint64Flag{
+			Name: maxProofSizeName,
+			Usage: "the max size in bytes of an inserted proof, " +
+				"zero means no limit",
+		},
+		cli.Uint64Flag{
+			Name: maxInsertsPerMinuteName,
+			Usage: "the max number of proofs a single client can " +
+				"insert per minute, zero means no limit",
+		},
+	},
+	Action: universePolicySet,
+}
+
+// parseAdmissionRules parses the hex encoded values of the given flag into
+// admission rules of the given type that target either asset IDs or group
+// keys.
+func parseAdmissionRules(ctx *cli.Context, flagName string,
+	ruleType unirpc.AdmissionRuleType,
+	isGroupKey bool) ([]*unirpc.AdmissionRule, error) {
+
+	var rules []*unirpc.AdmissionRule
+	for _, hexStr := range ctx.StringSlice(flagName) {
+		target, err := hex.DecodeString(hexStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %v %v: %w", flagName,
+				hexStr, err)
+		}
+
+		rule := &unirpc.AdmissionRule{
+			RuleType: ruleType,
+		}
+		if isGroupKey {
+			rule.GroupKey = target
+		} else {
+			rule.AssetId = target
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return rules, nil
+}
+
+func universePolicySet(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getUniverseClient(ctx)
+	defer cleanUp()
+
+	var (
+		allow = unirpc.AdmissionRuleType_ADMISSION_RULE_TYPE_ALLOW
+		deny  = unirpc.AdmissionRuleType_ADMISSION_RULE_TYPE_DENY
+	)
+	ruleFlags := []struct {
+		name       string
+		ruleType   unirpc.AdmissionRuleType
+		isGroupKey bool
+	}{
+		{allowAssetIDName, allow, false},
+		{denyAssetIDName, deny, false},
+		{allowGroupKeyName, allow, true},
+		{denyGroupKeyName, deny, true},
+	}
+
+	var rules []*unirpc.AdmissionRule
+	for _, ruleFlag := range ruleFlags {
+		flagRules, err := parseAdmissionRules(
+			ctx, ruleFlag.name, ruleFlag.ruleType,
+			ruleFlag.isGroupKey,
+		)
+		if err != nil {
+			return err
+		}
+
+		rules = append(rules, flagRules...)
+	}
+
+	resp, err := client.SetAdmissionPolicy(
+		ctxc, &unirpc.SetAdmissionPolicyRequest{
+			Policy: &unirpc.AdmissionPolicy{
+				Rules:        rules,
+				MaxProofSize: ctx.Uint64(maxProofSizeName),
+				MaxInsertsPerMinute: uint32(
+					ctx.Uint64(maxInsertsPerMinuteName),
+				),
+			},
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var universePolicyInfoCommand = cli.Command{
+	Name:      "info",
+	ShortName: "i",
+	Usage:     "get the current admission policy",
+	Action:    universePolicyInfo,
+}
+
+func universePolicyInfo(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getUniverseClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.QueryAdmissionPolicy(
+		ctxc, &unirpc.QueryAdmissionPolicyRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/lightningnetwork/lnd"
 	"github.com/lightningnetwork/lnd/build"
 	"github.com/lightningnetwork/lnd/signal"
+	"github.com/lightningnetwork/lnd/tor"
 	"golang.org/x/time/rate"
 	"google.golang.org/grpc"
 )
@@ -84,6 +85,11 @@ type Config struct {
 	// connecting to itself as a federation member.
 	RuntimeID int64
 
+	// Net is the network used for outbound connections to universe
+	// servers. It routes connections through a Tor proxy if one is
+	// configured.
+	Net tor.Net
+
 	ChainParams address.ChainParams
 
 	Lnd *lndclient.LndServices
@@ -121,6 +127,18 @@ type Config struct {
 
 	UniverseFederation *universe.FederationEnvoy
 
+	// UniverseAdmission enforces the admission policy for proofs inserted
+	// by remote clients.
+	UniverseAdmission *universe.AdmissionController
+
+	// UniverseCanonical periodically commits the issuance multiverse root
+	// to the chain and serves issuance proofs tied to those commitments.
+	UniverseCanonical *universe.CanonicalUniverse
+
+	// UniversePruner periodically prunes superseded transfer proofs from
+	// the transfer universes.
+	UniversePruner *universe.TransferPruner
+
 	RfqManager *rfq.Manager
 
 	UniverseStats universe.Telemetry
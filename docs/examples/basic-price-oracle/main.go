@@ -0,0 +1,180 @@
+// Package main implements a basic reference price oracle RPC server. It serves
+// the priceoraclerpc.PriceOracle service over TLS using a self-signed
+// certificate and quotes every asset at a fixed price per asset unit.
+//
+// Start it with:
+//
+//	go run ./docs/examples/basic-price-oracle --listen=localhost:8095
+//
+// and point tapd at it with:
+//
+//	--experimental.rfq.priceoracleaddress=rfqrpc://localhost:8095
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"flag"
+	"fmt"
+	"log"
+	"net"
+	"time"
+
+	oraclerpc "github.com/lightninglabs/taproot-assets/taprpc/priceoraclerpc"
+	"github.com/lightningnetwork/lnd/cert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+const (
+	// defaultListenAddr is the default address the oracle listens on.
+	defaultListenAddr = "localhost:8095"
+
+	// defaultMsatPerUnit is the default price of a single asset unit in
+	// millisatoshi.
+	defaultMsatPerUnit = 1000
+
+	// defaultPriceLifetime is the default amount of time for which a quoted
+	// price is valid.
+	defaultPriceLifetime = 5 * time.Minute
+)
+
+// RpcPriceOracleServer is a basic example RPC price oracle server. It quotes
+// the same fixed price per asset unit for both asks and bids.
+type RpcPriceOracleServer struct {
+	oraclerpc.UnimplementedPriceOracleServer
+
+	// msatPerUnit is the price of a single asset unit in millisatoshi.
+	msatPerUnit uint64
+
+	// lifetime is the amount of time for which a quoted price is valid.
+	lifetime time.Duration
+}
+
+// isSupportedAsset returns true if the given asset specifier is well formed.
+// A production oracle would look the asset up in its own price feed here.
+func isSupportedAsset(specifier *oraclerpc.AssetSpecifier) bool {
+	return specifier != nil && specifier.GetId() != nil
+}
+
+// newQuote computes a price quote for the given asset amount.
+func (p *RpcPriceOracleServer) newQuote(
+	assetAmount uint64) *oraclerpc.PriceQuote {
+
+	return &oraclerpc.PriceQuote{
+		Price: assetAmount * p.msatPerUnit,
+		ExpiryTimestamp: uint64(
+			time.Now().Add(p.lifetime).Unix(),
+		),
+	}
+}
+
+// QueryAskPrice returns the asking price for the given asset amount.
+func (p *RpcPriceOracleServer) QueryAskPrice(_ context.Context,
+	req *oraclerpc.QueryAskPriceRequest) (*oraclerpc.QueryAskPriceResponse,
+	error) {
+
+	if !isSupportedAsset(req.AssetSpecifier) {
+		return &oraclerpc.QueryAskPriceResponse{
+			Result: &oraclerpc.QueryAskPriceResponse_Error{
+				Error: &oraclerpc.OracleError{
+					Code:    1,
+					Message: "unsupported asset",
+				},
+			},
+		}, nil
+	}
+
+	log.Printf("Quoting ask price (asset_amount=%d, suggested_bid=%d, "+
+		"suggested_bid_expiry=%d)", req.AssetAmount,
+		req.SuggestedBidPrice, req.SuggestedBidPriceExpiry)
+
+	return &oraclerpc.QueryAskPriceResponse{
+		Result: &oraclerpc.QueryAskPriceResponse_Success{
+			Success: p.newQuote(req.AssetAmount),
+		},
+	}, nil
+}
+
+// QueryBidPrice returns a bid price for the given asset amount.
+func (p *RpcPriceOracleServer) QueryBidPrice(_ context.Context,
+	req *oraclerpc.QueryBidPriceRequest) (*oraclerpc.QueryBidPriceResponse,
+	error) {
+
+	if !isSupportedAsset(req.AssetSpecifier) {
+		return &oraclerpc.QueryBidPriceResponse{
+			Result: &oraclerpc.QueryBidPriceResponse_Error{
+				Error: &oraclerpc.OracleError{
+					Code:    1,
+					Message: "unsupported asset",
+				},
+			},
+		}, nil
+	}
+
+	log.Printf("Quoting bid price (asset_amount=%d, suggested_ask=%d, "+
+		"suggested_ask_expiry=%d)", req.AssetAmount,
+		req.SuggestedAskPrice, req.SuggestedAskPriceExpiry)
+
+	return &oraclerpc.QueryBidPriceResponse{
+		Result: &oraclerpc.QueryBidPriceResponse_Success{
+			Success: p.newQuote(req.AssetAmount),
+		},
+	}, nil
+}
+
+// serverTLSCreds generates a self-signed certificate and returns the transport
+// credentials for the oracle gRPC server.
+func serverTLSCreds() (credentials.TransportCredentials, error) {
+	certBytes, keyBytes, err := cert.GenCertPair(
+		"basic price oracle", nil, nil, false, 24*time.Hour,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to generate cert pair: %w", err)
+	}
+
+	tlsCert, err := tls.X509KeyPair(certBytes, keyBytes)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load cert pair: %w", err)
+	}
+
+	return credentials.NewTLS(&tls.Config{
+		Certificates: []tls.Certificate{tlsCert},
+	}), nil
+}
+
+func main() {
+	listenAddr := flag.String(
+		"listen", defaultListenAddr, "address to listen on",
+	)
+	msatPerUnit := flag.Uint64(
+		"msatperunit", defaultMsatPerUnit,
+		"price of a single asset unit in millisatoshi",
+	)
+	lifetime := flag.Duration(
+		"lifetime", defaultPriceLifetime,
+		"amount of time for which a quoted price is valid",
+	)
+	flag.Parse()
+
+	creds, err := serverTLSCreds()
+	if err != nil {
+		log.Fatalf("Unable to create TLS credentials: %v", err)
+	}
+
+	listener, err := net.Listen("tcp", *listenAddr)
+	if err != nil {
+		log.Fatalf("Unable to listen on %v: %v", *listenAddr, err)
+	}
+
+	grpcServer := grpc.NewServer(grpc.Creds(creds))
+	oraclerpc.RegisterPriceOracleServer(grpcServer, &RpcPriceOracleServer{
+		msatPerUnit: *msatPerUnit,
+		lifetime:    *lifetime,
+	})
+
+	log.Printf("Price oracle listening on %v", listener.Addr())
+	if err := grpcServer.Serve(listener); err != nil {
+		log.Fatalf("Price oracle server failed: %v", err)
+	}
+}
@@ -118,6 +118,11 @@ type harnessOpts struct {
 	// sync ticker will fire.
 	fedSyncTickerInterval *time.Duration
 
+	// fedPushRetryBackoff is the fixed backoff the federation envoy uses
+	// to retry failed proof pushes, and to quarantine federation servers
+	// that keep failing.
+	fedPushRetryBackoff *time.Duration
+
 	// sqliteDatabaseFilePath is the path to the SQLite database file to
 	// use.
 	sqliteDatabaseFilePath *string
@@ -273,6 +278,14 @@ func newTapdHarness(t *testing.T, ht *harnessTest, cfg tapdConfig,
 		finalCfg.Universe.SyncInterval = *opts.fedSyncTickerInterval
 	}
 
+	if opts.fedPushRetryBackoff != nil {
+		backoff := *opts.fedPushRetryBackoff
+		finalCfg.Universe.PushRetryInitialBackoff = backoff
+		finalCfg.Universe.PushRetryMaxBackoff = backoff
+		finalCfg.Universe.QuarantineInitialBackoff = backoff
+		finalCfg.Universe.QuarantineMaxBackoff = backoff
+	}
+
 	return &tapdHarness{
 		cfg:       &cfg,
 		clientCfg: finalCfg,
@@ -372,6 +372,11 @@ type tapdHarnessParams struct {
 	// sync ticker will fire.
 	fedSyncTickerInterval *time.Duration
 
+	// fedPushRetryBackoff is the fixed backoff the federation envoy uses
+	// to retry failed proof pushes, and to quarantine federation servers
+	// that keep failing.
+	fedPushRetryBackoff *time.Duration
+
 	// noDefaultUniverseSync indicates whether the default universe server
 	// should be added as a federation server or not.
 	noDefaultUniverseSync bool
@@ -413,6 +418,7 @@ func setupTapdHarness(t *testing.T, ht *harnessTest,
 		ho.custodianProofRetrievalDelay = params.custodianProofRetrievalDelay
 		ho.addrAssetSyncerDisable = params.addrAssetSyncerDisable
 		ho.fedSyncTickerInterval = params.fedSyncTickerInterval
+		ho.fedPushRetryBackoff = params.fedPushRetryBackoff
 		ho.sqliteDatabaseFilePath = params.sqliteDatabaseFilePath
 	}
 
@@ -209,6 +209,14 @@ var testCases = []*testCase{
 		name: "universe sync",
 		test: testUniverseSync,
 	},
+	{
+		name: "universe snapshot",
+		test: testUniverseSnapshot,
+	},
+	{
+		name: "universe query proofs",
+		test: testUniverseQueryProofs,
+	},
 	{
 		name: "universe sync manual insert",
 		test: testUniverseManualSync,
@@ -2,10 +2,12 @@ package itest
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/lightninglabs/taproot-assets/taprpc/mintrpc"
 	unirpc "github.com/lightninglabs/taproot-assets/taprpc/universerpc"
+	"github.com/lightningnetwork/lnd/lntest/wait"
 	"github.com/stretchr/testify/require"
 )
 
@@ -14,14 +16,16 @@ import (
 // node is offline at the time of the initial sync attempt.
 func testMintProofRepeatFedSyncAttempt(t *harnessTest) {
 	// Create a new minting node, without hooking it up to any existing
-	// Universe server. We will also set the sync ticker to 2 second, so
-	// that we can test that the proof push sync is retried and eventually
-	// succeeds after the fed server peer node reappears online.
+	// Universe server. We will also set the sync ticker and the proof push
+	// retry backoff to 2 seconds, so that we can test that the proof push
+	// sync is retried and eventually succeeds after the fed server peer
+	// node reappears online.
 	syncTickerInterval := 2 * time.Second
 	mintingNode := setupTapdHarness(
 		t.t, t, t.lndHarness.Bob, nil,
 		func(params *tapdHarnessParams) {
 			params.fedSyncTickerInterval = &syncTickerInterval
+			params.fedPushRetryBackoff = &syncTickerInterval
 			params.noDefaultUniverseSync = true
 		},
 	)
@@ -82,9 +86,35 @@ func testMintProofRepeatFedSyncAttempt(t *harnessTest) {
 	// proof push sync.
 	time.Sleep(syncTickerInterval * 2)
 
+	// The failed proof pushes should now be queued for a retry.
+	pushQueue, err := mintingNode.ListFederationPushQueue(
+		ctxt, &unirpc.ListFederationPushQueueRequest{
+			ServerHost: fedServerNodeRpcHost,
+		},
+	)
+	require.NoError(t.t, err)
+	require.NotEmpty(t.t, pushQueue.Pushes)
+	for _, push := range pushQueue.Pushes {
+		require.False(t.t, push.Exhausted)
+	}
+
+	// The failed pushes should also be reflected in the health of the
+	// federation server.
+	fedServers, err := mintingNode.ListFederationServers(
+		ctxt, &unirpc.ListFederationServersRequest{},
+	)
+	require.NoError(t.t, err)
+	require.Len(t.t, fedServers.Servers, 1)
+
+	fedServerHealth := fedServers.Servers[0].Health
+	require.NotNil(t.t, fedServerHealth)
+	require.NotZero(t.t, fedServerHealth.ConsecutiveFailures)
+	require.NotZero(t.t, fedServerHealth.LastFailureTimestamp)
+	require.NotEmpty(t.t, fedServerHealth.LastError)
+
 	// Start the federation server peer node. The federation envoy component
 	// of our minting node should currently be retrying the proof push sync
-	// with the federation peer at each tick.
+	// with the federation peer after each backoff.
 	t.Logf("Start (previously stopped) fed server tapd node")
 	err = fedServerNode.start(false)
 	require.NoError(t.t, err)
@@ -96,4 +126,35 @@ func testMintProofRepeatFedSyncAttempt(t *harnessTest) {
 
 	t.Logf("Assert that fed peer node has seen the asset minting proofs")
 	AssertUniverseStats(t.t, fedServerNode, 2, 2, 1)
+
+	// Once all proofs were pushed, the push queue should be empty.
+	err = wait.NoError(func() error {
+		pushQueue, err := mintingNode.ListFederationPushQueue(
+			ctxt, &unirpc.ListFederationPushQueueRequest{},
+		)
+		if err != nil {
+			return err
+		}
+
+		if len(pushQueue.Pushes) != 0 {
+			return fmt.Errorf("expected empty push queue, got %d "+
+				"pushes", len(pushQueue.Pushes))
+		}
+
+		return nil
+	}, defaultWaitTimeout)
+	require.NoError(t.t, err)
+
+	// With the pushes done, the federation server should be healthy
+	// again.
+	fedServers, err = mintingNode.ListFederationServers(
+		ctxt, &unirpc.ListFederationServersRequest{},
+	)
+	require.NoError(t.t, err)
+	require.Len(t.t, fedServers.Servers, 1)
+
+	fedServerHealth = fedServers.Servers[0].Health
+	require.Zero(t.t, fedServerHealth.ConsecutiveFailures)
+	require.False(t.t, fedServerHealth.Quarantined)
+	require.NotZero(t.t, fedServerHealth.LastSuccessTimestamp)
 }
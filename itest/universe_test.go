@@ -89,6 +89,9 @@ func testUniverseSync(t *harnessTest) {
 		// A single new leaf should be present.
 		require.Len(t.t, uniDiff.NewAssetLeaves, 1)
 
+		// After the sync, our root should match the remote root.
+		require.Nil(t.t, uniDiff.Divergence)
+
 		// The new root should match the root we got from the primary
 		// node above.
 		newRoot := uniDiff.NewAssetRoot
@@ -138,6 +141,13 @@ func testUniverseSync(t *harnessTest) {
 		t.t, AssertUniverseRootsEqual(universeRoots, universeRootsBob),
 	)
 
+	// As the sync was clean, no divergences should have been recorded.
+	divergences, err := bob.QueryDivergences(
+		ctxt, &unirpc.QueryDivergencesRequest{},
+	)
+	require.NoError(t.t, err)
+	require.Empty(t.t, divergences.Divergences)
+
 	// Finally, we'll ensure that the universe keys and leaves matches for
 	// both parties.
 	uniRoots := maps.Values(universeRoots.UniverseRoots)
@@ -293,6 +303,193 @@ func testUniverseSync(t *harnessTest) {
 	)
 }
 
+// testUniverseSnapshot tests that a snapshot of the universe can be exported
+// from one node and imported into another, resulting in the same roots.
+func testUniverseSnapshot(t *harnessTest) {
+	miner := t.lndHarness.Miner.Client
+	MintAssetsConfirmBatch(t.t, miner, t.tapd, simpleAssets)
+	MintAssetsConfirmBatch(t.t, miner, t.tapd, issuableAssets)
+
+	bob := setupTapdHarness(
+		t.t, t, t.lndHarness.Bob, t.universeServer,
+		func(params *tapdHarnessParams) {
+			params.noDefaultUniverseSync = true
+		},
+	)
+	defer func() {
+		require.NoError(t.t, bob.stop(!*noDelete))
+	}()
+
+	ctxb := context.Background()
+	ctxt, cancel := context.WithTimeout(ctxb, defaultWaitTimeout)
+	defer cancel()
+
+	// We'll export all universes of the primary node, collecting the
+	// chunks of the snapshot in memory.
+	exportStream, err := t.tapd.ExportSnapshot(
+		ctxt, &unirpc.ExportSnapshotRequest{},
+	)
+	require.NoError(t.t, err)
+
+	var snapshot bytes.Buffer
+	for {
+		chunk, err := exportStream.Recv()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t.t, err)
+
+		snapshot.Write(chunk.Data)
+	}
+
+	// Before the import, Bob doesn't know any of the universes.
+	AssertUniverseRootEquality(t.t, t.tapd, bob, false)
+
+	// We'll import the snapshot into Bob's universe in a few chunks.
+	importStream, err := bob.ImportSnapshot(ctxt)
+	require.NoError(t.t, err)
+
+	snapshotBytes := snapshot.Bytes()
+	const chunkSize = 1000
+	for len(snapshotBytes) > 0 {
+		n := min(chunkSize, len(snapshotBytes))
+		err := importStream.Send(&unirpc.SnapshotChunk{
+			Data: snapshotBytes[:n],
+		})
+		require.NoError(t.t, err)
+
+		snapshotBytes = snapshotBytes[n:]
+	}
+
+	resp, err := importStream.CloseAndRecv()
+	require.NoError(t.t, err)
+	require.Len(
+		t.t, resp.Universes, len(simpleAssets)+len(issuableAssets),
+	)
+
+	// Now both nodes should have the same set of universe roots.
+	AssertUniverseRootEquality(t.t, t.tapd, bob, true)
+
+	// Importing the same snapshot again is a no-op, as all leaves are
+	// already known.
+	importStream, err = bob.ImportSnapshot(ctxt)
+	require.NoError(t.t, err)
+	err = importStream.Send(&unirpc.SnapshotChunk{
+		Data: snapshot.Bytes(),
+	})
+	require.NoError(t.t, err)
+	_, err = importStream.CloseAndRecv()
+	require.NoError(t.t, err)
+
+	AssertUniverseRootEquality(t.t, t.tapd, bob, true)
+}
+
+// testUniverseQueryProofs tests that the proofs of several leaves of a universe
+// can be queried at once, proven by a single multi-proof.
+func testUniverseQueryProofs(t *harnessTest) {
+	miner := t.lndHarness.Miner.Client
+	rpcAssets := MintAssetsConfirmBatch(t.t, miner, t.tapd, simpleAssets)
+
+	ctxb := context.Background()
+	ctxt, cancel := context.WithTimeout(ctxb, defaultWaitTimeout)
+	defer cancel()
+
+	uniID := &unirpc.ID{
+		Id: &unirpc.ID_AssetId{
+			AssetId: rpcAssets[0].AssetGenesis.AssetId,
+		},
+		ProofType: unirpc.ProofType_PROOF_TYPE_ISSUANCE,
+	}
+	uniKeys, err := t.tapd.AssetLeafKeys(
+		ctxt, &unirpc.AssetLeafKeysRequest{
+			Id: uniID,
+		},
+	)
+	require.NoError(t.t, err)
+	require.NotEmpty(t.t, uniKeys.AssetKeys)
+
+	// We'll also query for a couple of keys that aren't in the universe.
+	leafKeys := uniKeys.AssetKeys
+	for i := 0; i < 2; i++ {
+		leafKeys = append(leafKeys, &unirpc.AssetKey{
+			Outpoint: &unirpc.AssetKey_OpStr{
+				OpStr: test.RandOp(t.t).String(),
+			},
+			ScriptKey: &unirpc.AssetKey_ScriptKeyBytes{
+				ScriptKeyBytes: schnorr.SerializePubKey(
+					test.RandPubKey(t.t),
+				),
+			},
+		})
+	}
+
+	resp, err := t.tapd.QueryProofs(ctxt, &unirpc.QueryProofsRequest{
+		Id:       uniID,
+		LeafKeys: leafKeys,
+	})
+	require.NoError(t.t, err)
+	require.Len(t.t, resp.Leaves, len(leafKeys))
+
+	// Only the keys that are in the universe have a leaf.
+	numIncluded := len(uniKeys.AssetKeys)
+	keys := make([][32]byte, len(resp.Leaves))
+	leafNodes := make([]*mssmt.LeafNode, len(resp.Leaves))
+	for i, leaf := range resp.Leaves {
+		require.Equal(
+			t.t, leafKeys[i].GetOpStr(), leaf.LeafKey.GetOpStr(),
+		)
+
+		op, err := wire.NewOutPointFromString(leaf.LeafKey.GetOpStr())
+		require.NoError(t.t, err)
+		scriptKey, err := schnorr.ParsePubKey(
+			leaf.LeafKey.GetScriptKeyBytes(),
+		)
+		require.NoError(t.t, err)
+
+		leafKey := universe.LeafKey{
+			OutPoint:  *op,
+			ScriptKey: fn.Ptr(asset.NewScriptKey(scriptKey)),
+		}
+		keys[i] = leafKey.UniverseKey()
+
+		if i >= numIncluded {
+			require.Nil(t.t, leaf.AssetLeaf)
+			leafNodes[i] = mssmt.EmptyLeafNode
+
+			continue
+		}
+
+		require.NotNil(t.t, leaf.AssetLeaf)
+		leafNodes[i] = mssmt.NewLeafNode(
+			leaf.AssetLeaf.Proof, leaf.AssetLeaf.Asset.Amount,
+		)
+	}
+
+	// The multi-proof proves all leaves against the universe root.
+	var multiProof mssmt.MultiProof
+	err = multiProof.Decode(bytes.NewReader(resp.UniverseMultiProof))
+	require.NoError(t.t, err)
+
+	uniRoot := resp.UniverseRoot.MssmtRoot
+	root := mssmt.NewComputedBranch(
+		fn.ToArray[mssmt.NodeHash](uniRoot.RootHash),
+		uint64(uniRoot.RootSum),
+	)
+	require.True(t.t, mssmt.VerifyMultiProof(
+		keys, leafNodes, &multiProof, root,
+	))
+
+	// Queries without a proof type are rejected, as a multi-proof is
+	// always for a single universe tree.
+	_, err = t.tapd.QueryProofs(ctxt, &unirpc.QueryProofsRequest{
+		Id: &unirpc.ID{
+			Id: uniID.Id,
+		},
+		LeafKeys: leafKeys,
+	})
+	require.ErrorContains(t.t, err, "proof type must be specified")
+}
+
 // testUniverseManualSync tests that we're able to insert proofs manually into
 // a universe instead of using a full sync.
 func testUniverseManualSync(t *harnessTest) {
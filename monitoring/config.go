@@ -34,6 +34,10 @@ type PrometheusConfig struct {
 	// asset minter.
 	AssetMinter tapgarden.Planter
 
+	// UniversePruner is used to collect the stats of the pruning of
+	// superseded transfer proofs.
+	UniversePruner *universe.TransferPruner
+
 	// PerfHistograms indicates if the additional histogram information for
 	// latency, and handling time of gRPC calls should be enabled. This
 	// generates additional data, and consume more memory for the
@@ -74,6 +74,12 @@ func (p *PrometheusExporter) Start() error {
 	}
 	p.registry.MustRegister(gardenCollector)
 
+	prunerCollector, err := newUniversePrunerCollector(p.config, p.registry)
+	if err != nil {
+		return err
+	}
+	p.registry.MustRegister(prunerCollector)
+
 	// Make ensure that all metrics exist when collecting and querying.
 	serverMetrics.InitializeMetrics(p.config.RPCServer)
 
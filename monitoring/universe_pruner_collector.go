@@ -0,0 +1,142 @@
+package monitoring
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	transferPruneRunsMetric = "transfer_prune_runs"
+
+	transferPruneFailedRunsMetric = "transfer_prune_failed_runs"
+
+	transferLeavesPrunedMetric = "transfer_leaves_pruned"
+
+	transferPruneNodesRemovedMetric = "transfer_prune_nodes_removed"
+
+	transferPruneLastRunMetric = "transfer_prune_last_run_timestamp"
+
+	transferPruneDurationMetric = "transfer_prune_last_run_duration_seconds"
+)
+
+// universePrunerCollector is a Prometheus collector that exports the stats of
+// the pruning of superseded transfer proofs.
+type universePrunerCollector struct {
+	collectMx sync.Mutex
+
+	cfg      *PrometheusConfig
+	registry *prometheus.Registry
+
+	gauges map[string]prometheus.Gauge
+}
+
+func newUniversePrunerCollector(cfg *PrometheusConfig,
+	registry *prometheus.Registry) (*universePrunerCollector, error) {
+
+	if cfg == nil {
+		return nil, errors.New("universe pruner collector prometheus " +
+			"cfg is nil")
+	}
+
+	if cfg.UniversePruner == nil {
+		return nil, errors.New("universe pruner collector universe " +
+			"pruner is nil")
+	}
+
+	gaugesMap := map[string]prometheus.Gauge{
+		transferPruneRunsMetric: prometheus.NewGauge(
+			prometheus.GaugeOpts{
+				Name: transferPruneRunsMetric,
+				Help: "Total number of completed transfer " +
+					"proof prune runs",
+			},
+		),
+		transferPruneFailedRunsMetric: prometheus.NewGauge(
+			prometheus.GaugeOpts{
+				Name: transferPruneFailedRunsMetric,
+				Help: "Total number of failed transfer proof " +
+					"prune runs",
+			},
+		),
+		transferLeavesPrunedMetric: prometheus.NewGauge(
+			prometheus.GaugeOpts{
+				Name: transferLeavesPrunedMetric,
+				Help: "Total number of pruned superseded " +
+					"transfer proofs",
+			},
+		),
+		transferPruneNodesRemovedMetric: prometheus.NewGauge(
+			prometheus.GaugeOpts{
+				Name: transferPruneNodesRemovedMetric,
+				Help: "Total number of MS-SMT nodes removed " +
+					"while pruning transfer proofs",
+			},
+		),
+		transferPruneLastRunMetric: prometheus.NewGauge(
+			prometheus.GaugeOpts{
+				Name: transferPruneLastRunMetric,
+				Help: "Unix timestamp of the last completed " +
+					"transfer proof prune run",
+			},
+		),
+		transferPruneDurationMetric: prometheus.NewGauge(
+			prometheus.GaugeOpts{
+				Name: transferPruneDurationMetric,
+				Help: "Duration of the last completed transfer " +
+					"proof prune run",
+			},
+		),
+	}
+
+	return &universePrunerCollector{
+		cfg:      cfg,
+		registry: registry,
+		gauges:   gaugesMap,
+	}, nil
+}
+
+// Describe sends the super-set of all possible descriptors of metrics
+// collected by this Collector to the provided channel and returns once the
+// last descriptor has been sent.
+//
+// NOTE: Part of the prometheus.Collector interface.
+func (a *universePrunerCollector) Describe(ch chan<- *prometheus.Desc) {
+	a.collectMx.Lock()
+	defer a.collectMx.Unlock()
+
+	for _, gauge := range a.gauges {
+		gauge.Describe(ch)
+	}
+}
+
+// Collect is called by the Prometheus registry when collecting metrics.
+//
+// NOTE: Part of the prometheus.Collector interface.
+func (a *universePrunerCollector) Collect(ch chan<- prometheus.Metric) {
+	a.collectMx.Lock()
+	defer a.collectMx.Unlock()
+
+	stats := a.cfg.UniversePruner.Stats()
+
+	a.gauges[transferPruneRunsMetric].Set(float64(stats.Runs))
+	a.gauges[transferPruneFailedRunsMetric].Set(float64(stats.FailedRuns))
+	a.gauges[transferLeavesPrunedMetric].Set(float64(stats.LeavesPruned))
+	a.gauges[transferPruneNodesRemovedMetric].Set(
+		float64(stats.NodesRemoved),
+	)
+
+	if !stats.LastRun.IsZero() {
+		a.gauges[transferPruneLastRunMetric].Set(
+			float64(stats.LastRun.Unix()),
+		)
+	}
+	a.gauges[transferPruneDurationMetric].Set(
+		stats.LastRunDuration.Seconds(),
+	)
+
+	for _, gauge := range a.gauges {
+		gauge.Collect(ch)
+	}
+}
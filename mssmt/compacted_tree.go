@@ -392,3 +392,75 @@ func (t *CompactedTree) MerkleProof(ctx context.Context, key [hashSize]byte) (
 
 	return NewProof(proof), nil
 }
+
+// SubtreeRoot returns the root of the subtree at the given height that lies on
+// the path to the given key. The root of the tree is at height zero and the
+// leaves are at height MaxTreeLevels, so only the first `height` bits of the
+// key are used to select the subtree. If the subtree only contains a single
+// leaf, then a CompactedLeafNode is returned, which allows the caller to
+// inspect the leaf and its key without walking further down the tree.
+func (t *CompactedTree) SubtreeRoot(ctx context.Context, height int,
+	key [hashSize]byte) (Node, error) {
+
+	if height < 0 || height > MaxTreeLevels {
+		return nil, fmt.Errorf("invalid subtree height: %d", height)
+	}
+
+	var subtreeRoot Node
+	err := t.store.View(ctx, func(tx TreeStoreViewTx) error {
+		current, err := tx.RootNode()
+		if err != nil {
+			return err
+		}
+
+		for i := 0; i < height; i++ {
+			// Once we reach an empty subtree, everything below it
+			// is empty as well.
+			if current.NodeHash() == EmptyTree[i].NodeHash() {
+				subtreeRoot = EmptyTree[height]
+				return nil
+			}
+
+			left, right, err := tx.GetChildren(
+				i, current.NodeHash(),
+			)
+			if err != nil {
+				return err
+			}
+			next, _ := stepOrder(i, &key, left, right)
+
+			compLeaf, ok := next.(*CompactedLeafNode)
+			if !ok {
+				current = next
+				continue
+			}
+
+			// The remainder of the path is compacted into a single
+			// leaf. The subtree we're looking for either contains
+			// just that leaf, or is empty if the leaf key diverges
+			// from our key before the target height.
+			leafKey := compLeaf.Key()
+			for j := i + 1; j < height; j++ {
+				if bitIndex(uint8(j), &leafKey) !=
+					bitIndex(uint8(j), &key) {
+
+					subtreeRoot = EmptyTree[height]
+					return nil
+				}
+			}
+
+			subtreeRoot = NewCompactedLeafNode(
+				height, &leafKey, compLeaf.LeafNode,
+			)
+			return nil
+		}
+
+		subtreeRoot = current
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return subtreeRoot, nil
+}
@@ -0,0 +1,341 @@
+package mssmt
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math/bits"
+	"sort"
+)
+
+const (
+	// maxMultiProofSiblings is the maximum number of siblings a multi-proof
+	// can reference. This prevents large allocations when deserializing
+	// multi-proofs. It allows for a multi-proof of more than 4k leaves
+	// that don't share a single sibling.
+	maxMultiProofSiblings = 1 << 20
+)
+
+var (
+	// ErrInvalidMultiProof is returned when a multi-proof doesn't contain
+	// exactly the siblings needed to prove the given leaves.
+	ErrInvalidMultiProof = errors.New("mssmt: invalid multi-proof")
+)
+
+// MultiProof is a merkle proof for a set of leaves within the same MS-SMT.
+// Siblings that are shared between the leaves, or that can be computed from
+// other proven leaves, are only included once. Like a CompressedProof, empty
+// siblings are replaced by a bit vector.
+//
+// The siblings are ordered by the walk that computes the root from the leaves:
+// level by level from the leaves up to the root, and within each level in the
+// order of the leaves' paths from left to right.
+type MultiProof struct {
+	// Bits determines for each sibling needed to compute the root whether
+	// it is part of the empty tree.
+	Bits []bool
+
+	// Nodes represents the non-empty siblings needed to compute the root.
+	Nodes []Node
+}
+
+// multiProofNode is a node on the path of at least one of the leaves of a
+// multi-proof.
+type multiProofNode struct {
+	// key is the key of one of the leaves below the node. Only the bits
+	// above the node's height are relevant.
+	key [hashSize]byte
+
+	// leafIdx is the index of the leaf identified by key.
+	leafIdx int
+
+	node Node
+}
+
+// siblingFunc returns the sibling at the given height of the node on the path
+// of the leaf with the given index.
+type siblingFunc = func(height int, leafIdx int) (Node, error)
+
+// pathLess returns true if the path of key a is to the left of the path of
+// key b. Since the bits of a key are selected from the least significant bit
+// of each byte, we compare the reversed bytes.
+func pathLess(a, b *[hashSize]byte) bool {
+	for i := 0; i < hashSize; i++ {
+		if a[i] != b[i] {
+			return bits.Reverse8(a[i]) < bits.Reverse8(b[i])
+		}
+	}
+
+	return false
+}
+
+// sameParent returns true if the nodes at the given height on the paths of the
+// two keys have the same parent, which is the case if the first height bits
+// of the keys are equal.
+func sameParent(a, b *[hashSize]byte, height int) bool {
+	numBytes := height / 8
+	if !bytes.Equal(a[:numBytes], b[:numBytes]) {
+		return false
+	}
+
+	mask := byte(1<<(height%8)) - 1
+	return a[numBytes]&mask == b[numBytes]&mask
+}
+
+// walkUpMulti computes the root of the tree from the given leaves, fetching
+// any sibling that can't be computed from the other leaves through the given
+// function.
+func walkUpMulti(keys [][hashSize]byte, leaves []*LeafNode,
+	sibling siblingFunc) (*BranchNode, error) {
+
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("%w: no leaves", ErrInvalidMultiProof)
+	}
+	if len(keys) != len(leaves) {
+		return nil, fmt.Errorf("%w: %d keys but %d leaves",
+			ErrInvalidMultiProof, len(keys), len(leaves))
+	}
+
+	current := make([]multiProofNode, len(keys))
+	for i := range keys {
+		current[i] = multiProofNode{
+			key:     keys[i],
+			leafIdx: i,
+			node:    leaves[i],
+		}
+	}
+	sort.Slice(current, func(i, j int) bool {
+		return pathLess(&current[i].key, &current[j].key)
+	})
+
+	for i := 1; i < len(current); i++ {
+		if current[i-1].key == current[i].key {
+			return nil, fmt.Errorf("%w: duplicate key %x",
+				ErrInvalidMultiProof, current[i].key)
+		}
+	}
+
+	for height := lastBitIndex; height >= 0; height-- {
+		parents := current[:0]
+		for i := 0; i < len(current); i++ {
+			cur := current[i]
+
+			// If the next node has the same parent, it must be our
+			// right sibling, as the nodes are sorted by path.
+			if i+1 < len(current) {
+				next := current[i+1]
+				if sameParent(&cur.key, &next.key, height) {
+					left, right := cur.node, next.node
+					cur.node = NewBranch(left, right)
+					parents = append(parents, cur)
+					i++
+
+					continue
+				}
+			}
+
+			siblingNode, err := sibling(height, cur.leafIdx)
+			if err != nil {
+				return nil, err
+			}
+
+			if bitIndex(uint8(height), &cur.key) == 0 {
+				cur.node = NewBranch(cur.node, siblingNode)
+			} else {
+				cur.node = NewBranch(siblingNode, cur.node)
+			}
+			parents = append(parents, cur)
+		}
+
+		current = parents
+	}
+
+	return current[0].node.(*BranchNode), nil
+}
+
+// NewMultiProof creates a multi-proof for the given leaves from their
+// individual merkle proofs, which must all be for the same tree. The keys,
+// leaves and proofs are matched by their index. An empty leaf can be used to
+// prove the non-inclusion of a key. The root of the tree is returned along
+// with the multi-proof.
+func NewMultiProof(keys [][hashSize]byte, leaves []*LeafNode,
+	proofs []*Proof) (*MultiProof, *BranchNode, error) {
+
+	if len(proofs) != len(keys) {
+		return nil, nil, fmt.Errorf("%w: %d keys but %d proofs",
+			ErrInvalidMultiProof, len(keys), len(proofs))
+	}
+
+	var multiProof MultiProof
+	root, err := walkUpMulti(
+		keys, leaves, func(height int, leafIdx int) (Node, error) {
+			proof := proofs[leafIdx]
+			if len(proof.Nodes) != MaxTreeLevels {
+				return nil, fmt.Errorf("%w: proof %d has %d "+
+					"nodes", ErrInvalidMultiProof, leafIdx,
+					len(proof.Nodes))
+			}
+
+			// The proof nodes start at the leaf, while the
+			// EmptyTree starts at the root.
+			node := proof.Nodes[lastBitIndex-height]
+			emptyHash := EmptyTree[height+1].NodeHash()
+			if node.NodeHash() == emptyHash {
+				multiProof.Bits = append(multiProof.Bits, true)
+				return node, nil
+			}
+
+			multiProof.Bits = append(multiProof.Bits, false)
+			multiProof.Nodes = append(multiProof.Nodes, node)
+
+			return node, nil
+		},
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// All individual proofs must lead to the same root, otherwise the
+	// multi-proof wouldn't be valid for any of them.
+	for i := range proofs {
+		if !IsEqualNode(proofs[i].Root(keys[i], leaves[i]), root) {
+			return nil, nil, fmt.Errorf("%w: proof %d is for a "+
+				"different root", ErrInvalidMultiProof, i)
+		}
+	}
+
+	return &multiProof, root, nil
+}
+
+// Root returns the root node obtained by walking up the tree from the given
+// leaves. The keys and leaves are matched by their index, but their order
+// doesn't matter otherwise. An error is returned if the multi-proof doesn't
+// contain exactly the siblings needed for the leaves.
+func (p *MultiProof) Root(keys [][hashSize]byte,
+	leaves []*LeafNode) (*BranchNode, error) {
+
+	var nextBit, nextNode int
+	root, err := walkUpMulti(
+		keys, leaves, func(height int, _ int) (Node, error) {
+			if nextBit >= len(p.Bits) {
+				return nil, fmt.Errorf("%w: missing siblings",
+					ErrInvalidMultiProof)
+			}
+
+			isEmpty := p.Bits[nextBit]
+			nextBit++
+
+			if isEmpty {
+				return EmptyTree[height+1], nil
+			}
+
+			if nextNode >= len(p.Nodes) {
+				return nil, fmt.Errorf("%w: missing nodes",
+					ErrInvalidMultiProof)
+			}
+
+			node := p.Nodes[nextNode]
+			nextNode++
+
+			return node, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if nextBit != len(p.Bits) || nextNode != len(p.Nodes) {
+		return nil, fmt.Errorf("%w: unused siblings",
+			ErrInvalidMultiProof)
+	}
+
+	return root, nil
+}
+
+// VerifyMultiProof determines whether a multi-proof for the given leaves is
+// valid for the given root.
+func VerifyMultiProof(keys [][hashSize]byte, leaves []*LeafNode,
+	proof *MultiProof, root Node) bool {
+
+	proofRoot, err := proof.Root(keys, leaves)
+	if err != nil {
+		return false
+	}
+
+	return IsEqualNode(proofRoot, root)
+}
+
+// Encode encodes the multi-proof into the provided Writer.
+func (p *MultiProof) Encode(w io.Writer) error {
+	if len(p.Bits) > maxMultiProofSiblings {
+		return fmt.Errorf("multi-proof has too many siblings: %d",
+			len(p.Bits))
+	}
+
+	err := binary.Write(w, byteOrder, uint32(len(p.Bits)))
+	if err != nil {
+		return err
+	}
+	err = binary.Write(w, byteOrder, uint32(len(p.Nodes)))
+	if err != nil {
+		return err
+	}
+
+	for _, node := range p.Nodes {
+		key := node.NodeHash()
+		if _, err := w.Write(key[:]); err != nil {
+			return err
+		}
+		err := binary.Write(w, byteOrder, node.NodeSum())
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = w.Write(PackBits(p.Bits))
+	return err
+}
+
+// Decode decodes the multi-proof encoded within Reader.
+func (p *MultiProof) Decode(r io.Reader) error {
+	var numBits, numNodes uint32
+	if err := binary.Read(r, byteOrder, &numBits); err != nil {
+		return err
+	}
+	if err := binary.Read(r, byteOrder, &numNodes); err != nil {
+		return err
+	}
+
+	if numBits > maxMultiProofSiblings || numNodes > numBits {
+		return fmt.Errorf("%w: %d nodes for %d siblings",
+			ErrInvalidMultiProof, numNodes, numBits)
+	}
+
+	nodes := make([]Node, 0, numNodes)
+	for i := uint32(0); i < numNodes; i++ {
+		var keyBytes [sha256.Size]byte
+		if _, err := io.ReadFull(r, keyBytes[:]); err != nil {
+			return err
+		}
+		var sum uint64
+		if err := binary.Read(r, byteOrder, &sum); err != nil {
+			return err
+		}
+		nodes = append(nodes, NewComputedNode(NodeHash(keyBytes), sum))
+	}
+
+	bitsBytes := make([]byte, (numBits+8-1)/8)
+	if _, err := io.ReadFull(r, bitsBytes); err != nil {
+		return err
+	}
+	siblingBits := UnpackBits(bitsBytes)[:numBits]
+
+	*p = MultiProof{
+		Bits:  siblingBits,
+		Nodes: nodes,
+	}
+	return nil
+}
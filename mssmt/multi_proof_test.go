@@ -0,0 +1,130 @@
+package mssmt_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/mssmt"
+	"github.com/stretchr/testify/require"
+)
+
+// TestMultiProof asserts that multi-proofs for a set of leaves (inclusion and
+// non-inclusion) are constructed from individual proofs, encoded, decoded, and
+// verified properly.
+func TestMultiProof(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	tree := mssmt.NewCompactedTree(mssmt.NewDefaultStore())
+
+	leaves := randTree(200)
+	for _, item := range leaves {
+		_, err := tree.Insert(ctx, item.key, item.leaf)
+		require.NoError(t, err)
+	}
+
+	treeRoot, err := tree.Root(ctx)
+	require.NoError(t, err)
+
+	// We prove a subset of the leaves, plus a few keys that aren't in the
+	// tree.
+	var (
+		keys           [][32]byte
+		proofLeaves    []*mssmt.LeafNode
+		proofs         []*mssmt.Proof
+		numSingleNodes int
+	)
+	addKey := func(key [32]byte, leaf *mssmt.LeafNode) {
+		proof, err := tree.MerkleProof(ctx, key)
+		require.NoError(t, err)
+
+		keys = append(keys, key)
+		proofLeaves = append(proofLeaves, leaf)
+		proofs = append(proofs, proof)
+		numSingleNodes += len(proof.Compress().Nodes)
+	}
+	for _, item := range leaves[:50] {
+		addKey(item.key, item.leaf)
+	}
+	for i := 0; i < 5; i++ {
+		addKey(test.RandHash(), mssmt.EmptyLeafNode)
+	}
+
+	multiProof, root, err := mssmt.NewMultiProof(keys, proofLeaves, proofs)
+	require.NoError(t, err)
+	require.True(t, mssmt.IsEqualNode(treeRoot, root))
+
+	// Shared siblings are only included once.
+	require.Less(t, len(multiProof.Nodes), numSingleNodes)
+
+	// The proof survives an encoding round trip.
+	var buf bytes.Buffer
+	require.NoError(t, multiProof.Encode(&buf))
+
+	var decodedProof mssmt.MultiProof
+	require.NoError(t, decodedProof.Decode(&buf))
+	require.Equal(t, multiProof.Bits, decodedProof.Bits)
+	require.Len(t, decodedProof.Nodes, len(multiProof.Nodes))
+	for i := range multiProof.Nodes {
+		require.True(t, mssmt.IsEqualNode(
+			multiProof.Nodes[i], decodedProof.Nodes[i],
+		))
+	}
+
+	require.True(t, mssmt.VerifyMultiProof(
+		keys, proofLeaves, &decodedProof, treeRoot,
+	))
+
+	// The order of the leaves doesn't matter.
+	reversedKeys := make([][32]byte, len(keys))
+	reversedLeaves := make([]*mssmt.LeafNode, len(keys))
+	for i := range keys {
+		reversedKeys[len(keys)-1-i] = keys[i]
+		reversedLeaves[len(keys)-1-i] = proofLeaves[i]
+	}
+	require.True(t, mssmt.VerifyMultiProof(
+		reversedKeys, reversedLeaves, multiProof, treeRoot,
+	))
+
+	// A modified leaf results in a different root.
+	modifiedLeaves := append([]*mssmt.LeafNode{}, proofLeaves...)
+	modifiedLeaves[0] = randLeaf()
+	require.False(t, mssmt.VerifyMultiProof(
+		keys, modifiedLeaves, multiProof, treeRoot,
+	))
+
+	// Leaving out a leaf changes the siblings that are needed, so the
+	// proof is rejected.
+	_, err = multiProof.Root(keys[1:], proofLeaves[1:])
+	require.ErrorIs(t, err, mssmt.ErrInvalidMultiProof)
+
+	// Duplicate keys are rejected.
+	_, _, err = mssmt.NewMultiProof(
+		append(keys, keys[0]),
+		append(proofLeaves, proofLeaves[0]),
+		append(proofs, proofs[0]),
+	)
+	require.ErrorIs(t, err, mssmt.ErrInvalidMultiProof)
+
+	// Proofs for a different tree are rejected.
+	_, err = tree.Insert(ctx, test.RandHash(), randLeaf())
+	require.NoError(t, err)
+
+	otherProof, err := tree.MerkleProof(ctx, keys[0])
+	require.NoError(t, err)
+
+	mixedProofs := append([]*mssmt.Proof{otherProof}, proofs[1:]...)
+	_, _, err = mssmt.NewMultiProof(keys, proofLeaves, mixedProofs)
+	require.ErrorIs(t, err, mssmt.ErrInvalidMultiProof)
+
+	// A multi-proof for a single leaf contains the same nodes as its
+	// compressed proof.
+	singleProof, _, err := mssmt.NewMultiProof(
+		keys[:1], proofLeaves[:1], proofs[:1],
+	)
+	require.NoError(t, err)
+	require.Len(t, singleProof.Bits, mssmt.MaxTreeLevels)
+	require.Len(t, singleProof.Nodes, len(proofs[0].Compress().Nodes))
+}
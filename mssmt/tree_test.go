@@ -996,3 +996,140 @@ func runBIPTestVector(t *testing.T, testVectors *mssmt.TestVectors) {
 		})
 	}
 }
+
+// keyBit returns the bit of the key at the given height, using the same bit
+// order as the tree.
+func keyBit(height int, key [hashSize]byte) byte {
+	return (key[height/8] >> (height % 8)) & 1
+}
+
+// subtreeRoot computes the root of the subtree at the given height that
+// contains all the given leaves, which are assumed to share the same path up
+// to that height.
+func subtreeRoot(height int, leaves []treeLeaf) mssmt.Node {
+	switch {
+	case len(leaves) == 0:
+		return mssmt.EmptyTree[height]
+
+	case height == mssmt.MaxTreeLevels:
+		return leaves[0].leaf
+	}
+
+	var left, right []treeLeaf
+	for _, item := range leaves {
+		if keyBit(height, item.key) == 0 {
+			left = append(left, item)
+		} else {
+			right = append(right, item)
+		}
+	}
+
+	return mssmt.NewBranch(
+		subtreeRoot(height+1, left), subtreeRoot(height+1, right),
+	)
+}
+
+// TestSubtreeRoot asserts that the compacted tree returns the correct subtree
+// roots for any height along the path to a key.
+func TestSubtreeRoot(t *testing.T) {
+	t.Parallel()
+
+	for storeName, makeStore := range genTestStores(t) {
+		t.Run(storeName, func(t *testing.T) {
+			store, err := makeStore()
+			require.NoError(t, err)
+			tree := mssmt.NewCompactedTree(store)
+
+			ctx := context.TODO()
+
+			// The subtrees of an empty tree are all empty.
+			probe := test.RandHash()
+			node, err := tree.SubtreeRoot(ctx, 5, probe)
+			require.NoError(t, err)
+			require.True(t, mssmt.IsEqualNode(
+				mssmt.EmptyTree[5], node,
+			))
+
+			leaves := randTree(50)
+			for _, item := range leaves {
+				_, err := tree.Insert(ctx, item.key, item.leaf)
+				require.NoError(t, err)
+			}
+
+			root, err := tree.Root(ctx)
+			require.NoError(t, err)
+
+			node, err = tree.SubtreeRoot(ctx, 0, probe)
+			require.NoError(t, err)
+			require.True(t, mssmt.IsEqualNode(root, node))
+
+			_, err = tree.SubtreeRoot(
+				ctx, mssmt.MaxTreeLevels+1, probe,
+			)
+			require.Error(t, err)
+
+			// We'll query the subtrees along the paths of both
+			// existing and random keys.
+			var keys [][hashSize]byte
+			for _, item := range leaves[:10] {
+				keys = append(keys, item.key)
+			}
+			for i := 0; i < 10; i++ {
+				keys = append(keys, test.RandHash())
+			}
+
+			for _, key := range keys {
+				for _, height := range []int{
+					1, 2, 3, 4, 5, 6, 7, 8, 12, 16, 64,
+					mssmt.MaxTreeLevels,
+				} {
+					assertSubtreeRoot(
+						t, tree, leaves, height, key,
+					)
+				}
+			}
+		})
+	}
+}
+
+// assertSubtreeRoot asserts that the tree returns the expected subtree root at
+// the given height along the path to the given key.
+func assertSubtreeRoot(t *testing.T, tree *mssmt.CompactedTree,
+	leaves []treeLeaf, height int, key [hashSize]byte) {
+
+	var subtreeLeaves []treeLeaf
+	for _, item := range leaves {
+		if samePrefix(height, key, item.key) {
+			subtreeLeaves = append(subtreeLeaves, item)
+		}
+	}
+
+	expected := subtreeRoot(height, subtreeLeaves)
+
+	node, err := tree.SubtreeRoot(context.TODO(), height, key)
+	require.NoError(t, err)
+	require.True(t, mssmt.IsEqualNode(expected, node))
+
+	// A subtree with a single leaf is returned in compacted form.
+	if len(subtreeLeaves) != 1 {
+		return
+	}
+
+	compLeaf, ok := node.(*mssmt.CompactedLeafNode)
+	require.True(t, ok)
+	require.Equal(t, subtreeLeaves[0].key, compLeaf.Key())
+	require.True(t, mssmt.IsEqualNode(
+		subtreeLeaves[0].leaf, compLeaf.LeafNode,
+	))
+}
+
+// samePrefix returns true if the first `height` bits of both keys match.
+func samePrefix(height int, key1, key2 [hashSize]byte) bool {
+	for i := 0; i < height; i++ {
+		if keyBit(i, key1) != keyBit(i, key2) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -140,6 +140,14 @@ var (
 			Entity: "mint",
 			Action: "write",
 		}},
+		"/mintrpc.Mint/FundBatch": {{
+			Entity: "mint",
+			Action: "write",
+		}},
+		"/mintrpc.Mint/SealBatch": {{
+			Entity: "mint",
+			Action: "write",
+		}},
 		"/mintrpc.Mint/FinalizeBatch": {{
 			Entity: "mint",
 			Action: "write",
@@ -148,10 +156,26 @@ var (
 			Entity: "mint",
 			Action: "write",
 		}},
+		"/mintrpc.Mint/RemoveSeedling": {{
+			Entity: "mint",
+			Action: "write",
+		}},
+		"/mintrpc.Mint/UpdateSeedling": {{
+			Entity: "mint",
+			Action: "write",
+		}},
+		"/mintrpc.Mint/BumpBatchFee": {{
+			Entity: "mint",
+			Action: "write",
+		}},
 		"/mintrpc.Mint/ListBatches": {{
 			Entity: "mint",
 			Action: "read",
 		}},
+		"/mintrpc.Mint/ListGroupVirtualTxs": {{
+			Entity: "mint",
+			Action: "read",
+		}},
 		"/mintrpc.Mint/SubscribeMintEvents": {{
 			Entity: "mint",
 			Action: "read",
@@ -176,18 +200,38 @@ var (
 			Entity: "universe",
 			Action: "read",
 		}},
+		"/universerpc.Universe/AssetTreeNodes": {{
+			Entity: "universe",
+			Action: "read",
+		}},
 		"/universerpc.Universe/AssetLeaves": {{
 			Entity: "universe",
 			Action: "read",
 		}},
+		"/universerpc.Universe/SubscribeLeaves": {{
+			Entity: "universe",
+			Action: "read",
+		}},
 		"/universerpc.Universe/QueryProof": {{
 			Entity: "universe",
 			Action: "read",
 		}},
+		"/universerpc.Universe/QueryProofs": {{
+			Entity: "universe",
+			Action: "read",
+		}},
 		"/universerpc.Universe/InsertProof": {{
 			Entity: "universe",
 			Action: "write",
 		}},
+		"/universerpc.Universe/ExportSnapshot": {{
+			Entity: "universe",
+			Action: "read",
+		}},
+		"/universerpc.Universe/ImportSnapshot": {{
+			Entity: "universe",
+			Action: "write",
+		}},
 		"/universerpc.Universe/SyncUniverse": {{
 			Entity: "universe",
 			Action: "write",
@@ -196,6 +240,22 @@ var (
 			Entity: "universe",
 			Action: "read",
 		}},
+		"/universerpc.Universe/ListFederationPushQueue": {{
+			Entity: "universe",
+			Action: "read",
+		}},
+		"/universerpc.Universe/QueryDivergences": {{
+			Entity: "universe",
+			Action: "read",
+		}},
+		"/universerpc.Universe/SetAdmissionPolicy": {{
+			Entity: "universe",
+			Action: "write",
+		}},
+		"/universerpc.Universe/QueryAdmissionPolicy": {{
+			Entity: "universe",
+			Action: "read",
+		}},
 		"/universerpc.Universe/AddFederationServer": {{
 			Entity: "universe",
 			Action: "write",
@@ -224,6 +284,14 @@ var (
 			Entity: "universe",
 			Action: "read",
 		}},
+		"/universerpc.Universe/QueryCommittedProof": {{
+			Entity: "universe",
+			Action: "read",
+		}},
+		"/universerpc.Universe/ListCommitments": {{
+			Entity: "universe",
+			Action: "read",
+		}},
 		"/rfqrpc.Rfq/AddAssetBuyOrder": {{
 			Entity: "rfq",
 			Action: "write",
@@ -248,6 +316,14 @@ var (
 			Entity: "rfq",
 			Action: "write",
 		}},
+		"/rfqrpc.Rfq/ListQuotes": {{
+			Entity: "rfq",
+			Action: "read",
+		}},
+		"/rfqrpc.Rfq/ListTrades": {{
+			Entity: "rfq",
+			Action: "read",
+		}},
 		"/tapdevrpc.TapDev/ImportProof": {{
 			Entity: "proofs",
 			Action: "write",
@@ -271,8 +347,11 @@ var (
 		"/universerpc.Universe/AssetRoots":      {},
 		"/universerpc.Universe/QueryAssetRoots": {},
 		"/universerpc.Universe/AssetLeafKeys":   {},
+		"/universerpc.Universe/AssetTreeNodes":  {},
 		"/universerpc.Universe/AssetLeaves":     {},
+		"/universerpc.Universe/SubscribeLeaves": {},
 		"/universerpc.Universe/Info":            {},
+		"/universerpc.Universe/ListCommitments": {},
 	}
 )
 
@@ -291,7 +370,10 @@ func MacaroonWhitelist(allowPublicUniProofCourier bool,
 	// the whitelist.
 	if allowPublicUniProofCourier {
 		whitelist["/universerpc.Universe/QueryProof"] = struct{}{}
+		whitelist["/universerpc.Universe/QueryProofs"] = struct{}{}
 		whitelist["/universerpc.Universe/InsertProof"] = struct{}{}
+		whitelist["/universerpc.Universe/QueryCommittedProof"] =
+			struct{}{}
 	}
 
 	// Conditionally add public stats RPC endpoints to the whitelist.
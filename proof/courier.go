@@ -6,7 +6,9 @@ import (
 	"crypto/sha512"
 	"crypto/tls"
 	"fmt"
+	"net"
 	"net/url"
+	"strings"
 	"sync"
 	"time"
 
@@ -17,6 +19,7 @@ import (
 	"github.com/lightninglabs/taproot-assets/fn"
 	"github.com/lightninglabs/taproot-assets/taprpc"
 	unirpc "github.com/lightninglabs/taproot-assets/taprpc/universerpc"
+	"github.com/lightningnetwork/lnd/tor"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
@@ -89,6 +92,12 @@ type CourierCfg struct {
 	// LocalArchive is an archive that can be used to fetch proofs from the
 	// local archive.
 	LocalArchive Archiver
+
+	// Net is the network used to connect to proof courier services. This
+	// can route connections through a Tor SOCKS5 proxy, which is required
+	// for courier services with an onion address. If nil, connections are
+	// made directly.
+	Net tor.Net
 }
 
 // CourierDispatch is an interface that abstracts away the different proof
@@ -127,7 +136,7 @@ func (u *URLDispatch) NewCourier(addr *url.URL,
 			cfg.BackoffCfg, u.cfg.TransferLog,
 		)
 
-		hashMailBox, err := NewHashMailBox(addr)
+		hashMailBox, err := NewHashMailBox(addr, u.cfg.Net)
 		if err != nil {
 			return nil, fmt.Errorf("unable to make mailbox: %w",
 				err)
@@ -148,7 +157,7 @@ func (u *URLDispatch) NewCourier(addr *url.URL,
 		)
 
 		// Connect to the universe RPC server.
-		dialOpts, err := serverDialOpts()
+		dialOpts, err := serverDialOpts(u.cfg.Net)
 		if err != nil {
 			return nil, err
 		}
@@ -205,6 +214,13 @@ func ValidateCourierAddress(addr *url.URL) error {
 		return fmt.Errorf("proof courier URI address port unspecified")
 	}
 
+	// An onion host must be a valid onion service address.
+	host := addr.Hostname()
+	if strings.HasSuffix(host, tor.OnionSuffix) && !tor.IsOnionHost(host) {
+		return fmt.Errorf("invalid proof courier onion address: %v",
+			host)
+	}
+
 	switch addr.Scheme {
 	case HashmailCourierType, UniverseRpcCourierType:
 		// Valid and known courier address protocol.
@@ -251,9 +267,26 @@ type HashMailBox struct {
 	client hashmailrpc.HashMailClient
 }
 
+// NewContextDialer returns a gRPC context dialer that establishes all
+// connections over the given network. The address is passed to the network
+// unresolved, so a Tor network can resolve it through its SOCKS5 proxy.
+func NewContextDialer(
+	dialNet tor.Net) func(context.Context, string) (net.Conn, error) {
+
+	return func(ctx context.Context, addr string) (net.Conn, error) {
+		timeout := tor.DefaultConnTimeout
+		if deadline, ok := ctx.Deadline(); ok {
+			timeout = time.Until(deadline)
+		}
+
+		return dialNet.Dial("tcp", addr, timeout)
+	}
+}
+
 // serverDialOpts returns the set of server options needed to connect to the
-// server using a TLS connection.
-func serverDialOpts() ([]grpc.DialOption, error) {
+// server using a TLS connection. If a network is given, all connections are
+// established over it.
+func serverDialOpts(dialNet tor.Net) ([]grpc.DialOption, error) {
 	var opts []grpc.DialOption
 
 	// Skip TLS certificate verification.
@@ -261,6 +294,12 @@ func serverDialOpts() ([]grpc.DialOption, error) {
 	transportCredentials := credentials.NewTLS(&tlsConfig)
 	opts = append(opts, grpc.WithTransportCredentials(transportCredentials))
 
+	if dialNet != nil {
+		opts = append(
+			opts, grpc.WithContextDialer(NewContextDialer(dialNet)),
+		)
+	}
+
 	return opts, nil
 }
 
@@ -268,8 +307,9 @@ func serverDialOpts() ([]grpc.DialOption, error) {
 // address above.
 //
 // NOTE: The TLS certificate path argument (tlsCertPath) is optional. If unset,
-// then the system's TLS trust store is used.
-func NewHashMailBox(courierAddr *url.URL) (*HashMailBox,
+// then the system's TLS trust store is used. The network argument (dialNet) is
+// optional as well. If unset, the server is dialed directly.
+func NewHashMailBox(courierAddr *url.URL, dialNet tor.Net) (*HashMailBox,
 	error) {
 
 	if courierAddr.Scheme != HashmailCourierType {
@@ -277,7 +317,7 @@ func NewHashMailBox(courierAddr *url.URL) (*HashMailBox,
 			courierAddr.Scheme)
 	}
 
-	dialOpts, err := serverDialOpts()
+	dialOpts, err := serverDialOpts(dialNet)
 	if err != nil {
 		return nil, err
 	}
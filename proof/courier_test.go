@@ -4,11 +4,14 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"io"
+	"net"
 	"testing"
 
 	"github.com/lightninglabs/taproot-assets/asset"
 	"github.com/lightninglabs/taproot-assets/fn"
 	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightningnetwork/lnd/tor"
 	"github.com/stretchr/testify/require"
 )
 
@@ -112,3 +115,121 @@ func TestUniverseRpcCourierLocalArchiveShortCut(t *testing.T) {
 	})
 	require.ErrorContains(t, err, "is missing outpoint")
 }
+
+// testOnionHost is a valid v3 onion service host.
+const testOnionHost = "vww6ybal4bd7szmgncyruucpgfkqahzddi37ktceo3ah7ngmcopn" +
+	"pyyd.onion"
+
+// serveSocks5 runs a minimal SOCKS5 proxy stand-in on the given listener that
+// accepts a single unauthenticated CONNECT request. Instead of connecting to
+// the requested target, it sends the requested host to the returned channel
+// and echoes all data back to the client.
+func serveSocks5(listener net.Listener) <-chan string {
+	targets := make(chan string, 1)
+
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		// Read the greeting and select the "no authentication"
+		// method.
+		var greeting [2]byte
+		if _, err := io.ReadFull(conn, greeting[:]); err != nil {
+			return
+		}
+		methods := make([]byte, greeting[1])
+		if _, err := io.ReadFull(conn, methods); err != nil {
+			return
+		}
+		if _, err := conn.Write([]byte{0x05, 0x00}); err != nil {
+			return
+		}
+
+		// Read the CONNECT request. We expect the target to be passed
+		// as a domain name, so the proxy can resolve it.
+		var req [5]byte
+		if _, err := io.ReadFull(conn, req[:]); err != nil {
+			return
+		}
+		if req[3] != 0x03 {
+			targets <- ""
+			return
+		}
+		host := make([]byte, req[4])
+		if _, err := io.ReadFull(conn, host); err != nil {
+			return
+		}
+		var port [2]byte
+		if _, err := io.ReadFull(conn, port[:]); err != nil {
+			return
+		}
+		targets <- string(host)
+
+		// Report success with an empty IPv4 bind address.
+		_, err = conn.Write([]byte{
+			0x05, 0x00, 0x00, 0x01, 0, 0, 0, 0, 0, 0,
+		})
+		if err != nil {
+			return
+		}
+
+		_, _ = io.Copy(conn, conn)
+	}()
+
+	return targets
+}
+
+// TestContextDialerSocksProxy tests that the context dialer used for courier
+// and universe connections can reach an onion host through a SOCKS5 proxy,
+// without resolving the host itself.
+func TestContextDialerSocksProxy(t *testing.T) {
+	t.Parallel()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, listener.Close())
+	})
+
+	targets := serveSocks5(listener)
+
+	dialer := NewContextDialer(&tor.ProxyNet{
+		SOCKS: listener.Addr().String(),
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), testTimeout)
+	defer cancel()
+
+	conn, err := dialer(ctx, testOnionHost+":10029")
+	require.NoError(t, err)
+	defer conn.Close()
+
+	require.Equal(t, testOnionHost, <-targets)
+
+	// Data is relayed through the proxy.
+	msg := []byte("taproot assets")
+	_, err = conn.Write(msg)
+	require.NoError(t, err)
+
+	resp := make([]byte, len(msg))
+	_, err = io.ReadFull(conn, resp)
+	require.NoError(t, err)
+	require.Equal(t, msg, resp)
+}
+
+// TestValidateCourierAddressOnion tests that onion courier addresses must be
+// valid onion service addresses.
+func TestValidateCourierAddressOnion(t *testing.T) {
+	t.Parallel()
+
+	_, err := ParseCourierAddress(
+		"universerpc://" + testOnionHost + ":10029",
+	)
+	require.NoError(t, err)
+
+	_, err = ParseCourierAddress("universerpc://invalid.onion:10029")
+	require.ErrorContains(t, err, "invalid proof courier onion address")
+}
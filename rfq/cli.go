@@ -0,0 +1,100 @@
+package rfq
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// CliConfig is a struct that holds tapd cli configuration options for the RFQ
+// service.
+type CliConfig struct {
+	PriceOracleAddress string `long:"priceoracleaddress" description:"Price oracle gRPC server address (rfqrpc://<hostname>:<port>). If unset, the built-in mock price oracle is used. The mock oracle must not be used on mainnet."`
+
+	PriceOracleTLSCertPath string `long:"priceoracletlscertpath" description:"Path to the TLS certificate of the price oracle gRPC server(s). If set, the server certificate is pinned to this certificate. If unset, the server certificate is verified against the system's root certificate authorities."`
+
+	PriceOracleTLSInsecure bool `long:"priceoracletlsinsecure" description:"Skip the TLS certificate verification of the price oracle gRPC server(s). This must only be used for testing."`
+
+	PriceOracleSources []string `long:"priceoraclesource" description:"A source of the composite price oracle, which quotes the median price of all its sources. Either a price oracle gRPC server address (rfqrpc://<hostname>:<port>) or the path to a static JSON rate table (file://<path>). Can be specified multiple times. Cannot be combined with priceoracleaddress."`
+
+	PriceOracleMinSources uint32 `long:"priceoracleminsources" description:"The minimum number of composite price oracle sources that must return a price within the maximum spread of the median. Set to 0 to require a strict majority of all sources."`
+
+	PriceOracleMaxSpreadPpm uint64 `long:"priceoraclemaxspreadppm" description:"The maximum deviation, in parts per million, of a composite price oracle source price from the median price."`
+
+	MaxQuotesPerPeer uint32 `long:"maxquotesperpeer" description:"The maximum number of outstanding quotes that a single peer can hold. Set to 0 to disable the limit."`
+
+	MaxAssetAmount uint64 `long:"maxassetamount" description:"The maximum aggregate asset amount of all outstanding quotes for a single asset or asset group. Set to 0 to disable the limit."`
+
+	MaxQuoteRatePerPeer uint32 `long:"maxquoterateperpeer" description:"The maximum number of quote requests per minute that are considered from a single peer. Set to 0 to disable the limit."`
+
+	QuoteLogRetention time.Duration `long:"quotelogretention" description:"The duration for which quotes that were never traded against are kept in the quote ledger. If unset, all quotes are kept forever."`
+}
+
+// DefaultCliConfig returns the default RFQ configuration.
+func DefaultCliConfig() CliConfig {
+	return CliConfig{
+		PriceOracleMaxSpreadPpm: DefaultOracleMaxSpreadPpm,
+	}
+}
+
+// OracleTLSConfig returns the TLS options used to connect to price oracle RPC
+// servers.
+func (c *CliConfig) OracleTLSConfig() RpcOracleTLSConfig {
+	return RpcOracleTLSConfig{
+		CertPath: c.PriceOracleTLSCertPath,
+		Insecure: c.PriceOracleTLSInsecure,
+	}
+}
+
+// ExposureLimits returns the exposure limits defined by the configuration.
+func (c *CliConfig) ExposureLimits() ExposureLimits {
+	return ExposureLimits{
+		MaxQuotesPerPeer:    c.MaxQuotesPerPeer,
+		MaxAssetAmount:      c.MaxAssetAmount,
+		MaxQuoteRatePerPeer: c.MaxQuoteRatePerPeer,
+	}
+}
+
+// Validate returns an error if the configuration is invalid.
+func (c *CliConfig) Validate() error {
+	if c.PriceOracleAddress != "" && len(c.PriceOracleSources) > 0 {
+		return fmt.Errorf("price oracle address and price oracle " +
+			"sources are mutually exclusive")
+	}
+
+	if c.PriceOracleTLSCertPath != "" && c.PriceOracleTLSInsecure {
+		return fmt.Errorf("price oracle TLS certificate path and " +
+			"insecure price oracle TLS are mutually exclusive")
+	}
+
+	for _, source := range c.PriceOracleSources {
+		// Static rate tables are only loaded on startup.
+		if strings.HasPrefix(source, StaticPriceOracleScheme+"://") {
+			continue
+		}
+
+		_, err := ParsePriceOracleAddress(source)
+		if err != nil {
+			return fmt.Errorf("invalid price oracle source: %w",
+				err)
+		}
+	}
+
+	if int(c.PriceOracleMinSources) > len(c.PriceOracleSources) {
+		return fmt.Errorf("minimum number of price oracle sources "+
+			"(%d) exceeds number of sources (%d)",
+			c.PriceOracleMinSources, len(c.PriceOracleSources))
+	}
+
+	// An empty price oracle address selects the mock price oracle.
+	if c.PriceOracleAddress == "" {
+		return nil
+	}
+
+	_, err := ParsePriceOracleAddress(c.PriceOracleAddress)
+	if err != nil {
+		return fmt.Errorf("invalid price oracle address: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,348 @@
+package rfq
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+const (
+	// DefaultOracleMaxSpreadPpm is the default maximum deviation, in parts
+	// per million, of a source price from the median price for the source
+	// to be considered in agreement.
+	DefaultOracleMaxSpreadPpm = 20_000
+
+	// DefaultOracleQueryTimeout is the default time after which a price
+	// oracle source that has not responded is considered unavailable.
+	DefaultOracleQueryTimeout = 10 * time.Second
+)
+
+// CompositeOracleCfg is the configuration of a composite price oracle.
+type CompositeOracleCfg struct {
+	// Sources are the price oracles that are queried for every price.
+	Sources []PriceOracle
+
+	// MinSources is the minimum number of sources that must return an
+	// unexpired price within the maximum spread of the median price. If
+	// zero, a strict majority of all sources is required.
+	MinSources uint32
+
+	// MaxSpreadPpm is the maximum deviation, in parts per million, of a
+	// source price from the median of all source prices.
+	MaxSpreadPpm uint64
+
+	// QueryTimeout is the time after which a source that has not
+	// responded is considered unavailable.
+	QueryTimeout time.Duration
+}
+
+// CompositePriceOracle is a price oracle that queries several price oracle
+// sources and returns the median of their prices. Sources that fail, return
+// an expired price or deviate too far from the median are ignored. If too
+// few sources remain, the query fails closed with an oracle error.
+type CompositePriceOracle struct {
+	cfg CompositeOracleCfg
+
+	// now returns the current time. It can be overridden in tests.
+	now func() time.Time
+}
+
+// NewCompositePriceOracle creates a new composite price oracle.
+func NewCompositePriceOracle(
+	cfg CompositeOracleCfg) (*CompositePriceOracle, error) {
+
+	if len(cfg.Sources) == 0 {
+		return nil, fmt.Errorf("at least one price oracle source is " +
+			"required")
+	}
+
+	if cfg.MinSources == 0 {
+		cfg.MinSources = uint32(len(cfg.Sources)/2 + 1)
+	}
+	if int(cfg.MinSources) > len(cfg.Sources) {
+		return nil, fmt.Errorf("minimum number of sources (%d) "+
+			"exceeds number of sources (%d)", cfg.MinSources,
+			len(cfg.Sources))
+	}
+
+	if cfg.QueryTimeout == 0 {
+		cfg.QueryTimeout = DefaultOracleQueryTimeout
+	}
+
+	return &CompositePriceOracle{
+		cfg: cfg,
+		now: time.Now,
+	}, nil
+}
+
+// sourcePrice is the price quoted by a single price oracle source.
+type sourcePrice struct {
+	price  lnwire.MilliSatoshi
+	expiry uint64
+}
+
+// sourceQuery queries a single price oracle source. It returns a nil price if
+// the source declined to quote.
+type sourceQuery func(ctx context.Context, source PriceOracle) (
+	*lnwire.MilliSatoshi, uint64, *OracleError, error)
+
+// queryPrice queries all sources concurrently and aggregates their prices.
+func (c *CompositePriceOracle) queryPrice(ctx context.Context,
+	query sourceQuery) (*sourcePrice, *OracleError) {
+
+	var (
+		wg     sync.WaitGroup
+		mu     sync.Mutex
+		prices []sourcePrice
+	)
+	for idx, source := range c.cfg.Sources {
+		wg.Add(1)
+		go func(idx int, source PriceOracle) {
+			defer wg.Done()
+
+			ctxt, cancel := context.WithTimeout(
+				ctx, c.cfg.QueryTimeout,
+			)
+			defer cancel()
+
+			price, expiry, oracleErr, err := query(ctxt, source)
+			switch {
+			case err != nil:
+				log.Warnf("Price oracle source %d failed: %v",
+					idx, err)
+				return
+
+			case oracleErr != nil:
+				log.Debugf("Price oracle source %d declined "+
+					"to quote: %v", idx, oracleErr)
+				return
+
+			case price == nil:
+				log.Warnf("Price oracle source %d did not "+
+					"return a price", idx)
+				return
+			}
+
+			mu.Lock()
+			prices = append(prices, sourcePrice{
+				price:  *price,
+				expiry: expiry,
+			})
+			mu.Unlock()
+		}(idx, source)
+	}
+	wg.Wait()
+
+	return aggregatePrices(
+		prices, c.now(), c.cfg.MinSources, c.cfg.MaxSpreadPpm,
+	)
+}
+
+// aggregatePrices drops the expired prices and those that deviate more than
+// the maximum spread from the median, and then returns the median of the
+// remaining prices. The expiry of the result is the earliest expiry of the
+// remaining prices. An oracle error is returned if fewer than the minimum
+// number of prices remain.
+func aggregatePrices(prices []sourcePrice, now time.Time, minSources uint32,
+	maxSpreadPpm uint64) (*sourcePrice, *OracleError) {
+
+	nowUnix := now.Unix()
+
+	fresh := make([]sourcePrice, 0, len(prices))
+	for _, price := range prices {
+		if int64(price.expiry) <= nowUnix {
+			log.Debugf("Ignoring expired price oracle price "+
+				"(price=%v, expiry=%d)", price.price,
+				price.expiry)
+			continue
+		}
+
+		fresh = append(fresh, price)
+	}
+
+	if len(fresh) < int(minSources) || len(fresh) == 0 {
+		return nil, &OracleError{
+			Msg: fmt.Sprintf("only %d of %d required price "+
+				"sources available", len(fresh), minSources),
+		}
+	}
+
+	median := medianPrice(fresh)
+
+	agreeing := make([]sourcePrice, 0, len(fresh))
+	for _, price := range fresh {
+		if withinSpread(price.price, median, maxSpreadPpm) {
+			agreeing = append(agreeing, price)
+		}
+	}
+
+	if len(agreeing) < int(minSources) {
+		return nil, &OracleError{
+			Msg: fmt.Sprintf("only %d of %d required price "+
+				"sources agree on a price", len(agreeing),
+				minSources),
+		}
+	}
+
+	result := sourcePrice{
+		price:  medianPrice(agreeing),
+		expiry: agreeing[0].expiry,
+	}
+	for _, price := range agreeing[1:] {
+		if price.expiry < result.expiry {
+			result.expiry = price.expiry
+		}
+	}
+
+	return &result, nil
+}
+
+// medianPrice returns the median of the given non-empty set of prices. For an
+// even number of prices, the mean of the two middle prices is returned.
+func medianPrice(prices []sourcePrice) lnwire.MilliSatoshi {
+	sorted := make([]lnwire.MilliSatoshi, len(prices))
+	for idx, price := range prices {
+		sorted[idx] = price.price
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i] < sorted[j]
+	})
+
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 1 {
+		return sorted[mid]
+	}
+
+	// We compute the mean in a way that can't overflow.
+	low, high := sorted[mid-1], sorted[mid]
+	return low + (high-low)/2
+}
+
+// withinSpread returns true if the given price deviates at most the given
+// number of parts per million from the reference price.
+func withinSpread(price, reference lnwire.MilliSatoshi,
+	maxSpreadPpm uint64) bool {
+
+	diff := price - reference
+	if price < reference {
+		diff = reference - price
+	}
+
+	// We compare diff/reference <= maxSpreadPpm/1e6 using floating point
+	// arithmetic to avoid overflowing large prices.
+	return float64(diff)*1e6 <= float64(reference)*float64(maxSpreadPpm)
+}
+
+// QueryAskPrice returns the median asking price of the price oracle sources
+// for the given asset amount.
+func (c *CompositePriceOracle) QueryAskPrice(ctx context.Context,
+	assetId *asset.ID, assetGroupKey *btcec.PublicKey, assetAmount uint64,
+	suggestedBidPrice *lnwire.MilliSatoshi,
+	suggestedBidPriceExpiry uint64) (*OracleAskResponse, error) {
+
+	price, oracleErr := c.queryPrice(ctx, func(ctx context.Context,
+		source PriceOracle) (*lnwire.MilliSatoshi, uint64,
+		*OracleError, error) {
+
+		resp, err := source.QueryAskPrice(
+			ctx, assetId, assetGroupKey, assetAmount,
+			suggestedBidPrice, suggestedBidPriceExpiry,
+		)
+		if err != nil {
+			return nil, 0, nil, err
+		}
+
+		return resp.AskPrice, resp.Expiry, resp.Err, nil
+	})
+	if oracleErr != nil {
+		return &OracleAskResponse{
+			Err: oracleErr,
+		}, nil
+	}
+
+	return &OracleAskResponse{
+		AskPrice: &price.price,
+		Expiry:   price.expiry,
+	}, nil
+}
+
+// QueryBidPrice returns the median bid price of the price oracle sources for
+// the given asset amount.
+func (c *CompositePriceOracle) QueryBidPrice(ctx context.Context,
+	assetId *asset.ID, assetGroupKey *btcec.PublicKey, assetAmount uint64,
+	suggestedAskPrice *lnwire.MilliSatoshi,
+	suggestedAskPriceExpiry uint64) (*OracleBidResponse, error) {
+
+	price, oracleErr := c.queryPrice(ctx, func(ctx context.Context,
+		source PriceOracle) (*lnwire.MilliSatoshi, uint64,
+		*OracleError, error) {
+
+		resp, err := source.QueryBidPrice(
+			ctx, assetId, assetGroupKey, assetAmount,
+			suggestedAskPrice, suggestedAskPriceExpiry,
+		)
+		if err != nil {
+			return nil, 0, nil, err
+		}
+
+		return resp.BidPrice, resp.Expiry, resp.Err, nil
+	})
+	if oracleErr != nil {
+		return &OracleBidResponse{
+			Err: oracleErr,
+		}, nil
+	}
+
+	return &OracleBidResponse{
+		BidPrice: &price.price,
+		Expiry:   price.expiry,
+	}, nil
+}
+
+// Close closes all sources of the composite price oracle that hold a
+// connection.
+func (c *CompositePriceOracle) Close() error {
+	var closeErr error
+	for _, source := range c.cfg.Sources {
+		closer, ok := source.(io.Closer)
+		if !ok {
+			continue
+		}
+
+		err := closer.Close()
+		if err != nil && closeErr == nil {
+			closeErr = err
+		}
+	}
+
+	return closeErr
+}
+
+// Ensure that CompositePriceOracle implements the PriceOracle interface.
+var _ PriceOracle = (*CompositePriceOracle)(nil)
+
+// NewPriceOracleSource creates a price oracle source from the given source
+// address. The address is either a price oracle RPC server address of the
+// form rfqrpc://<host>:<port> or the path to a static rate table file of the
+// form file://<path>. The TLS options are used for RPC server sources.
+func NewPriceOracleSource(source string,
+	tlsCfg RpcOracleTLSConfig) (PriceOracle, error) {
+
+	staticPrefix := StaticPriceOracleScheme + "://"
+	if strings.HasPrefix(source, staticPrefix) {
+		return NewStaticPriceOracleFromFile(
+			strings.TrimPrefix(source, staticPrefix),
+			DefaultStaticOracleExpiry,
+		)
+	}
+
+	return NewRpcPriceOracle(source, tlsCfg)
+}
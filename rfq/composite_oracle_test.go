@@ -0,0 +1,258 @@
+package rfq
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+// fixedPriceOracle is a price oracle source that quotes a fixed price, fails
+// or blocks until the query context is done.
+type fixedPriceOracle struct {
+	price  lnwire.MilliSatoshi
+	expiry uint64
+
+	// err is returned as a query error if set.
+	err error
+
+	// oracleErr is returned as an oracle error if set.
+	oracleErr *OracleError
+
+	// block makes the oracle block until the query context is done.
+	block bool
+}
+
+// respond returns the configured response of the oracle.
+func (f *fixedPriceOracle) respond(ctx context.Context) (*lnwire.MilliSatoshi,
+	error) {
+
+	if f.block {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+
+	if f.err != nil {
+		return nil, f.err
+	}
+
+	price := f.price
+	return &price, nil
+}
+
+// QueryAskPrice returns the fixed price as the asking price.
+func (f *fixedPriceOracle) QueryAskPrice(ctx context.Context, _ *asset.ID,
+	_ *btcec.PublicKey, _ uint64,
+	_ *lnwire.MilliSatoshi, _ uint64) (*OracleAskResponse, error) {
+
+	price, err := f.respond(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &OracleAskResponse{
+		AskPrice: price,
+		Expiry:   f.expiry,
+		Err:      f.oracleErr,
+	}, nil
+}
+
+// QueryBidPrice returns the fixed price as the bid price.
+func (f *fixedPriceOracle) QueryBidPrice(ctx context.Context, _ *asset.ID,
+	_ *btcec.PublicKey, _ uint64, _ *lnwire.MilliSatoshi,
+	_ uint64) (*OracleBidResponse, error) {
+
+	price, err := f.respond(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &OracleBidResponse{
+		BidPrice: price,
+		Expiry:   f.expiry,
+		Err:      f.oracleErr,
+	}, nil
+}
+
+// TestCompositePriceOracle tests that the composite price oracle returns the
+// median price of the agreeing sources and fails closed otherwise.
+func TestCompositePriceOracle(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	valid := uint64(now.Add(time.Minute).Unix())
+	later := uint64(now.Add(time.Hour).Unix())
+	expired := uint64(now.Add(-time.Minute).Unix())
+
+	testCases := []struct {
+		name         string
+		sources      []*fixedPriceOracle
+		minSources   uint32
+		expectPrice  lnwire.MilliSatoshi
+		expectExpiry uint64
+		expectErr    string
+	}{{
+		name: "median of all sources",
+		sources: []*fixedPriceOracle{
+			{price: 1010, expiry: later},
+			{price: 1000, expiry: valid},
+			{price: 990, expiry: later},
+		},
+		expectPrice:  1000,
+		expectExpiry: valid,
+	}, {
+		name: "mean of the middle prices",
+		sources: []*fixedPriceOracle{
+			{price: 1000, expiry: valid},
+			{price: 1010, expiry: valid},
+		},
+		expectPrice:  1005,
+		expectExpiry: valid,
+	}, {
+		name: "outlier is ignored",
+		sources: []*fixedPriceOracle{
+			{price: 1000, expiry: valid},
+			{price: 1001, expiry: valid},
+			{price: 1002, expiry: valid},
+			{price: 5000, expiry: later},
+		},
+		expectPrice:  1001,
+		expectExpiry: valid,
+	}, {
+		name: "broken and expired sources are ignored",
+		sources: []*fixedPriceOracle{
+			{price: 1000, expiry: valid},
+			{price: 1010, expiry: valid},
+			{price: 1020, expiry: valid},
+			{price: 9000, expiry: expired},
+			{err: fmt.Errorf("connection refused")},
+		},
+		expectPrice:  1010,
+		expectExpiry: valid,
+	}, {
+		name: "too few sources available",
+		sources: []*fixedPriceOracle{
+			{price: 1000, expiry: valid},
+			{price: 1000, expiry: expired},
+			{oracleErr: &OracleError{Msg: "unsupported"}},
+		},
+		expectErr: "only 1 of 2 required price sources available",
+	}, {
+		name: "too few sources agree",
+		sources: []*fixedPriceOracle{
+			{price: 1000, expiry: valid},
+			{price: 2000, expiry: valid},
+			{price: 3000, expiry: valid},
+		},
+		expectErr: "only 1 of 2 required price sources agree",
+	}, {
+		name: "explicit minimum number of sources",
+		sources: []*fixedPriceOracle{
+			{price: 1000, expiry: valid},
+			{price: 1000, expiry: valid},
+			{price: 1000, expiry: valid},
+			{price: 2000, expiry: valid},
+		},
+		minSources: 4,
+		expectErr:  "only 3 of 4 required price sources agree",
+	}, {
+		name: "unresponsive source times out",
+		sources: []*fixedPriceOracle{
+			{price: 1000, expiry: valid},
+			{price: 1000, expiry: valid},
+			{block: true},
+		},
+		expectPrice:  1000,
+		expectExpiry: valid,
+	}}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			sources := make([]PriceOracle, len(tc.sources))
+			for idx := range tc.sources {
+				sources[idx] = tc.sources[idx]
+			}
+
+			oracle, err := NewCompositePriceOracle(
+				CompositeOracleCfg{
+					Sources:      sources,
+					MinSources:   tc.minSources,
+					MaxSpreadPpm: 20_000,
+					QueryTimeout: 100 * time.Millisecond,
+				},
+			)
+			require.NoError(t, err)
+			oracle.now = func() time.Time {
+				return now
+			}
+
+			ctx := context.Background()
+			assetID := asset.RandID(t)
+
+			askResp, err := oracle.QueryAskPrice(
+				ctx, &assetID, nil, 1, nil, 0,
+			)
+			require.NoError(t, err)
+
+			bidResp, err := oracle.QueryBidPrice(
+				ctx, &assetID, nil, 1, nil, 0,
+			)
+			require.NoError(t, err)
+
+			if tc.expectErr != "" {
+				require.ErrorContains(
+					t, askResp.Err, tc.expectErr,
+				)
+				require.Nil(t, askResp.AskPrice)
+				require.ErrorContains(
+					t, bidResp.Err, tc.expectErr,
+				)
+				require.Nil(t, bidResp.BidPrice)
+
+				return
+			}
+
+			require.Nil(t, askResp.Err)
+			require.Equal(t, tc.expectPrice, *askResp.AskPrice)
+			require.Equal(t, tc.expectExpiry, askResp.Expiry)
+
+			require.Nil(t, bidResp.Err)
+			require.Equal(t, tc.expectPrice, *bidResp.BidPrice)
+			require.Equal(t, tc.expectExpiry, bidResp.Expiry)
+		})
+	}
+}
+
+// TestNewCompositePriceOracle tests the validation of the composite price
+// oracle configuration.
+func TestNewCompositePriceOracle(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewCompositePriceOracle(CompositeOracleCfg{})
+	require.ErrorContains(t, err, "at least one price oracle source")
+
+	_, err = NewCompositePriceOracle(CompositeOracleCfg{
+		Sources:    []PriceOracle{&fixedPriceOracle{}},
+		MinSources: 2,
+	})
+	require.ErrorContains(t, err, "exceeds number of sources")
+
+	oracle, err := NewCompositePriceOracle(CompositeOracleCfg{
+		Sources: []PriceOracle{
+			&fixedPriceOracle{}, &fixedPriceOracle{},
+			&fixedPriceOracle{}, &fixedPriceOracle{},
+		},
+	})
+	require.NoError(t, err)
+	require.EqualValues(t, 3, oracle.cfg.MinSources)
+	require.Equal(t, DefaultOracleQueryTimeout, oracle.cfg.QueryTimeout)
+}
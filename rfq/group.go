@@ -0,0 +1,95 @@
+package rfq
+
+import (
+	"context"
+	"errors"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightninglabs/taproot-assets/address"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightningnetwork/lnd/lnutils"
+)
+
+// GroupLookup is used to look up the asset group that an asset belongs to.
+// This allows the RFQ subsystem to treat all tranches of a grouped asset as a
+// single asset.
+type GroupLookup interface {
+	// QueryAssetGroup fetches the group information of the asset with the
+	// given ID. The group key of the returned asset group is nil if the
+	// asset isn't grouped. If the asset is not known,
+	// address.ErrAssetGroupUnknown is returned.
+	QueryAssetGroup(ctx context.Context,
+		assetID asset.ID) (*asset.AssetGroup, error)
+}
+
+// groupResolver resolves asset IDs to the group keys of their asset groups.
+// Since the group of an asset can't change, resolved group keys are cached.
+type groupResolver struct {
+	// lookup is used to look up asset groups. If it is nil, every asset
+	// is considered to be ungrouped.
+	lookup GroupLookup
+
+	// groupKeys caches the group keys of grouped assets, keyed by asset
+	// ID. Ungrouped and unknown assets are not cached, as we might learn
+	// about their group later on.
+	groupKeys lnutils.SyncMap[asset.ID, *btcec.PublicKey]
+}
+
+// newGroupResolver creates a new group resolver that uses the given lookup.
+func newGroupResolver(lookup GroupLookup) *groupResolver {
+	return &groupResolver{
+		lookup:    lookup,
+		groupKeys: lnutils.SyncMap[asset.ID, *btcec.PublicKey]{},
+	}
+}
+
+// groupKey returns the group key of the asset with the given ID. Nil is
+// returned if the asset isn't grouped or if its group is unknown.
+func (g *groupResolver) groupKey(ctx context.Context,
+	assetID asset.ID) *btcec.PublicKey {
+
+	if g.lookup == nil {
+		return nil
+	}
+
+	if groupKey, ok := g.groupKeys.Load(assetID); ok {
+		return groupKey
+	}
+
+	assetGroup, err := g.lookup.QueryAssetGroup(ctx, assetID)
+	switch {
+	case errors.Is(err, address.ErrAssetGroupUnknown):
+		return nil
+
+	case err != nil:
+		log.Warnf("Unable to look up asset group of asset %v: %v",
+			assetID, err)
+		return nil
+
+	case assetGroup.GroupKey == nil:
+		return nil
+	}
+
+	groupKey := &assetGroup.GroupKey.GroupPubKey
+	g.groupKeys.Store(assetID, groupKey)
+
+	return groupKey
+}
+
+// resolve returns the asset specifier under which the given asset is
+// accounted for. Grouped assets specified by their asset ID are resolved to
+// their group key, so that all tranches of the group are treated as one
+// asset.
+func (g *groupResolver) resolve(ctx context.Context, assetID *asset.ID,
+	groupKey *btcec.PublicKey) (*asset.ID, *btcec.PublicKey) {
+
+	if assetID == nil {
+		return nil, groupKey
+	}
+
+	if resolvedKey := g.groupKey(ctx, *assetID); resolvedKey != nil {
+		return nil, resolvedKey
+	}
+
+	return assetID, groupKey
+}
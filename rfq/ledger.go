@@ -0,0 +1,286 @@
+package rfq
+
+import (
+	"context"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/rfqmsg"
+	invpkg "github.com/lightningnetwork/lnd/invoices"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+// QuoteType is the type of a quote.
+type QuoteType uint8
+
+const (
+	// QuoteTypeBuy is the type of a quote which was requested by the party
+	// that wants to buy the asset.
+	QuoteTypeBuy QuoteType = iota
+
+	// QuoteTypeSell is the type of a quote which was requested by the party
+	// that wants to sell the asset.
+	QuoteTypeSell
+)
+
+// String returns a human-readable representation of the quote type.
+func (t QuoteType) String() string {
+	switch t {
+	case QuoteTypeBuy:
+		return "buy"
+
+	case QuoteTypeSell:
+		return "sell"
+
+	default:
+		return "unknown"
+	}
+}
+
+// QuoteStatus is the status of a quote in the quote ledger.
+type QuoteStatus uint8
+
+const (
+	// QuoteStatusRequested is the status of a quote which was requested
+	// but has not been answered yet.
+	QuoteStatusRequested QuoteStatus = iota
+
+	// QuoteStatusAccepted is the status of a quote which was accepted.
+	QuoteStatusAccepted
+
+	// QuoteStatusRejected is the status of a quote which was rejected.
+	QuoteStatusRejected
+
+	// QuoteStatusExpired is the status of an accepted quote which expired
+	// without any HTLC having been accepted against it.
+	QuoteStatusExpired
+)
+
+// String returns a human-readable representation of the quote status.
+func (s QuoteStatus) String() string {
+	switch s {
+	case QuoteStatusRequested:
+		return "requested"
+
+	case QuoteStatusAccepted:
+		return "accepted"
+
+	case QuoteStatusRejected:
+		return "rejected"
+
+	case QuoteStatusExpired:
+		return "expired"
+
+	default:
+		return "unknown"
+	}
+}
+
+// TradeStatus is the status of a trade in the quote ledger.
+type TradeStatus uint8
+
+const (
+	// TradeStatusPending is the status of a trade whose HTLC was accepted
+	// but has not been settled or failed yet.
+	TradeStatusPending TradeStatus = iota
+
+	// TradeStatusSettled is the status of a trade whose HTLC was settled.
+	TradeStatusSettled
+
+	// TradeStatusFailed is the status of a trade whose HTLC was failed.
+	TradeStatusFailed
+)
+
+// String returns a human-readable representation of the trade status.
+func (s TradeStatus) String() string {
+	switch s {
+	case TradeStatusPending:
+		return "pending"
+
+	case TradeStatusSettled:
+		return "settled"
+
+	case TradeStatusFailed:
+		return "failed"
+
+	default:
+		return "unknown"
+	}
+}
+
+// QuoteLogEntry is a single quote in the quote ledger.
+type QuoteLogEntry struct {
+	// ID is the unique ID of the quote request.
+	ID rfqmsg.ID
+
+	// Type is the type of the quote.
+	Type QuoteType
+
+	// Incoming is true if the quote was requested by the peer and answered
+	// by our node.
+	Incoming bool
+
+	// Peer is the counterparty peer of the quote.
+	Peer route.Vertex
+
+	// AssetID is the ID of the subject asset, if the quote is for a
+	// specific asset.
+	AssetID *asset.ID
+
+	// AssetGroupKey is the group key of the subject asset, if the quote is
+	// for an asset group.
+	AssetGroupKey *btcec.PublicKey
+
+	// AssetAmount is the amount of the asset that the quote is for.
+	AssetAmount uint64
+
+	// Price is the price for the asset amount. This is the price suggested
+	// in the request until the quote is accepted, after which it is the
+	// accepted price.
+	Price lnwire.MilliSatoshi
+
+	// Expiry is the unix timestamp in seconds after which the accepted
+	// quote is no longer valid. It is zero if the quote was never
+	// accepted.
+	Expiry uint64
+
+	// Status is the status of the quote.
+	Status QuoteStatus
+
+	// Signature is the signature of the accept message, if the quote was
+	// accepted.
+	Signature fn.Option[[64]byte]
+
+	// RejectErr is the reason for the rejection, if the quote was
+	// rejected.
+	RejectErr fn.Option[rfqmsg.RejectErr]
+
+	// CreatedAt is the time at which the quote was requested.
+	CreatedAt time.Time
+
+	// UpdatedAt is the time at which the status of the quote last changed.
+	UpdatedAt time.Time
+}
+
+// Trade is an HTLC which our node accepted against one of the quotes it
+// issued.
+type Trade struct {
+	// QuoteID is the ID of the quote which the HTLC was accepted against.
+	QuoteID rfqmsg.ID
+
+	// QuoteType is the type of the quote.
+	QuoteType QuoteType
+
+	// Peer is the counterparty peer of the quote.
+	Peer route.Vertex
+
+	// AssetID is the ID of the subject asset, if the quote is for a
+	// specific asset.
+	AssetID *asset.ID
+
+	// AssetGroupKey is the group key of the subject asset, if the quote is
+	// for an asset group.
+	AssetGroupKey *btcec.PublicKey
+
+	// AssetAmount is the asset amount of the quote.
+	AssetAmount uint64
+
+	// QuotePrice is the accepted price of the quote for the asset amount.
+	QuotePrice lnwire.MilliSatoshi
+
+	// IncomingChanID is the ID of the channel the HTLC was received on.
+	IncomingChanID lnwire.ShortChannelID
+
+	// HtlcID is the index of the HTLC within the incoming channel.
+	HtlcID uint64
+
+	// Amount is the outgoing amount of the HTLC.
+	Amount lnwire.MilliSatoshi
+
+	// HtlcAssetAmount is the asset amount that the HTLC pays for. This is
+	// the share of the quote's asset amount that corresponds to the HTLC
+	// amount.
+	HtlcAssetAmount uint64
+
+	// Status is the status of the trade.
+	Status TradeStatus
+
+	// Timestamp is the time at which the HTLC was accepted.
+	Timestamp time.Time
+
+	// ResolvedAt is the time at which the HTLC was settled or failed. It
+	// is zero while the trade is pending.
+	ResolvedAt time.Time
+}
+
+// LedgerQuery is used to filter the entries of the quote ledger.
+type LedgerQuery struct {
+	// Peer restricts the result to quotes with the given counterparty.
+	Peer *route.Vertex
+
+	// StartTime restricts the result to entries created at or after the
+	// given time. A zero value disables the filter.
+	StartTime time.Time
+
+	// EndTime restricts the result to entries created before the given
+	// time. A zero value disables the filter.
+	EndTime time.Time
+}
+
+// QuoteLedger is a persistent record of all quotes that were negotiated with
+// our peers and of the HTLCs that were accepted against them. Unlike the
+// QuoteStore, entries are not removed once they have expired. Only quotes that
+// were never traded against are pruned, and only if a retention is configured.
+type QuoteLedger interface {
+	// LogBuyRequest records a buy quote request. The incoming flag is true
+	// if the request was sent by the peer.
+	LogBuyRequest(ctx context.Context, req rfqmsg.BuyRequest,
+		incoming bool) error
+
+	// LogSellRequest records a sell quote request. The incoming flag is
+	// true if the request was sent by the peer.
+	LogSellRequest(ctx context.Context, req rfqmsg.SellRequest,
+		incoming bool) error
+
+	// LogBuyAccept marks the buy quote request that the given accept
+	// message responds to as accepted.
+	LogBuyAccept(ctx context.Context, accept rfqmsg.BuyAccept) error
+
+	// LogSellAccept marks the sell quote request that the given accept
+	// message responds to as accepted.
+	LogSellAccept(ctx context.Context, accept rfqmsg.SellAccept) error
+
+	// LogReject marks the quote request that the given reject message
+	// responds to as rejected. Like an accept, a reject only applies to a
+	// pending request from or to the same peer.
+	LogReject(ctx context.Context, reject rfqmsg.Reject) error
+
+	// LogTrade records an HTLC which was accepted against the quote with
+	// the given ID as a pending trade. The asset amount is the share of
+	// the quote's asset amount that the HTLC pays for.
+	LogTrade(ctx context.Context, quoteID rfqmsg.ID,
+		htlc lndclient.InterceptedHtlc, assetAmount uint64) error
+
+	// ResolveTrade marks the pending trade of the HTLC with the given
+	// incoming circuit key as settled or failed.
+	ResolveTrade(ctx context.Context, circuitKey invpkg.CircuitKey,
+		settled bool) error
+
+	// PruneQuotes removes the quotes which were requested before the
+	// given time and which were never traded against.
+	PruneQuotes(ctx context.Context, createdBefore time.Time) error
+
+	// QueryQuotes returns the quotes in the ledger which match the given
+	// query. Accepted quotes which have expired as of the given time
+	// without being traded against are reported as expired.
+	QueryQuotes(ctx context.Context, query LedgerQuery,
+		now time.Time) ([]QuoteLogEntry, error)
+
+	// QueryTrades returns the trades in the ledger which match the given
+	// query.
+	QueryTrades(ctx context.Context, query LedgerQuery) ([]Trade, error)
+}
@@ -0,0 +1,243 @@
+package rfq
+
+import (
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/rfqmsg"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"golang.org/x/time/rate"
+)
+
+const (
+	// maxTrackedPeers is the number of peers we keep a quote request rate
+	// limiter for before we start to prune the limiters of idle peers.
+	maxTrackedPeers = 10_000
+)
+
+// ExposureLimits holds the limits that the negotiator enforces on incoming
+// quote requests. A zero value disables the respective limit.
+type ExposureLimits struct {
+	// MaxQuotesPerPeer is the maximum number of outstanding (unexpired)
+	// quotes that a single peer can hold at any time.
+	MaxQuotesPerPeer uint32
+
+	// MaxAssetAmount is the maximum aggregate asset amount of all
+	// outstanding quotes for a single asset (or asset group), across all
+	// peers and both trade directions.
+	MaxAssetAmount uint64
+
+	// MaxQuoteRatePerPeer is the maximum number of quote requests per
+	// minute that are considered from a single peer.
+	MaxQuoteRatePerPeer uint32
+}
+
+// assetKey identifies the subject asset of a quote. Either the asset ID or the
+// group key is set.
+type assetKey struct {
+	id       asset.ID
+	groupKey asset.SerializedKey
+}
+
+// newAssetKey creates a new asset key from the asset specifier of a quote
+// request.
+func newAssetKey(assetID *asset.ID, groupKey *btcec.PublicKey) assetKey {
+	var key assetKey
+	switch {
+	case assetID != nil:
+		key.id = *assetID
+
+	case groupKey != nil:
+		key.groupKey = asset.ToSerialized(groupKey)
+	}
+
+	return key
+}
+
+// liveQuote is an outstanding quote that counts towards the exposure limits.
+type liveQuote struct {
+	peer   route.Vertex
+	asset  assetKey
+	amount uint64
+
+	// expiry is the time at which the quote expires. It is unset while
+	// the quote request is still pending.
+	expiry time.Time
+}
+
+// exposureTracker keeps track of the outstanding quotes of the negotiator and
+// enforces the configured exposure limits.
+type exposureTracker struct {
+	limits ExposureLimits
+
+	// now returns the current time. It can be overridden in tests.
+	now func() time.Time
+
+	mu sync.Mutex
+
+	// quotes holds the outstanding quotes, keyed by quote ID.
+	quotes map[rfqmsg.ID]liveQuote
+
+	// rateLimiters holds the quote request rate limiter of each peer.
+	rateLimiters map[route.Vertex]*rate.Limiter
+}
+
+// newExposureTracker creates a new exposure tracker for the given limits.
+func newExposureTracker(limits ExposureLimits) *exposureTracker {
+	return &exposureTracker{
+		limits:       limits,
+		now:          time.Now,
+		quotes:       make(map[rfqmsg.ID]liveQuote),
+		rateLimiters: make(map[route.Vertex]*rate.Limiter),
+	}
+}
+
+// pruneExpired removes all expired quotes. Pending quote requests are kept
+// until they are either confirmed or released. The caller must hold the mutex.
+func (e *exposureTracker) pruneExpired(now time.Time) {
+	for id, quote := range e.quotes {
+		if !quote.expiry.IsZero() && now.After(quote.expiry) {
+			delete(e.quotes, id)
+		}
+	}
+}
+
+// allowRequest reports whether the peer is still within its quote request
+// rate. Each call consumes one request of the peer's allowance. The caller
+// must hold the mutex.
+func (e *exposureTracker) allowRequest(peer route.Vertex,
+	now time.Time) bool {
+
+	if e.limits.MaxQuoteRatePerPeer == 0 {
+		return true
+	}
+
+	limiter, ok := e.rateLimiters[peer]
+	if !ok {
+		// Peers that have their full allowance again don't need their
+		// limiter anymore, so we drop them to bound our memory use.
+		if len(e.rateLimiters) >= maxTrackedPeers {
+			e.pruneLimiters(now)
+		}
+
+		perMinute := float64(e.limits.MaxQuoteRatePerPeer)
+		limiter = rate.NewLimiter(
+			rate.Limit(perMinute/time.Minute.Seconds()),
+			int(e.limits.MaxQuoteRatePerPeer),
+		)
+		e.rateLimiters[peer] = limiter
+	}
+
+	return limiter.AllowN(now, 1)
+}
+
+// pruneLimiters removes the rate limiters of all peers that have their full
+// allowance. The caller must hold the mutex.
+func (e *exposureTracker) pruneLimiters(now time.Time) {
+	maxTokens := float64(e.limits.MaxQuoteRatePerPeer)
+	for peer, limiter := range e.rateLimiters {
+		if limiter.TokensAt(now) >= maxTokens {
+			delete(e.rateLimiters, peer)
+		}
+	}
+}
+
+// Reserve checks the given quote request against the exposure limits. If the
+// request is within the limits, it is counted as an outstanding quote until it
+// is released or, once confirmed, until the quote expires. Otherwise, the
+// reject error that should be sent to the peer is returned.
+func (e *exposureTracker) Reserve(id rfqmsg.ID, peer route.Vertex,
+	assetID *asset.ID, groupKey *btcec.PublicKey,
+	amount uint64) *rfqmsg.RejectErr {
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	now := e.now()
+	e.pruneExpired(now)
+
+	if !e.allowRequest(peer, now) {
+		return &rfqmsg.ErrPeerQuoteRateExceeded
+	}
+
+	key := newAssetKey(assetID, groupKey)
+
+	var (
+		peerQuotes        uint32
+		outstandingAmount uint64
+	)
+	for _, quote := range e.quotes {
+		if quote.peer == peer {
+			peerQuotes++
+		}
+		if quote.asset == key {
+			outstandingAmount += quote.amount
+		}
+	}
+
+	maxPeerQuotes := e.limits.MaxQuotesPerPeer
+	if maxPeerQuotes != 0 && peerQuotes >= maxPeerQuotes {
+		return &rfqmsg.ErrPeerQuoteLimitReached
+	}
+
+	// We check the requested amount first so that the subtraction below
+	// can't underflow.
+	maxAssetAmount := e.limits.MaxAssetAmount
+	if maxAssetAmount != 0 && (amount > maxAssetAmount ||
+		outstandingAmount > maxAssetAmount-amount) {
+
+		return &rfqmsg.ErrAssetExposureLimitReached
+	}
+
+	e.quotes[id] = liveQuote{
+		peer:   peer,
+		asset:  key,
+		amount: amount,
+	}
+
+	return nil
+}
+
+// Confirm extends the reservation of an accepted quote until the given quote
+// expiry unix timestamp.
+func (e *exposureTracker) Confirm(id rfqmsg.ID, expiry uint64) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	quote, ok := e.quotes[id]
+	if !ok {
+		return
+	}
+
+	quote.expiry = time.Unix(int64(expiry), 0)
+	e.quotes[id] = quote
+}
+
+// Restore adds an accepted quote that was restored from the quote store after
+// a restart. The quote counts towards the exposure limits until the given
+// quote expiry unix timestamp. No limits are checked, as the quote has already
+// been committed to.
+func (e *exposureTracker) Restore(id rfqmsg.ID, peer route.Vertex,
+	assetID *asset.ID, groupKey *btcec.PublicKey, amount uint64,
+	expiry uint64) {
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.quotes[id] = liveQuote{
+		peer:   peer,
+		asset:  newAssetKey(assetID, groupKey),
+		amount: amount,
+		expiry: time.Unix(int64(expiry), 0),
+	}
+}
+
+// Release removes the reservation of a quote which was not accepted.
+func (e *exposureTracker) Release(id rfqmsg.ID) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	delete(e.quotes, id)
+}
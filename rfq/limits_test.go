@@ -0,0 +1,211 @@
+package rfq
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/rfqmsg"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+)
+
+// randQuoteID returns a random quote ID.
+func randQuoteID() rfqmsg.ID {
+	var id rfqmsg.ID
+	copy(id[:], test.RandBytes(len(id)))
+
+	return id
+}
+
+// TestExposureTrackerPeerQuoteLimit tests that the number of outstanding
+// quotes per peer is limited.
+func TestExposureTrackerPeerQuoteLimit(t *testing.T) {
+	t.Parallel()
+
+	tracker := newExposureTracker(ExposureLimits{
+		MaxQuotesPerPeer: 2,
+	})
+
+	now := time.Now()
+	tracker.now = func() time.Time {
+		return now
+	}
+
+	peerA := route.NewVertex(test.RandPubKey(t))
+	peerB := route.NewVertex(test.RandPubKey(t))
+	assetID := asset.RandID(t)
+
+	// The first two quotes of peer A are within the limit. The first one
+	// is accepted, the second one is still pending.
+	firstID := randQuoteID()
+	require.Nil(t, tracker.Reserve(firstID, peerA, &assetID, nil, 10))
+	tracker.Confirm(firstID, uint64(now.Add(time.Minute).Unix()))
+
+	secondID := randQuoteID()
+	require.Nil(t, tracker.Reserve(secondID, peerA, &assetID, nil, 10))
+
+	// A third quote exceeds the limit of peer A, but peer B is not
+	// affected.
+	rejectErr := tracker.Reserve(randQuoteID(), peerA, &assetID, nil, 10)
+	require.Equal(t, &rfqmsg.ErrPeerQuoteLimitReached, rejectErr)
+	require.Nil(t, tracker.Reserve(randQuoteID(), peerB, &assetID, nil, 10))
+
+	// Releasing the pending quote frees up a slot.
+	tracker.Release(secondID)
+	thirdID := randQuoteID()
+	require.Nil(t, tracker.Reserve(thirdID, peerA, &assetID, nil, 10))
+
+	rejectErr = tracker.Reserve(randQuoteID(), peerA, &assetID, nil, 10)
+	require.Equal(t, &rfqmsg.ErrPeerQuoteLimitReached, rejectErr)
+
+	// Once the accepted quote has expired, it no longer counts towards the
+	// limit. Pending quotes are never pruned.
+	now = now.Add(2 * time.Minute)
+	require.Nil(t, tracker.Reserve(randQuoteID(), peerA, &assetID, nil, 10))
+
+	rejectErr = tracker.Reserve(randQuoteID(), peerA, &assetID, nil, 10)
+	require.Equal(t, &rfqmsg.ErrPeerQuoteLimitReached, rejectErr)
+}
+
+// TestExposureTrackerAssetAmountLimit tests that the aggregate outstanding
+// amount per asset is limited across peers.
+func TestExposureTrackerAssetAmountLimit(t *testing.T) {
+	t.Parallel()
+
+	tracker := newExposureTracker(ExposureLimits{
+		MaxAssetAmount: 100,
+	})
+
+	peerA := route.NewVertex(test.RandPubKey(t))
+	peerB := route.NewVertex(test.RandPubKey(t))
+	assetID := asset.RandID(t)
+	otherAssetID := asset.RandID(t)
+	groupKey := test.RandPubKey(t)
+
+	// A single quote that exceeds the limit is rejected.
+	rejectErr := tracker.Reserve(randQuoteID(), peerA, &assetID, nil, 101)
+	require.Equal(t, &rfqmsg.ErrAssetExposureLimitReached, rejectErr)
+
+	// Quotes of different peers for the same asset add up.
+	firstID := randQuoteID()
+	require.Nil(t, tracker.Reserve(firstID, peerA, &assetID, nil, 60))
+	require.Nil(t, tracker.Reserve(randQuoteID(), peerB, &assetID, nil, 40))
+
+	rejectErr = tracker.Reserve(randQuoteID(), peerB, &assetID, nil, 1)
+	require.Equal(t, &rfqmsg.ErrAssetExposureLimitReached, rejectErr)
+
+	// Other assets and asset groups are tracked separately.
+	require.Nil(t, tracker.Reserve(
+		randQuoteID(), peerA, &otherAssetID, nil, 100,
+	))
+	require.Nil(t, tracker.Reserve(
+		randQuoteID(), peerA, nil, groupKey, 100,
+	))
+
+	// Releasing a quote frees up its amount.
+	tracker.Release(firstID)
+	require.Nil(t, tracker.Reserve(randQuoteID(), peerB, &assetID, nil, 60))
+}
+
+// TestExposureTrackerRateLimit tests that the quote request rate per peer is
+// limited.
+func TestExposureTrackerRateLimit(t *testing.T) {
+	t.Parallel()
+
+	tracker := newExposureTracker(ExposureLimits{
+		MaxQuoteRatePerPeer: 2,
+	})
+
+	now := time.Now()
+	tracker.now = func() time.Time {
+		return now
+	}
+
+	peerA := route.NewVertex(test.RandPubKey(t))
+	peerB := route.NewVertex(test.RandPubKey(t))
+	assetID := asset.RandID(t)
+
+	require.Nil(t, tracker.Reserve(randQuoteID(), peerA, &assetID, nil, 1))
+	require.Nil(t, tracker.Reserve(randQuoteID(), peerA, &assetID, nil, 1))
+
+	rejectErr := tracker.Reserve(randQuoteID(), peerA, &assetID, nil, 1)
+	require.Equal(t, &rfqmsg.ErrPeerQuoteRateExceeded, rejectErr)
+
+	// Other peers have their own allowance.
+	require.Nil(t, tracker.Reserve(randQuoteID(), peerB, &assetID, nil, 1))
+
+	// After half a minute, the peer may send one more request.
+	now = now.Add(30 * time.Second)
+	require.Nil(t, tracker.Reserve(randQuoteID(), peerA, &assetID, nil, 1))
+
+	rejectErr = tracker.Reserve(randQuoteID(), peerA, &assetID, nil, 1)
+	require.Equal(t, &rfqmsg.ErrPeerQuoteRateExceeded, rejectErr)
+}
+
+// TestExposureTrackerPruneLimiters tests that only the rate limiters of peers
+// that have their full allowance again are pruned.
+func TestExposureTrackerPruneLimiters(t *testing.T) {
+	t.Parallel()
+
+	tracker := newExposureTracker(ExposureLimits{
+		MaxQuoteRatePerPeer: 2,
+	})
+
+	now := time.Now()
+	tracker.now = func() time.Time {
+		return now
+	}
+
+	peerA := route.NewVertex(test.RandPubKey(t))
+	peerB := route.NewVertex(test.RandPubKey(t))
+	assetID := asset.RandID(t)
+
+	require.Nil(t, tracker.Reserve(randQuoteID(), peerA, &assetID, nil, 1))
+	now = now.Add(time.Minute)
+	require.Nil(t, tracker.Reserve(randQuoteID(), peerB, &assetID, nil, 1))
+	require.Len(t, tracker.rateLimiters, 2)
+
+	// Peer A has been idle long enough to have its full allowance again,
+	// while peer B has just used up part of its allowance.
+	tracker.pruneLimiters(now)
+	require.Len(t, tracker.rateLimiters, 1)
+	require.Contains(t, tracker.rateLimiters, peerB)
+}
+
+// TestExposureTrackerRestore tests that restored quotes count towards the
+// exposure limits until they expire.
+func TestExposureTrackerRestore(t *testing.T) {
+	t.Parallel()
+
+	tracker := newExposureTracker(ExposureLimits{
+		MaxQuotesPerPeer: 1,
+		MaxAssetAmount:   100,
+	})
+
+	now := time.Now()
+	tracker.now = func() time.Time {
+		return now
+	}
+
+	peerA := route.NewVertex(test.RandPubKey(t))
+	peerB := route.NewVertex(test.RandPubKey(t))
+	assetID := asset.RandID(t)
+
+	// A restored quote of peer A uses up its only quote slot and most of
+	// the asset's exposure.
+	expiry := uint64(now.Add(time.Minute).Unix())
+	tracker.Restore(randQuoteID(), peerA, &assetID, nil, 90, expiry)
+
+	rejectErr := tracker.Reserve(randQuoteID(), peerA, &assetID, nil, 1)
+	require.Equal(t, &rfqmsg.ErrPeerQuoteLimitReached, rejectErr)
+
+	rejectErr = tracker.Reserve(randQuoteID(), peerB, &assetID, nil, 20)
+	require.Equal(t, &rfqmsg.ErrAssetExposureLimitReached, rejectErr)
+
+	// Once the restored quote has expired, it no longer counts.
+	now = now.Add(2 * time.Minute)
+	require.Nil(t, tracker.Reserve(randQuoteID(), peerA, &assetID, nil, 1))
+	require.Nil(t, tracker.Reserve(randQuoteID(), peerB, &assetID, nil, 20))
+}
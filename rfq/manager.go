@@ -3,6 +3,7 @@ package rfq
 import (
 	"context"
 	"fmt"
+	"io"
 	"sync"
 	"time"
 
@@ -11,6 +12,8 @@ import (
 	"github.com/lightninglabs/taproot-assets/asset"
 	"github.com/lightninglabs/taproot-assets/fn"
 	"github.com/lightninglabs/taproot-assets/rfqmsg"
+	invpkg "github.com/lightningnetwork/lnd/invoices"
+	"github.com/lightningnetwork/lnd/keychain"
 	"github.com/lightningnetwork/lnd/lnutils"
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/routing/route"
@@ -23,8 +26,48 @@ const (
 	// CacheCleanupInterval is the interval at which local runtime caches
 	// are cleaned up.
 	CacheCleanupInterval = 30 * time.Second
+
+	// OutgoingRequestTimeout is the time after which a quote request that
+	// our node sent to a peer and that hasn't been answered is forgotten.
+	// An accept message that arrives later is dropped.
+	OutgoingRequestTimeout = 5 * time.Minute
+
+	// QuoteLogPruneInterval is the interval at which the quotes that have
+	// exceeded the quote ledger retention are removed.
+	QuoteLogPruneInterval = time.Hour
+
+	// HtlcEventsRetryDelay is the time we wait before we subscribe to the
+	// HTLC events again after the subscription failed.
+	HtlcEventsRetryDelay = 10 * time.Second
 )
 
+// outgoingRequest is a quote request that our node sent to a peer and that
+// hasn't been answered yet.
+type outgoingRequest[T any] struct {
+	// request is the quote request message.
+	request T
+
+	// expiry is the time after which the request is forgotten.
+	expiry time.Time
+}
+
+// nodeKeyLocator is the key locator of the lnd node identity key. Quote accept
+// messages are signed with this key.
+var nodeKeyLocator = keychain.KeyLocator{
+	Family: keychain.KeyFamilyNodeKey,
+	Index:  0,
+}
+
+// MsgSigner is used to sign the quote accept messages that we send to our
+// peers with our node identity key.
+type MsgSigner interface {
+	// SignMessage signs a message with the key specified in the key
+	// locator.
+	SignMessage(ctx context.Context, msg []byte,
+		locator keychain.KeyLocator,
+		opts ...lndclient.SignMessageOption) ([]byte, error)
+}
+
 // ManagerCfg is a struct that holds the configuration parameters for the RFQ
 // manager.
 type ManagerCfg struct {
@@ -40,6 +83,37 @@ type ManagerCfg struct {
 	// determine whether a quote is accepted or rejected.
 	PriceOracle PriceOracle
 
+	// QuoteStore is the persistent store for accepted quotes and the HTLC
+	// policies derived from them.
+	QuoteStore QuoteStore
+
+	// QuoteLedger is the persistent record of all negotiated quotes and
+	// the HTLCs that were accepted against them. Failures to update the
+	// ledger are logged, but never interrupt the RFQ service.
+	QuoteLedger QuoteLedger
+
+	// QuoteLogRetention is the duration for which quotes that were never
+	// traded against are kept in the quote ledger. A zero value keeps
+	// them forever.
+	QuoteLogRetention time.Duration
+
+	// HtlcEvents is used to learn whether the HTLCs that were accepted
+	// against our quotes were settled or failed, so that their trades can
+	// be finalized in the quote ledger. If nil, trades stay pending.
+	HtlcEvents HtlcEventSubscriber
+
+	// Signer is used to sign the quote accept messages that we send to our
+	// peers.
+	Signer MsgSigner
+
+	// ExposureLimits are the limits that the negotiator enforces on
+	// incoming quote requests.
+	ExposureLimits ExposureLimits
+
+	// GroupLookup is used to look up the asset group of an asset, so that
+	// quotes for an asset group can be served by any asset in the group.
+	GroupLookup GroupLookup
+
 	// ErrChan is the main error channel which will be used to report back
 	// critical errors to the main server.
 	ErrChan chan<- error
@@ -78,6 +152,18 @@ type Manager struct {
 	// events.
 	acceptHtlcEvents chan *AcceptHtlcEvent
 
+	// outgoingBuyRequests holds the buy requests that our node has sent
+	// to peers and which have not yet been answered. An incoming accept
+	// message is matched against its request in order to verify the
+	// accept message signature.
+	outgoingBuyRequests lnutils.SyncMap[
+		rfqmsg.ID, outgoingRequest[rfqmsg.BuyRequest]]
+
+	// outgoingSellRequests holds the sell requests that our node has sent
+	// to peers and which have not yet been answered.
+	outgoingSellRequests lnutils.SyncMap[
+		rfqmsg.ID, outgoingRequest[rfqmsg.SellRequest]]
+
 	// peerAcceptedBuyQuotes holds buy quotes for assets that our node has
 	// requested and that have been accepted by peer nodes. These quotes are
 	// exclusively used by our node for the acquisition of assets, as they
@@ -112,6 +198,10 @@ func NewManager(cfg ManagerCfg) (*Manager, error) {
 		outgoingMessages: make(chan rfqmsg.OutgoingMsg),
 
 		acceptHtlcEvents: make(chan *AcceptHtlcEvent),
+		outgoingBuyRequests: lnutils.SyncMap[
+			rfqmsg.ID, outgoingRequest[rfqmsg.BuyRequest]]{},
+		outgoingSellRequests: lnutils.SyncMap[
+			rfqmsg.ID, outgoingRequest[rfqmsg.SellRequest]]{},
 		peerAcceptedBuyQuotes: lnutils.SyncMap[
 			SerialisedScid, rfqmsg.BuyAccept]{},
 		peerAcceptedSellQuotes: lnutils.SyncMap[
@@ -133,10 +223,25 @@ func NewManager(cfg ManagerCfg) (*Manager, error) {
 func (m *Manager) startSubsystems(ctx context.Context) error {
 	var err error
 
+	// Before any subsystem is started, we'll remove all persisted quotes
+	// and policies that have expired while we were offline. We'll then
+	// restore the remaining quotes which were accepted by our peers.
+	err = m.cfg.QuoteStore.DeleteExpiredQuotes(ctx, time.Now())
+	if err != nil {
+		return fmt.Errorf("unable to delete expired quotes: %w", err)
+	}
+
+	err = m.restorePeerAcceptedQuotes(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to restore peer accepted quotes: %w",
+			err)
+	}
+
 	// Initialise and start the order handler.
 	m.orderHandler, err = NewOrderHandler(OrderHandlerCfg{
 		CleanupInterval:  CacheCleanupInterval,
 		HtlcInterceptor:  m.cfg.HtlcInterceptor,
+		QuoteStore:       m.cfg.QuoteStore,
 		AcceptHtlcEvents: m.acceptHtlcEvents,
 	})
 	if err != nil {
@@ -169,6 +274,9 @@ func (m *Manager) startSubsystems(ctx context.Context) error {
 	m.negotiator, err = NewNegotiator(
 		NegotiatorCfg{
 			PriceOracle:      m.cfg.PriceOracle,
+			ExposureLimits:   m.cfg.ExposureLimits,
+			GroupLookup:      m.cfg.GroupLookup,
+			QuoteLedger:      m.cfg.QuoteLedger,
 			OutgoingMessages: m.outgoingMessages,
 			ErrChan:          m.subsystemErrChan,
 		},
@@ -178,6 +286,16 @@ func (m *Manager) startSubsystems(ctx context.Context) error {
 			err)
 	}
 
+	// The quotes that we accepted before a restart still count towards
+	// our exposure limits until they expire.
+	saleQuotes, purchaseQuotes, err := m.cfg.QuoteStore.FetchPolicies(
+		ctx, time.Now(),
+	)
+	if err != nil {
+		return fmt.Errorf("unable to fetch policies: %w", err)
+	}
+	m.negotiator.RestoreExposure(saleQuotes, purchaseQuotes)
+
 	if err := m.negotiator.Start(); err != nil {
 		return fmt.Errorf("unable to start RFQ negotiator: %w", err)
 	}
@@ -185,6 +303,32 @@ func (m *Manager) startSubsystems(ctx context.Context) error {
 	return err
 }
 
+// restorePeerAcceptedQuotes loads the unexpired quotes which were requested by
+// our node and accepted by our peers from the quote store.
+func (m *Manager) restorePeerAcceptedQuotes(ctx context.Context) error {
+	buyQuotes, sellQuotes, err := m.cfg.QuoteStore.FetchPeerAcceptedQuotes(
+		ctx, time.Now(),
+	)
+	if err != nil {
+		return err
+	}
+
+	for _, quote := range buyQuotes {
+		scid := SerialisedScid(quote.ShortChannelId())
+		m.peerAcceptedBuyQuotes.Store(scid, quote)
+	}
+
+	for _, quote := range sellQuotes {
+		scid := SerialisedScid(quote.ShortChannelId())
+		m.peerAcceptedSellQuotes.Store(scid, quote)
+	}
+
+	log.Infof("Restored peer accepted quotes (buy_quotes=%d, "+
+		"sell_quotes=%d)", len(buyQuotes), len(sellQuotes))
+
+	return nil
+}
+
 // Start attempts to start a new RFQ manager.
 func (m *Manager) Start() error {
 	var startErr error
@@ -220,6 +364,13 @@ func (m *Manager) Start() error {
 			log.Info("Starting RFQ manager main event loop")
 			m.mainEventLoop()
 		}()
+
+		// Finalize the trades of the quote ledger once their HTLCs
+		// are resolved.
+		if m.cfg.HtlcEvents != nil {
+			m.Wg.Add(1)
+			go m.watchHtlcEvents()
+		}
 	})
 	return startErr
 }
@@ -260,6 +411,14 @@ func (m *Manager) stopSubsystems() error {
 			err)
 	}
 
+	// Close the connection to the price oracle, if it holds one.
+	if closer, ok := m.cfg.PriceOracle.(io.Closer); ok {
+		err = closer.Close()
+		if err != nil {
+			return fmt.Errorf("error closing price oracle: %w", err)
+		}
+	}
+
 	return nil
 }
 
@@ -269,6 +428,8 @@ func (m *Manager) handleIncomingMessage(incomingMsg rfqmsg.IncomingMsg) error {
 	// Perform type specific handling of the incoming message.
 	switch msg := incomingMsg.(type) {
 	case *rfqmsg.BuyRequest:
+		// The negotiator records the request in the quote ledger once
+		// it passed the exposure and rate limits.
 		err := m.negotiator.HandleIncomingBuyRequest(*msg)
 		if err != nil {
 			return fmt.Errorf("error handling incoming buy "+
@@ -276,12 +437,54 @@ func (m *Manager) handleIncomingMessage(incomingMsg rfqmsg.IncomingMsg) error {
 		}
 
 	case *rfqmsg.BuyAccept:
-		// TODO(ffranr): The stream handler should ensure that the
-		//  accept message corresponds to a request.
-		//
+		// Ensure that the accept message corresponds to a request that
+		// we sent to the same peer and that it was signed by the peer.
+		// Otherwise, we drop the message.
+		outgoing, ok := m.outgoingBuyRequests.Load(msg.ID)
+		if !ok {
+			log.Warnf("Dropping buy accept message which does not "+
+				"correspond to an outgoing request: %s", msg)
+			return nil
+		}
+
+		request := outgoing.request
+		if msg.Peer != request.Peer {
+			log.Warnf("Dropping buy accept message from a peer "+
+				"other than the one the request was sent to "+
+				"(request_peer=%x): %s", request.Peer[:], msg)
+			return nil
+		}
+		m.outgoingBuyRequests.Delete(msg.ID)
+
+		msg.AssetAmount = request.AssetAmount
+		msg.AssetID = request.AssetID
+		msg.AssetGroupKey = request.AssetGroupKey
+
+		if err := msg.VerifySignature(); err != nil {
+			log.Warnf("Dropping buy accept message with invalid "+
+				"signature (msg=%s): %v", msg, err)
+			return nil
+		}
+
 		// The quote request has been accepted. Store accepted quote
 		// so that it can be used to send a payment by our lightning
-		// node.
+		// node. We also persist the quote so that it survives a
+		// restart.
+		ctx, cancel := m.WithCtxQuit()
+		defer cancel()
+
+		err := m.cfg.QuoteStore.UpsertPeerAcceptedBuyQuote(ctx, *msg)
+		if err != nil {
+			return fmt.Errorf("unable to store peer accepted buy "+
+				"quote: %w", err)
+		}
+
+		err = m.cfg.QuoteLedger.LogBuyAccept(ctx, *msg)
+		if err != nil {
+			log.Errorf("Unable to log peer accepted buy quote: "+
+				"%v", err)
+		}
+
 		scid := SerialisedScid(msg.ShortChannelId())
 		m.peerAcceptedBuyQuotes.Store(scid, *msg)
 
@@ -291,6 +494,8 @@ func (m *Manager) handleIncomingMessage(incomingMsg rfqmsg.IncomingMsg) error {
 		m.publishSubscriberEvent(event)
 
 	case *rfqmsg.SellRequest:
+		// The negotiator records the request in the quote ledger once
+		// it passed the exposure and rate limits.
 		err := m.negotiator.HandleIncomingSellRequest(*msg)
 		if err != nil {
 			return fmt.Errorf("error handling incoming sell "+
@@ -298,12 +503,55 @@ func (m *Manager) handleIncomingMessage(incomingMsg rfqmsg.IncomingMsg) error {
 		}
 
 	case *rfqmsg.SellAccept:
-		// TODO(ffranr): The stream handler should ensure that the
-		//  accept message corresponds to a request.
-		//
+		// Ensure that the accept message corresponds to a request that
+		// we sent to the same peer and that it was signed by the peer.
+		// Otherwise, we drop the message.
+		outgoing, ok := m.outgoingSellRequests.Load(msg.ID)
+		if !ok {
+			log.Warnf("Dropping sell accept message which does "+
+				"not correspond to an outgoing request: %s",
+				msg)
+			return nil
+		}
+
+		request := outgoing.request
+		if msg.Peer != request.Peer {
+			log.Warnf("Dropping sell accept message from a peer "+
+				"other than the one the request was sent to "+
+				"(request_peer=%x): %s", request.Peer[:], msg)
+			return nil
+		}
+		m.outgoingSellRequests.Delete(msg.ID)
+
+		msg.AssetAmount = request.AssetAmount
+		msg.AssetID = request.AssetID
+		msg.AssetGroupKey = request.AssetGroupKey
+
+		if err := msg.VerifySignature(); err != nil {
+			log.Warnf("Dropping sell accept message with invalid "+
+				"signature (msg=%s): %v", msg, err)
+			return nil
+		}
+
 		// The quote request has been accepted. Store accepted quote
 		// so that it can be used to send a payment by our lightning
-		// node.
+		// node. We also persist the quote so that it survives a
+		// restart.
+		ctx, cancel := m.WithCtxQuit()
+		defer cancel()
+
+		err := m.cfg.QuoteStore.UpsertPeerAcceptedSellQuote(ctx, *msg)
+		if err != nil {
+			return fmt.Errorf("unable to store peer accepted sell "+
+				"quote: %w", err)
+		}
+
+		err = m.cfg.QuoteLedger.LogSellAccept(ctx, *msg)
+		if err != nil {
+			log.Errorf("Unable to log peer accepted sell quote: "+
+				"%v", err)
+		}
+
 		scid := SerialisedScid(msg.ShortChannelId())
 		m.peerAcceptedSellQuotes.Store(scid, *msg)
 
@@ -313,8 +561,19 @@ func (m *Manager) handleIncomingMessage(incomingMsg rfqmsg.IncomingMsg) error {
 		m.publishSubscriberEvent(event)
 
 	case *rfqmsg.Reject:
-		// The quote request has been rejected. Notify subscribers of
-		// the rejection.
+		// The quote request has been rejected. We no longer expect an
+		// accept message for the request.
+		m.outgoingBuyRequests.Delete(msg.ID)
+		m.outgoingSellRequests.Delete(msg.ID)
+
+		ctx, cancel := m.WithCtxQuit()
+		err := m.cfg.QuoteLedger.LogReject(ctx, *msg)
+		cancel()
+		if err != nil {
+			log.Errorf("Unable to log incoming reject: %v", err)
+		}
+
+		// Notify subscribers of the rejection.
 		event := NewIncomingRejectQuoteEvent(msg)
 		m.publishSubscriberEvent(event)
 
@@ -330,21 +589,108 @@ func (m *Manager) handleIncomingMessage(incomingMsg rfqmsg.IncomingMsg) error {
 func (m *Manager) handleOutgoingMessage(outgoingMsg rfqmsg.OutgoingMsg) error {
 	// Perform type specific handling of the outgoing message.
 	switch msg := outgoingMsg.(type) {
+	case *rfqmsg.BuyRequest:
+		// Keep track of the request so that we can match it with the
+		// accept message of our peer.
+		m.outgoingBuyRequests.Store(
+			msg.ID, outgoingRequest[rfqmsg.BuyRequest]{
+				request: *msg,
+				expiry: time.Now().Add(
+					OutgoingRequestTimeout,
+				),
+			},
+		)
+
+		ctx, cancel := m.WithCtxQuit()
+		err := m.cfg.QuoteLedger.LogBuyRequest(ctx, *msg, false)
+		cancel()
+		if err != nil {
+			log.Errorf("Unable to log outgoing buy request: %v",
+				err)
+		}
+
+	case *rfqmsg.SellRequest:
+		// Keep track of the request so that we can match it with the
+		// accept message of our peer.
+		m.outgoingSellRequests.Store(
+			msg.ID, outgoingRequest[rfqmsg.SellRequest]{
+				request: *msg,
+				expiry: time.Now().Add(
+					OutgoingRequestTimeout,
+				),
+			},
+		)
+
+		ctx, cancel := m.WithCtxQuit()
+		err := m.cfg.QuoteLedger.LogSellRequest(ctx, *msg, false)
+		cancel()
+		if err != nil {
+			log.Errorf("Unable to log outgoing sell request: %v",
+				err)
+		}
+
 	case *rfqmsg.BuyAccept:
+		// Sign the accept message with our node identity key so that
+		// our peer can prove that we committed to the quote.
+		sig, err := m.signAcceptMsg(msg.SigMsg())
+		if err != nil {
+			return fmt.Errorf("unable to sign buy accept: %w", err)
+		}
+		msg.SetSignature(sig)
+
 		// A peer sent us an asset buy quote request in an attempt to
 		// buy an asset from us. Having accepted the request, but before
 		// we inform our peer of our decision, we inform the order
 		// handler that we are willing to sell the asset subject to a
 		// sale policy.
-		m.orderHandler.RegisterAssetSalePolicy(*msg)
+		err = m.orderHandler.RegisterAssetSalePolicy(*msg)
+		if err != nil {
+			return fmt.Errorf("unable to register asset sale "+
+				"policy: %w", err)
+		}
+
+		ctx, cancel := m.WithCtxQuit()
+		err = m.cfg.QuoteLedger.LogBuyAccept(ctx, *msg)
+		cancel()
+		if err != nil {
+			log.Errorf("Unable to log buy accept: %v", err)
+		}
 
 	case *rfqmsg.SellAccept:
+		// Sign the accept message with our node identity key so that
+		// our peer can prove that we committed to the quote.
+		sig, err := m.signAcceptMsg(msg.SigMsg())
+		if err != nil {
+			return fmt.Errorf("unable to sign sell accept: %w", err)
+		}
+		msg.SetSignature(sig)
+
 		// A peer sent us an asset sell quote request in an attempt to
 		// sell an asset to us. Having accepted the request, but before
 		// we inform our peer of our decision, we inform the order
 		// handler that we are willing to buy the asset subject to a
 		// purchase policy.
-		m.orderHandler.RegisterAssetPurchasePolicy(*msg)
+		err = m.orderHandler.RegisterAssetPurchasePolicy(*msg)
+		if err != nil {
+			return fmt.Errorf("unable to register asset purchase "+
+				"policy: %w", err)
+		}
+
+		ctx, cancel := m.WithCtxQuit()
+		err = m.cfg.QuoteLedger.LogSellAccept(ctx, *msg)
+		cancel()
+		if err != nil {
+			log.Errorf("Unable to log sell accept: %v", err)
+		}
+
+	case *rfqmsg.Reject:
+		// Record that we declined the quote request of our peer.
+		ctx, cancel := m.WithCtxQuit()
+		err := m.cfg.QuoteLedger.LogReject(ctx, *msg)
+		cancel()
+		if err != nil {
+			log.Errorf("Unable to log outgoing reject: %v", err)
+		}
 	}
 
 	// Send the outgoing message to the peer.
@@ -357,8 +703,179 @@ func (m *Manager) handleOutgoingMessage(outgoingMsg rfqmsg.OutgoingMsg) error {
 	return nil
 }
 
+// signAcceptMsg creates a Schnorr signature over the given quote accept
+// message using our node identity key.
+func (m *Manager) signAcceptMsg(msg []byte) ([64]byte, error) {
+	var sig [64]byte
+
+	ctx, cancel := m.WithCtxQuit()
+	defer cancel()
+
+	sigBytes, err := m.cfg.Signer.SignMessage(
+		ctx, msg, nodeKeyLocator, lndclient.SignSchnorr(nil),
+	)
+	if err != nil {
+		return sig, err
+	}
+
+	if len(sigBytes) != len(sig) {
+		return sig, fmt.Errorf("unexpected signature length: %d",
+			len(sigBytes))
+	}
+	copy(sig[:], sigBytes)
+
+	return sig, nil
+}
+
+// pruneOutgoingRequests removes the outgoing quote requests which have not
+// been answered by our peers before their expiry.
+func (m *Manager) pruneOutgoingRequests(now time.Time) {
+	m.outgoingBuyRequests.ForEach(
+		func(id rfqmsg.ID,
+			req outgoingRequest[rfqmsg.BuyRequest]) error {
+
+			if now.After(req.expiry) {
+				log.Debugf("Removing unanswered outgoing buy "+
+					"request: %x", id[:])
+				m.outgoingBuyRequests.Delete(id)
+			}
+
+			return nil
+		},
+	)
+
+	m.outgoingSellRequests.ForEach(
+		func(id rfqmsg.ID,
+			req outgoingRequest[rfqmsg.SellRequest]) error {
+
+			if now.After(req.expiry) {
+				log.Debugf("Removing unanswered outgoing "+
+					"sell request: %x", id[:])
+				m.outgoingSellRequests.Delete(id)
+			}
+
+			return nil
+		},
+	)
+}
+
+// logTrade records the HTLC of the given accept event in the quote ledger as
+// a pending trade. The trade is finalized once the HTLC is settled or failed.
+func (m *Manager) logTrade(event *AcceptHtlcEvent) {
+	ctx, cancel := m.WithCtxQuit()
+	defer cancel()
+
+	quoteID := event.Policy.QuoteID()
+	assetAmount := event.Policy.HtlcAssetAmount(event.Htlc)
+	err := m.cfg.QuoteLedger.LogTrade(
+		ctx, quoteID, event.Htlc, assetAmount,
+	)
+	if err != nil {
+		log.Errorf("Unable to log trade for quote %x: %v", quoteID[:],
+			err)
+	}
+}
+
+// pruneQuoteLog removes the quotes that have exceeded the quote ledger
+// retention and were never traded against.
+func (m *Manager) pruneQuoteLog() {
+	if m.cfg.QuoteLogRetention == 0 {
+		return
+	}
+
+	ctx, cancel := m.WithCtxQuit()
+	defer cancel()
+
+	createdBefore := time.Now().Add(-m.cfg.QuoteLogRetention)
+	err := m.cfg.QuoteLedger.PruneQuotes(ctx, createdBefore)
+	if err != nil {
+		log.Errorf("Unable to prune quote ledger: %v", err)
+	}
+}
+
+// watchHtlcEvents finalizes the pending trades of the quote ledger once their
+// HTLCs are settled or failed. If the HTLC event subscription fails, we
+// subscribe again after a delay.
+//
+// NOTE: This MUST be run as a goroutine.
+func (m *Manager) watchHtlcEvents() {
+	defer m.Wg.Done()
+
+	for {
+		err := m.processHtlcEvents()
+		if err != nil {
+			log.Errorf("HTLC event subscription failed, trades "+
+				"can't be finalized: %v", err)
+		}
+
+		select {
+		case <-time.After(HtlcEventsRetryDelay):
+		case <-m.Quit:
+			return
+		}
+	}
+}
+
+// processHtlcEvents subscribes to the HTLC events and finalizes the pending
+// trade of every HTLC that reaches its final state. It returns once the
+// subscription fails or the manager shuts down.
+func (m *Manager) processHtlcEvents() error {
+	ctx, cancel := m.WithCtxQuitNoTimeout()
+	defer cancel()
+
+	events, errChan, err := m.cfg.HtlcEvents.SubscribeHtlcEvents(ctx)
+	if err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case event, ok := <-events:
+			if !ok {
+				return fmt.Errorf("HTLC event stream closed")
+			}
+
+			finalEvent := event.GetFinalHtlcEvent()
+			if finalEvent == nil {
+				continue
+			}
+
+			chanID := lnwire.NewShortChanIDFromInt(
+				event.IncomingChannelId,
+			)
+			circuitKey := invpkg.CircuitKey{
+				ChanID: chanID,
+				HtlcID: event.IncomingHtlcId,
+			}
+
+			// Only the HTLCs that were accepted against one of
+			// our quotes have a pending trade, all other HTLCs
+			// are ignored by the ledger.
+			err := m.cfg.QuoteLedger.ResolveTrade(
+				ctx, circuitKey, finalEvent.Settled,
+			)
+			if err != nil {
+				log.Errorf("Unable to resolve trade of HTLC "+
+					"%v: %v", circuitKey, err)
+			}
+
+		case err := <-errChan:
+			return err
+
+		case <-m.Quit:
+			return nil
+		}
+	}
+}
+
 // mainEventLoop is the main event loop of the RFQ manager.
 func (m *Manager) mainEventLoop() {
+	cleanupTicker := time.NewTicker(CacheCleanupInterval)
+	defer cleanupTicker.Stop()
+
+	pruneTicker := time.NewTicker(QuoteLogPruneInterval)
+	defer pruneTicker.Stop()
+
 	for {
 		select {
 		// Handle incoming message.
@@ -384,9 +901,20 @@ func (m *Manager) mainEventLoop() {
 			}
 
 		case acceptHtlcEvent := <-m.acceptHtlcEvents:
-			// Handle a HTLC accept event. Notify any subscribers.
+			// Handle a HTLC accept event. Record the trade against
+			// the quote of the policy and notify any subscribers.
+			m.logTrade(acceptHtlcEvent)
 			m.publishSubscriberEvent(acceptHtlcEvent)
 
+		// Forget the outgoing quote requests that our peers didn't
+		// answer in time.
+		case <-cleanupTicker.C:
+			m.pruneOutgoingRequests(time.Now())
+
+		// Remove the quotes that exceeded the ledger retention.
+		case <-pruneTicker.C:
+			m.pruneQuoteLog()
+
 		// Handle subsystem errors.
 		case err := <-m.subsystemErrChan:
 			// Report the subsystem error to the main server.
@@ -550,7 +1078,7 @@ func (m *Manager) QueryPeerAcceptedQuotes() (map[SerialisedScid]rfqmsg.BuyAccept
 	m.peerAcceptedSellQuotes.ForEach(
 		func(scid SerialisedScid, accept rfqmsg.SellAccept) error {
 			if time.Now().Unix() > int64(accept.Expiry) {
-				m.peerAcceptedBuyQuotes.Delete(scid)
+				m.peerAcceptedSellQuotes.Delete(scid)
 				return nil
 			}
 
@@ -562,6 +1090,22 @@ func (m *Manager) QueryPeerAcceptedQuotes() (map[SerialisedScid]rfqmsg.BuyAccept
 	return buyQuotesCopy, sellQuotesCopy
 }
 
+// QueryQuoteLedger returns the quotes in the quote ledger which match the given
+// query.
+func (m *Manager) QueryQuoteLedger(ctx context.Context,
+	query LedgerQuery) ([]QuoteLogEntry, error) {
+
+	return m.cfg.QuoteLedger.QueryQuotes(ctx, query, time.Now())
+}
+
+// QueryTradeLedger returns the HTLCs that our node accepted against its quotes
+// and which match the given query.
+func (m *Manager) QueryTradeLedger(ctx context.Context,
+	query LedgerQuery) ([]Trade, error) {
+
+	return m.cfg.QuoteLedger.QueryTrades(ctx, query)
+}
+
 // RegisterSubscriber adds a new subscriber to the set of subscribers that will
 // be notified of any new events that are broadcast.
 //
@@ -0,0 +1,137 @@
+package rfq
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/rfqmsg"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+)
+
+// TestPruneOutgoingRequests tests that outgoing quote requests which our
+// peers didn't answer in time are forgotten, and that a late accept message
+// for such a request is dropped.
+func TestPruneOutgoingRequests(t *testing.T) {
+	t.Parallel()
+
+	manager, err := NewManager(ManagerCfg{})
+	require.NoError(t, err)
+
+	peer := route.NewVertex(test.RandPubKey(t))
+	assetID := asset.RandID(t)
+
+	buyReq, err := rfqmsg.NewBuyRequest(peer, &assetID, nil, 10, 0, 0)
+	require.NoError(t, err)
+	sellReq, err := rfqmsg.NewSellRequest(peer, &assetID, nil, 10, 0, 0)
+	require.NoError(t, err)
+	staleReq, err := rfqmsg.NewBuyRequest(peer, &assetID, nil, 10, 0, 0)
+	require.NoError(t, err)
+
+	now := time.Now()
+	expiry := now.Add(OutgoingRequestTimeout)
+	manager.outgoingBuyRequests.Store(
+		buyReq.ID, outgoingRequest[rfqmsg.BuyRequest]{
+			request: *buyReq,
+			expiry:  expiry,
+		},
+	)
+	manager.outgoingSellRequests.Store(
+		sellReq.ID, outgoingRequest[rfqmsg.SellRequest]{
+			request: *sellReq,
+			expiry:  expiry,
+		},
+	)
+	manager.outgoingBuyRequests.Store(
+		staleReq.ID, outgoingRequest[rfqmsg.BuyRequest]{
+			request: *staleReq,
+			expiry:  now.Add(-time.Second),
+		},
+	)
+
+	// Only the request that has expired should be removed.
+	manager.pruneOutgoingRequests(now)
+
+	require.Equal(t, 1, manager.outgoingBuyRequests.Len())
+	require.Equal(t, 1, manager.outgoingSellRequests.Len())
+
+	_, ok := manager.outgoingBuyRequests.Load(staleReq.ID)
+	require.False(t, ok)
+
+	// An accept message for the forgotten request is dropped without
+	// being stored.
+	staleAccept := rfqmsg.NewBuyAcceptFromRequest(*staleReq, 100, 0)
+	require.NoError(t, manager.handleIncomingMessage(staleAccept))
+
+	_, ok = manager.peerAcceptedBuyQuotes.Load(
+		SerialisedScid(staleAccept.ShortChannelId()),
+	)
+	require.False(t, ok)
+
+	// Once the timeout has passed, the remaining requests are removed as
+	// well.
+	manager.pruneOutgoingRequests(expiry.Add(time.Second))
+
+	require.Zero(t, manager.outgoingBuyRequests.Len())
+	require.Zero(t, manager.outgoingSellRequests.Len())
+}
+
+// TestAcceptFromOtherPeer tests that an accept message is dropped if it wasn't
+// sent by the peer that the corresponding quote request was sent to.
+func TestAcceptFromOtherPeer(t *testing.T) {
+	t.Parallel()
+
+	manager, err := NewManager(ManagerCfg{})
+	require.NoError(t, err)
+
+	peer := route.NewVertex(test.RandPubKey(t))
+	otherPeer := route.NewVertex(test.RandPubKey(t))
+	assetID := asset.RandID(t)
+
+	buyReq, err := rfqmsg.NewBuyRequest(peer, &assetID, nil, 10, 0, 0)
+	require.NoError(t, err)
+	sellReq, err := rfqmsg.NewSellRequest(peer, &assetID, nil, 10, 0, 0)
+	require.NoError(t, err)
+
+	expiry := time.Now().Add(OutgoingRequestTimeout)
+	manager.outgoingBuyRequests.Store(
+		buyReq.ID, outgoingRequest[rfqmsg.BuyRequest]{
+			request: *buyReq,
+			expiry:  expiry,
+		},
+	)
+	manager.outgoingSellRequests.Store(
+		sellReq.ID, outgoingRequest[rfqmsg.SellRequest]{
+			request: *sellReq,
+			expiry:  expiry,
+		},
+	)
+
+	buyAccept := rfqmsg.NewBuyAcceptFromRequest(*buyReq, 100, 0)
+	buyAccept.Peer = otherPeer
+	require.NoError(t, manager.handleIncomingMessage(buyAccept))
+
+	sellAccept := rfqmsg.NewSellAcceptFromRequest(*sellReq, 100, 0)
+	sellAccept.Peer = otherPeer
+	require.NoError(t, manager.handleIncomingMessage(sellAccept))
+
+	// Neither accept message is stored, and the requests are still
+	// waiting for an answer from the peer they were sent to.
+	_, ok := manager.peerAcceptedBuyQuotes.Load(
+		SerialisedScid(buyAccept.ShortChannelId()),
+	)
+	require.False(t, ok)
+
+	_, ok = manager.peerAcceptedSellQuotes.Load(
+		SerialisedScid(sellAccept.ShortChannelId()),
+	)
+	require.False(t, ok)
+
+	_, ok = manager.outgoingBuyRequests.Load(buyReq.ID)
+	require.True(t, ok)
+
+	_, ok = manager.outgoingSellRequests.Load(sellReq.ID)
+	require.True(t, ok)
+}
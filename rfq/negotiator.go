@@ -1,8 +1,10 @@
 package rfq
 
 import (
+	"context"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/lightninglabs/taproot-assets/asset"
@@ -19,6 +21,21 @@ type NegotiatorCfg struct {
 	// determine whether a quote is accepted or rejected.
 	PriceOracle PriceOracle
 
+	// ExposureLimits are the limits that are enforced on incoming quote
+	// requests.
+	ExposureLimits ExposureLimits
+
+	// GroupLookup is used to resolve the asset group of the assets that
+	// quotes are requested for. Offers and exposure limits for an asset
+	// group then apply to all assets in the group. If nil, every asset is
+	// treated as ungrouped.
+	GroupLookup GroupLookup
+
+	// QuoteLedger is the persistent record of all negotiated quotes. Only
+	// the incoming quote requests that pass the exposure limits are
+	// recorded. If nil, no requests are recorded.
+	QuoteLedger QuoteLedger
+
 	// OutgoingMessages is a channel which is populated with outgoing peer
 	// messages. These are messages which are destined to be sent to peers.
 	OutgoingMessages chan<- rfqmsg.OutgoingMsg
@@ -52,6 +69,13 @@ type Negotiator struct {
 	// asset buy offers.
 	assetGroupBuyOffers lnutils.SyncMap[asset.SerializedKey, BuyOffer]
 
+	// exposure keeps track of the outstanding quotes that we have accepted
+	// and enforces the exposure limits.
+	exposure *exposureTracker
+
+	// groups resolves the asset groups of grouped assets.
+	groups *groupResolver
+
 	// ContextGuard provides a wait group and main quit channel that can be
 	// used to create guarded contexts.
 	*fn.ContextGuard
@@ -75,6 +99,9 @@ func NewNegotiator(cfg NegotiatorCfg) (*Negotiator, error) {
 		assetGroupBuyOffers: lnutils.SyncMap[
 			asset.SerializedKey, BuyOffer]{},
 
+		exposure: newExposureTracker(cfg.ExposureLimits),
+		groups:   newGroupResolver(cfg.GroupLookup),
+
 		ContextGuard: &fn.ContextGuard{
 			DefaultTimeout: DefaultTimeout,
 			Quit:           make(chan struct{}),
@@ -84,22 +111,21 @@ func NewNegotiator(cfg NegotiatorCfg) (*Negotiator, error) {
 
 // queryBidFromPriceOracle queries the price oracle for a bid price. It returns
 // an appropriate outgoing response message which should be sent to the peer.
+//
+// The ask price proposed by the peer is optional, because at some call sites
+// we are initiating a request and do not have one. If set, it is passed to
+// the price oracle together with its expiry, so that the oracle can use it as
+// a factor when computing the bid price.
 func (n *Negotiator) queryBidFromPriceOracle(peer route.Vertex,
-	assetId *asset.ID, assetGroupKey *btcec.PublicKey,
-	assetAmount uint64) (lnwire.MilliSatoshi, uint64, error) {
-
-	// TODO(ffranr): Optionally accept a peer's proposed ask price as an
-	//  arg to this func and pass it to the price oracle. The price oracle
-	//  service might be intelligent enough to use the peer's proposed ask
-	//  price as a factor when computing the bid price. This argument must
-	//  be optional because at some call sites we are initiating a request
-	//  and do not have a peer's proposed ask price.
+	assetId *asset.ID, assetGroupKey *btcec.PublicKey, assetAmount uint64,
+	ask *lnwire.MilliSatoshi,
+	askExpiry uint64) (lnwire.MilliSatoshi, uint64, error) {
 
 	ctx, cancel := n.WithCtxQuitNoTimeout()
 	defer cancel()
 
 	oracleResponse, err := n.cfg.PriceOracle.QueryBidPrice(
-		ctx, assetId, assetGroupKey, assetAmount,
+		ctx, assetId, assetGroupKey, assetAmount, ask, askExpiry,
 	)
 	if err != nil {
 		return 0, 0, fmt.Errorf("failed to query price oracle for "+
@@ -138,9 +164,10 @@ func (n *Negotiator) HandleOutgoingBuyOrder(buyOrder BuyOrder) error {
 		defer n.Wg.Done()
 
 		// Query the price oracle for a bid price.
-		bidPrice, _, err := n.queryBidFromPriceOracle(
+		bidPrice, bidExpiry, err := n.queryBidFromPriceOracle(
 			*buyOrder.Peer, buyOrder.AssetID,
 			buyOrder.AssetGroupKey, buyOrder.MinAssetAmount,
+			nil, 0,
 		)
 		if err != nil {
 			err := fmt.Errorf("negotiator failed to handle price "+
@@ -149,10 +176,12 @@ func (n *Negotiator) HandleOutgoingBuyOrder(buyOrder BuyOrder) error {
 			return
 		}
 
+		// The suggested bid price is only valid for as long as the
+		// price oracle's quote.
 		request, err := rfqmsg.NewBuyRequest(
 			*buyOrder.Peer, buyOrder.AssetID,
 			buyOrder.AssetGroupKey, buyOrder.MinAssetAmount,
-			bidPrice,
+			bidPrice, bidExpiry,
 		)
 		if err != nil {
 			err := fmt.Errorf("unable to create buy request "+
@@ -180,17 +209,19 @@ func (n *Negotiator) HandleOutgoingBuyOrder(buyOrder BuyOrder) error {
 
 // queryAskFromPriceOracle queries the price oracle for an asking price. It
 // returns an appropriate outgoing response message which should be sent to the
-// peer.
+// peer. The optional bid price proposed by the peer is passed to the price
+// oracle together with its expiry.
 func (n *Negotiator) queryAskFromPriceOracle(peer *route.Vertex,
 	assetId *asset.ID, assetGroupKey *btcec.PublicKey, assetAmount uint64,
-	bid *lnwire.MilliSatoshi) (lnwire.MilliSatoshi, uint64, error) {
+	bid *lnwire.MilliSatoshi,
+	bidExpiry uint64) (lnwire.MilliSatoshi, uint64, error) {
 
 	// Query the price oracle for an asking price.
 	ctx, cancel := n.WithCtxQuitNoTimeout()
 	defer cancel()
 
 	oracleResponse, err := n.cfg.PriceOracle.QueryAskPrice(
-		ctx, assetId, assetGroupKey, assetAmount, bid,
+		ctx, assetId, assetGroupKey, assetAmount, bid, bidExpiry,
 	)
 	if err != nil {
 		return 0, 0, fmt.Errorf("failed to query price oracle for "+
@@ -232,6 +263,22 @@ func (n *Negotiator) queryAskFromPriceOracle(peer *route.Vertex,
 	return finalAskPrice, oracleResponse.Expiry, nil
 }
 
+// sendReject sends a quote reject message with the given error to the peer.
+func (n *Negotiator) sendReject(peer route.Vertex, requestID rfqmsg.ID,
+	rejectErr rfqmsg.RejectErr) error {
+
+	var msg rfqmsg.OutgoingMsg = rfqmsg.NewReject(
+		peer, requestID, rejectErr,
+	)
+
+	sendSuccess := fn.SendOrQuit(n.cfg.OutgoingMessages, msg, n.Quit)
+	if !sendSuccess {
+		return fmt.Errorf("negotiator failed to send reject message")
+	}
+
+	return nil
+}
+
 // HandleIncomingBuyRequest handles an incoming asset buy quote request.
 func (n *Negotiator) HandleIncomingBuyRequest(
 	request rfqmsg.BuyRequest) error {
@@ -261,6 +308,34 @@ func (n *Negotiator) HandleIncomingBuyRequest(
 		return nil
 	}
 
+	// Ensure that accepting the quote request would not exceed any of our
+	// exposure limits. If it doesn't, the requested amount is reserved
+	// until the quote expires.
+	//
+	// The tranches of a grouped asset are all accounted for under their
+	// group key.
+	ctx, cancel := n.WithCtxQuit()
+	defer cancel()
+
+	assetID, groupKey := n.groups.resolve(
+		ctx, request.AssetID, request.AssetGroupKey,
+	)
+	rejectErr := n.exposure.Reserve(
+		request.ID, request.Peer, assetID, groupKey,
+		request.AssetAmount,
+	)
+	if rejectErr != nil {
+		log.Debugf("Rejecting buy request from peer %v: %v",
+			request.Peer, rejectErr.Msg)
+
+		return n.sendReject(request.Peer, request.ID, *rejectErr)
+	}
+
+	// The request passed our exposure limits and rate limits, so we
+	// record it in the quote ledger. Failing to do so doesn't affect the
+	// negotiation.
+	n.logBuyRequest(ctx, request)
+
 	// Define a thread safe helper function for adding outgoing message to
 	// the outgoing messages channel.
 	sendOutgoingMsg := func(msg rfqmsg.OutgoingMsg) {
@@ -282,12 +357,25 @@ func (n *Negotiator) HandleIncomingBuyRequest(
 	go func() {
 		defer n.Wg.Done()
 
+		// Ignore the bid price suggested by the peer if it has
+		// already expired.
+		bid := &request.BidPrice
+		if request.BidPriceExpired(time.Now()) {
+			log.Debugf("Ignoring expired suggested bid price of "+
+				"buy request %x (expiry=%d)", request.ID[:],
+				request.BidPriceExpiry)
+
+			bid = nil
+		}
+
 		// Query the price oracle for an asking price.
 		askPrice, askExpiry, err := n.queryAskFromPriceOracle(
 			nil, request.AssetID, request.AssetGroupKey,
-			request.AssetAmount, &request.BidPrice,
+			request.AssetAmount, bid, request.BidPriceExpiry,
 		)
 		if err != nil {
+			n.exposure.Release(request.ID)
+
 			// Send a reject message to the peer.
 			msg := rfqmsg.NewReject(
 				request.Peer, request.ID,
@@ -303,6 +391,7 @@ func (n *Negotiator) HandleIncomingBuyRequest(
 		}
 
 		// Construct and send a buy accept message.
+		n.exposure.Confirm(request.ID, askExpiry)
 		msg := rfqmsg.NewBuyAcceptFromRequest(
 			request, askPrice, askExpiry,
 		)
@@ -345,6 +434,34 @@ func (n *Negotiator) HandleIncomingSellRequest(
 		return nil
 	}
 
+	// Ensure that accepting the quote request would not exceed any of our
+	// exposure limits. If it doesn't, the requested amount is reserved
+	// until the quote expires.
+	//
+	// The tranches of a grouped asset are all accounted for under their
+	// group key.
+	ctx, cancel := n.WithCtxQuit()
+	defer cancel()
+
+	assetID, groupKey := n.groups.resolve(
+		ctx, request.AssetID, request.AssetGroupKey,
+	)
+	rejectErr := n.exposure.Reserve(
+		request.ID, request.Peer, assetID, groupKey,
+		request.AssetAmount,
+	)
+	if rejectErr != nil {
+		log.Debugf("Rejecting sell request from peer %v: %v",
+			request.Peer, rejectErr.Msg)
+
+		return n.sendReject(request.Peer, request.ID, *rejectErr)
+	}
+
+	// The request passed our exposure limits and rate limits, so we
+	// record it in the quote ledger. Failing to do so doesn't affect the
+	// negotiation.
+	n.logSellRequest(ctx, request)
+
 	// Define a thread safe helper function for adding outgoing message to
 	// the outgoing messages channel.
 	sendOutgoingMsg := func(msg rfqmsg.OutgoingMsg) {
@@ -366,14 +483,27 @@ func (n *Negotiator) HandleIncomingSellRequest(
 	go func() {
 		defer n.Wg.Done()
 
+		// The ask price suggested by the peer is only passed on to
+		// the price oracle if it hasn't expired yet.
+		ask := &request.AskPrice
+		if request.AskPriceExpired(time.Now()) {
+			log.Debugf("Ignoring expired suggested ask price of "+
+				"sell request %x (expiry=%d)", request.ID[:],
+				request.AskPriceExpiry)
+
+			ask = nil
+		}
+
 		// Query the price oracle for a bid price. This is the price we
 		// are willing to pay for the asset that our peer is trying to
 		// sell to us.
 		bidPrice, bidExpiry, err := n.queryBidFromPriceOracle(
 			request.Peer, request.AssetID, request.AssetGroupKey,
-			request.AssetAmount,
+			request.AssetAmount, ask, request.AskPriceExpiry,
 		)
 		if err != nil {
+			n.exposure.Release(request.ID)
+
 			// Send a reject message to the peer.
 			msg := rfqmsg.NewReject(
 				request.Peer, request.ID,
@@ -389,6 +519,7 @@ func (n *Negotiator) HandleIncomingSellRequest(
 		}
 
 		// Construct and send a sell accept message.
+		n.exposure.Confirm(request.ID, bidExpiry)
 		msg := rfqmsg.NewSellAcceptFromRequest(
 			request, bidPrice, bidExpiry,
 		)
@@ -410,9 +541,9 @@ func (n *Negotiator) HandleOutgoingSellOrder(order SellOrder) {
 		defer n.Wg.Done()
 
 		// Query the price oracle for an asking price.
-		askPrice, _, err := n.queryAskFromPriceOracle(
+		askPrice, askExpiry, err := n.queryAskFromPriceOracle(
 			order.Peer, order.AssetID, order.AssetGroupKey,
-			order.MaxAssetAmount, nil,
+			order.MaxAssetAmount, nil, 0,
 		)
 		if err != nil {
 			err := fmt.Errorf("negotiator failed to handle price "+
@@ -423,7 +554,7 @@ func (n *Negotiator) HandleOutgoingSellOrder(order SellOrder) {
 
 		request, err := rfqmsg.NewSellRequest(
 			*order.Peer, order.AssetID, order.AssetGroupKey,
-			order.MaxAssetAmount, askPrice,
+			order.MaxAssetAmount, askPrice, askExpiry,
 		)
 		if err != nil {
 			err := fmt.Errorf("unable to create sell request "+
@@ -536,6 +667,20 @@ func (n *Negotiator) RemoveAssetSellOffer(assetID *asset.ID,
 	return nil
 }
 
+// groupSellOffer returns the sell offer for the asset group of the asset with
+// the given ID, if the asset is grouped and such an offer exists.
+func (n *Negotiator) groupSellOffer(assetID asset.ID) (SellOffer, bool) {
+	ctx, cancel := n.WithCtxQuit()
+	defer cancel()
+
+	groupKey := n.groups.groupKey(ctx, assetID)
+	if groupKey == nil {
+		return SellOffer{}, false
+	}
+
+	return n.assetGroupSellOffers.Load(asset.ToSerialized(groupKey))
+}
+
 // HasAssetSellOffer returns true if the negotiator has an asset sell offer
 // which matches the given asset ID/group and asset amount.
 //
@@ -560,12 +705,19 @@ func (n *Negotiator) HasAssetSellOffer(assetID *asset.ID,
 
 	case assetID != nil:
 		offer, ok := n.assetSellOffers.Load(*assetID)
+		if ok {
+			sellOffer = &offer
+			break
+		}
+
+		// An offer for an asset group covers all assets in the group.
+		groupOffer, ok := n.groupSellOffer(*assetID)
 		if !ok {
 			// Corresponding offer not found.
 			return false
 		}
 
-		sellOffer = &offer
+		sellOffer = &groupOffer
 	}
 
 	// We should never have a nil sell offer at this point. Check added here
@@ -653,6 +805,20 @@ func (n *Negotiator) UpsertAssetBuyOffer(offer BuyOffer) error {
 	return nil
 }
 
+// groupBuyOffer returns the buy offer for the asset group of the asset with
+// the given ID, if the asset is grouped and such an offer exists.
+func (n *Negotiator) groupBuyOffer(assetID asset.ID) (BuyOffer, bool) {
+	ctx, cancel := n.WithCtxQuit()
+	defer cancel()
+
+	groupKey := n.groups.groupKey(ctx, assetID)
+	if groupKey == nil {
+		return BuyOffer{}, false
+	}
+
+	return n.assetGroupBuyOffers.Load(asset.ToSerialized(groupKey))
+}
+
 // HasAssetBuyOffer returns true if the negotiator has an asset buy offer which
 // matches the given asset ID/group and asset amount.
 //
@@ -678,12 +844,19 @@ func (n *Negotiator) HasAssetBuyOffer(assetID *asset.ID,
 
 	case assetID != nil:
 		offer, ok := n.assetBuyOffers.Load(*assetID)
+		if ok {
+			buyOffer = &offer
+			break
+		}
+
+		// An offer for an asset group covers all assets in the group.
+		groupOffer, ok := n.groupBuyOffer(*assetID)
 		if !ok {
 			// Corresponding offer not found.
 			return false
 		}
 
-		buyOffer = &offer
+		buyOffer = &groupOffer
 	}
 
 	// We should never have a nil buy offer at this point. Check added here
@@ -706,6 +879,67 @@ func (n *Negotiator) HasAssetBuyOffer(assetID *asset.ID,
 	return true
 }
 
+// logBuyRequest records the given incoming buy request in the quote ledger.
+func (n *Negotiator) logBuyRequest(ctx context.Context,
+	request rfqmsg.BuyRequest) {
+
+	if n.cfg.QuoteLedger == nil {
+		return
+	}
+
+	err := n.cfg.QuoteLedger.LogBuyRequest(ctx, request, true)
+	if err != nil {
+		log.Errorf("Unable to log incoming buy request: %v", err)
+	}
+}
+
+// logSellRequest records the given incoming sell request in the quote ledger.
+func (n *Negotiator) logSellRequest(ctx context.Context,
+	request rfqmsg.SellRequest) {
+
+	if n.cfg.QuoteLedger == nil {
+		return
+	}
+
+	err := n.cfg.QuoteLedger.LogSellRequest(ctx, request, true)
+	if err != nil {
+		log.Errorf("Unable to log incoming sell request: %v", err)
+	}
+}
+
+// RestoreExposure counts the quotes of the given accepted buy and sell
+// requests, which were restored after a restart, towards the exposure limits
+// until they expire.
+func (n *Negotiator) RestoreExposure(buyAccepts []rfqmsg.BuyAccept,
+	sellAccepts []rfqmsg.SellAccept) {
+
+	ctx, cancel := n.WithCtxQuit()
+	defer cancel()
+
+	// Restored quotes are accounted for in the same way as new quotes,
+	// so the tranches of a grouped asset are counted under their group
+	// key.
+	for _, accept := range buyAccepts {
+		assetID, groupKey := n.groups.resolve(
+			ctx, accept.AssetID, accept.AssetGroupKey,
+		)
+		n.exposure.Restore(
+			accept.ID, accept.Peer, assetID, groupKey,
+			accept.AssetAmount, accept.Expiry,
+		)
+	}
+
+	for _, accept := range sellAccepts {
+		assetID, groupKey := n.groups.resolve(
+			ctx, accept.AssetID, accept.AssetGroupKey,
+		)
+		n.exposure.Restore(
+			accept.ID, accept.Peer, assetID, groupKey,
+			accept.AssetAmount, accept.Expiry,
+		)
+	}
+}
+
 // Start starts the service.
 func (n *Negotiator) Start() error {
 	var startErr error
@@ -0,0 +1,176 @@
+package rfq
+
+import (
+	"context"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightninglabs/taproot-assets/address"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/rfqmsg"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+)
+
+// mockGroupLookup is a group lookup that resolves asset IDs from a fixed set
+// of asset groups.
+type mockGroupLookup struct {
+	// groupKeys holds the group keys of the known assets. Ungrouped assets
+	// map to a nil group key.
+	groupKeys map[asset.ID]*btcec.PublicKey
+}
+
+// QueryAssetGroup returns the asset group of the given asset.
+func (m *mockGroupLookup) QueryAssetGroup(_ context.Context,
+	assetID asset.ID) (*asset.AssetGroup, error) {
+
+	groupKey, ok := m.groupKeys[assetID]
+	if !ok {
+		return nil, address.ErrAssetGroupUnknown
+	}
+
+	assetGroup := &asset.AssetGroup{}
+	if groupKey != nil {
+		assetGroup.GroupKey = &asset.GroupKey{
+			GroupPubKey: *groupKey,
+		}
+	}
+
+	return assetGroup, nil
+}
+
+// newTestNegotiator creates a negotiator which knows about the asset groups
+// of the given group lookup.
+func newTestNegotiator(t *testing.T, lookup GroupLookup, limits ExposureLimits,
+	outgoing chan rfqmsg.OutgoingMsg) *Negotiator {
+
+	negotiator, err := NewNegotiator(NegotiatorCfg{
+		PriceOracle:      NewMockPriceOracle(3600),
+		ExposureLimits:   limits,
+		GroupLookup:      lookup,
+		OutgoingMessages: outgoing,
+		ErrChan:          make(chan error, 1),
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, negotiator.Stop())
+	})
+
+	return negotiator
+}
+
+// TestNegotiatorGroupOffers tests that an offer for an asset group covers
+// requests for any asset in the group.
+func TestNegotiatorGroupOffers(t *testing.T) {
+	t.Parallel()
+
+	groupKey := test.RandPubKey(t)
+	trancheA := asset.RandID(t)
+	trancheB := asset.RandID(t)
+	ungrouped := asset.RandID(t)
+	unknown := asset.RandID(t)
+
+	lookup := &mockGroupLookup{
+		groupKeys: map[asset.ID]*btcec.PublicKey{
+			trancheA:  groupKey,
+			trancheB:  groupKey,
+			ungrouped: nil,
+		},
+	}
+	negotiator := newTestNegotiator(
+		t, lookup, ExposureLimits{}, make(chan rfqmsg.OutgoingMsg),
+	)
+
+	require.NoError(t, negotiator.UpsertAssetSellOffer(SellOffer{
+		AssetGroupKey: groupKey,
+		MaxUnits:      100,
+	}))
+	require.NoError(t, negotiator.UpsertAssetBuyOffer(BuyOffer{
+		AssetGroupKey: groupKey,
+		MaxUnits:      50,
+	}))
+
+	// Requests for the group itself and for any of its tranches are
+	// covered by the group offers, within the offered amount.
+	require.True(t, negotiator.HasAssetSellOffer(nil, groupKey, 100))
+	require.True(t, negotiator.HasAssetSellOffer(&trancheA, nil, 100))
+	require.True(t, negotiator.HasAssetSellOffer(&trancheB, nil, 1))
+	require.False(t, negotiator.HasAssetSellOffer(&trancheA, nil, 101))
+
+	require.True(t, negotiator.HasAssetBuyOffer(&trancheB, nil, 50))
+	require.False(t, negotiator.HasAssetBuyOffer(&trancheB, nil, 51))
+
+	// Assets outside of the group aren't covered.
+	require.False(t, negotiator.HasAssetSellOffer(&ungrouped, nil, 1))
+	require.False(t, negotiator.HasAssetBuyOffer(&unknown, nil, 1))
+
+	// An offer for a specific tranche takes precedence over the offer for
+	// its group.
+	require.NoError(t, negotiator.UpsertAssetSellOffer(SellOffer{
+		AssetID:  &trancheA,
+		MaxUnits: 10,
+	}))
+	require.False(t, negotiator.HasAssetSellOffer(&trancheA, nil, 11))
+	require.True(t, negotiator.HasAssetSellOffer(&trancheB, nil, 11))
+}
+
+// TestNegotiatorGroupExposure tests that the quotes for all assets in a group
+// count towards the same exposure limit.
+func TestNegotiatorGroupExposure(t *testing.T) {
+	t.Parallel()
+
+	groupKey := test.RandPubKey(t)
+	trancheA := asset.RandID(t)
+	trancheB := asset.RandID(t)
+
+	lookup := &mockGroupLookup{
+		groupKeys: map[asset.ID]*btcec.PublicKey{
+			trancheA: groupKey,
+			trancheB: groupKey,
+		},
+	}
+	outgoing := make(chan rfqmsg.OutgoingMsg, 3)
+	negotiator := newTestNegotiator(t, lookup, ExposureLimits{
+		MaxAssetAmount: 100,
+	}, outgoing)
+
+	require.NoError(t, negotiator.UpsertAssetSellOffer(SellOffer{
+		AssetGroupKey: groupKey,
+		MaxUnits:      100,
+	}))
+
+	peer := route.NewVertex(test.RandPubKey(t))
+	requestQuote := func(assetID *asset.ID, groupKey *btcec.PublicKey,
+		amount uint64) rfqmsg.OutgoingMsg {
+
+		req, err := rfqmsg.NewBuyRequest(
+			peer, assetID, groupKey, amount, 0, 0,
+		)
+		require.NoError(t, err)
+		require.NoError(t, negotiator.HandleIncomingBuyRequest(*req))
+
+		return <-outgoing
+	}
+
+	// A quote for the first tranche is accepted.
+	msg := requestQuote(&trancheA, nil, 60)
+	require.IsType(t, &rfqmsg.BuyAccept{}, msg)
+
+	// A quote for the second tranche of the same group would exceed the
+	// exposure limit of the group, and so would a quote for the group
+	// itself.
+	msg = requestQuote(&trancheB, nil, 60)
+	require.IsType(t, &rfqmsg.Reject{}, msg)
+	require.Equal(
+		t, rfqmsg.ErrAssetExposureLimitReached,
+		msg.(*rfqmsg.Reject).Err,
+	)
+
+	msg = requestQuote(nil, groupKey, 41)
+	require.IsType(t, &rfqmsg.Reject{}, msg)
+
+	// The remaining exposure can be used by any tranche.
+	msg = requestQuote(&trancheB, nil, 40)
+	require.IsType(t, &rfqmsg.BuyAccept{}, msg)
+}
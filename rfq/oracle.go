@@ -2,12 +2,18 @@ package rfq
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
+	"math"
+	"net/url"
 	"time"
 
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/lightninglabs/taproot-assets/asset"
+	oraclerpc "github.com/lightninglabs/taproot-assets/taprpc/priceoraclerpc"
 	"github.com/lightningnetwork/lnd/lnwire"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 )
 
 // OracleError is a struct that holds an error returned by the price oracle
@@ -63,66 +69,280 @@ type OracleBidResponse struct {
 // assets.
 type PriceOracle interface {
 	// QueryAskPrice returns an asking price for the given asset amount.
+	// The optional suggested bid price is the price proposed by the
+	// counterparty, and the suggested bid price expiry is the unix
+	// timestamp after which that proposal lapses, or zero if unknown.
 	QueryAskPrice(ctx context.Context, assetId *asset.ID,
 		assetGroupKey *btcec.PublicKey, assetAmount uint64,
-		suggestedBidPrice *lnwire.MilliSatoshi) (*OracleAskResponse,
-		error)
+		suggestedBidPrice *lnwire.MilliSatoshi,
+		suggestedBidPriceExpiry uint64) (*OracleAskResponse, error)
 
-	// QueryBidPrice returns a bid price for the given asset amount.
+	// QueryBidPrice returns a bid price for the given asset amount. The
+	// optional suggested ask price and its expiry are handled like the
+	// suggested bid price of QueryAskPrice.
 	QueryBidPrice(ctx context.Context, assetId *asset.ID,
-		assetGroupKey *btcec.PublicKey,
-		assetAmount uint64) (*OracleBidResponse, error)
-}
-
-//// RpcPriceOracle is a price oracle that uses an external RPC server to get
-//// exchange rate information.
-// type RpcPriceOracle struct {
-// }
-//
-// // serverDialOpts returns the set of server options needed to connect to the
-// // price oracle RPC server using a TLS connection.
-// func serverDialOpts() ([]grpc.DialOption, error) {
-//	var opts []grpc.DialOption
-//
-//	// Skip TLS certificate verification.
-//	tlsConfig := tls.Config{InsecureSkipVerify: true}
-//	transportCredentials := credentials.NewTLS(&tlsConfig)
-//	opts = append(opts, grpc.WithTransportCredentials(transportCredentials))
-//
-//	return opts, nil
-// }
-//
-// // NewRpcPriceOracle creates a new RPC price oracle handle given the address
-// // of the price oracle RPC server.
-// func NewRpcPriceOracle(addr url.URL) (*RpcPriceOracle, error) {
-//	//// Connect to the RPC server.
-//	//dialOpts, err := serverDialOpts()
-//	//if err != nil {
-//	//	return nil, err
-//	//}
-//	//
-//	//serverAddr := fmt.Sprintf("%s:%s", addr.Hostname(), addr.Port())
-//	//conn, err := grpc.Dial(serverAddr, dialOpts...)
-//	//if err != nil {
-//	//	return nil, err
-//	//}
-//
-//	return &RpcPriceOracle{}, nil
-// }
-//
-// // QueryAskingPrice returns the asking price for the given asset amount.
-// func (r *RpcPriceOracle) QueryAskingPrice(ctx context.Context,
-//	assetId *asset.ID, assetGroupKey *btcec.PublicKey, assetAmount uint64,
-//	bidPrice *lnwire.MilliSatoshi) (*OracleAskResponse, error) {
-//
-//	//// Call the external oracle service to get the exchange rate.
-//	//conn := getClientConn(ctx, false)
-//
-//	return nil, nil
-// }
-//
-// // Ensure that RpcPriceOracle implements the PriceOracle interface.
-// var _ PriceOracle = (*RpcPriceOracle)(nil)
+		assetGroupKey *btcec.PublicKey, assetAmount uint64,
+		suggestedAskPrice *lnwire.MilliSatoshi,
+		suggestedAskPriceExpiry uint64) (*OracleBidResponse, error)
+}
+
+const (
+	// PriceOracleRpcScheme is the URL scheme which identifies the address
+	// of a price oracle RPC server.
+	PriceOracleRpcScheme = "rfqrpc"
+)
+
+// RpcPriceOracle is a price oracle that uses an external RPC server to get
+// exchange rate information.
+type RpcPriceOracle struct {
+	// client is the client for the price oracle RPC server.
+	client oraclerpc.PriceOracleClient
+
+	// rawConn is the raw connection to the remote gRPC service.
+	rawConn *grpc.ClientConn
+}
+
+// RpcOracleTLSConfig holds the TLS options used when connecting to a price
+// oracle RPC server.
+type RpcOracleTLSConfig struct {
+	// CertPath is the optional path to the TLS certificate of the price
+	// oracle RPC server. If set, only this certificate (or certificates
+	// signed by it) is trusted. Otherwise, the system's root certificate
+	// authorities are used to verify the server.
+	CertPath string
+
+	// Insecure disables the verification of the price oracle RPC
+	// server's TLS certificate. This should only be used for testing.
+	Insecure bool
+}
+
+// serverDialOpts returns the set of server options needed to connect to the
+// price oracle RPC server using a TLS connection.
+func serverDialOpts(tlsCfg RpcOracleTLSConfig) ([]grpc.DialOption, error) {
+	var opts []grpc.DialOption
+
+	var transportCredentials credentials.TransportCredentials
+	switch {
+	case tlsCfg.Insecure:
+		log.Warnf("Skipping TLS certificate verification of price " +
+			"oracle RPC server")
+
+		tlsConfig := tls.Config{InsecureSkipVerify: true}
+		transportCredentials = credentials.NewTLS(&tlsConfig)
+
+	// Pin the server certificate if a certificate path is given.
+	case tlsCfg.CertPath != "":
+		var err error
+		transportCredentials, err = credentials.NewClientTLSFromFile(
+			tlsCfg.CertPath, "",
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to load price oracle "+
+				"TLS certificate: %w", err)
+		}
+
+	// Otherwise, verify the server using the system's root certificate
+	// authorities.
+	default:
+		transportCredentials = credentials.NewTLS(&tls.Config{})
+	}
+	opts = append(opts, grpc.WithTransportCredentials(transportCredentials))
+
+	return opts, nil
+}
+
+// ParsePriceOracleAddress parses and validates the given price oracle RPC
+// server address. The address must be of the form rfqrpc://<host>:<port>.
+func ParsePriceOracleAddress(addrStr string) (*url.URL, error) {
+	addr, err := url.ParseRequestURI(addrStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid price oracle address: %w", err)
+	}
+
+	if addr.Scheme != PriceOracleRpcScheme {
+		return nil, fmt.Errorf("unsupported price oracle address "+
+			"scheme: %v (expected %v)", addr.Scheme,
+			PriceOracleRpcScheme)
+	}
+
+	if addr.Hostname() == "" || addr.Port() == "" {
+		return nil, fmt.Errorf("price oracle address must specify a "+
+			"host and port: %v", addrStr)
+	}
+
+	return addr, nil
+}
+
+// NewRpcPriceOracle creates a new RPC price oracle handle given the address
+// of the price oracle RPC server and the TLS options used to verify it.
+func NewRpcPriceOracle(addrStr string,
+	tlsCfg RpcOracleTLSConfig) (*RpcPriceOracle, error) {
+
+	addr, err := ParsePriceOracleAddress(addrStr)
+	if err != nil {
+		return nil, err
+	}
+
+	// Connect to the RPC server.
+	dialOpts, err := serverDialOpts(tlsCfg)
+	if err != nil {
+		return nil, err
+	}
+
+	serverAddr := fmt.Sprintf("%s:%s", addr.Hostname(), addr.Port())
+	conn, err := grpc.Dial(serverAddr, dialOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("unable to connect to price oracle "+
+			"RPC server: %w", err)
+	}
+
+	return &RpcPriceOracle{
+		client:  oraclerpc.NewPriceOracleClient(conn),
+		rawConn: conn,
+	}, nil
+}
+
+// marshalAssetSpecifier converts the given asset ID or group key into an RPC
+// asset specifier. The asset ID takes precedence if both are set.
+func marshalAssetSpecifier(assetId *asset.ID,
+	assetGroupKey *btcec.PublicKey) (*oraclerpc.AssetSpecifier, error) {
+
+	switch {
+	case assetId != nil:
+		return &oraclerpc.AssetSpecifier{
+			Id: &oraclerpc.AssetSpecifier_AssetId{
+				AssetId: assetId[:],
+			},
+		}, nil
+
+	case assetGroupKey != nil:
+		return &oraclerpc.AssetSpecifier{
+			Id: &oraclerpc.AssetSpecifier_GroupKey{
+				GroupKey: assetGroupKey.SerializeCompressed(),
+			},
+		}, nil
+
+	default:
+		return nil, fmt.Errorf("asset ID and asset group key are " +
+			"both nil")
+	}
+}
+
+// unmarshalOracleError converts an RPC oracle error into an OracleError.
+func unmarshalOracleError(rpcErr *oraclerpc.OracleError) *OracleError {
+	// The error code is transmitted as a uint32 over the wire, but error
+	// codes are limited to a single byte, so we clamp any out of range
+	// values.
+	code := uint8(math.MaxUint8)
+	if rpcErr.Code <= math.MaxUint8 {
+		code = uint8(rpcErr.Code)
+	}
+
+	return &OracleError{
+		Code: code,
+		Msg:  rpcErr.Message,
+	}
+}
+
+// QueryAskPrice returns the asking price for the given asset amount.
+func (r *RpcPriceOracle) QueryAskPrice(ctx context.Context,
+	assetId *asset.ID, assetGroupKey *btcec.PublicKey, assetAmount uint64,
+	suggestedBidPrice *lnwire.MilliSatoshi,
+	suggestedBidPriceExpiry uint64) (*OracleAskResponse, error) {
+
+	assetSpecifier, err := marshalAssetSpecifier(assetId, assetGroupKey)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &oraclerpc.QueryAskPriceRequest{
+		AssetSpecifier: assetSpecifier,
+		AssetAmount:    assetAmount,
+	}
+	if suggestedBidPrice != nil {
+		req.SuggestedBidPrice = uint64(*suggestedBidPrice)
+		req.SuggestedBidPriceExpiry = suggestedBidPriceExpiry
+	}
+
+	resp, err := r.client.QueryAskPrice(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to query price oracle for ask "+
+			"price: %w", err)
+	}
+
+	switch result := resp.GetResult().(type) {
+	case *oraclerpc.QueryAskPriceResponse_Success:
+		askPrice := lnwire.MilliSatoshi(result.Success.Price)
+
+		return &OracleAskResponse{
+			AskPrice: &askPrice,
+			Expiry:   result.Success.ExpiryTimestamp,
+		}, nil
+
+	case *oraclerpc.QueryAskPriceResponse_Error:
+		return &OracleAskResponse{
+			Err: unmarshalOracleError(result.Error),
+		}, nil
+
+	default:
+		return nil, fmt.Errorf("unexpected price oracle ask response "+
+			"type: %T", result)
+	}
+}
+
+// QueryBidPrice returns a bid price for the given asset amount.
+func (r *RpcPriceOracle) QueryBidPrice(ctx context.Context,
+	assetId *asset.ID, assetGroupKey *btcec.PublicKey, assetAmount uint64,
+	suggestedAskPrice *lnwire.MilliSatoshi,
+	suggestedAskPriceExpiry uint64) (*OracleBidResponse, error) {
+
+	assetSpecifier, err := marshalAssetSpecifier(assetId, assetGroupKey)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &oraclerpc.QueryBidPriceRequest{
+		AssetSpecifier: assetSpecifier,
+		AssetAmount:    assetAmount,
+	}
+	if suggestedAskPrice != nil {
+		req.SuggestedAskPrice = uint64(*suggestedAskPrice)
+		req.SuggestedAskPriceExpiry = suggestedAskPriceExpiry
+	}
+
+	resp, err := r.client.QueryBidPrice(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to query price oracle for bid "+
+			"price: %w", err)
+	}
+
+	switch result := resp.GetResult().(type) {
+	case *oraclerpc.QueryBidPriceResponse_Success:
+		bidPrice := lnwire.MilliSatoshi(result.Success.Price)
+
+		return &OracleBidResponse{
+			BidPrice: &bidPrice,
+			Expiry:   result.Success.ExpiryTimestamp,
+		}, nil
+
+	case *oraclerpc.QueryBidPriceResponse_Error:
+		return &OracleBidResponse{
+			Err: unmarshalOracleError(result.Error),
+		}, nil
+
+	default:
+		return nil, fmt.Errorf("unexpected price oracle bid response "+
+			"type: %T", result)
+	}
+}
+
+// Close closes the connection to the price oracle RPC server.
+func (r *RpcPriceOracle) Close() error {
+	return r.rawConn.Close()
+}
+
+// Ensure that RpcPriceOracle implements the PriceOracle interface.
+var _ PriceOracle = (*RpcPriceOracle)(nil)
 
 // MockPriceOracle is a mock implementation of the PriceOracle interface.
 // It returns the suggested rate as the exchange rate.
@@ -140,7 +360,8 @@ func NewMockPriceOracle(expiryDelay uint64) *MockPriceOracle {
 // QueryAskPrice returns the ask price for the given asset amount.
 func (m *MockPriceOracle) QueryAskPrice(_ context.Context,
 	_ *asset.ID, _ *btcec.PublicKey, _ uint64,
-	suggestedBidPrice *lnwire.MilliSatoshi) (*OracleAskResponse, error) {
+	suggestedBidPrice *lnwire.MilliSatoshi,
+	_ uint64) (*OracleAskResponse, error) {
 
 	// Calculate the rate expiryDelay lifetime.
 	expiry := uint64(time.Now().Unix()) + m.expiryDelay
@@ -158,7 +379,8 @@ func (m *MockPriceOracle) QueryAskPrice(_ context.Context,
 
 // QueryBidPrice returns a bid price for the given asset amount.
 func (m *MockPriceOracle) QueryBidPrice(_ context.Context, _ *asset.ID,
-	_ *btcec.PublicKey, _ uint64) (*OracleBidResponse, error) {
+	_ *btcec.PublicKey, _ uint64, _ *lnwire.MilliSatoshi,
+	_ uint64) (*OracleBidResponse, error) {
 
 	// Calculate the rate expiryDelay lifetime.
 	expiry := uint64(time.Now().Unix()) + m.expiryDelay
@@ -0,0 +1,290 @@
+package rfq
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	oraclerpc "github.com/lightninglabs/taproot-assets/taprpc/priceoraclerpc"
+	"github.com/lightningnetwork/lnd/cert"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+const (
+	// testMsatPerUnit is the price per asset unit quoted by the test
+	// oracle server.
+	testMsatPerUnit = 1000
+
+	// testUnsupportedCode is the error code returned by the test oracle
+	// server for unsupported assets.
+	testUnsupportedCode = 7
+)
+
+// mockRpcPriceOracleServer is an in-process reference implementation of the
+// price oracle RPC server.
+type mockRpcPriceOracleServer struct {
+	oraclerpc.UnimplementedPriceOracleServer
+
+	// expiry is the expiry timestamp attached to every quote.
+	expiry uint64
+
+	// unsupportedAsset is an asset ID for which the server refuses to
+	// quote.
+	unsupportedAsset asset.ID
+
+	// lastSuggestedBid is the suggested bid price of the last ask request.
+	lastSuggestedBid atomic.Uint64
+
+	// lastSuggestedBidExpiry is the suggested bid price expiry of the last
+	// ask request.
+	lastSuggestedBidExpiry atomic.Uint64
+
+	// lastSuggestedAsk is the suggested ask price of the last bid request.
+	lastSuggestedAsk atomic.Uint64
+
+	// lastSuggestedAskExpiry is the suggested ask price expiry of the last
+	// bid request.
+	lastSuggestedAskExpiry atomic.Uint64
+}
+
+// isUnsupported returns true if the server refuses to quote the given asset.
+func (m *mockRpcPriceOracleServer) isUnsupported(
+	specifier *oraclerpc.AssetSpecifier) bool {
+
+	var assetID asset.ID
+	copy(assetID[:], specifier.GetAssetId())
+
+	return assetID == m.unsupportedAsset
+}
+
+// QueryAskPrice returns the asking price for the given asset amount.
+func (m *mockRpcPriceOracleServer) QueryAskPrice(_ context.Context,
+	req *oraclerpc.QueryAskPriceRequest) (*oraclerpc.QueryAskPriceResponse,
+	error) {
+
+	m.lastSuggestedBid.Store(req.SuggestedBidPrice)
+	m.lastSuggestedBidExpiry.Store(req.SuggestedBidPriceExpiry)
+
+	if m.isUnsupported(req.AssetSpecifier) {
+		return &oraclerpc.QueryAskPriceResponse{
+			Result: &oraclerpc.QueryAskPriceResponse_Error{
+				Error: &oraclerpc.OracleError{
+					Code:    testUnsupportedCode,
+					Message: "unsupported asset",
+				},
+			},
+		}, nil
+	}
+
+	return &oraclerpc.QueryAskPriceResponse{
+		Result: &oraclerpc.QueryAskPriceResponse_Success{
+			Success: &oraclerpc.PriceQuote{
+				Price:           req.AssetAmount * testMsatPerUnit,
+				ExpiryTimestamp: m.expiry,
+			},
+		},
+	}, nil
+}
+
+// QueryBidPrice returns a bid price for the given asset amount.
+func (m *mockRpcPriceOracleServer) QueryBidPrice(_ context.Context,
+	req *oraclerpc.QueryBidPriceRequest) (*oraclerpc.QueryBidPriceResponse,
+	error) {
+
+	m.lastSuggestedAsk.Store(req.SuggestedAskPrice)
+	m.lastSuggestedAskExpiry.Store(req.SuggestedAskPriceExpiry)
+
+	if m.isUnsupported(req.AssetSpecifier) {
+		return &oraclerpc.QueryBidPriceResponse{
+			Result: &oraclerpc.QueryBidPriceResponse_Error{
+				Error: &oraclerpc.OracleError{
+					Code:    testUnsupportedCode,
+					Message: "unsupported asset",
+				},
+			},
+		}, nil
+	}
+
+	return &oraclerpc.QueryBidPriceResponse{
+		Result: &oraclerpc.QueryBidPriceResponse_Success{
+			Success: &oraclerpc.PriceQuote{
+				Price:           req.AssetAmount * testMsatPerUnit,
+				ExpiryTimestamp: m.expiry,
+			},
+		},
+	}, nil
+}
+
+// startMockOracleServer starts an in-process price oracle RPC server on a
+// loopback TLS listener and returns its rfqrpc:// address and the path of its
+// TLS certificate.
+func startMockOracleServer(t *testing.T,
+	server oraclerpc.PriceOracleServer) (string, string) {
+
+	certBytes, keyBytes, err := cert.GenCertPair(
+		"test price oracle", nil, nil, false, time.Hour,
+	)
+	require.NoError(t, err)
+
+	tlsCert, err := tls.X509KeyPair(certBytes, keyBytes)
+	require.NoError(t, err)
+
+	certPath := filepath.Join(t.TempDir(), "oracle.cert")
+	require.NoError(t, os.WriteFile(certPath, certBytes, 0600))
+
+	creds := credentials.NewTLS(&tls.Config{
+		Certificates: []tls.Certificate{tlsCert},
+	})
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	grpcServer := grpc.NewServer(grpc.Creds(creds))
+	oraclerpc.RegisterPriceOracleServer(grpcServer, server)
+
+	go func() {
+		_ = grpcServer.Serve(listener)
+	}()
+	t.Cleanup(grpcServer.Stop)
+
+	addr := fmt.Sprintf("%s://%s", PriceOracleRpcScheme, listener.Addr())
+
+	return addr, certPath
+}
+
+// TestRpcPriceOracle tests that the RPC price oracle correctly queries an
+// external price oracle RPC server.
+func TestRpcPriceOracle(t *testing.T) {
+	t.Parallel()
+
+	var unsupportedAsset asset.ID
+	copy(unsupportedAsset[:], test.RandBytes(32))
+
+	expiry := uint64(time.Now().Add(time.Hour).Unix())
+	server := &mockRpcPriceOracleServer{
+		expiry:           expiry,
+		unsupportedAsset: unsupportedAsset,
+	}
+	addr, certPath := startMockOracleServer(t, server)
+
+	oracle, err := NewRpcPriceOracle(
+		addr, RpcOracleTLSConfig{CertPath: certPath},
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, oracle.Close())
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), DefaultTimeout)
+	defer cancel()
+
+	// The self-signed server certificate isn't trusted by the system's
+	// root certificate authorities, so an oracle without the pinned
+	// certificate must refuse to talk to the server.
+	unpinnedOracle, err := NewRpcPriceOracle(addr, RpcOracleTLSConfig{})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, unpinnedOracle.Close())
+	})
+
+	var testAsset asset.ID
+	_, err = unpinnedOracle.QueryAskPrice(
+		ctx, &testAsset, nil, 1, nil, 0,
+	)
+	require.ErrorContains(t, err, "certificate")
+
+	var assetID asset.ID
+	copy(assetID[:], test.RandBytes(32))
+
+	// Query an ask price with a suggested bid price and make sure the
+	// suggestion and its expiry are forwarded to the oracle.
+	bid := lnwire.MilliSatoshi(4200)
+	bidExpiry := uint64(time.Now().Add(time.Minute).Unix())
+	askResp, err := oracle.QueryAskPrice(
+		ctx, &assetID, nil, 42, &bid, bidExpiry,
+	)
+	require.NoError(t, err)
+	require.Nil(t, askResp.Err)
+	require.Equal(t, lnwire.MilliSatoshi(42*testMsatPerUnit),
+		*askResp.AskPrice)
+	require.Equal(t, expiry, askResp.Expiry)
+	require.EqualValues(t, bid, server.lastSuggestedBid.Load())
+	require.Equal(t, bidExpiry, server.lastSuggestedBidExpiry.Load())
+
+	// Query a bid price using a group key as the asset specifier, this
+	// time with a suggested ask price.
+	groupKey := test.RandPubKey(t)
+	ask := lnwire.MilliSatoshi(7100)
+	askExpiry := uint64(time.Now().Add(2 * time.Minute).Unix())
+	bidResp, err := oracle.QueryBidPrice(
+		ctx, nil, groupKey, 7, &ask, askExpiry,
+	)
+	require.NoError(t, err)
+	require.Nil(t, bidResp.Err)
+	require.Equal(t, lnwire.MilliSatoshi(7*testMsatPerUnit),
+		*bidResp.BidPrice)
+	require.Equal(t, expiry, bidResp.Expiry)
+	require.EqualValues(t, ask, server.lastSuggestedAsk.Load())
+	require.Equal(t, askExpiry, server.lastSuggestedAskExpiry.Load())
+
+	// A structured oracle error should be returned as part of the
+	// response and not as a transport error.
+	askResp, err = oracle.QueryAskPrice(
+		ctx, &unsupportedAsset, nil, 1, nil, 0,
+	)
+	require.NoError(t, err)
+	require.Nil(t, askResp.AskPrice)
+	require.Equal(t, &OracleError{
+		Code: testUnsupportedCode,
+		Msg:  "unsupported asset",
+	}, askResp.Err)
+
+	bidResp, err = oracle.QueryBidPrice(
+		ctx, &unsupportedAsset, nil, 1, nil, 0,
+	)
+	require.NoError(t, err)
+	require.Nil(t, bidResp.BidPrice)
+	require.EqualValues(t, testUnsupportedCode, bidResp.Err.Code)
+
+	// An asset must be specified.
+	_, err = oracle.QueryBidPrice(ctx, nil, nil, 1, nil, 0)
+	require.ErrorContains(t, err, "both nil")
+}
+
+// TestParsePriceOracleAddress tests the validation of price oracle RPC server
+// addresses.
+func TestParsePriceOracleAddress(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		addr  string
+		valid bool
+	}{
+		{addr: "rfqrpc://localhost:8095", valid: true},
+		{addr: "rfqrpc://127.0.0.1:10029", valid: true},
+		{addr: "localhost:8095", valid: false},
+		{addr: "https://localhost:8095", valid: false},
+		{addr: "rfqrpc://localhost", valid: false},
+		{addr: "", valid: false},
+	}
+
+	for _, tc := range testCases {
+		_, err := ParsePriceOracleAddress(tc.addr)
+		if tc.valid {
+			require.NoError(t, err, tc.addr)
+		} else {
+			require.Error(t, err, tc.addr)
+		}
+	}
+}
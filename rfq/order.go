@@ -3,12 +3,14 @@ package rfq
 import (
 	"context"
 	"fmt"
+	"math/big"
 	"sync"
 	"time"
 
 	"github.com/lightninglabs/lndclient"
 	"github.com/lightninglabs/taproot-assets/fn"
 	"github.com/lightninglabs/taproot-assets/rfqmsg"
+	"github.com/lightningnetwork/lnd/lnrpc/routerrpc"
 	"github.com/lightningnetwork/lnd/lnutils"
 	"github.com/lightningnetwork/lnd/lnwire"
 )
@@ -58,6 +60,32 @@ type Policy interface {
 	// Scid returns the serialised short channel ID (SCID) of the channel to
 	// which the policy applies.
 	Scid() uint64
+
+	// QuoteID returns the ID of the accepted quote from which the policy
+	// is derived.
+	QuoteID() rfqmsg.ID
+
+	// HtlcAssetAmount returns the asset amount that the given compliant
+	// HTLC pays for.
+	HtlcAssetAmount(htlc lndclient.InterceptedHtlc) uint64
+}
+
+// htlcAssetAmount returns the share of a quote's asset amount that an HTLC
+// pays for. The price of a quote is for its entire asset amount, so an HTLC
+// that pays at least the price pays for the entire asset amount.
+func htlcAssetAmount(assetAmount uint64, price,
+	htlcAmount lnwire.MilliSatoshi) uint64 {
+
+	if price == 0 || htlcAmount >= price {
+		return assetAmount
+	}
+
+	// The intermediate product may not fit into 64 bits.
+	share := new(big.Int).SetUint64(assetAmount)
+	share.Mul(share, new(big.Int).SetUint64(uint64(htlcAmount)))
+	share.Div(share, new(big.Int).SetUint64(uint64(price)))
+
+	return share.Uint64()
 }
 
 // AssetSalePolicy is a struct that holds the terms which determine whether an
@@ -67,6 +95,9 @@ type AssetSalePolicy struct {
 	// which the policy applies.
 	scid SerialisedScid
 
+	// AcceptedQuoteId is the ID of the accepted quote.
+	AcceptedQuoteId rfqmsg.ID
+
 	// AssetAmount is the amount of the tap asset that is being requested.
 	AssetAmount uint64
 
@@ -86,6 +117,7 @@ func NewAssetSalePolicy(quote rfqmsg.BuyAccept) *AssetSalePolicy {
 
 	return &AssetSalePolicy{
 		scid:                  scid,
+		AcceptedQuoteId:       quote.ID,
 		AssetAmount:           quote.AssetAmount,
 		MinimumChannelPayment: quote.AskPrice,
 		expiry:                quote.Expiry,
@@ -132,6 +164,22 @@ func (c *AssetSalePolicy) Scid() uint64 {
 	return uint64(c.scid)
 }
 
+// QuoteID returns the ID of the accepted quote from which the policy is
+// derived.
+func (c *AssetSalePolicy) QuoteID() rfqmsg.ID {
+	return c.AcceptedQuoteId
+}
+
+// HtlcAssetAmount returns the asset amount that the given compliant HTLC pays
+// for.
+func (c *AssetSalePolicy) HtlcAssetAmount(
+	htlc lndclient.InterceptedHtlc) uint64 {
+
+	return htlcAssetAmount(
+		c.AssetAmount, c.MinimumChannelPayment, htlc.AmountOutMsat,
+	)
+}
+
 // Ensure that AssetSalePolicy implements the Policy interface.
 var _ Policy = (*AssetSalePolicy)(nil)
 
@@ -216,6 +264,22 @@ func (c *AssetPurchasePolicy) Scid() uint64 {
 	return uint64(c.scid)
 }
 
+// QuoteID returns the ID of the accepted quote from which the policy is
+// derived.
+func (c *AssetPurchasePolicy) QuoteID() rfqmsg.ID {
+	return c.AcceptedQuoteId
+}
+
+// HtlcAssetAmount returns the asset amount that the given compliant HTLC pays
+// for.
+func (c *AssetPurchasePolicy) HtlcAssetAmount(
+	htlc lndclient.InterceptedHtlc) uint64 {
+
+	return htlcAssetAmount(
+		c.AssetAmount, c.MinimumChannelPayment, htlc.AmountOutMsat,
+	)
+}
+
 // Ensure that AssetPurchasePolicy implements the Policy interface.
 var _ Policy = (*AssetPurchasePolicy)(nil)
 
@@ -230,6 +294,10 @@ type OrderHandlerCfg struct {
 	// intercept and accept/reject HTLCs.
 	HtlcInterceptor HtlcInterceptor
 
+	// QuoteStore is the persistent store for the accept messages from which
+	// the HTLC policies are derived.
+	QuoteStore QuoteStore
+
 	// AcceptHtlcEvents is a channel that receives accepted HTLCs.
 	AcceptHtlcEvents chan<- *AcceptHtlcEvent
 }
@@ -350,12 +418,49 @@ func (h *OrderHandler) mainEventLoop() {
 	}
 }
 
+// restorePolicies loads the unexpired policies from the quote store and
+// registers them with the order handler.
+func (h *OrderHandler) restorePolicies() error {
+	ctx, cancel := h.WithCtxQuit()
+	defer cancel()
+
+	saleQuotes, purchaseQuotes, err := h.cfg.QuoteStore.FetchPolicies(
+		ctx, time.Now(),
+	)
+	if err != nil {
+		return fmt.Errorf("unable to fetch policies: %w", err)
+	}
+
+	for _, buyAccept := range saleQuotes {
+		policy := NewAssetSalePolicy(buyAccept)
+		h.policies.Store(policy.scid, policy)
+	}
+
+	for _, sellAccept := range purchaseQuotes {
+		policy := NewAssetPurchasePolicy(sellAccept)
+		h.policies.Store(policy.scid, policy)
+	}
+
+	log.Infof("Order handler restored policies (asset_sale=%d, "+
+		"asset_purchase=%d)", len(saleQuotes), len(purchaseQuotes))
+
+	return nil
+}
+
 // Start starts the service.
 func (h *OrderHandler) Start() error {
 	var startErr error
 	h.startOnce.Do(func() {
 		log.Info("Starting subsystem: order handler")
 
+		// Restore any persisted policies before we start intercepting
+		// HTLCs, so that in-flight HTLCs which carry a valid quote ID
+		// are not rejected after a restart.
+		startErr = h.restorePolicies()
+		if startErr != nil {
+			return
+		}
+
 		// Start the main event loop in a separate goroutine.
 		h.Wg.Add(1)
 		go func() {
@@ -380,26 +485,51 @@ func (h *OrderHandler) Start() error {
 
 // RegisterAssetSalePolicy generates and registers an asset sale policy with the
 // order handler. This function takes an outgoing buy accept message as an
-// argument.
-func (h *OrderHandler) RegisterAssetSalePolicy(buyAccept rfqmsg.BuyAccept) {
+// argument. The accept message is persisted so that the policy can be restored
+// after a restart.
+func (h *OrderHandler) RegisterAssetSalePolicy(
+	buyAccept rfqmsg.BuyAccept) error {
+
 	log.Debugf("Order handler is registering an asset sale policy given a "+
 		"buy accept message: %s", buyAccept.String())
 
+	ctx, cancel := h.WithCtxQuit()
+	defer cancel()
+
+	err := h.cfg.QuoteStore.UpsertAssetSalePolicy(ctx, buyAccept)
+	if err != nil {
+		return fmt.Errorf("unable to store asset sale policy: %w", err)
+	}
+
 	policy := NewAssetSalePolicy(buyAccept)
 	h.policies.Store(policy.scid, policy)
+
+	return nil
 }
 
 // RegisterAssetPurchasePolicy generates and registers an asset buy policy with the
 // order handler. This function takes an incoming sell accept message as an
-// argument.
+// argument. The accept message is persisted so that the policy can be restored
+// after a restart.
 func (h *OrderHandler) RegisterAssetPurchasePolicy(
-	sellAccept rfqmsg.SellAccept) {
+	sellAccept rfqmsg.SellAccept) error {
 
 	log.Debugf("Order handler is registering an asset buy policy given a "+
 		"sell accept message: %s", sellAccept.String())
 
+	ctx, cancel := h.WithCtxQuit()
+	defer cancel()
+
+	err := h.cfg.QuoteStore.UpsertAssetPurchasePolicy(ctx, sellAccept)
+	if err != nil {
+		return fmt.Errorf("unable to store asset purchase policy: %w",
+			err)
+	}
+
 	policy := NewAssetPurchasePolicy(sellAccept)
 	h.policies.Store(policy.scid, policy)
+
+	return nil
 }
 
 // fetchPolicy fetches a policy which is relevant to a given HTLC. If a policy
@@ -510,3 +640,11 @@ type HtlcInterceptor interface {
 	// to respond to HTLCs.
 	InterceptHtlcs(context.Context, lndclient.HtlcInterceptHandler) error
 }
+
+// HtlcEventSubscriber is used to learn about the final resolution of the HTLCs
+// that were accepted against our quotes.
+type HtlcEventSubscriber interface {
+	// SubscribeHtlcEvents subscribes to a stream of HTLC events.
+	SubscribeHtlcEvents(ctx context.Context) (<-chan *routerrpc.HtlcEvent,
+		<-chan error, error)
+}
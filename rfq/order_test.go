@@ -0,0 +1,68 @@
+package rfq
+
+import (
+	"math"
+	"testing"
+
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+// TestHtlcAssetAmount tests that the asset amount of a trade is the share of
+// the quote's asset amount that the HTLC pays for.
+func TestHtlcAssetAmount(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name        string
+		assetAmount uint64
+		price       lnwire.MilliSatoshi
+		htlcAmount  lnwire.MilliSatoshi
+		expected    uint64
+	}{
+		{
+			name:        "full amount",
+			assetAmount: 100,
+			price:       6000,
+			htlcAmount:  6000,
+			expected:    100,
+		},
+		{
+			name:        "partial amount",
+			assetAmount: 100,
+			price:       6000,
+			htlcAmount:  1500,
+			expected:    25,
+		},
+		{
+			name:        "overpayment is capped",
+			assetAmount: 100,
+			price:       6000,
+			htlcAmount:  9000,
+			expected:    100,
+		},
+		{
+			name:        "zero price",
+			assetAmount: 100,
+			price:       0,
+			htlcAmount:  1000,
+			expected:    100,
+		},
+		{
+			name:        "no overflow",
+			assetAmount: math.MaxUint64,
+			price:       math.MaxUint64,
+			htlcAmount:  math.MaxUint64 / 2,
+			expected:    math.MaxUint64 / 2,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			amount := htlcAssetAmount(
+				tc.assetAmount, tc.price, tc.htlcAmount,
+			)
+			require.Equal(t, tc.expected, amount)
+		})
+	}
+}
@@ -0,0 +1,235 @@
+package rfq
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+const (
+	// StaticPriceOracleScheme is the URL scheme which identifies the path
+	// of a static price oracle rate table file.
+	StaticPriceOracleScheme = "file"
+
+	// DefaultStaticOracleExpiry is the lifetime of the prices quoted by a
+	// static price oracle.
+	DefaultStaticOracleExpiry = time.Hour
+)
+
+// StaticRate is the exchange rate of a single asset or asset group in a static
+// rate table.
+type StaticRate struct {
+	// AssetID is the hex encoded ID of the asset. Either the asset ID or
+	// the group key must be set.
+	AssetID string `json:"asset_id,omitempty"`
+
+	// GroupKey is the hex encoded group key of the asset group.
+	GroupKey string `json:"group_key,omitempty"`
+
+	// BidMsatPerUnit is the price in millisatoshi per asset unit at which
+	// we buy the asset.
+	BidMsatPerUnit uint64 `json:"bid_msat_per_unit"`
+
+	// AskMsatPerUnit is the price in millisatoshi per asset unit at which
+	// we sell the asset.
+	AskMsatPerUnit uint64 `json:"ask_msat_per_unit"`
+}
+
+// StaticRateTable is the on-disk format of a static price oracle rate table.
+type StaticRateTable struct {
+	// Rates is the list of exchange rates.
+	Rates []StaticRate `json:"rates"`
+}
+
+// staticRate is a parsed static exchange rate.
+type staticRate struct {
+	bid lnwire.MilliSatoshi
+	ask lnwire.MilliSatoshi
+}
+
+// StaticPriceOracle is a price oracle that quotes prices from a fixed rate
+// table. It is mostly useful as one of several sources of a composite price
+// oracle.
+type StaticPriceOracle struct {
+	// rates holds the exchange rates, keyed by asset ID or group key.
+	rates map[assetKey]staticRate
+
+	// expiry is the lifetime of the quoted prices.
+	expiry time.Duration
+
+	// now returns the current time. It can be overridden in tests.
+	now func() time.Time
+}
+
+// NewStaticPriceOracle creates a new static price oracle from the given rate
+// table.
+func NewStaticPriceOracle(table StaticRateTable,
+	expiry time.Duration) (*StaticPriceOracle, error) {
+
+	rates := make(map[assetKey]staticRate, len(table.Rates))
+	for idx, rate := range table.Rates {
+		key, err := parseStaticRateKey(rate)
+		if err != nil {
+			return nil, fmt.Errorf("invalid rate at index %d: %w",
+				idx, err)
+		}
+
+		if _, ok := rates[key]; ok {
+			return nil, fmt.Errorf("duplicate rate at index %d",
+				idx)
+		}
+
+		rates[key] = staticRate{
+			bid: lnwire.MilliSatoshi(rate.BidMsatPerUnit),
+			ask: lnwire.MilliSatoshi(rate.AskMsatPerUnit),
+		}
+	}
+
+	return &StaticPriceOracle{
+		rates:  rates,
+		expiry: expiry,
+		now:    time.Now,
+	}, nil
+}
+
+// NewStaticPriceOracleFromFile creates a new static price oracle from the JSON
+// rate table file at the given path.
+func NewStaticPriceOracleFromFile(path string,
+	expiry time.Duration) (*StaticPriceOracle, error) {
+
+	tableBytes, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read rate table: %w", err)
+	}
+
+	var table StaticRateTable
+	if err := json.Unmarshal(tableBytes, &table); err != nil {
+		return nil, fmt.Errorf("unable to parse rate table: %w", err)
+	}
+
+	return NewStaticPriceOracle(table, expiry)
+}
+
+// parseStaticRateKey parses the asset ID or group key of a static rate.
+func parseStaticRateKey(rate StaticRate) (assetKey, error) {
+	switch {
+	case rate.AssetID != "" && rate.GroupKey != "":
+		return assetKey{}, fmt.Errorf("asset ID and group key are " +
+			"mutually exclusive")
+
+	case rate.AssetID != "":
+		idBytes, err := hex.DecodeString(rate.AssetID)
+		if err != nil {
+			return assetKey{}, fmt.Errorf("invalid asset ID: %w",
+				err)
+		}
+
+		var id asset.ID
+		if len(idBytes) != len(id) {
+			return assetKey{}, fmt.Errorf("invalid asset ID "+
+				"length: %d", len(idBytes))
+		}
+		copy(id[:], idBytes)
+
+		return newAssetKey(&id, nil), nil
+
+	case rate.GroupKey != "":
+		keyBytes, err := hex.DecodeString(rate.GroupKey)
+		if err != nil {
+			return assetKey{}, fmt.Errorf("invalid group key: %w",
+				err)
+		}
+
+		groupKey, err := btcec.ParsePubKey(keyBytes)
+		if err != nil {
+			return assetKey{}, fmt.Errorf("invalid group key: %w",
+				err)
+		}
+
+		return newAssetKey(nil, groupKey), nil
+
+	default:
+		return assetKey{}, fmt.Errorf("either asset ID or group key " +
+			"must be set")
+	}
+}
+
+// lookupPrice returns the price of the given asset amount at the given rate.
+// An error is returned for unknown assets and prices that overflow.
+func (s *StaticPriceOracle) lookupPrice(assetId *asset.ID,
+	assetGroupKey *btcec.PublicKey, assetAmount uint64,
+	isBid bool) (*lnwire.MilliSatoshi, *OracleError) {
+
+	rate, ok := s.rates[newAssetKey(assetId, assetGroupKey)]
+	if !ok {
+		return nil, &OracleError{
+			Msg: "asset not found in static rate table",
+		}
+	}
+
+	msatPerUnit := uint64(rate.ask)
+	if isBid {
+		msatPerUnit = uint64(rate.bid)
+	}
+
+	if msatPerUnit != 0 && assetAmount > math.MaxUint64/msatPerUnit {
+		return nil, &OracleError{
+			Msg: "asset amount too large",
+		}
+	}
+
+	price := lnwire.MilliSatoshi(assetAmount * msatPerUnit)
+
+	return &price, nil
+}
+
+// QueryAskPrice returns the asking price for the given asset amount.
+func (s *StaticPriceOracle) QueryAskPrice(_ context.Context,
+	assetId *asset.ID, assetGroupKey *btcec.PublicKey, assetAmount uint64,
+	_ *lnwire.MilliSatoshi, _ uint64) (*OracleAskResponse, error) {
+
+	askPrice, oracleErr := s.lookupPrice(
+		assetId, assetGroupKey, assetAmount, false,
+	)
+	if oracleErr != nil {
+		return &OracleAskResponse{
+			Err: oracleErr,
+		}, nil
+	}
+
+	return &OracleAskResponse{
+		AskPrice: askPrice,
+		Expiry:   uint64(s.now().Add(s.expiry).Unix()),
+	}, nil
+}
+
+// QueryBidPrice returns a bid price for the given asset amount.
+func (s *StaticPriceOracle) QueryBidPrice(_ context.Context,
+	assetId *asset.ID, assetGroupKey *btcec.PublicKey, assetAmount uint64,
+	_ *lnwire.MilliSatoshi, _ uint64) (*OracleBidResponse, error) {
+
+	bidPrice, oracleErr := s.lookupPrice(
+		assetId, assetGroupKey, assetAmount, true,
+	)
+	if oracleErr != nil {
+		return &OracleBidResponse{
+			Err: oracleErr,
+		}, nil
+	}
+
+	return &OracleBidResponse{
+		BidPrice: bidPrice,
+		Expiry:   uint64(s.now().Add(s.expiry).Unix()),
+	}, nil
+}
+
+// Ensure that StaticPriceOracle implements the PriceOracle interface.
+var _ PriceOracle = (*StaticPriceOracle)(nil)
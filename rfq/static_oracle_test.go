@@ -0,0 +1,146 @@
+package rfq
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+// TestStaticPriceOracle tests that the static price oracle quotes prices from
+// its rate table file.
+func TestStaticPriceOracle(t *testing.T) {
+	t.Parallel()
+
+	assetID := asset.RandID(t)
+	groupKey := test.RandPubKey(t)
+	unknownID := asset.RandID(t)
+
+	table := StaticRateTable{
+		Rates: []StaticRate{{
+			AssetID:        hex.EncodeToString(assetID[:]),
+			BidMsatPerUnit: 90,
+			AskMsatPerUnit: 110,
+		}, {
+			GroupKey: hex.EncodeToString(
+				groupKey.SerializeCompressed(),
+			),
+			BidMsatPerUnit: 9,
+			AskMsatPerUnit: 11,
+		}},
+	}
+	tableBytes, err := json.Marshal(table)
+	require.NoError(t, err)
+
+	tablePath := filepath.Join(t.TempDir(), "rates.json")
+	require.NoError(t, os.WriteFile(tablePath, tableBytes, 0600))
+
+	oracle, err := NewPriceOracleSource(
+		StaticPriceOracleScheme+"://"+tablePath, RpcOracleTLSConfig{},
+	)
+	require.NoError(t, err)
+
+	staticOracle, ok := oracle.(*StaticPriceOracle)
+	require.True(t, ok)
+
+	now := time.Now()
+	staticOracle.now = func() time.Time {
+		return now
+	}
+	expiry := uint64(now.Add(DefaultStaticOracleExpiry).Unix())
+
+	ctx := context.Background()
+
+	askResp, err := oracle.QueryAskPrice(ctx, &assetID, nil, 10, nil, 0)
+	require.NoError(t, err)
+	require.Nil(t, askResp.Err)
+	require.Equal(t, lnwire.MilliSatoshi(1100), *askResp.AskPrice)
+	require.Equal(t, expiry, askResp.Expiry)
+
+	bidResp, err := oracle.QueryBidPrice(ctx, nil, groupKey, 10, nil, 0)
+	require.NoError(t, err)
+	require.Nil(t, bidResp.Err)
+	require.Equal(t, lnwire.MilliSatoshi(90), *bidResp.BidPrice)
+	require.Equal(t, expiry, bidResp.Expiry)
+
+	// Unknown assets and overflowing amounts are declined.
+	bidResp, err = oracle.QueryBidPrice(
+		ctx, &unknownID, nil, 10, nil, 0,
+	)
+	require.NoError(t, err)
+	require.ErrorContains(t, bidResp.Err, "not found")
+	require.Nil(t, bidResp.BidPrice)
+
+	askResp, err = oracle.QueryAskPrice(
+		ctx, &assetID, nil, math.MaxUint64, nil, 0,
+	)
+	require.NoError(t, err)
+	require.ErrorContains(t, askResp.Err, "too large")
+	require.Nil(t, askResp.AskPrice)
+}
+
+// TestStaticPriceOracleInvalidTable tests that invalid rate tables are
+// rejected.
+func TestStaticPriceOracleInvalidTable(t *testing.T) {
+	t.Parallel()
+
+	assetID := asset.RandID(t)
+	groupKey := test.RandPubKey(t)
+
+	testCases := []struct {
+		name      string
+		rates     []StaticRate
+		expectErr string
+	}{{
+		name:      "no asset specifier",
+		rates:     []StaticRate{{BidMsatPerUnit: 1}},
+		expectErr: "either asset ID or group key must be set",
+	}, {
+		name: "both asset specifiers",
+		rates: []StaticRate{{
+			AssetID: hex.EncodeToString(assetID[:]),
+			GroupKey: hex.EncodeToString(
+				groupKey.SerializeCompressed(),
+			),
+		}},
+		expectErr: "mutually exclusive",
+	}, {
+		name:      "invalid asset ID",
+		rates:     []StaticRate{{AssetID: "abcd"}},
+		expectErr: "invalid asset ID length",
+	}, {
+		name:      "invalid group key",
+		rates:     []StaticRate{{GroupKey: "abcd"}},
+		expectErr: "invalid group key",
+	}, {
+		name: "duplicate rate",
+		rates: []StaticRate{{
+			AssetID: hex.EncodeToString(assetID[:]),
+		}, {
+			AssetID: hex.EncodeToString(assetID[:]),
+		}},
+		expectErr: "duplicate rate at index 1",
+	}}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := NewStaticPriceOracle(StaticRateTable{
+				Rates: tc.rates,
+			}, DefaultStaticOracleExpiry)
+			require.ErrorContains(t, err, tc.expectErr)
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package rfq
+
+import (
+	"context"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/rfqmsg"
+)
+
+// QuoteStore is an interface that provides persistent storage for accepted
+// quotes and the HTLC policies derived from them. It allows the RFQ subsystems
+// to restore their state after a restart.
+type QuoteStore interface {
+	// UpsertPeerAcceptedBuyQuote stores a buy quote which was requested by
+	// our node and has been accepted by a peer.
+	UpsertPeerAcceptedBuyQuote(ctx context.Context,
+		quote rfqmsg.BuyAccept) error
+
+	// UpsertPeerAcceptedSellQuote stores a sell quote which was requested
+	// by our node and has been accepted by a peer.
+	UpsertPeerAcceptedSellQuote(ctx context.Context,
+		quote rfqmsg.SellAccept) error
+
+	// FetchPeerAcceptedQuotes returns all stored peer accepted buy and sell
+	// quotes which have not expired as of the given time.
+	FetchPeerAcceptedQuotes(ctx context.Context, now time.Time) (
+		[]rfqmsg.BuyAccept, []rfqmsg.SellAccept, error)
+
+	// UpsertAssetSalePolicy stores the buy accept message from which an
+	// asset sale policy is derived.
+	UpsertAssetSalePolicy(ctx context.Context,
+		buyAccept rfqmsg.BuyAccept) error
+
+	// UpsertAssetPurchasePolicy stores the sell accept message from which
+	// an asset purchase policy is derived.
+	UpsertAssetPurchasePolicy(ctx context.Context,
+		sellAccept rfqmsg.SellAccept) error
+
+	// FetchPolicies returns the accept messages of all stored asset sale
+	// and asset purchase policies which have not expired as of the given
+	// time.
+	FetchPolicies(ctx context.Context, now time.Time) ([]rfqmsg.BuyAccept,
+		[]rfqmsg.SellAccept, error)
+
+	// DeleteExpiredQuotes removes all peer accepted quotes and policies
+	// which have expired as of the given time.
+	DeleteExpiredQuotes(ctx context.Context, now time.Time) error
+}
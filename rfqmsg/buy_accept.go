@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"io"
 
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightninglabs/taproot-assets/asset"
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/routing/route"
 	"github.com/lightningnetwork/lnd/tlv"
@@ -81,7 +83,7 @@ type buyAcceptMsgData struct {
 	// Expiry is the asking price expiry lifetime unix timestamp.
 	Expiry uint64
 
-	// sig is a signature over the serialized contents of the message.
+	// sig is a signature over the quote terms of the message. See SigMsg.
 	sig [64]byte
 }
 
@@ -133,6 +135,18 @@ type BuyAccept struct {
 	// is for.
 	AssetAmount uint64
 
+	// AssetID is the ID of the asset that the accept message is for. This
+	// field is not part of the wire message. It is taken from the
+	// corresponding quote request and is covered by the message
+	// signature.
+	AssetID *asset.ID
+
+	// AssetGroupKey is the public key of the asset group that the accept
+	// message is for. This field is not part of the wire message. It is
+	// taken from the corresponding quote request and is covered by the
+	// message signature.
+	AssetGroupKey *btcec.PublicKey
+
 	// buyAcceptMsgData is the message data for the quote accept message.
 	buyAcceptMsgData
 }
@@ -143,8 +157,10 @@ func NewBuyAcceptFromRequest(request BuyRequest, askPrice lnwire.MilliSatoshi,
 	expiry uint64) *BuyAccept {
 
 	return &BuyAccept{
-		Peer:        request.Peer,
-		AssetAmount: request.AssetAmount,
+		Peer:          request.Peer,
+		AssetAmount:   request.AssetAmount,
+		AssetID:       request.AssetID,
+		AssetGroupKey: request.AssetGroupKey,
 		buyAcceptMsgData: buyAcceptMsgData{
 			ID:       request.ID,
 			AskPrice: askPrice,
@@ -153,6 +169,22 @@ func NewBuyAcceptFromRequest(request BuyRequest, askPrice lnwire.MilliSatoshi,
 	}
 }
 
+// NewBuyAccept creates a new instance of a buy quote accept message from its
+// individual fields. This is used to restore a previously accepted quote.
+func NewBuyAccept(peer route.Vertex, id ID, assetAmount uint64,
+	askPrice lnwire.MilliSatoshi, expiry uint64) *BuyAccept {
+
+	return &BuyAccept{
+		Peer:        peer,
+		AssetAmount: assetAmount,
+		buyAcceptMsgData: buyAcceptMsgData{
+			ID:       id,
+			AskPrice: askPrice,
+			Expiry:   expiry,
+		},
+	}
+}
+
 // NewBuyAcceptFromWireMsg instantiates a new instance from a wire message.
 func NewBuyAcceptFromWireMsg(wireMsg WireMessage) (*BuyAccept, error) {
 	// Ensure that the message type is an accept message.
@@ -180,10 +212,8 @@ func (q *BuyAccept) ShortChannelId() SerialisedScid {
 	return q.ID.Scid()
 }
 
-// ToWire returns a wire message with a serialized data field.
-//
-// TODO(ffranr): This method should accept a signer so that we can generate a
-// signature over the message data.
+// ToWire returns a wire message with a serialized data field. The message
+// should be signed using SetSignature before it is converted.
 func (q *BuyAccept) ToWire() (WireMessage, error) {
 	// Encode message data component as TLV bytes.
 	msgDataBytes, err := q.buyAcceptMsgData.Bytes()
@@ -199,6 +229,32 @@ func (q *BuyAccept) ToWire() (WireMessage, error) {
 	}, nil
 }
 
+// SigMsg returns the message which is signed by the author of the accept
+// message. The signature is a Schnorr signature over the SHA256 digest of this
+// message, created with the node identity key of the author.
+func (q *BuyAccept) SigMsg() []byte {
+	return acceptSigMsg(
+		MsgTypeBuyAccept, q.ID, q.AssetID, q.AssetGroupKey, q.AskPrice,
+		q.Expiry,
+	)
+}
+
+// Signature returns the signature of the accept message.
+func (q *BuyAccept) Signature() [64]byte {
+	return q.sig
+}
+
+// SetSignature sets the signature of the accept message.
+func (q *BuyAccept) SetSignature(sig [64]byte) {
+	q.sig = sig
+}
+
+// VerifySignature verifies that the accept message was signed by the node
+// identity key of the peer which authored the message.
+func (q *BuyAccept) VerifySignature() error {
+	return verifyAcceptSig(q.Peer, q.SigMsg(), q.sig)
+}
+
 // String returns a human-readable string representation of the message.
 func (q *BuyAccept) String() string {
 	return fmt.Sprintf("BuyAccept(peer=%x, id=%x, ask_price=%d, "+
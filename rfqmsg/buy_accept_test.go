@@ -2,12 +2,16 @@ package rfqmsg
 
 import (
 	"bytes"
+	"crypto/sha256"
 	"encoding/binary"
 	"math/rand"
 	"testing"
 
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/lightninglabs/taproot-assets/asset"
 	"github.com/lightninglabs/taproot-assets/internal/test"
 	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
 	"github.com/stretchr/testify/require"
 )
 
@@ -104,3 +108,58 @@ func TestBuyAcceptMsgDataEncodeDecode(t *testing.T) {
 		})
 	}
 }
+
+// TestBuyAcceptSignature tests that a buy accept message signature is
+// verified against the node key of the message author and covers the quote
+// terms.
+func TestBuyAcceptSignature(t *testing.T) {
+	t.Parallel()
+
+	authorKey := test.RandPrivKey(t)
+	author := route.NewVertex(authorKey.PubKey())
+
+	assetID := asset.RandID(t)
+	request, err := NewBuyRequest(author, &assetID, nil, 100, 1000, 0)
+	require.NoError(t, err)
+
+	msg := NewBuyAcceptFromRequest(*request, 2000, 42000)
+	require.Equal(t, &assetID, msg.AssetID)
+
+	// An unsigned message should fail verification.
+	require.Error(t, msg.VerifySignature())
+
+	// Sign the message the same way lnd does when asked for a Schnorr
+	// signature.
+	digest := sha256.Sum256(msg.SigMsg())
+	schnorrSig, err := schnorr.Sign(authorKey, digest[:])
+	require.NoError(t, err)
+
+	var sig [64]byte
+	copy(sig[:], schnorrSig.Serialize())
+	msg.SetSignature(sig)
+	require.NoError(t, msg.VerifySignature())
+	require.Equal(t, sig, msg.Signature())
+
+	// The signature should survive a wire round trip.
+	wireMsg, err := msg.ToWire()
+	require.NoError(t, err)
+
+	decoded, err := NewBuyAcceptFromWireMsg(wireMsg)
+	require.NoError(t, err)
+	require.Equal(t, sig, decoded.Signature())
+
+	// Changing any of the signed quote terms should invalidate the
+	// signature.
+	tampered := *msg
+	tampered.AskPrice++
+	require.ErrorIs(t, tampered.VerifySignature(), ErrInvalidAcceptSig)
+
+	tampered = *msg
+	tampered.AssetID = &asset.ID{}
+	require.ErrorIs(t, tampered.VerifySignature(), ErrInvalidAcceptSig)
+
+	// The signature should only be valid for the message author.
+	tampered = *msg
+	tampered.Peer = route.NewVertex(test.RandPubKey(t))
+	require.ErrorIs(t, tampered.VerifySignature(), ErrInvalidAcceptSig)
+}
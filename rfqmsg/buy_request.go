@@ -6,6 +6,7 @@ import (
 	"crypto/sha256"
 	"fmt"
 	"io"
+	"time"
 
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/lightninglabs/taproot-assets/asset"
@@ -22,6 +23,7 @@ const (
 	TypeBuyRequestAssetGroupKey tlv.Type = 3
 	TypeBuyRequestAssetAmount   tlv.Type = 4
 	TypeBuyRequestBidPrice      tlv.Type = 6
+	TypeBuyRequestBidExpiry     tlv.Type = 7
 )
 
 func TypeRecordBuyRequestID(id *ID) tlv.Record {
@@ -121,6 +123,10 @@ func TypeRecordBuyRequestBidPrice(bid *lnwire.MilliSatoshi) tlv.Record {
 	)
 }
 
+func TypeRecordBuyRequestBidExpiry(expiry *uint64) tlv.Record {
+	return tlv.MakePrimitiveRecord(TypeBuyRequestBidExpiry, expiry)
+}
+
 // buyRequestMsgData is a struct that represents the message data from an asset
 // buy quote request message.
 type buyRequestMsgData struct {
@@ -141,6 +147,11 @@ type buyRequestMsgData struct {
 
 	// BidPrice is the peer's proposed bid price for the asset amount.
 	BidPrice lnwire.MilliSatoshi
+
+	// BidPriceExpiry is the unix timestamp in seconds after which the
+	// suggested bid price is no longer valid. It is zero if unset, in
+	// which case the record is omitted from the wire message.
+	BidPriceExpiry uint64
 }
 
 // Validate ensures that the asset buy quote request is valid.
@@ -180,6 +191,11 @@ func (q *buyRequestMsgData) encodeRecords() []tlv.Record {
 	)
 	records = append(records, TypeRecordBuyRequestBidPrice(&q.BidPrice))
 
+	if q.BidPriceExpiry != 0 {
+		record := TypeRecordBuyRequestBidExpiry(&q.BidPriceExpiry)
+		records = append(records, record)
+	}
+
 	return records
 }
 
@@ -211,6 +227,7 @@ func (q *buyRequestMsgData) decodeRecords() []tlv.Record {
 		TypeRecordBuyRequestAssetGroupKey(&q.AssetGroupKey),
 		TypeRecordBuyRequestAssetAmount(&q.AssetAmount),
 		TypeRecordBuyRequestBidPrice(&q.BidPrice),
+		TypeRecordBuyRequestBidExpiry(&q.BidPriceExpiry),
 	}
 }
 
@@ -236,7 +253,8 @@ type BuyRequest struct {
 // NewBuyRequest creates a new asset buy quote request.
 func NewBuyRequest(peer route.Vertex, assetID *asset.ID,
 	assetGroupKey *btcec.PublicKey, assetAmount uint64,
-	bidPrice lnwire.MilliSatoshi) (*BuyRequest, error) {
+	bidPrice lnwire.MilliSatoshi,
+	bidPriceExpiry uint64) (*BuyRequest, error) {
 
 	var id [32]byte
 	_, err := rand.Read(id[:])
@@ -248,11 +266,12 @@ func NewBuyRequest(peer route.Vertex, assetID *asset.ID,
 	return &BuyRequest{
 		Peer: peer,
 		buyRequestMsgData: buyRequestMsgData{
-			ID:            id,
-			AssetID:       assetID,
-			AssetGroupKey: assetGroupKey,
-			AssetAmount:   assetAmount,
-			BidPrice:      bidPrice,
+			ID:             id,
+			AssetID:        assetID,
+			AssetGroupKey:  assetGroupKey,
+			AssetAmount:    assetAmount,
+			BidPrice:       bidPrice,
+			BidPriceExpiry: bidPriceExpiry,
 		},
 	}, nil
 }
@@ -287,6 +306,13 @@ func NewBuyRequestMsgFromWire(wireMsg WireMessage) (*BuyRequest, error) {
 	return &req, nil
 }
 
+// BidPriceExpired returns true if the suggested bid price carries an expiry
+// which is not after the given time.
+func (q *BuyRequest) BidPriceExpired(now time.Time) bool {
+	return q.BidPriceExpiry != 0 &&
+		int64(q.BidPriceExpiry) <= now.Unix()
+}
+
 // Validate ensures that the buy request is valid.
 func (q *BuyRequest) Validate() error {
 	return q.buyRequestMsgData.Validate()
@@ -316,8 +342,9 @@ func (q *BuyRequest) String() string {
 	}
 
 	return fmt.Sprintf("BuyRequest(peer=%s, id=%x, asset_id=%s, "+
-		"asset_group_key=%x, asset_amount=%d, bid_price=%d)", q.Peer,
-		q.ID, q.AssetID, groupKeyBytes, q.AssetAmount, q.BidPrice)
+		"asset_group_key=%x, asset_amount=%d, bid_price=%d, "+
+		"bid_price_expiry=%d)", q.Peer, q.ID, q.AssetID, groupKeyBytes,
+		q.AssetAmount, q.BidPrice, q.BidPriceExpiry)
 }
 
 // Ensure that the message type implements the OutgoingMsg interface.
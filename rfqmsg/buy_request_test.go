@@ -32,6 +32,7 @@ func TestBuyRequestMsgDataEncodeDecode(t *testing.T) {
 		assetGroupKey *btcec.PublicKey
 		assetAmount   uint64
 		bidPrice      lnwire.MilliSatoshi
+		bidExpiry     uint64
 	}{
 		{
 			testName:      "asset group key nil",
@@ -41,16 +42,26 @@ func TestBuyRequestMsgDataEncodeDecode(t *testing.T) {
 			assetAmount:   1000,
 			bidPrice:      lnwire.MilliSatoshi(42000),
 		},
+		{
+			testName:      "bid price with expiry",
+			id:            id,
+			assetId:       &assetId,
+			assetGroupKey: nil,
+			assetAmount:   1000,
+			bidPrice:      lnwire.MilliSatoshi(42000),
+			bidExpiry:     1_700_000_000,
+		},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.testName, func(tt *testing.T) {
 			req := buyRequestMsgData{
-				ID:            tc.id,
-				AssetID:       tc.assetId,
-				AssetGroupKey: tc.assetGroupKey,
-				AssetAmount:   tc.assetAmount,
-				BidPrice:      tc.bidPrice,
+				ID:             tc.id,
+				AssetID:        tc.assetId,
+				AssetGroupKey:  tc.assetGroupKey,
+				AssetAmount:    tc.assetAmount,
+				BidPrice:       tc.bidPrice,
+				BidPriceExpiry: tc.bidExpiry,
 			}
 
 			// Encode the request message.
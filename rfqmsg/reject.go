@@ -103,6 +103,27 @@ var (
 		Code: 2,
 		Msg:  "no suitable buy offer available",
 	}
+
+	// ErrPeerQuoteLimitReached is the error code for when the peer already
+	// holds the maximum number of outstanding quotes.
+	ErrPeerQuoteLimitReached = RejectErr{
+		Code: 3,
+		Msg:  "outstanding quote limit for peer reached",
+	}
+
+	// ErrAssetExposureLimitReached is the error code for when accepting
+	// the quote would exceed the maximum outstanding amount of the asset.
+	ErrAssetExposureLimitReached = RejectErr{
+		Code: 4,
+		Msg:  "asset exposure limit reached",
+	}
+
+	// ErrPeerQuoteRateExceeded is the error code for when the peer has
+	// sent too many quote requests in a short period of time.
+	ErrPeerQuoteRateExceeded = RejectErr{
+		Code: 5,
+		Msg:  "quote request rate limit for peer exceeded",
+	}
 )
 
 // rejectMsgData is a struct that represents the data field of a quote
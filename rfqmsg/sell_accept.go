@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"io"
 
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightninglabs/taproot-assets/asset"
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/routing/route"
 	"github.com/lightningnetwork/lnd/tlv"
@@ -57,7 +59,7 @@ type sellAcceptMsgData struct {
 	// Expiry is the bid price expiry lifetime unix timestamp.
 	Expiry uint64
 
-	// sig is a signature over the serialized contents of the message.
+	// sig is a signature over the quote terms of the message. See SigMsg.
 	sig [64]byte
 }
 
@@ -109,6 +111,18 @@ type SellAccept struct {
 	// is for.
 	AssetAmount uint64
 
+	// AssetID is the ID of the asset that the accept message is for. This
+	// field is not part of the wire message. It is taken from the
+	// corresponding quote request and is covered by the message
+	// signature.
+	AssetID *asset.ID
+
+	// AssetGroupKey is the public key of the asset group that the accept
+	// message is for. This field is not part of the wire message. It is
+	// taken from the corresponding quote request and is covered by the
+	// message signature.
+	AssetGroupKey *btcec.PublicKey
+
 	// sellAcceptMsgData is the message data for the quote accept message.
 	sellAcceptMsgData
 }
@@ -119,8 +133,10 @@ func NewSellAcceptFromRequest(request SellRequest, bidPrice lnwire.MilliSatoshi,
 	expiry uint64) *SellAccept {
 
 	return &SellAccept{
-		Peer:        request.Peer,
-		AssetAmount: request.AssetAmount,
+		Peer:          request.Peer,
+		AssetAmount:   request.AssetAmount,
+		AssetID:       request.AssetID,
+		AssetGroupKey: request.AssetGroupKey,
 		sellAcceptMsgData: sellAcceptMsgData{
 			ID:       request.ID,
 			BidPrice: bidPrice,
@@ -129,6 +145,23 @@ func NewSellAcceptFromRequest(request SellRequest, bidPrice lnwire.MilliSatoshi,
 	}
 }
 
+// NewSellAccept creates a new instance of an asset sell quote accept message
+// from its individual fields. This is used to restore a previously accepted
+// quote.
+func NewSellAccept(peer route.Vertex, id ID, assetAmount uint64,
+	bidPrice lnwire.MilliSatoshi, expiry uint64) *SellAccept {
+
+	return &SellAccept{
+		Peer:        peer,
+		AssetAmount: assetAmount,
+		sellAcceptMsgData: sellAcceptMsgData{
+			ID:       id,
+			BidPrice: bidPrice,
+			Expiry:   expiry,
+		},
+	}
+}
+
 // NewSellAcceptFromWireMsg instantiates a new instance from a wire message.
 func NewSellAcceptFromWireMsg(wireMsg WireMessage) (*SellAccept, error) {
 	// Ensure that the message type is an accept message.
@@ -164,10 +197,8 @@ func (q *SellAccept) ShortChannelId() SerialisedScid {
 	return SerialisedScid(scidInteger)
 }
 
-// ToWire returns a wire message with a serialized data field.
-//
-// TODO(ffranr): This method should accept a signer so that we can generate a
-// signature over the message data.
+// ToWire returns a wire message with a serialized data field. The message
+// should be signed using SetSignature before it is converted.
 func (q *SellAccept) ToWire() (WireMessage, error) {
 	// Encode message data component as TLV bytes.
 	msgDataBytes, err := q.sellAcceptMsgData.Bytes()
@@ -183,6 +214,32 @@ func (q *SellAccept) ToWire() (WireMessage, error) {
 	}, nil
 }
 
+// SigMsg returns the message which is signed by the author of the accept
+// message. The signature is a Schnorr signature over the SHA256 digest of this
+// message, created with the node identity key of the author.
+func (q *SellAccept) SigMsg() []byte {
+	return acceptSigMsg(
+		MsgTypeSellAccept, q.ID, q.AssetID, q.AssetGroupKey, q.BidPrice,
+		q.Expiry,
+	)
+}
+
+// Signature returns the signature of the accept message.
+func (q *SellAccept) Signature() [64]byte {
+	return q.sig
+}
+
+// SetSignature sets the signature of the accept message.
+func (q *SellAccept) SetSignature(sig [64]byte) {
+	q.sig = sig
+}
+
+// VerifySignature verifies that the accept message was signed by the node
+// identity key of the peer which authored the message.
+func (q *SellAccept) VerifySignature() error {
+	return verifyAcceptSig(q.Peer, q.SigMsg(), q.sig)
+}
+
 // String returns a human-readable string representation of the message.
 func (q *SellAccept) String() string {
 	return fmt.Sprintf("SellAccept(peer=%x, id=%x, bid_price=%d, "+
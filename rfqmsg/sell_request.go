@@ -6,6 +6,7 @@ import (
 	"crypto/sha256"
 	"fmt"
 	"io"
+	"time"
 
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/lightninglabs/taproot-assets/asset"
@@ -22,6 +23,7 @@ const (
 	TypeSellRequestAssetGroupKey tlv.Type = 3
 	TypeSellRequestAssetAmount   tlv.Type = 4
 	TypeSellRequestSuggestedAsk  tlv.Type = 6
+	TypeSellRequestAskExpiry     tlv.Type = 7
 )
 
 func TypeRecordSellRequestID(id *ID) tlv.Record {
@@ -62,6 +64,10 @@ func TypeRecordSellRequestAskPrice(ask *lnwire.MilliSatoshi) tlv.Record {
 	)
 }
 
+func TypeRecordSellRequestAskExpiry(expiry *uint64) tlv.Record {
+	return tlv.MakePrimitiveRecord(TypeSellRequestAskExpiry, expiry)
+}
+
 // sellRequestMsgData is a struct that represents the message data from an asset
 // sell quote request message.
 type sellRequestMsgData struct {
@@ -85,7 +91,11 @@ type sellRequestMsgData struct {
 	// peer is willing to accept.
 	AskPrice lnwire.MilliSatoshi
 
-	// TODO(ffranr): Add expiry time for suggested ask price.
+	// AskPriceExpiry is the unix timestamp in seconds after which the
+	// suggested ask price is no longer valid. A value of zero means that
+	// no expiry was specified. The field is encoded as an optional odd TLV
+	// record so that peers which do not know about it can ignore it.
+	AskPriceExpiry uint64
 }
 
 // Validate ensures that the quote request is valid.
@@ -127,6 +137,11 @@ func (q *sellRequestMsgData) encodeRecords() []tlv.Record {
 		records, TypeRecordSellRequestAskPrice(&q.AskPrice),
 	)
 
+	if q.AskPriceExpiry != 0 {
+		record := TypeRecordSellRequestAskExpiry(&q.AskPriceExpiry)
+		records = append(records, record)
+	}
+
 	return records
 }
 
@@ -158,6 +173,7 @@ func (q *sellRequestMsgData) decodeRecords() []tlv.Record {
 		TypeRecordSellRequestAssetGroupKey(&q.AssetGroupKey),
 		TypeRecordSellRequestAssetAmount(&q.AssetAmount),
 		TypeRecordSellRequestAskPrice(&q.AskPrice),
+		TypeRecordSellRequestAskExpiry(&q.AskPriceExpiry),
 	}
 }
 
@@ -183,7 +199,8 @@ type SellRequest struct {
 // NewSellRequest creates a new asset sell quote request.
 func NewSellRequest(peer route.Vertex, assetID *asset.ID,
 	assetGroupKey *btcec.PublicKey, assetAmount uint64,
-	askPrice lnwire.MilliSatoshi) (*SellRequest, error) {
+	askPrice lnwire.MilliSatoshi,
+	askPriceExpiry uint64) (*SellRequest, error) {
 
 	var id [32]byte
 	_, err := rand.Read(id[:])
@@ -194,11 +211,12 @@ func NewSellRequest(peer route.Vertex, assetID *asset.ID,
 	return &SellRequest{
 		Peer: peer,
 		sellRequestMsgData: sellRequestMsgData{
-			ID:            id,
-			AssetID:       assetID,
-			AssetGroupKey: assetGroupKey,
-			AssetAmount:   assetAmount,
-			AskPrice:      askPrice,
+			ID:             id,
+			AssetID:        assetID,
+			AssetGroupKey:  assetGroupKey,
+			AssetAmount:    assetAmount,
+			AskPrice:       askPrice,
+			AskPriceExpiry: askPriceExpiry,
 		},
 	}, nil
 }
@@ -234,6 +252,13 @@ func NewSellRequestMsgFromWire(wireMsg WireMessage) (*SellRequest, error) {
 	return &req, nil
 }
 
+// AskPriceExpired returns true if the suggested ask price carries an expiry
+// which is not after the given time.
+func (q *SellRequest) AskPriceExpired(now time.Time) bool {
+	return q.AskPriceExpiry != 0 &&
+		int64(q.AskPriceExpiry) <= now.Unix()
+}
+
 // Validate ensures that the quote request is valid.
 func (q *SellRequest) Validate() error {
 	return q.sellRequestMsgData.Validate()
@@ -263,8 +288,9 @@ func (q *SellRequest) String() string {
 	}
 
 	return fmt.Sprintf("SellRequest(peer=%s, id=%x, asset_id=%s, "+
-		"asset_group_key=%x, asset_amount=%d, ask_price=%d)", q.Peer,
-		q.ID, q.AssetID, groupKeyBytes, q.AssetAmount, q.AskPrice)
+		"asset_group_key=%x, asset_amount=%d, ask_price=%d, "+
+		"ask_price_expiry=%d)", q.Peer, q.ID, q.AssetID, groupKeyBytes,
+		q.AssetAmount, q.AskPrice, q.AskPriceExpiry)
 }
 
 // Ensure that the message type implements the OutgoingMsg interface.
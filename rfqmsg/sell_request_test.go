@@ -3,11 +3,13 @@ package rfqmsg
 import (
 	"bytes"
 	"testing"
+	"time"
 
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/lightninglabs/taproot-assets/asset"
 	"github.com/lightninglabs/taproot-assets/internal/test"
 	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/tlv"
 	"github.com/stretchr/testify/require"
 )
 
@@ -32,6 +34,7 @@ func TestSellRequestMsgDataEncodeDecode(t *testing.T) {
 		assetGroupKey *btcec.PublicKey
 		assetAmount   uint64
 		askPrice      lnwire.MilliSatoshi
+		askExpiry     uint64
 	}{
 		{
 			testName:      "all fields populated with basic values",
@@ -41,16 +44,26 @@ func TestSellRequestMsgDataEncodeDecode(t *testing.T) {
 			assetAmount:   1000,
 			askPrice:      lnwire.MilliSatoshi(42000),
 		},
+		{
+			testName:      "ask price with expiry",
+			id:            id,
+			assetId:       &assetId,
+			assetGroupKey: nil,
+			assetAmount:   1000,
+			askPrice:      lnwire.MilliSatoshi(42000),
+			askExpiry:     1_700_000_000,
+		},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.testName, func(tt *testing.T) {
 			msg := sellRequestMsgData{
-				ID:            tc.id,
-				AssetID:       tc.assetId,
-				AssetGroupKey: tc.assetGroupKey,
-				AssetAmount:   tc.assetAmount,
-				AskPrice:      tc.askPrice,
+				ID:             tc.id,
+				AssetID:        tc.assetId,
+				AssetGroupKey:  tc.assetGroupKey,
+				AssetAmount:    tc.assetAmount,
+				AskPrice:       tc.askPrice,
+				AskPriceExpiry: tc.askExpiry,
 			}
 
 			// Encode the message.
@@ -68,3 +81,55 @@ func TestSellRequestMsgDataEncodeDecode(t *testing.T) {
 		})
 	}
 }
+
+// TestSellRequestAskExpiryCompatibility tests that the optional ask price
+// expiry record doesn't break peers which don't know about it, and that
+// messages without the record still decode.
+func TestSellRequestAskExpiryCompatibility(t *testing.T) {
+	t.Parallel()
+
+	assetId := asset.ID(test.RandBytes(32))
+	msg := sellRequestMsgData{
+		ID:             ID(test.RandBytes(32)),
+		AssetID:        &assetId,
+		AssetAmount:    1000,
+		AskPrice:       lnwire.MilliSatoshi(42000),
+		AskPriceExpiry: 1_700_000_000,
+	}
+	msgBytes, err := msg.Bytes()
+	require.NoError(t, err)
+
+	// A legacy peer only knows about the records up to the ask price. The
+	// unknown odd expiry record must be skipped.
+	var legacyMsg sellRequestMsgData
+	legacyStream, err := tlv.NewStream(
+		TypeRecordSellRequestID(&legacyMsg.ID),
+		TypeRecordSellRequestAssetID(&legacyMsg.AssetID),
+		TypeRecordSellRequestAssetGroupKey(&legacyMsg.AssetGroupKey),
+		TypeRecordSellRequestAssetAmount(&legacyMsg.AssetAmount),
+		TypeRecordSellRequestAskPrice(&legacyMsg.AskPrice),
+	)
+	require.NoError(t, err)
+	require.NoError(t, legacyStream.Decode(bytes.NewReader(msgBytes)))
+
+	expectedLegacyMsg := msg
+	expectedLegacyMsg.AskPriceExpiry = 0
+	require.Equal(t, expectedLegacyMsg, legacyMsg)
+
+	// A message from a legacy peer doesn't carry an expiry, so the
+	// suggested price never counts as expired.
+	legacyBytes, err := expectedLegacyMsg.Bytes()
+	require.NoError(t, err)
+
+	req, err := NewSellRequestMsgFromWire(WireMessage{
+		MsgType: MsgTypeSellRequest,
+		Data:    legacyBytes,
+	})
+	require.NoError(t, err)
+	require.Zero(t, req.AskPriceExpiry)
+	require.False(t, req.AskPriceExpired(time.Now()))
+
+	// With an expiry in the past, the suggested price is expired.
+	req.AskPriceExpiry = uint64(time.Now().Add(-time.Minute).Unix())
+	require.True(t, req.AskPriceExpired(time.Now()))
+}
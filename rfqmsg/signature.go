@@ -0,0 +1,85 @@
+package rfqmsg
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+var (
+	// ErrInvalidAcceptSig is returned when the signature of a quote accept
+	// message is not valid for the message author.
+	ErrInvalidAcceptSig = errors.New("invalid accept message signature")
+)
+
+// acceptSigMsg serializes the quote terms which are covered by the signature
+// of a quote accept message. The message type is included so that a buy accept
+// signature can't be replayed as a sell accept signature and vice versa.
+//
+// The asset ID and asset group key are not part of the accept wire message.
+// They are taken from the quote request which the accept message responds to.
+// An unset asset ID or group key is serialized as all zero bytes.
+func acceptSigMsg(msgType lnwire.MessageType, id ID, assetID *asset.ID,
+	assetGroupKey *btcec.PublicKey, price lnwire.MilliSatoshi,
+	expiry uint64) []byte {
+
+	var (
+		b       bytes.Buffer
+		scratch [8]byte
+	)
+
+	binary.BigEndian.PutUint16(scratch[:2], uint16(msgType))
+	b.Write(scratch[:2])
+
+	b.Write(id[:])
+
+	var assetIDBytes [32]byte
+	if assetID != nil {
+		assetIDBytes = *assetID
+	}
+	b.Write(assetIDBytes[:])
+
+	var groupKeyBytes [btcec.PubKeyBytesLenCompressed]byte
+	if assetGroupKey != nil {
+		copy(groupKeyBytes[:], assetGroupKey.SerializeCompressed())
+	}
+	b.Write(groupKeyBytes[:])
+
+	binary.BigEndian.PutUint64(scratch[:], uint64(price))
+	b.Write(scratch[:])
+
+	binary.BigEndian.PutUint64(scratch[:], expiry)
+	b.Write(scratch[:])
+
+	return b.Bytes()
+}
+
+// verifyAcceptSig verifies that the given Schnorr signature over the SHA256
+// digest of the given message was created by the node identity key of the
+// given peer.
+func verifyAcceptSig(peer route.Vertex, msg []byte, sig [64]byte) error {
+	pubKey, err := btcec.ParsePubKey(peer[:])
+	if err != nil {
+		return fmt.Errorf("unable to parse peer public key: %w", err)
+	}
+
+	signature, err := schnorr.ParseSignature(sig[:])
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidAcceptSig, err)
+	}
+
+	digest := sha256.Sum256(msg)
+	if !signature.Verify(digest[:], pubKey) {
+		return ErrInvalidAcceptSig
+	}
+
+	return nil
+}
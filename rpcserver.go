@@ -1,6 +1,7 @@
 package taprootassets
 
 import (
+	"bufio"
 	"bytes"
 	"context"
 	"crypto/sha256"
@@ -9,6 +10,7 @@ import (
 	"fmt"
 	"io"
 	"math"
+	"net"
 	"net/http"
 	"strings"
 	"sync"
@@ -58,6 +60,9 @@ import (
 	"github.com/lightningnetwork/lnd/signal"
 	"golang.org/x/time/rate"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
 )
 
 var (
@@ -96,6 +101,10 @@ const (
 	// timestamp, not including any map/cache overhead).
 	maxNumBlocksInCache = 100_000
 
+	// snapshotChunkSize is the size of the chunks a universe snapshot is
+	// streamed back in.
+	snapshotChunkSize = 1024 * 1024
+
 	// AssetBurnConfirmationText is the text that needs to be set on the
 	// RPC to confirm an asset burn.
 	AssetBurnConfirmationText = "assets will be destroyed"
@@ -423,40 +432,12 @@ func (r *rpcServer) MintAsset(ctx context.Context,
 		return nil, fmt.Errorf("invalid asset name: %w", err)
 	}
 
-	specificGroupKey := len(req.Asset.GroupKey) != 0
-	specificGroupAnchor := len(req.Asset.GroupAnchor) != 0
-
-	switch {
-	// New grouped asset and grouped asset cannot both be set.
-	case req.Asset.NewGroupedAsset && req.Asset.GroupedAsset:
-		return nil, fmt.Errorf("cannot set both new grouped asset " +
-			"and grouped asset",
-		)
-
-	// Using a specific group key or anchor implies disabling emission.
-	case req.Asset.NewGroupedAsset:
-		if specificGroupKey || specificGroupAnchor {
-			return nil, fmt.Errorf("must disable emission to " +
-				"specify a group")
-		}
-
-	// If the asset is intended to be part of an existing group, a group key
-	// or anchor must be specified, but not both.
-	case req.Asset.GroupedAsset:
-		if !specificGroupKey && !specificGroupAnchor {
-			return nil, fmt.Errorf("must specify a group key or" +
-				"group anchor")
-		}
-
-		if specificGroupKey && specificGroupAnchor {
-			return nil, fmt.Errorf("cannot specify both a group " +
-				"key and a group anchor")
-		}
-
-	// A group was specified without GroupedAsset being set.
-	case specificGroupKey || specificGroupAnchor:
-		return nil, fmt.Errorf("must set grouped asset to mint into " +
-			"a specific group")
+	group, err := unmarshalSeedlingGroup(
+		req.Asset.NewGroupedAsset, req.Asset.GroupedAsset,
+		req.Asset.GroupKey, req.Asset.GroupAnchor,
+	)
+	if err != nil {
+		return nil, err
 	}
 
 	assetVersion, err := taprpc.UnmarshalAssetVersion(
@@ -466,68 +447,41 @@ func (r *rpcServer) MintAsset(ctx context.Context,
 		return nil, err
 	}
 
+	batchTarget, err := unmarshalBatchTarget(req.BatchName, req.BatchKey)
+	if err != nil {
+		return nil, err
+	}
+
 	seedling := &tapgarden.Seedling{
 		AssetVersion:   assetVersion,
 		AssetType:      asset.Type(req.Asset.AssetType),
 		AssetName:      req.Asset.Name,
 		Amount:         req.Asset.Amount,
-		EnableEmission: req.Asset.NewGroupedAsset,
+		EnableEmission: group.EnableEmission,
+		GroupInfo:      group.GroupInfo,
+		GroupAnchor:    group.GroupAnchor,
+		Batch:          batchTarget,
 	}
 
 	rpcsLog.Infof("[MintAsset]: version=%v, type=%v, name=%v, amt=%v, "+
 		"issuance=%v", seedling.AssetVersion, seedling.AssetType,
 		seedling.AssetName, seedling.Amount, seedling.EnableEmission)
 
-	switch {
-	// If a group key is provided, parse the provided group public key
-	// before creating the asset seedling.
-	case specificGroupKey:
-		groupTweakedKey, err := btcec.ParsePubKey(req.Asset.GroupKey)
-		if err != nil {
-			return nil, fmt.Errorf("invalid group key: %w", err)
-		}
-
+	// If a group key is provided, minting the asset into that group must
+	// not overflow the balance of the group.
+	if seedling.HasGroupKey() {
 		err = r.checkBalanceOverflow(
-			ctx, nil, groupTweakedKey, req.Asset.Amount,
+			ctx, nil, &seedling.GroupInfo.GroupPubKey,
+			req.Asset.Amount,
 		)
 		if err != nil {
 			return nil, err
 		}
-
-		seedling.GroupInfo = &asset.AssetGroup{
-			GroupKey: &asset.GroupKey{
-				GroupPubKey: *groupTweakedKey,
-			},
-		}
-
-	// If a group anchor is provided, propoate the name to the seedling.
-	// We cannot do any name validation from outside the minter.
-	case specificGroupAnchor:
-		seedling.GroupAnchor = &req.Asset.GroupAnchor
 	}
 
-	if req.Asset.AssetMeta != nil {
-		// Ensure that the meta field is within bounds.
-		switch {
-		case req.Asset.AssetMeta.Type < 0:
-			return nil, fmt.Errorf("meta type cannot be negative")
-
-		case req.Asset.AssetMeta.Type > math.MaxUint8:
-			return nil, fmt.Errorf("meta type is too large: %v, "+
-				"max is: %v", req.Asset.AssetMeta.Type,
-				math.MaxUint8)
-		}
-
-		seedling.Meta = &proof.MetaReveal{
-			Type: proof.MetaType(req.Asset.AssetMeta.Type),
-			Data: req.Asset.AssetMeta.Data,
-		}
-
-		// If the asset meta field was specified, then the data inside
-		// must be valid. Let's check that now.
-		if err := seedling.Meta.Validate(); err != nil {
-			return nil, fmt.Errorf("invalid asset meta: %w", err)
-		}
+	seedling.Meta, err = unmarshalSeedlingMeta(req.Asset.AssetMeta)
+	if err != nil {
+		return nil, err
 	}
 
 	updates, err := r.cfg.AssetMinter.QueueNewSeedling(seedling)
@@ -560,6 +514,109 @@ func (r *rpcServer) MintAsset(ctx context.Context,
 	}
 }
 
+// unmarshalSeedlingGroup parses and validates the group settings of an asset
+// in a minting batch.
+func unmarshalSeedlingGroup(newGroupedAsset, groupedAsset bool,
+	groupKey []byte, groupAnchor string) (tapgarden.SeedlingGroupParams,
+	error) {
+
+	var group tapgarden.SeedlingGroupParams
+
+	specificGroupKey := len(groupKey) != 0
+	specificGroupAnchor := len(groupAnchor) != 0
+
+	switch {
+	// New grouped asset and grouped asset cannot both be set.
+	case newGroupedAsset && groupedAsset:
+		return group, fmt.Errorf("cannot set both new grouped asset " +
+			"and grouped asset",
+		)
+
+	// Using a specific group key or anchor implies disabling emission.
+	case newGroupedAsset:
+		if specificGroupKey || specificGroupAnchor {
+			return group, fmt.Errorf("must disable emission to " +
+				"specify a group")
+		}
+
+	// If the asset is intended to be part of an existing group, a group key
+	// or anchor must be specified, but not both.
+	case groupedAsset:
+		if !specificGroupKey && !specificGroupAnchor {
+			return group, fmt.Errorf("must specify a group key or" +
+				"group anchor")
+		}
+
+		if specificGroupKey && specificGroupAnchor {
+			return group, fmt.Errorf("cannot specify both a " +
+				"group key and a group anchor")
+		}
+
+	// A group was specified without GroupedAsset being set.
+	case specificGroupKey || specificGroupAnchor:
+		return group, fmt.Errorf("must set grouped asset to mint " +
+			"into a specific group")
+	}
+
+	group.EnableEmission = newGroupedAsset
+
+	switch {
+	// If a group key is provided, parse the provided group public key
+	// before creating the asset seedling.
+	case specificGroupKey:
+		groupTweakedKey, err := btcec.ParsePubKey(groupKey)
+		if err != nil {
+			return group, fmt.Errorf("invalid group key: %w", err)
+		}
+
+		group.GroupInfo = &asset.AssetGroup{
+			GroupKey: &asset.GroupKey{
+				GroupPubKey: *groupTweakedKey,
+			},
+		}
+
+	// If a group anchor is provided, propoate the name to the seedling.
+	// We cannot do any name validation from outside the minter.
+	case specificGroupAnchor:
+		group.GroupAnchor = &groupAnchor
+	}
+
+	return group, nil
+}
+
+// unmarshalSeedlingMeta parses and validates the optional metadata of an asset
+// in a minting batch.
+func unmarshalSeedlingMeta(rpcMeta *taprpc.AssetMeta) (*proof.MetaReveal,
+	error) {
+
+	if rpcMeta == nil {
+		return nil, nil
+	}
+
+	// Ensure that the meta field is within bounds.
+	switch {
+	case rpcMeta.Type < 0:
+		return nil, fmt.Errorf("meta type cannot be negative")
+
+	case rpcMeta.Type > math.MaxUint8:
+		return nil, fmt.Errorf("meta type is too large: %v, max is: %v",
+			rpcMeta.Type, math.MaxUint8)
+	}
+
+	meta := &proof.MetaReveal{
+		Type: proof.MetaType(rpcMeta.Type),
+		Data: rpcMeta.Data,
+	}
+
+	// If the asset meta field was specified, then the data inside must be
+	// valid. Let's check that now.
+	if err := meta.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid asset meta: %w", err)
+	}
+
+	return meta, nil
+}
+
 // checkFeeRateSanity ensures that the provided fee rate, in sat/kw, is above
 // the same minimum fee used as a floor in the fee estimator.
 func checkFeeRateSanity(rpcFeeRate uint32) (*chainfee.SatPerKWeight, error) {
@@ -580,168 +637,578 @@ func checkFeeRateSanity(rpcFeeRate uint32) (*chainfee.SatPerKWeight, error) {
 	}
 }
 
-// FinalizeBatch attempts to finalize the current pending batch.
-func (r *rpcServer) FinalizeBatch(_ context.Context,
-	req *mintrpc.FinalizeBatchRequest) (*mintrpc.FinalizeBatchResponse,
-	error) {
+// unmarshalBatchTarget parses the optional name and batch key of the pending
+// minting batch that an RPC request targets.
+func unmarshalBatchTarget(batchName string,
+	batchKey []byte) (tapgarden.BatchTarget, error) {
 
-	var batchSibling *asset.TapscriptTreeNodes
+	target := tapgarden.BatchTarget{
+		Name: batchName,
+	}
 
-	feeRate, err := checkFeeRateSanity(req.FeeRate)
-	if err != nil {
-		return nil, err
+	if len(batchKey) != 0 {
+		key, err := btcec.ParsePubKey(batchKey)
+		if err != nil {
+			return target, fmt.Errorf("invalid batch key: %w", err)
+		}
+
+		target.Key = key
 	}
-	feeRateOpt := fn.MaybeSome(feeRate)
 
-	batchTapscriptTree := req.GetFullTree()
-	batchTapBranch := req.GetBranch()
+	return target, nil
+}
+
+// unmarshalBatchSibling parses the optional tapscript sibling of a minting
+// batch, which is either a full tapscript tree or a tapscript branch.
+func unmarshalBatchSibling(batchTapscriptTree *taprpc.TapscriptFullTree,
+	batchTapBranch *taprpc.TapBranch) (fn.Option[asset.TapscriptTreeNodes],
+	error) {
+
+	var (
+		batchSibling *asset.TapscriptTreeNodes
+		err          error
+	)
 
 	switch {
 	case batchTapscriptTree != nil && batchTapBranch != nil:
-		return nil, fmt.Errorf("cannot specify both tapscript tree " +
-			"and tapscript tree branches")
+		return fn.None[asset.TapscriptTreeNodes](),
+			fmt.Errorf("cannot specify both tapscript tree and " +
+				"tapscript tree branches")
 
 	case batchTapscriptTree != nil:
 		batchSibling, err = marshalTapscriptFullTree(batchTapscriptTree)
 		if err != nil {
-			return nil, fmt.Errorf("invalid tapscript tree: %w",
-				err)
+			return fn.None[asset.TapscriptTreeNodes](),
+				fmt.Errorf("invalid tapscript tree: %w", err)
 		}
 
 	case batchTapBranch != nil:
 		batchSibling, err = marshalTapscriptBranch(batchTapBranch)
 		if err != nil {
-			return nil, fmt.Errorf("invalid tapscript branch: %w",
-				err)
+			return fn.None[asset.TapscriptTreeNodes](),
+				fmt.Errorf("invalid tapscript branch: %w", err)
 		}
 	}
-	tapTreeOpt := fn.MaybeSome(batchSibling)
 
-	batch, err := r.cfg.AssetMinter.FinalizeBatch(
-		tapgarden.FinalizeParams{
-			FeeRate:        feeRateOpt,
-			SiblingTapTree: tapTreeOpt,
-		},
+	return fn.MaybeSome(batchSibling), nil
+}
+
+// FundBatch attempts to fund the target pending batch, or creates a new funded
+// batch.
+func (r *rpcServer) FundBatch(_ context.Context,
+	req *mintrpc.FundBatchRequest) (*mintrpc.FundBatchResponse, error) {
+
+	feeRate, err := checkFeeRateSanity(req.FeeRate)
+	if err != nil {
+		return nil, err
+	}
+	feeRateOpt := fn.MaybeSome(feeRate)
+
+	tapTreeOpt, err := unmarshalBatchSibling(
+		req.GetFullTree(), req.GetBranch(),
 	)
 	if err != nil {
-		return nil, fmt.Errorf("unable to finalize batch: %w", err)
+		return nil, err
 	}
 
-	// If there was no batch to finalize, return an empty response.
-	if batch == nil {
-		return &mintrpc.FinalizeBatchResponse{}, nil
+	var templateOpt fn.Option[psbt.Packet]
+	if len(req.PsbtTemplate) != 0 {
+		template, err := psbt.NewFromRawBytes(
+			bytes.NewReader(req.PsbtTemplate), false,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode psbt "+
+				"template: %w", err)
+		}
+
+		templateOpt = fn.Some(*template)
 	}
 
-	rpcBatch, err := marshalMintingBatch(batch, req.ShortResponse)
+	batchTarget, err := unmarshalBatchTarget(req.BatchName, req.BatchKey)
 	if err != nil {
 		return nil, err
 	}
 
-	return &mintrpc.FinalizeBatchResponse{
-		Batch: rpcBatch,
-	}, nil
-}
-
-// CancelBatch attempts to cancel the current pending batch.
-func (r *rpcServer) CancelBatch(_ context.Context,
-	_ *mintrpc.CancelBatchRequest) (*mintrpc.CancelBatchResponse,
-	error) {
-
-	batchKey, err := r.cfg.AssetMinter.CancelBatch()
+	batch, err := r.cfg.AssetMinter.FundBatch(tapgarden.FundParams{
+		Batch:           batchTarget,
+		FeeRate:         feeRateOpt,
+		SiblingTapTree:  tapTreeOpt,
+		GenesisTemplate: templateOpt,
+	})
 	if err != nil {
-		return nil, fmt.Errorf("unable to cancel batch: %w", err)
+		return nil, fmt.Errorf("unable to fund batch: %w", err)
 	}
 
-	// If there was no batch to cancel, return an empty response.
-	if batchKey == nil {
-		return &mintrpc.CancelBatchResponse{}, nil
+	rpcBatch, err := marshalMintingBatch(batch, req.ShortResponse)
+	if err != nil {
+		return nil, err
 	}
 
-	return &mintrpc.CancelBatchResponse{
-		BatchKey: batchKey.SerializeCompressed(),
+	return &mintrpc.FundBatchResponse{
+		Batch: rpcBatch,
 	}, nil
 }
 
-// ListBatches lists the set of batches submitted for minting, including pending
-// and cancelled batches.
-func (r *rpcServer) ListBatches(_ context.Context,
-	req *mintrpc.ListBatchRequest) (*mintrpc.ListBatchResponse, error) {
+// SealBatch attempts to seal the target pending batch, using the provided
+// asset group witnesses where available.
+func (r *rpcServer) SealBatch(_ context.Context,
+	req *mintrpc.SealBatchRequest) (*mintrpc.SealBatchResponse, error) {
 
-	var (
-		batchKey *btcec.PublicKey
-		err      error
+	groupWitnesses := make(
+		[]tapgarden.GroupSeal, 0, len(req.GroupWitnesses),
 	)
-
-	switch {
-	case len(req.GetBatchKey()) > 0 && len(req.GetBatchKeyStr()) > 0:
-		return nil, fmt.Errorf("cannot specify both batch_key and " +
-			"batch_key_string")
-
-	case len(req.GetBatchKey()) > 0:
-		batchKey, err = btcec.ParsePubKey(req.GetBatchKey())
-		if err != nil {
-			return nil, fmt.Errorf("invalid batch key: %w", err)
+	for _, rpcWitness := range req.GroupWitnesses {
+		if len(rpcWitness.AssetId) != sha256.Size {
+			return nil, fmt.Errorf("asset ID must be 32 bytes")
 		}
 
-	case len(req.GetBatchKeyStr()) > 0:
-		batchKeyBytes, err := hex.DecodeString(req.GetBatchKeyStr())
-		if err != nil {
-			return nil, fmt.Errorf("invalid batch key string: %w",
-				err)
+		if len(rpcWitness.Witness) == 0 {
+			return nil, fmt.Errorf("group witness cannot be empty")
 		}
 
-		batchKey, err = btcec.ParsePubKey(batchKeyBytes)
-		if err != nil {
-			return nil, fmt.Errorf("invalid batch key: %w", err)
-		}
+		var assetID asset.ID
+		copy(assetID[:], rpcWitness.AssetId)
+
+		groupWitnesses = append(groupWitnesses, tapgarden.GroupSeal{
+			GroupMember:  assetID,
+			GroupWitness: rpcWitness.Witness,
+		})
 	}
 
-	batches, err := r.cfg.AssetMinter.ListBatches(batchKey)
+	batchTarget, err := unmarshalBatchTarget(req.BatchName, req.BatchKey)
 	if err != nil {
-		return nil, fmt.Errorf("unable to list batches: %w", err)
+		return nil, err
 	}
 
-	rpcBatches, err := fn.MapErr(
-		batches,
-		func(b *tapgarden.MintingBatch) (*mintrpc.MintingBatch, error) {
-			return marshalMintingBatch(b, false)
-		},
-	)
+	batch, err := r.cfg.AssetMinter.SealBatch(tapgarden.SealParams{
+		Batch:          batchTarget,
+		GroupWitnesses: groupWitnesses,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to seal batch: %w", err)
+	}
+
+	rpcBatch, err := marshalMintingBatch(batch, req.ShortResponse)
 	if err != nil {
 		return nil, err
 	}
 
-	return &mintrpc.ListBatchResponse{
-		Batches: rpcBatches,
+	return &mintrpc.SealBatchResponse{
+		Batch: rpcBatch,
 	}, nil
 }
 
-// checkBalanceOverflow ensures that the new asset amount will not overflow
-// the max allowed asset (or asset group) balance.
-func (r *rpcServer) checkBalanceOverflow(ctx context.Context,
-	assetID *asset.ID, groupPubKey *btcec.PublicKey,
-	newAmount uint64) error {
+// FinalizeBatch attempts to finalize the target pending batch.
+func (r *rpcServer) FinalizeBatch(_ context.Context,
+	req *mintrpc.FinalizeBatchRequest) (*mintrpc.FinalizeBatchResponse,
+	error) {
 
-	if assetID != nil && groupPubKey != nil {
-		return fmt.Errorf("asset ID and group public key cannot both " +
-			"be set")
+	feeRate, err := checkFeeRateSanity(req.FeeRate)
+	if err != nil {
+		return nil, err
 	}
+	feeRateOpt := fn.MaybeSome(feeRate)
 
-	if assetID == nil && groupPubKey == nil {
-		return fmt.Errorf("asset ID and group public key cannot both " +
-			"be nil")
+	tapTreeOpt, err := unmarshalBatchSibling(
+		req.GetFullTree(), req.GetBranch(),
+	)
+	if err != nil {
+		return nil, err
 	}
 
-	var balance uint64
+	batchTarget, err := unmarshalBatchTarget(req.BatchName, req.BatchKey)
+	if err != nil {
+		return nil, err
+	}
 
-	switch {
-	case assetID != nil:
-		// Retrieve the current asset balance.
-		balances, err := r.cfg.AssetStore.QueryBalancesByAsset(
-			ctx, assetID,
+	var signedPktOpt fn.Option[psbt.Packet]
+	if len(req.SignedBatchPsbt) != 0 {
+		signedPkt, err := psbt.NewFromRawBytes(
+			bytes.NewReader(req.SignedBatchPsbt), false,
 		)
 		if err != nil {
-			return fmt.Errorf("unable to query asset balance: %w",
+			return nil, fmt.Errorf("unable to decode signed batch "+
+				"psbt: %w", err)
+		}
+
+		signedPktOpt = fn.Some(*signedPkt)
+	}
+
+	batch, err := r.cfg.AssetMinter.FinalizeBatch(
+		tapgarden.FinalizeParams{
+			Batch:               batchTarget,
+			FeeRate:             feeRateOpt,
+			SiblingTapTree:      tapTreeOpt,
+			SignedGenesisPacket: signedPktOpt,
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to finalize batch: %w", err)
+	}
+
+	// If there was no batch to finalize, return an empty response.
+	if batch == nil {
+		return &mintrpc.FinalizeBatchResponse{}, nil
+	}
+
+	rpcBatch, err := marshalMintingBatch(batch, req.ShortResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return &mintrpc.FinalizeBatchResponse{
+		Batch: rpcBatch,
+	}, nil
+}
+
+// CancelBatch attempts to cancel the target batch.
+func (r *rpcServer) CancelBatch(_ context.Context,
+	req *mintrpc.CancelBatchRequest) (*mintrpc.CancelBatchResponse,
+	error) {
+
+	batchTarget, err := unmarshalBatchTarget(req.BatchName, req.BatchKey)
+	if err != nil {
+		return nil, err
+	}
+
+	batchKey, err := r.cfg.AssetMinter.CancelBatch(batchTarget)
+	if err != nil {
+		return nil, fmt.Errorf("unable to cancel batch: %w", err)
+	}
+
+	// If there was no batch to cancel, return an empty response.
+	if batchKey == nil {
+		return &mintrpc.CancelBatchResponse{}, nil
+	}
+
+	return &mintrpc.CancelBatchResponse{
+		BatchKey: batchKey.SerializeCompressed(),
+	}, nil
+}
+
+// RemoveSeedling attempts to remove a single asset from a pending minting
+// batch that isn't sealed yet.
+func (r *rpcServer) RemoveSeedling(_ context.Context,
+	req *mintrpc.RemoveSeedlingRequest) (*mintrpc.RemoveSeedlingResponse,
+	error) {
+
+	batchTarget, err := unmarshalBatchTarget(req.BatchName, req.BatchKey)
+	if err != nil {
+		return nil, err
+	}
+
+	batch, err := r.cfg.AssetMinter.RemoveSeedling(
+		tapgarden.RemoveSeedlingParams{
+			Batch:     batchTarget,
+			AssetName: req.AssetName,
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to remove seedling: %w", err)
+	}
+
+	rpcBatch, err := marshalMintingBatch(batch, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return &mintrpc.RemoveSeedlingResponse{
+		Batch: rpcBatch,
+	}, nil
+}
+
+// UpdateSeedling attempts to change the amount, metadata or group settings of
+// a single asset in a pending minting batch that isn't sealed yet.
+func (r *rpcServer) UpdateSeedling(ctx context.Context,
+	req *mintrpc.UpdateSeedlingRequest) (*mintrpc.UpdateSeedlingResponse,
+	error) {
+
+	batchTarget, err := unmarshalBatchTarget(req.BatchName, req.BatchKey)
+	if err != nil {
+		return nil, err
+	}
+
+	params := tapgarden.UpdateSeedlingParams{
+		Batch:     batchTarget,
+		AssetName: req.AssetName,
+	}
+
+	if req.Amount != 0 {
+		params.Amount = fn.Some(req.Amount)
+	}
+
+	if req.AssetMeta != nil {
+		meta, err := unmarshalSeedlingMeta(req.AssetMeta)
+		if err != nil {
+			return nil, err
+		}
+
+		params.Meta = fn.Some(meta)
+	}
+
+	if req.UpdateGroup {
+		group, err := unmarshalSeedlingGroup(
+			req.NewGroupedAsset, req.GroupedAsset, req.GroupKey,
+			req.GroupAnchor,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		// Minting the asset into an existing group must not overflow
+		// the balance of the group. If the amount isn't changed, we
+		// check the current amount of the asset instead.
+		if group.GroupInfo != nil {
+			amount := req.Amount
+			if amount == 0 {
+				amount, err = r.pendingSeedlingAmount(
+					batchTarget, req.AssetName,
+				)
+				if err != nil {
+					return nil, err
+				}
+			}
+
+			err = r.checkBalanceOverflow(
+				ctx, nil, &group.GroupInfo.GroupPubKey, amount,
+			)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		params.Group = fn.Some(group)
+	}
+
+	batch, err := r.cfg.AssetMinter.UpdateSeedling(params)
+	if err != nil {
+		return nil, fmt.Errorf("unable to update seedling: %w", err)
+	}
+
+	rpcBatch, err := marshalMintingBatch(batch, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return &mintrpc.UpdateSeedlingResponse{
+		Batch: rpcBatch,
+	}, nil
+}
+
+// pendingSeedlingAmount returns the amount of the asset with the given name in
+// the pending minting batch selected by the target.
+func (r *rpcServer) pendingSeedlingAmount(target tapgarden.BatchTarget,
+	assetName string) (uint64, error) {
+
+	batches, err := r.cfg.AssetMinter.ListBatches(target.Key)
+	if err != nil {
+		return 0, fmt.Errorf("unable to list batches: %w", err)
+	}
+
+	for _, batch := range batches {
+		if batch.State() != tapgarden.BatchStatePending {
+			continue
+		}
+
+		if target.Key == nil && batch.Name != target.Name {
+			continue
+		}
+
+		seedling, ok := batch.Seedlings[assetName]
+		if !ok {
+			break
+		}
+
+		return seedling.Amount, nil
+	}
+
+	return 0, fmt.Errorf("asset with name %v not in pending batch",
+		assetName)
+}
+
+// BumpBatchFee attempts to replace the minting transaction of a broadcast but
+// unconfirmed batch with one that pays a higher fee rate.
+func (r *rpcServer) BumpBatchFee(_ context.Context,
+	req *mintrpc.BumpBatchFeeRequest) (*mintrpc.BumpBatchFeeResponse,
+	error) {
+
+	batchKey, err := btcec.ParsePubKey(req.BatchKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid batch key: %w", err)
+	}
+
+	feeRate, err := checkFeeRateSanity(req.FeeRate)
+	if err != nil {
+		return nil, err
+	}
+	if feeRate == nil {
+		return nil, fmt.Errorf("fee rate must be specified")
+	}
+
+	batch, err := r.cfg.AssetMinter.BumpBatchFee(tapgarden.BumpFeeParams{
+		BatchKey: batchKey,
+		FeeRate:  *feeRate,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to bump batch fee: %w", err)
+	}
+
+	rpcBatch, err := marshalMintingBatch(batch, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return &mintrpc.BumpBatchFeeResponse{
+		Batch: rpcBatch,
+	}, nil
+}
+
+// ListGroupVirtualTxs lists the group virtual transactions of the grouped
+// assets in the funded target pending batch.
+func (r *rpcServer) ListGroupVirtualTxs(_ context.Context,
+	req *mintrpc.ListGroupVirtualTxsRequest) (
+	*mintrpc.ListGroupVirtualTxsResponse, error) {
+
+	batchTarget, err := unmarshalBatchTarget(req.BatchName, req.BatchKey)
+	if err != nil {
+		return nil, err
+	}
+
+	unsealedSeedlings, err := r.cfg.AssetMinter.ListGroupVirtualTxs(
+		batchTarget,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list group virtual txs: %w",
+			err)
+	}
+
+	rpcGroupTxs := make(
+		[]*mintrpc.GroupVirtualTx, 0, len(unsealedSeedlings),
+	)
+	for _, unsealed := range unsealedSeedlings {
+		rpcGroupTx, err := marshalGroupVirtualTx(unsealed)
+		if err != nil {
+			return nil, err
+		}
+
+		rpcGroupTxs = append(rpcGroupTxs, rpcGroupTx)
+	}
+
+	return &mintrpc.ListGroupVirtualTxsResponse{
+		GroupVirtualTxs: rpcGroupTxs,
+	}, nil
+}
+
+// marshalGroupVirtualTx converts an unsealed seedling into the RPC
+// representation of its group virtual transaction.
+func marshalGroupVirtualTx(
+	unsealed *tapgarden.UnsealedSeedling) (*mintrpc.GroupVirtualTx, error) {
+
+	var txBuf bytes.Buffer
+	err := unsealed.GroupVirtualTx.Tx.Serialize(&txBuf)
+	if err != nil {
+		return nil, fmt.Errorf("unable to serialize group virtual tx: "+
+			"%w", err)
+	}
+
+	assetID := unsealed.GroupReq.NewAsset.ID()
+	genID := unsealed.GroupVirtualTx.GenID
+	tweakedKey := unsealed.GroupVirtualTx.TweakedKey
+	prevOut := unsealed.GroupVirtualTx.PrevOut
+
+	return &mintrpc.GroupVirtualTx{
+		AssetName:       unsealed.AssetName,
+		AssetId:         assetID[:],
+		RawKey:          marshalKeyDescriptor(unsealed.GroupReq.RawKey),
+		SingleTweak:     genID[:],
+		TapscriptRoot:   unsealed.GroupReq.TapscriptRoot,
+		TweakedKey:      tweakedKey.SerializeCompressed(),
+		Transaction:     txBuf.Bytes(),
+		PrevOutValue:    prevOut.Value,
+		PrevOutPkScript: prevOut.PkScript,
+	}, nil
+}
+
+// ListBatches lists the set of batches submitted for minting, including pending
+// and cancelled batches.
+func (r *rpcServer) ListBatches(_ context.Context,
+	req *mintrpc.ListBatchRequest) (*mintrpc.ListBatchResponse, error) {
+
+	var (
+		batchKey *btcec.PublicKey
+		err      error
+	)
+
+	switch {
+	case len(req.GetBatchKey()) > 0 && len(req.GetBatchKeyStr()) > 0:
+		return nil, fmt.Errorf("cannot specify both batch_key and " +
+			"batch_key_string")
+
+	case len(req.GetBatchKey()) > 0:
+		batchKey, err = btcec.ParsePubKey(req.GetBatchKey())
+		if err != nil {
+			return nil, fmt.Errorf("invalid batch key: %w", err)
+		}
+
+	case len(req.GetBatchKeyStr()) > 0:
+		batchKeyBytes, err := hex.DecodeString(req.GetBatchKeyStr())
+		if err != nil {
+			return nil, fmt.Errorf("invalid batch key string: %w",
+				err)
+		}
+
+		batchKey, err = btcec.ParsePubKey(batchKeyBytes)
+		if err != nil {
+			return nil, fmt.Errorf("invalid batch key: %w", err)
+		}
+	}
+
+	batches, err := r.cfg.AssetMinter.ListBatches(batchKey)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list batches: %w", err)
+	}
+
+	rpcBatches, err := fn.MapErr(
+		batches,
+		func(b *tapgarden.MintingBatch) (*mintrpc.MintingBatch, error) {
+			return marshalMintingBatch(b, false)
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &mintrpc.ListBatchResponse{
+		Batches: rpcBatches,
+	}, nil
+}
+
+// checkBalanceOverflow ensures that the new asset amount will not overflow
+// the max allowed asset (or asset group) balance.
+func (r *rpcServer) checkBalanceOverflow(ctx context.Context,
+	assetID *asset.ID, groupPubKey *btcec.PublicKey,
+	newAmount uint64) error {
+
+	if assetID != nil && groupPubKey != nil {
+		return fmt.Errorf("asset ID and group public key cannot both " +
+			"be set")
+	}
+
+	if assetID == nil && groupPubKey == nil {
+		return fmt.Errorf("asset ID and group public key cannot both " +
+			"be nil")
+	}
+
+	var balance uint64
+
+	switch {
+	case assetID != nil:
+		// Retrieve the current asset balance.
+		balances, err := r.cfg.AssetStore.QueryBalancesByAsset(
+			ctx, assetID,
+		)
+		if err != nil {
+			return fmt.Errorf("unable to query asset balance: %w",
 				err)
 		}
 
@@ -3722,6 +4189,7 @@ func marshalMintingBatch(batch *tapgarden.MintingBatch,
 
 	rpcBatch := &mintrpc.MintingBatch{
 		BatchKey:   batch.BatchKey.PubKey.SerializeCompressed(),
+		BatchName:  batch.Name,
 		State:      rpcBatchState,
 		CreatedAt:  batch.CreationTime.UTC().Unix(),
 		HeightHint: batch.HeightHint,
@@ -4674,6 +5142,105 @@ func (r *rpcServer) AssetLeafKeys(ctx context.Context,
 	return resp, nil
 }
 
+// marshalTreeNodePath marshals a universe tree node path into its RPC
+// counterpart.
+func marshalTreeNodePath(path universe.TreeNodePath) *unirpc.TreeNodePath {
+	return &unirpc.TreeNodePath{
+		Depth:  uint32(path.Depth),
+		Prefix: fn.ByteSlice(path.Prefix),
+	}
+}
+
+// unmarshalTreeNodePath parses a universe tree node path from its RPC
+// counterpart.
+func unmarshalTreeNodePath(
+	rpcPath *unirpc.TreeNodePath) (universe.TreeNodePath, error) {
+
+	var path universe.TreeNodePath
+
+	switch {
+	case rpcPath == nil:
+		return path, fmt.Errorf("missing tree node path")
+
+	case rpcPath.Depth > mssmt.MaxTreeLevels:
+		return path, fmt.Errorf("invalid tree node depth: %d",
+			rpcPath.Depth)
+
+	case len(rpcPath.Prefix) != len(path.Prefix):
+		return path, fmt.Errorf("invalid tree node prefix length: %d",
+			len(rpcPath.Prefix))
+	}
+
+	path.Depth = uint16(rpcPath.Depth)
+	copy(path.Prefix[:], rpcPath.Prefix)
+
+	return path, nil
+}
+
+// AssetTreeNodes queries for a set of nodes within the MS-SMT of the Universe
+// identified by the given asset_id or group_key.
+func (r *rpcServer) AssetTreeNodes(ctx context.Context,
+	req *unirpc.AssetTreeNodesRequest) (*unirpc.AssetTreeNodesResponse,
+	error) {
+
+	if req == nil {
+		return nil, fmt.Errorf("request must be set")
+	}
+
+	universeID, err := UnmarshalUniID(req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if universeID.ProofType == universe.ProofTypeUnspecified {
+		return nil, fmt.Errorf("proof type must be specified")
+	}
+
+	if len(req.Paths) > universe.MaxPageSize {
+		return nil, fmt.Errorf("too many tree node paths: %d, max %d",
+			len(req.Paths), universe.MaxPageSize)
+	}
+
+	paths := make([]universe.TreeNodePath, len(req.Paths))
+	for i, rpcPath := range req.Paths {
+		paths[i], err = unmarshalTreeNodePath(rpcPath)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Check the rate limiter to see if we need to wait at all. If not then
+	// this'll be a noop.
+	if err = r.proofQueryRateLimiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+
+	treeNodes, err := r.cfg.UniverseArchive.UniverseTreeNodes(
+		ctx, universeID, paths,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &unirpc.AssetTreeNodesResponse{
+		Nodes: make([]*unirpc.TreeNode, len(treeNodes)),
+	}
+	for i, treeNode := range treeNodes {
+		rpcNode := &unirpc.TreeNode{
+			Path: marshalTreeNodePath(treeNode.Path),
+			Node: marshalMssmtNode(treeNode.Node),
+		}
+
+		if treeNode.LeafKey != nil {
+			rpcNode.LeafKey = marshalLeafKey(*treeNode.LeafKey)
+		}
+
+		resp.Nodes[i] = rpcNode
+	}
+
+	return resp, nil
+}
+
 func marshalAssetLeaf(ctx context.Context, keys taprpc.KeyLookup,
 	assetLeaf *universe.Leaf) (*unirpc.AssetLeaf, error) {
 
@@ -4710,30 +5277,230 @@ func (r *rpcServer) AssetLeaves(ctx context.Context,
 		return nil, err
 	}
 
-	// Check the rate limiter to see if we need to wait at all. If not then
-	// this'll be a noop.
-	if err = r.proofQueryRateLimiter.Wait(ctx); err != nil {
-		return nil, err
+	// Check the rate limiter to see if we need to wait at all. If not then
+	// this'll be a noop.
+	if err = r.proofQueryRateLimiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+
+	assetLeaves, err := r.cfg.UniverseArchive.MintingLeaves(ctx, universeID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &unirpc.AssetLeafResponse{
+		Leaves: make([]*unirpc.AssetLeaf, len(assetLeaves)),
+	}
+	for i, assetLeaf := range assetLeaves {
+		assetLeaf := assetLeaf
+
+		resp.Leaves[i], err = r.marshalAssetLeaf(ctx, &assetLeaf)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return resp, nil
+}
+
+// unmarshalLeafEventQuery parses the filters of a leaf subscription request.
+func unmarshalLeafEventQuery(
+	req *unirpc.SubscribeLeavesRequest) (universe.LeafEventQuery, error) {
+
+	var query universe.LeafEventQuery
+
+	assetIDBytes := req.AssetId
+	if req.AssetIdStr != "" {
+		var err error
+		assetIDBytes, err = hex.DecodeString(req.AssetIdStr)
+		if err != nil {
+			return query, fmt.Errorf("invalid asset ID: %w", err)
+		}
+	}
+	if len(assetIDBytes) > 0 {
+		if len(assetIDBytes) != sha256.Size {
+			return query, fmt.Errorf("asset ID must be 32 bytes")
+		}
+
+		var assetID asset.ID
+		copy(assetID[:], assetIDBytes)
+		query.AssetID = &assetID
+	}
+
+	groupKeyBytes := req.GroupKey
+	if req.GroupKeyStr != "" {
+		var err error
+		groupKeyBytes, err = hex.DecodeString(req.GroupKeyStr)
+		if err != nil {
+			return query, fmt.Errorf("invalid group key: %w", err)
+		}
+	}
+	if len(groupKeyBytes) > 0 {
+		groupKey, err := parseUserKey(groupKeyBytes)
+		if err != nil {
+			return query, fmt.Errorf("invalid group key: %w", err)
+		}
+		query.GroupKey = groupKey
+	}
+
+	proofType, err := UnmarshalUniProofType(req.ProofType)
+	if err != nil {
+		return query, err
+	}
+	query.ProofType = proofType
+
+	// The cursor only applies to the existing leaves. Without those, the
+	// subscriber only receives the leaves inserted from now on.
+	if req.DeliverExisting {
+		query.AfterCursor = req.AfterCursor
+	}
+
+	return query, nil
+}
+
+// marshalLeafEvent marshals a universe leaf event into the RPC form.
+func (r *rpcServer) marshalLeafEvent(ctx context.Context,
+	event *universe.LeafEvent) (*unirpc.UniverseLeafEvent, error) {
+
+	uniID, err := MarshalUniID(event.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	leaf, err := r.marshalAssetLeaf(ctx, event.Leaf)
+	if err != nil {
+		return nil, err
+	}
+
+	return &unirpc.UniverseLeafEvent{
+		Cursor:  event.Cursor,
+		Id:      uniID,
+		LeafKey: marshalLeafKey(event.Key),
+		Leaf:    leaf,
+	}, nil
+}
+
+// SubscribeLeaves allows a caller to subscribe to the leaves that are inserted
+// into the Universe, optionally filtered by asset ID, group key or proof type.
+func (r *rpcServer) SubscribeLeaves(req *unirpc.SubscribeLeavesRequest,
+	stream unirpc.Universe_SubscribeLeavesServer) error {
+
+	query, err := unmarshalLeafEventQuery(req)
+	if err != nil {
+		return err
+	}
+	query.Limit = universe.MaxPageSize
+
+	// We register the subscriber before delivering any existing leaves, so
+	// we don't miss any leaves that are inserted in the meantime.
+	eventSubscriber := fn.NewEventReceiver[*universe.LeafEvent](
+		fn.DefaultQueueSize,
+	)
+	defer eventSubscriber.Stop()
+
+	err = r.cfg.UniverseArchive.RegisterSubscriber(
+		eventSubscriber, false, query,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to register leaf event "+
+			"subscription: %w", err)
+	}
+	defer func() {
+		err := r.cfg.UniverseArchive.RemoveSubscriber(eventSubscriber)
+		if err != nil {
+			rpcsLog.Errorf("Error unsubscribing subscriber: %v",
+				err)
+		}
+	}()
+
+	// Leaf events are delivered to us in the order in which the inserts
+	// completed, which isn't necessarily the order of their cursors. So
+	// instead of forwarding the events directly, we use them as a signal
+	// to send all new leaves after our cursor from the database.
+	ctx := stream.Context()
+	sendNewLeaves := func() error {
+		for {
+			err := r.proofQueryRateLimiter.Wait(ctx)
+			if err != nil {
+				return err
+			}
+
+			events, err := r.cfg.UniverseArchive.FetchLeafEvents(
+				ctx, query,
+			)
+			if err != nil {
+				return err
+			}
+
+			for _, event := range events {
+				rpcEvent, err := r.marshalLeafEvent(ctx, event)
+				if err != nil {
+					return err
+				}
+
+				if err := stream.Send(rpcEvent); err != nil {
+					return err
+				}
+
+				query.AfterCursor = event.Cursor
+			}
+
+			if len(events) < universe.MaxPageSize {
+				return nil
+			}
+		}
+	}
+
+	if req.DeliverExisting {
+		if err := sendNewLeaves(); err != nil {
+			return err
+		}
+	} else {
+		// Without any existing leaves requested, we only start after
+		// the most recently inserted leaf.
+		latest, err := r.cfg.UniverseArchive.LatestLeafCursor(ctx)
+		if err != nil {
+			return err
+		}
+		query.AfterCursor = latest
 	}
 
-	assetLeaves, err := r.cfg.UniverseArchive.MintingLeaves(ctx, universeID)
-	if err != nil {
-		return nil, err
-	}
+	for {
+		select {
+		case <-eventSubscriber.NewItemCreated.ChanOut():
+			// A batch insert results in one event per leaf, but a
+			// single round of queries picks up all of them.
+			drainEvents(eventSubscriber)
 
-	resp := &unirpc.AssetLeafResponse{
-		Leaves: make([]*unirpc.AssetLeaf, len(assetLeaves)),
-	}
-	for i, assetLeaf := range assetLeaves {
-		assetLeaf := assetLeaf
+			if err := sendNewLeaves(); err != nil {
+				return err
+			}
 
-		resp.Leaves[i], err = r.marshalAssetLeaf(ctx, &assetLeaf)
-		if err != nil {
-			return nil, err
+		case <-ctx.Done():
+			// Don't return an error if a normal context
+			// cancellation has occurred.
+			if errors.Is(ctx.Err(), context.Canceled) {
+				return nil
+			}
+
+			return ctx.Err()
+
+		case <-r.quit:
+			return nil
 		}
 	}
+}
 
-	return resp, nil
+// drainEvents removes all events that are currently queued for the given
+// receiver without blocking.
+func drainEvents[T any](receiver *fn.EventReceiver[T]) {
+	for {
+		select {
+		case <-receiver.NewItemCreated.ChanOut():
+		default:
+			return
+		}
+	}
 }
 
 // unmarshalLeafKey un-marshals a leaf key from the RPC form.
@@ -4969,6 +5736,230 @@ func (r *rpcServer) QueryProof(ctx context.Context,
 	return r.marshalUniverseProofLeaf(ctx, req, firstProof)
 }
 
+// QueryProofs attempts to query for the issuance or transfer proofs of a batch
+// of leaves within a single Universe. All leaves are proven against the same
+// Universe root with a single MS-SMT multi-proof. Leaf keys that aren't
+// included in the Universe are returned without an asset leaf, and their
+// non-inclusion is proven by the multi-proof.
+func (r *rpcServer) QueryProofs(ctx context.Context,
+	req *unirpc.QueryProofsRequest) (*unirpc.QueryProofsResponse, error) {
+
+	universeID, err := UnmarshalUniID(req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	// A multi-proof is always for a single Universe tree, so we need to
+	// know which one.
+	if universeID.ProofType == universe.ProofTypeUnspecified {
+		return nil, fmt.Errorf("proof type must be specified")
+	}
+
+	if len(req.LeafKeys) == 0 {
+		return nil, fmt.Errorf("at least one leaf key must be specified")
+	}
+
+	leafKeys, err := fn.MapErr(req.LeafKeys, unmarshalLeafKey)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse leaf keys: %w", err)
+	}
+
+	rpcsLog.Tracef("[QueryProofs]: fetching %d proofs at (universeID=%v)",
+		len(leafKeys), universeID.StringForLog())
+
+	// Make sure proof export is enabled for the given universe.
+	syncConfigs, err := r.cfg.UniverseFederation.QuerySyncConfigs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !syncConfigs.IsSyncExportEnabled(universeID) {
+		return nil, fmt.Errorf("proof export is disabled for the " +
+			"given universe")
+	}
+
+	// Each queried leaf counts against the rate limit, but we can't wait
+	// for more than the burst budget at once.
+	numTokens := min(len(leafKeys), r.proofQueryRateLimiter.Burst())
+	if err = r.proofQueryRateLimiter.WaitN(ctx, numTokens); err != nil {
+		return nil, err
+	}
+
+	multiProof, err := r.cfg.UniverseArchive.FetchProofLeaves(
+		ctx, universeID, leafKeys,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	uniRoot, err := marshalUniverseRoot(universe.Root{
+		ID:   universeID,
+		Node: multiProof.UniverseRoot,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	rpcLeaves := make([]*unirpc.MultiProofLeaf, len(multiProof.LeafKeys))
+	for i, leafKey := range multiProof.LeafKeys {
+		rpcLeaves[i] = &unirpc.MultiProofLeaf{
+			LeafKey: marshalLeafKey(leafKey),
+		}
+
+		leaf := multiProof.Leaves[i]
+		if leaf == nil {
+			continue
+		}
+
+		rpcLeaves[i].AssetLeaf, err = r.marshalAssetLeaf(ctx, leaf)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var uniProof bytes.Buffer
+	err = multiProof.UniverseInclusionProof.Encode(&uniProof)
+	if err != nil {
+		return nil, err
+	}
+
+	multiverseRoot := marshalMssmtNode(multiProof.MultiverseRoot)
+	multiverseProof, err := marshalMssmtProof(
+		multiProof.MultiverseInclusionProof,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &unirpc.QueryProofsResponse{
+		UniverseRoot:             uniRoot,
+		Leaves:                   rpcLeaves,
+		UniverseMultiProof:       uniProof.Bytes(),
+		MultiverseRoot:           multiverseRoot,
+		MultiverseInclusionProof: multiverseProof,
+	}, nil
+}
+
+// marshalUniverseCommitment marshals an on-chain universe commitment into the
+// RPC form.
+func marshalUniverseCommitment(
+	c *universe.Commitment) (*unirpc.UniverseCommitment, error) {
+
+	var headerBuf, proofBuf, txBuf bytes.Buffer
+	if err := c.BlockHeader.Serialize(&headerBuf); err != nil {
+		return nil, err
+	}
+	if err := c.MerkleProof.Encode(&proofBuf); err != nil {
+		return nil, err
+	}
+	if err := c.Tx.Serialize(&txBuf); err != nil {
+		return nil, err
+	}
+
+	return &unirpc.UniverseCommitment{
+		BlockHeight:    c.BlockHeight,
+		BlockHeader:    headerBuf.Bytes(),
+		MerkleProof:    proofBuf.Bytes(),
+		AnchorTx:       txBuf.Bytes(),
+		OutputIndex:    c.OutputIndex,
+		InternalKey:    c.InternalKey.PubKey.SerializeCompressed(),
+		MultiverseRoot: marshalMssmtNode(c.UniverseRoot),
+	}, nil
+}
+
+// QueryCommittedProof attempts to query for an issuance proof for a given
+// asset based on its UniverseKey, tied to the most recent on-chain commitment
+// of the issuance multiverse root that includes the current root of the
+// asset's universe.
+func (r *rpcServer) QueryCommittedProof(ctx context.Context,
+	req *unirpc.UniverseKey) (*unirpc.CommittedProofResponse, error) {
+
+	universeID, err := UnmarshalUniID(req.Id)
+	if err != nil {
+		return nil, err
+	}
+	leafKey, err := unmarshalLeafKey(req.LeafKey)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only the issuance multiverse root is committed to on chain.
+	switch universeID.ProofType {
+	case universe.ProofTypeUnspecified:
+		universeID.ProofType = universe.ProofTypeIssuance
+
+	case universe.ProofTypeTransfer:
+		return nil, fmt.Errorf("only issuance proofs are committed " +
+			"to on chain")
+	}
+
+	syncConfigs, err := r.cfg.UniverseFederation.QuerySyncConfigs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !syncConfigs.IsSyncExportEnabled(universeID) {
+		return nil, fmt.Errorf("proof export is disabled for the " +
+			"given universe")
+	}
+
+	if err = r.proofQueryRateLimiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+
+	committedProof, err := r.cfg.UniverseCanonical.Query(
+		ctx, universeID, leafKey,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	chainCommitment, err := marshalUniverseCommitment(
+		committedProof.ChainProof,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	uniProof, err := r.marshalUniverseProofLeaf(
+		ctx, req, committedProof.TaprootAssetProof,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &unirpc.CommittedProofResponse{
+		ChainCommitment: chainCommitment,
+		Proof:           uniProof,
+	}, nil
+}
+
+// ListCommitments lists the on-chain commitments of the issuance multiverse
+// root, most recent first.
+func (r *rpcServer) ListCommitments(ctx context.Context,
+	_ *unirpc.ListCommitmentsRequest) (*unirpc.ListCommitmentsResponse,
+	error) {
+
+	commitments, err := r.cfg.UniverseCanonical.ListCommitments(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcCommitments := make(
+		[]*unirpc.UniverseCommitment, 0, len(commitments),
+	)
+	for _, c := range commitments {
+		rpcCommitment, err := marshalUniverseCommitment(c)
+		if err != nil {
+			return nil, err
+		}
+
+		rpcCommitments = append(rpcCommitments, rpcCommitment)
+	}
+
+	return &unirpc.ListCommitmentsResponse{
+		Commitments: rpcCommitments,
+	}, nil
+}
+
 // unmarshalAssetLeaf unmarshals an asset leaf from the RPC form.
 func unmarshalAssetLeaf(leaf *unirpc.AssetLeaf) (*universe.Leaf, error) {
 	// We'll just pull the asset details from the serialized issuance proof
@@ -5058,10 +6049,26 @@ func (r *rpcServer) InsertProof(ctx context.Context,
 		"(universeID=%v, leafKey=%x)", universeID,
 		leafKey.UniverseKey())
 
+	// Proofs inserted over RPC are subject to our admission policy, which
+	// limits the insertion rate per client. Clients are identified by
+	// their IP address.
+	if clientPeer, ok := peer.FromContext(ctx); ok {
+		ctx = universe.WithAdmissionClient(
+			ctx, admissionClientID(clientPeer.Addr),
+		)
+	}
+
 	newUniverseState, err := r.cfg.UniverseArchive.UpsertProofLeaf(
 		ctx, universeID, leafKey, assetLeaf,
 	)
-	if err != nil {
+	switch {
+	case errors.Is(err, universe.ErrProofNotAdmitted):
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+
+	case errors.Is(err, universe.ErrInsertRateExceeded):
+		return nil, status.Error(codes.ResourceExhausted, err.Error())
+
+	case err != nil:
 		return nil, err
 	}
 
@@ -5072,6 +6079,148 @@ func (r *rpcServer) InsertProof(ctx context.Context,
 	return r.marshalUniverseProofLeaf(ctx, req.Key, newUniverseState)
 }
 
+// admissionClientID returns the ID of the remote client with the given
+// address for the admission policy, which is the IP address of the client.
+func admissionClientID(addr net.Addr) string {
+	host, _, err := net.SplitHostPort(addr.String())
+	if err != nil {
+		return addr.String()
+	}
+
+	return host
+}
+
+// snapshotStreamWriter is an io.Writer that sends everything written to it as
+// snapshot chunks over an ExportSnapshot stream.
+type snapshotStreamWriter struct {
+	stream unirpc.Universe_ExportSnapshotServer
+}
+
+// Write sends the given bytes as snapshot chunks. Large writes, like big
+// proofs that bypass any buffering, are split up to keep the messages below
+// the maximum message size of the client.
+func (w *snapshotStreamWriter) Write(p []byte) (int, error) {
+	var written int
+	for written < len(p) {
+		n := min(len(p)-written, snapshotChunkSize)
+		err := w.stream.Send(&unirpc.SnapshotChunk{
+			Data: p[written : written+n],
+		})
+		if err != nil {
+			return written, err
+		}
+
+		written += n
+	}
+
+	return written, nil
+}
+
+// ExportSnapshot exports a snapshot of the specified set of universes, or of
+// all known universes if none are specified.
+func (r *rpcServer) ExportSnapshot(req *unirpc.ExportSnapshotRequest,
+	stream unirpc.Universe_ExportSnapshotServer) error {
+
+	ids := make([]universe.Identifier, 0, len(req.Universes))
+	for _, rpcID := range req.Universes {
+		id, err := UnmarshalUniID(rpcID)
+		if err != nil {
+			return err
+		}
+
+		if id.ProofType == universe.ProofTypeUnspecified {
+			return fmt.Errorf("proof type must be specified for " +
+				"exported universes")
+		}
+
+		ids = append(ids, id)
+	}
+
+	// The snapshot writer does its own small buffering, so we make sure
+	// the chunks we send are reasonably sized.
+	chunkWriter := bufio.NewWriterSize(
+		&snapshotStreamWriter{stream: stream}, snapshotChunkSize,
+	)
+
+	universes, err := r.cfg.UniverseArchive.ExportSnapshot(
+		stream.Context(), chunkWriter, ids,
+	)
+	if err != nil {
+		return fmt.Errorf("unable to export snapshot: %w", err)
+	}
+
+	if err := chunkWriter.Flush(); err != nil {
+		return err
+	}
+
+	rpcsLog.Infof("[ExportSnapshot]: exported snapshot of %d universes",
+		len(universes))
+
+	return nil
+}
+
+// snapshotStreamReader is an io.Reader that reads the snapshot chunks received
+// over an ImportSnapshot stream.
+type snapshotStreamReader struct {
+	stream unirpc.Universe_ImportSnapshotServer
+	buf    []byte
+}
+
+// Read reads from the current snapshot chunk, receiving the next chunk once
+// the current one is exhausted.
+func (r *snapshotStreamReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		chunk, err := r.stream.Recv()
+		if err != nil {
+			return 0, err
+		}
+
+		r.buf = chunk.Data
+	}
+
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+
+	return n, nil
+}
+
+// ImportSnapshot imports a universe snapshot that was created by
+// ExportSnapshot. All proofs are verified before they're inserted.
+func (r *rpcServer) ImportSnapshot(
+	stream unirpc.Universe_ImportSnapshotServer) error {
+
+	results, err := r.cfg.UniverseArchive.ImportSnapshot(
+		stream.Context(), &snapshotStreamReader{stream: stream},
+		universe.DefaultSnapshotImportBatchSize,
+	)
+	if err != nil {
+		return fmt.Errorf("unable to import snapshot: %w", err)
+	}
+
+	resp := &unirpc.ImportSnapshotResponse{
+		Universes: make([]*unirpc.ImportedUniverse, 0, len(results)),
+	}
+	for _, result := range results {
+		rpcRoot, err := marshalUniverseRoot(universe.Root{
+			ID:   result.ID,
+			Node: result.Root,
+		})
+		if err != nil {
+			return err
+		}
+
+		resp.Universes = append(resp.Universes, &unirpc.ImportedUniverse{
+			Root:      rpcRoot,
+			NumLeaves: result.NumLeaves,
+		})
+	}
+
+	rpcsLog.Infof("[ImportSnapshot]: imported snapshot of %d universes",
+		len(results))
+
+	return stream.SendAndClose(resp)
+}
+
 // Info returns a set of information about the current state of the Universe.
 func (r *rpcServer) Info(ctx context.Context,
 	_ *unirpc.InfoRequest) (*unirpc.InfoResponse, error) {
@@ -5134,9 +6283,17 @@ func (r *rpcServer) marshalUniverseDiff(ctx context.Context,
 				"root: %w", err)
 		}
 
-		leaves := make([]*unirpc.AssetLeaf, len(diff.NewLeafProofs))
-		for i, leaf := range diff.NewLeafProofs {
-			leaves[i], err = r.marshalAssetLeaf(ctx, leaf)
+		leaves := make([]*unirpc.AssetLeaf, len(diff.NewLeafProofs))
+		for i, leaf := range diff.NewLeafProofs {
+			leaves[i], err = r.marshalAssetLeaf(ctx, leaf)
+			if err != nil {
+				return err
+			}
+		}
+
+		var divergence *unirpc.UniverseDivergence
+		if diff.Divergence != nil {
+			divergence, err = marshalDivergence(diff.Divergence)
 			if err != nil {
 				return err
 			}
@@ -5147,6 +6304,7 @@ func (r *rpcServer) marshalUniverseDiff(ctx context.Context,
 				OldAssetRoot:   oldUniRoot,
 				NewAssetRoot:   newUniRoot,
 				NewAssetLeaves: leaves,
+				Divergence:     divergence,
 			},
 		)
 		return nil
@@ -5178,7 +6336,10 @@ func (r *rpcServer) SyncUniverse(ctx context.Context,
 		return nil, fmt.Errorf("unable to parse sync targets: %w", err)
 	}
 
-	uniAddr := universe.NewServerAddrFromStr(req.UniverseHost)
+	uniAddr, err := universe.NewServerAddrFromStr(req.UniverseHost)
+	if err != nil {
+		return nil, err
+	}
 
 	// Obtain the general and universe specific federation sync configs.
 	queryFedSyncConfigs := r.cfg.FederationDB.QueryFederationSyncConfigs
@@ -5227,11 +6388,51 @@ func (r *rpcServer) ListFederationServers(ctx context.Context,
 		return nil, err
 	}
 
+	rpcServers := make(
+		[]*unirpc.UniverseFederationServer, 0, len(uniServers),
+	)
+	for _, server := range uniServers {
+		rpcServer := marshalUniverseServer(server)
+		rpcServer.Health = marshalServerHealth(
+			r.cfg.UniverseFederation.ServerHealth(server),
+		)
+
+		rpcServers = append(rpcServers, rpcServer)
+	}
+
 	return &unirpc.ListFederationServersResponse{
-		Servers: fn.Map(uniServers, marshalUniverseServer),
+		Servers: rpcServers,
 	}, nil
 }
 
+// marshalServerHealth marshals the health of a federation server into its RPC
+// counterpart.
+func marshalServerHealth(
+	health universe.ServerHealth) *unirpc.FederationServerHealth {
+
+	// A zero time would otherwise be marshaled as a large negative unix
+	// timestamp.
+	unixOrZero := func(t time.Time) int64 {
+		if t.IsZero() {
+			return 0
+		}
+
+		return t.Unix()
+	}
+
+	return &unirpc.FederationServerHealth{
+		LastSuccessTimestamp: unixOrZero(health.LastSuccess),
+		LastFailureTimestamp: unixOrZero(health.LastFailure),
+		LastError:            health.LastError,
+		ConsecutiveFailures:  health.ConsecutiveFailures,
+		AvgLatencyMs:         health.AvgLatency.Milliseconds(),
+		Quarantined:          health.Quarantined(time.Now()),
+		QuarantinedUntilTimestamp: unixOrZero(
+			health.QuarantinedUntil,
+		),
+	}
+}
+
 func unmarshalUniverseServer(
 	server *unirpc.UniverseFederationServer) universe.ServerAddr {
 
@@ -5250,11 +6451,19 @@ func (r *rpcServer) AddFederationServer(ctx context.Context,
 	for idx := range serversToAdd {
 		server := serversToAdd[idx]
 
+		// Make sure the server address is valid, which includes
+		// onion addresses being valid onion service addresses.
+		_, err := universe.NewServerAddrFromStr(server.HostStr())
+		if err != nil {
+			return nil, err
+		}
+
 		// Before we add the server as a federation member, we check
 		// that we can actually connect to it and that it isn't
 		// ourselves.
-		err := CheckFederationServer(
+		err = CheckFederationServer(
 			r.cfg.RuntimeID, universe.DefaultTimeout, server,
+			r.cfg.Net,
 		)
 		if err != nil {
 			return nil, err
@@ -5295,6 +6504,260 @@ func (r *rpcServer) DeleteFederationServer(ctx context.Context,
 	return &unirpc.DeleteFederationServerResponse{}, nil
 }
 
+// marshalQueuedProofPush marshals a pending proof push into its RPC
+// counterpart.
+func marshalQueuedProofPush(
+	push universe.PendingProofPush) (*unirpc.QueuedProofPush, error) {
+
+	uniID, err := MarshalUniID(push.UniID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &unirpc.QueuedProofPush{
+		Id:                   uniID,
+		LeafKey:              marshalLeafKey(push.LeafKey),
+		ServerHost:           push.ServerAddr.HostStr(),
+		NumRetries:           push.AttemptCounter,
+		LastAttemptTimestamp: push.Timestamp.Unix(),
+		NextAttemptTimestamp: push.NextAttempt.Unix(),
+		Exhausted:            push.Exhausted,
+	}, nil
+}
+
+// ListFederationPushQueue lists the proof pushes to federation servers that
+// haven't completed yet, along with their retry state.
+func (r *rpcServer) ListFederationPushQueue(ctx context.Context,
+	req *unirpc.ListFederationPushQueueRequest,
+) (*unirpc.ListFederationPushQueueResponse, error) {
+
+	pending, err := r.cfg.UniverseFederation.PendingProofPushes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &unirpc.ListFederationPushQueueResponse{}
+	for _, push := range pending {
+		host := push.ServerAddr.HostStr()
+		if req.ServerHost != "" && host != req.ServerHost {
+			continue
+		}
+
+		rpcPush, err := marshalQueuedProofPush(push)
+		if err != nil {
+			return nil, err
+		}
+
+		resp.Pushes = append(resp.Pushes, rpcPush)
+	}
+
+	return resp, nil
+}
+
+// marshalDivergence marshals a universe divergence into the RPC form.
+func marshalDivergence(
+	divergence *universe.Divergence) (*unirpc.UniverseDivergence, error) {
+
+	uniID, err := MarshalUniID(divergence.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &unirpc.UniverseDivergence{
+		Id:         uniID,
+		ServerHost: divergence.ServerHost,
+		LocalRoot:  marshalMssmtNode(divergence.LocalRoot),
+		RemoteRoot: marshalMssmtNode(divergence.RemoteRoot),
+		ConflictingLeafKeys: fn.Map(
+			divergence.ConflictingKeys, marshalLeafKey,
+		),
+		DetectedAt: divergence.DetectedAt.Unix(),
+	}, nil
+}
+
+// QueryDivergences lists the universes whose local root still didn't match the
+// root of a federation server after syncing with that server, most recent
+// first.
+func (r *rpcServer) QueryDivergences(ctx context.Context,
+	req *unirpc.QueryDivergencesRequest,
+) (*unirpc.QueryDivergencesResponse, error) {
+
+	if req.Offset < 0 {
+		return nil, fmt.Errorf("offset must be non-negative")
+	}
+
+	limit := req.Limit
+	switch {
+	case limit < 0:
+		return nil, fmt.Errorf("limit must be non-negative")
+
+	case limit == 0 || limit > universe.MaxPageSize:
+		limit = universe.MaxPageSize
+	}
+
+	divergences, err := r.cfg.FederationDB.QueryDivergences(
+		ctx, universe.DivergenceQuery{
+			ServerHost: req.ServerHost,
+			Offset:     req.Offset,
+			Limit:      limit,
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to query divergences: %w", err)
+	}
+
+	rpcDivergences, err := fn.MapErr(divergences, marshalDivergence)
+	if err != nil {
+		return nil, err
+	}
+
+	return &unirpc.QueryDivergencesResponse{
+		Divergences: rpcDivergences,
+	}, nil
+}
+
+const (
+	// rpcAdmissionAllow is the RPC type of an allow admission rule.
+	rpcAdmissionAllow = unirpc.AdmissionRuleType_ADMISSION_RULE_TYPE_ALLOW
+
+	// rpcAdmissionDeny is the RPC type of a deny admission rule.
+	rpcAdmissionDeny = unirpc.AdmissionRuleType_ADMISSION_RULE_TYPE_DENY
+)
+
+// marshalAdmissionPolicy marshals an admission policy into the RPC form.
+func marshalAdmissionPolicy(
+	policy *universe.AdmissionPolicy) (*unirpc.AdmissionPolicy, error) {
+
+	rpcRules := make([]*unirpc.AdmissionRule, len(policy.Rules))
+	for i, rule := range policy.Rules {
+		rpcRule := &unirpc.AdmissionRule{}
+		switch rule.Type {
+		case universe.AdmissionRuleAllow:
+			rpcRule.RuleType = rpcAdmissionAllow
+
+		case universe.AdmissionRuleDeny:
+			rpcRule.RuleType = rpcAdmissionDeny
+
+		default:
+			return nil, fmt.Errorf("unknown admission rule type: "+
+				"%v", rule.Type)
+		}
+
+		if rule.GroupKey != nil {
+			rpcRule.GroupKey = rule.GroupKey.SerializeCompressed()
+		} else {
+			rpcRule.AssetId = fn.ByteSlice(*rule.AssetID)
+		}
+
+		rpcRules[i] = rpcRule
+	}
+
+	return &unirpc.AdmissionPolicy{
+		Rules:               rpcRules,
+		MaxProofSize:        policy.MaxProofSize,
+		MaxInsertsPerMinute: policy.MaxInsertsPerMinute,
+	}, nil
+}
+
+// unmarshalAdmissionPolicy parses an admission policy from the RPC form.
+func unmarshalAdmissionPolicy(
+	rpcPolicy *unirpc.AdmissionPolicy) (*universe.AdmissionPolicy, error) {
+
+	if rpcPolicy == nil {
+		return nil, fmt.Errorf("admission policy must be set")
+	}
+
+	rules := make([]universe.AdmissionRule, len(rpcPolicy.Rules))
+	for i, rpcRule := range rpcPolicy.Rules {
+		switch rpcRule.RuleType {
+		case rpcAdmissionAllow:
+			rules[i].Type = universe.AdmissionRuleAllow
+
+		case rpcAdmissionDeny:
+			rules[i].Type = universe.AdmissionRuleDeny
+
+		default:
+			return nil, fmt.Errorf("unknown admission rule type: "+
+				"%v", rpcRule.RuleType)
+		}
+
+		switch {
+		case len(rpcRule.AssetId) != 0 && len(rpcRule.GroupKey) != 0:
+			return nil, fmt.Errorf("admission rule can't target " +
+				"both an asset ID and a group key")
+
+		case len(rpcRule.AssetId) != 0:
+			if len(rpcRule.AssetId) != sha256.Size {
+				return nil, fmt.Errorf("asset ID must be 32 " +
+					"bytes")
+			}
+
+			var assetID asset.ID
+			copy(assetID[:], rpcRule.AssetId)
+			rules[i].AssetID = &assetID
+
+		case len(rpcRule.GroupKey) != 0:
+			groupKey, err := parseUserKey(rpcRule.GroupKey)
+			if err != nil {
+				return nil, fmt.Errorf("invalid group key: %w",
+					err)
+			}
+			rules[i].GroupKey = groupKey
+
+		default:
+			return nil, fmt.Errorf("admission rule must target " +
+				"either an asset ID or a group key")
+		}
+	}
+
+	return &universe.AdmissionPolicy{
+		Rules:               rules,
+		MaxProofSize:        rpcPolicy.MaxProofSize,
+		MaxInsertsPerMinute: rpcPolicy.MaxInsertsPerMinute,
+	}, nil
+}
+
+// SetAdmissionPolicy replaces the admission policy for proofs that remote
+// clients insert into the universe.
+func (r *rpcServer) SetAdmissionPolicy(ctx context.Context,
+	req *unirpc.SetAdmissionPolicyRequest,
+) (*unirpc.SetAdmissionPolicyResponse, error) {
+
+	policy, err := unmarshalAdmissionPolicy(req.Policy)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.cfg.UniverseAdmission.SetPolicy(ctx, policy)
+	if err != nil {
+		return nil, fmt.Errorf("unable to set admission policy: %w",
+			err)
+	}
+
+	return &unirpc.SetAdmissionPolicyResponse{}, nil
+}
+
+// QueryAdmissionPolicy returns the current admission policy for proofs that
+// remote clients insert into the universe.
+func (r *rpcServer) QueryAdmissionPolicy(ctx context.Context,
+	_ *unirpc.QueryAdmissionPolicyRequest,
+) (*unirpc.QueryAdmissionPolicyResponse, error) {
+
+	policy, err := r.cfg.UniverseAdmission.Policy(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcPolicy, err := marshalAdmissionPolicy(policy)
+	if err != nil {
+		return nil, err
+	}
+
+	return &unirpc.QueryAdmissionPolicyResponse{
+		Policy: rpcPolicy,
+	}, nil
+}
+
 // SetFederationSyncConfig sets the configuration of the universe federation
 // sync.
 func (r *rpcServer) SetFederationSyncConfig(ctx context.Context,
@@ -6172,6 +7635,244 @@ func (r *rpcServer) SubscribeRfqEventNtfns(
 	)
 }
 
+// unmarshalLedgerQuery unmarshals the peer and time range filters of a quote
+// ledger query from the RPC form.
+func unmarshalLedgerQuery(peerPubKey []byte, startTimestamp,
+	endTimestamp int64) (*rfq.LedgerQuery, error) {
+
+	if startTimestamp < 0 || endTimestamp < 0 {
+		return nil, fmt.Errorf("timestamps must not be negative")
+	}
+
+	var query rfq.LedgerQuery
+	if len(peerPubKey) > 0 {
+		peer, err := route.NewVertexFromBytes(peerPubKey)
+		if err != nil {
+			return nil, fmt.Errorf("error unmarshalling peer "+
+				"route vertex: %w", err)
+		}
+
+		query.Peer = &peer
+	}
+
+	if startTimestamp != 0 {
+		query.StartTime = time.Unix(startTimestamp, 0)
+	}
+	if endTimestamp != 0 {
+		query.EndTime = time.Unix(endTimestamp, 0)
+	}
+
+	return &query, nil
+}
+
+// marshalRfqAssetSpecifier marshals the subject asset of a quote into the RPC
+// form.
+func marshalRfqAssetSpecifier(assetID *asset.ID,
+	groupKey *btcec.PublicKey) *rfqrpc.AssetSpecifier {
+
+	switch {
+	case assetID != nil:
+		return &rfqrpc.AssetSpecifier{
+			Id: &rfqrpc.AssetSpecifier_AssetId{
+				AssetId: fn.CopySlice(assetID[:]),
+			},
+		}
+
+	case groupKey != nil:
+		return &rfqrpc.AssetSpecifier{
+			Id: &rfqrpc.AssetSpecifier_GroupKey{
+				GroupKey: groupKey.SerializeCompressed(),
+			},
+		}
+
+	default:
+		return nil
+	}
+}
+
+// marshalQuoteType marshals a quote type into the RPC form.
+func marshalQuoteType(quoteType rfq.QuoteType) (rfqrpc.QuoteType, error) {
+	switch quoteType {
+	case rfq.QuoteTypeBuy:
+		return rfqrpc.QuoteType_QUOTE_TYPE_BUY, nil
+
+	case rfq.QuoteTypeSell:
+		return rfqrpc.QuoteType_QUOTE_TYPE_SELL, nil
+
+	default:
+		return 0, fmt.Errorf("unknown quote type: %v", quoteType)
+	}
+}
+
+// marshalQuoteStatus marshals a quote status into the RPC form.
+func marshalQuoteStatus(status rfq.QuoteStatus) (rfqrpc.QuoteStatus, error) {
+	switch status {
+	case rfq.QuoteStatusRequested:
+		return rfqrpc.QuoteStatus_QUOTE_STATUS_REQUESTED, nil
+
+	case rfq.QuoteStatusAccepted:
+		return rfqrpc.QuoteStatus_QUOTE_STATUS_ACCEPTED, nil
+
+	case rfq.QuoteStatusRejected:
+		return rfqrpc.QuoteStatus_QUOTE_STATUS_REJECTED, nil
+
+	case rfq.QuoteStatusExpired:
+		return rfqrpc.QuoteStatus_QUOTE_STATUS_EXPIRED, nil
+
+	default:
+		return 0, fmt.Errorf("unknown quote status: %v", status)
+	}
+}
+
+// marshalTradeStatus marshals a trade status into the RPC form.
+func marshalTradeStatus(status rfq.TradeStatus) (rfqrpc.TradeStatus, error) {
+	switch status {
+	case rfq.TradeStatusPending:
+		return rfqrpc.TradeStatus_TRADE_STATUS_PENDING, nil
+
+	case rfq.TradeStatusSettled:
+		return rfqrpc.TradeStatus_TRADE_STATUS_SETTLED, nil
+
+	case rfq.TradeStatusFailed:
+		return rfqrpc.TradeStatus_TRADE_STATUS_FAILED, nil
+
+	default:
+		return 0, fmt.Errorf("unknown trade status: %v", status)
+	}
+}
+
+// marshalQuoteLogEntry marshals a quote ledger entry into the RPC form.
+func marshalQuoteLogEntry(
+	entry rfq.QuoteLogEntry) (*rfqrpc.QuoteLogEntry, error) {
+
+	quoteType, err := marshalQuoteType(entry.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	status, err := marshalQuoteStatus(entry.Status)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcEntry := &rfqrpc.QuoteLogEntry{
+		Id:       fn.CopySlice(entry.ID[:]),
+		Type:     quoteType,
+		Incoming: entry.Incoming,
+		Peer:     entry.Peer.String(),
+		AssetSpecifier: marshalRfqAssetSpecifier(
+			entry.AssetID, entry.AssetGroupKey,
+		),
+		AssetAmount: entry.AssetAmount,
+		Price:       uint64(entry.Price),
+		Expiry:      entry.Expiry,
+		Status:      status,
+		CreatedAt:   entry.CreatedAt.Unix(),
+		UpdatedAt:   entry.UpdatedAt.Unix(),
+	}
+
+	entry.Signature.WhenSome(func(sig [64]byte) {
+		rpcEntry.Signature = sig[:]
+	})
+	entry.RejectErr.WhenSome(func(rejectErr rfqmsg.RejectErr) {
+		rpcEntry.RejectCode = uint32(rejectErr.Code)
+		rpcEntry.RejectMsg = rejectErr.Msg
+	})
+
+	return rpcEntry, nil
+}
+
+// ListQuotes lists the quotes of the quote ledger that match the given peer
+// and time range filters.
+func (r *rpcServer) ListQuotes(ctx context.Context,
+	req *rfqrpc.ListQuotesRequest) (*rfqrpc.ListQuotesResponse, error) {
+
+	query, err := unmarshalLedgerQuery(
+		req.PeerPubKey, req.StartTimestamp, req.EndTimestamp,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := r.cfg.RfqManager.QueryQuoteLedger(ctx, *query)
+	if err != nil {
+		return nil, fmt.Errorf("unable to query quote ledger: %w", err)
+	}
+
+	rpcEntries := make([]*rfqrpc.QuoteLogEntry, 0, len(entries))
+	for _, entry := range entries {
+		rpcEntry, err := marshalQuoteLogEntry(entry)
+		if err != nil {
+			return nil, fmt.Errorf("unable to marshal quote: %w",
+				err)
+		}
+
+		rpcEntries = append(rpcEntries, rpcEntry)
+	}
+
+	return &rfqrpc.ListQuotesResponse{
+		Quotes: rpcEntries,
+	}, nil
+}
+
+// ListTrades lists the HTLCs that were accepted against the quotes of our node
+// and that match the given peer and time range filters.
+func (r *rpcServer) ListTrades(ctx context.Context,
+	req *rfqrpc.ListTradesRequest) (*rfqrpc.ListTradesResponse, error) {
+
+	query, err := unmarshalLedgerQuery(
+		req.PeerPubKey, req.StartTimestamp, req.EndTimestamp,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	trades, err := r.cfg.RfqManager.QueryTradeLedger(ctx, *query)
+	if err != nil {
+		return nil, fmt.Errorf("unable to query trade ledger: %w", err)
+	}
+
+	rpcTrades := make([]*rfqrpc.Trade, 0, len(trades))
+	for _, trade := range trades {
+		quoteType, err := marshalQuoteType(trade.QuoteType)
+		if err != nil {
+			return nil, err
+		}
+
+		status, err := marshalTradeStatus(trade.Status)
+		if err != nil {
+			return nil, err
+		}
+
+		var resolvedTimestamp int64
+		if !trade.ResolvedAt.IsZero() {
+			resolvedTimestamp = trade.ResolvedAt.Unix()
+		}
+
+		rpcTrades = append(rpcTrades, &rfqrpc.Trade{
+			QuoteId:   fn.CopySlice(trade.QuoteID[:]),
+			QuoteType: quoteType,
+			Peer:      trade.Peer.String(),
+			AssetSpecifier: marshalRfqAssetSpecifier(
+				trade.AssetID, trade.AssetGroupKey,
+			),
+			AssetAmount:       trade.AssetAmount,
+			QuotePrice:        uint64(trade.QuotePrice),
+			IncomingChanId:    trade.IncomingChanID.ToUint64(),
+			HtlcId:            trade.HtlcID,
+			AmountMsat:        uint64(trade.Amount),
+			Timestamp:         trade.Timestamp.Unix(),
+			HtlcAssetAmount:   trade.HtlcAssetAmount,
+			Status:            status,
+			ResolvedTimestamp: resolvedTimestamp,
+		})
+	}
+
+	return &rfqrpc.ListTradesResponse{
+		Trades: rpcTrades,
+	}, nil
+}
+
 // serialize is a helper function that serializes a serializable object into a
 // byte slice.
 func serialize(s interface{ Serialize(io.Writer) error }) ([]byte, error) {
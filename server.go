@@ -158,6 +158,15 @@ func (s *Server) initialize(interceptorChain *rpcperms.InterceptorChain) error {
 			"federation: %w", err)
 	}
 
+	if err := s.cfg.UniverseCanonical.Start(); err != nil {
+		return fmt.Errorf("unable to start canonical universe: %w",
+			err)
+	}
+
+	if err := s.cfg.UniversePruner.Start(); err != nil {
+		return fmt.Errorf("unable to start universe pruner: %w", err)
+	}
+
 	// Start the request for quote (RFQ) manager.
 	if err := s.cfg.RfqManager.Start(); err != nil {
 		return fmt.Errorf("unable to start RFQ manager: %w", err)
@@ -336,6 +345,10 @@ func (s *Server) RunUntilShutdown(mainErrChan <-chan error) error {
 		// minter.
 		s.cfg.Prometheus.AssetMinter = s.cfg.AssetMinter
 
+		// Provide Prometheus collectors with access to the transfer
+		// pruner.
+		s.cfg.Prometheus.UniversePruner = s.cfg.UniversePruner
+
 		promExporter, err := monitoring.NewPrometheusExporter(
 			&s.cfg.Prometheus,
 		)
@@ -591,6 +604,14 @@ func (s *Server) Stop() error {
 		return err
 	}
 
+	if err := s.cfg.UniverseCanonical.Stop(); err != nil {
+		return err
+	}
+
+	if err := s.cfg.UniversePruner.Stop(); err != nil {
+		return err
+	}
+
 	if err := s.cfg.RfqManager.Stop(); err != nil {
 		return err
 	}
@@ -22,7 +22,9 @@ import (
 	tap "github.com/lightninglabs/taproot-assets"
 	"github.com/lightninglabs/taproot-assets/monitoring"
 	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/lightninglabs/taproot-assets/rfq"
 	"github.com/lightninglabs/taproot-assets/tapdb"
+	"github.com/lightninglabs/taproot-assets/universe"
 	"github.com/lightningnetwork/lnd/build"
 	"github.com/lightningnetwork/lnd/cert"
 	"github.com/lightningnetwork/lnd/lncfg"
@@ -46,11 +48,15 @@ const (
 	defaultLogFilename        = "tapd.log"
 	defaultRPCPort            = 10029
 	defaultRESTPort           = 8089
+	defaultTorSOCKSPort       = 9050
 	defaultLetsEncryptDirname = "letsencrypt"
 	defaultLetsEncryptListen  = ":80"
 
 	defaultNetwork = "testnet"
 
+	// defaultTorSOCKS is the default address of Tor's SOCKS5 proxy.
+	defaultTorSOCKS = "localhost:9050"
+
 	defaultMaxLogFiles    = 3
 	defaultMaxLogFileSize = 10
 
@@ -268,6 +274,39 @@ type UniverseConfig struct {
 	UniverseQueriesPerSecond rate.Limit `long:"max-qps" description:"The maximum number of queries per second across the set of active universe queries that is permitted. Anything above this starts to get rate limited."`
 
 	UniverseQueriesBurst int `long:"req-burst-budget" description:"The burst budget for the universe query rate limiting."`
+
+	CommitInterval time.Duration `long:"commitinterval" description:"Amount of time to wait between on-chain commitments of the issuance multiverse root. The root is only committed if it changed since the last commitment. Each commitment creates a transaction that is funded by the lnd wallet. Set to 0 to disable commitments."`
+
+	PushRetryInitialBackoff time.Duration `long:"push-retry-initial-backoff" description:"The amount of time to wait before retrying a failed proof push to a federation server for the first time. The backoff is doubled after every further failed attempt."`
+
+	PushRetryMaxBackoff time.Duration `long:"push-retry-max-backoff" description:"The maximum amount of time to wait between two attempts to push a proof to a federation server."`
+
+	PushRetryMaxAttempts int64 `long:"push-retry-max-attempts" description:"The number of attempts after which a failed proof push to a federation server is given up. Set to 0 to retry indefinitely."`
+
+	QuarantineThreshold uint32 `long:"quarantine-threshold" description:"The number of consecutive failed syncs or proof pushes after which a federation server is quarantined and skipped until the quarantine is over. Set to 0 to never quarantine servers."`
+
+	QuarantineInitialBackoff time.Duration `long:"quarantine-initial-backoff" description:"The amount of time a federation server is quarantined for once it reaches the failure threshold. The backoff is doubled for every further failure."`
+
+	QuarantineMaxBackoff time.Duration `long:"quarantine-max-backoff" description:"The maximum amount of time a federation server is quarantined for."`
+
+	TransferRetentionDepth uint32 `long:"transfer-retention-depth" description:"The number of blocks a superseded transfer proof can be anchored below the chain tip before it is pruned from the transfer universes. A transfer proof is superseded once the asset it proves was transferred again. Issuance proofs and the proofs of unspent assets are never pruned. Set to 0 to not prune proofs based on their depth."`
+
+	TransferRetentionAge time.Duration `long:"transfer-retention-age" description:"The maximum age of the anchor block of a superseded transfer proof before it is pruned from the transfer universes. Issuance proofs and the proofs of unspent assets are never pruned. Set to 0 to not prune proofs based on their age."`
+
+	TransferPruneInterval time.Duration `long:"transfer-prune-interval" description:"Amount of time to wait between pruning superseded transfer proofs. Only used if a transfer retention depth or age is set."`
+}
+
+// TorConfig is the config that houses the Tor related config values. If Tor
+// is active, all outbound connections to universe servers and proof couriers
+// are made through the Tor SOCKS5 proxy.
+type TorConfig struct {
+	Active bool `long:"active" description:"Route all outbound connections to universe servers and proof couriers through the Tor SOCKS5 proxy. This is required to connect to .onion addresses."`
+
+	SOCKS string `long:"socks" description:"The host:port that Tor's exposed SOCKS5 proxy is listening on"`
+
+	StreamIsolation bool `long:"streamisolation" description:"Enable Tor stream isolation by randomizing user credentials for each connection."`
+
+	SkipProxyForClearNetTargets bool `long:"skip-proxy-for-clearnet-targets" description:"Only route connections to .onion addresses through the Tor SOCKS5 proxy and connect to clearnet addresses directly. This has no privacy benefit for clearnet connections. Cannot be used together with streamisolation."`
 }
 
 // AddressConfig is the config that houses any address Book related config
@@ -276,6 +315,16 @@ type AddrBookConfig struct {
 	DisableSyncer bool `long:"disable-syncer" description:"If true, tapd will not try to sync issuance proofs for unknown assets when creating an address."`
 }
 
+// ExperimentalConfig houses experimental tapd cli configuration options.
+type ExperimentalConfig struct {
+	Rfq rfq.CliConfig `group:"rfq" namespace:"rfq"`
+}
+
+// Validate returns an error if the configuration is invalid.
+func (c *ExperimentalConfig) Validate() error {
+	return c.Rfq.Validate()
+}
+
 // Config is the main config for the tapd cli command.
 type Config struct {
 	ShowVersion bool `long:"version" description:"Display version information and exit"`
@@ -313,10 +362,14 @@ type Config struct {
 
 	Universe *UniverseConfig `group:"universe" namespace:"universe"`
 
+	Tor *TorConfig `group:"tor" namespace:"tor"`
+
 	AddrBook *AddrBookConfig `group:"address" namespace:"address"`
 
 	Prometheus monitoring.PrometheusConfig `group:"prometheus" namespace:"prometheus"`
 
+	Experimental *ExperimentalConfig `group:"experimental" namespace:"experimental"`
+
 	// LogWriter is the root logger that all of the daemon's subloggers are
 	// hooked up to.
 	LogWriter *build.RotatingLogWriter
@@ -400,10 +453,30 @@ func DefaultConfig() Config {
 				defaultUniverseMaxQps,
 			),
 			UniverseQueriesBurst: defaultUniverseQueriesBurst,
+			PushRetryInitialBackoff: universe.
+				DefaultPushRetryInitialBackoff,
+			PushRetryMaxBackoff: universe.
+				DefaultPushRetryMaxBackoff,
+			PushRetryMaxAttempts: universe.
+				DefaultPushRetryMaxAttempts,
+			QuarantineThreshold: universe.
+				DefaultQuarantineThreshold,
+			QuarantineInitialBackoff: universe.
+				DefaultQuarantineInitialBackoff,
+			QuarantineMaxBackoff: universe.
+				DefaultQuarantineMaxBackoff,
+			TransferPruneInterval: universe.
+				DefaultTransferPruneInterval,
+		},
+		Tor: &TorConfig{
+			SOCKS: defaultTorSOCKS,
 		},
 		AddrBook: &AddrBookConfig{
 			DisableSyncer: false,
 		},
+		Experimental: &ExperimentalConfig{
+			Rfq: rfq.DefaultCliConfig(),
+		},
 	}
 }
 
@@ -813,6 +886,86 @@ func ValidateConfig(cfg Config, cfgLogger btclog.Logger) (*Config, error) {
 		}
 	}
 
+	// Make sure the federation proof push retry backoff is sane.
+	switch {
+	case cfg.Universe.PushRetryInitialBackoff <= 0:
+		return nil, mkErr("universe.push-retry-initial-backoff must " +
+			"be positive")
+
+	case cfg.Universe.PushRetryMaxBackoff <
+		cfg.Universe.PushRetryInitialBackoff:
+
+		return nil, mkErr("universe.push-retry-max-backoff must not " +
+			"be smaller than universe.push-retry-initial-backoff")
+
+	case cfg.Universe.PushRetryMaxAttempts < 0:
+		return nil, mkErr("universe.push-retry-max-attempts must not " +
+			"be negative")
+	}
+
+	// Make sure the federation server quarantine backoff is sane.
+	switch {
+	case cfg.Universe.QuarantineInitialBackoff <= 0:
+		return nil, mkErr("universe.quarantine-initial-backoff must " +
+			"be positive")
+
+	case cfg.Universe.QuarantineMaxBackoff <
+		cfg.Universe.QuarantineInitialBackoff:
+
+		return nil, mkErr("universe.quarantine-max-backoff must not " +
+			"be smaller than universe.quarantine-initial-backoff")
+	}
+
+	// Make sure we can prune transfer proofs if a retention policy is set.
+	pruneTransfers := cfg.Universe.TransferRetentionDepth != 0 ||
+		cfg.Universe.TransferRetentionAge != 0
+	switch {
+	case cfg.Universe.TransferRetentionAge < 0:
+		return nil, mkErr("universe.transfer-retention-age must not " +
+			"be negative")
+
+	case pruneTransfers && cfg.Universe.TransferPruneInterval <= 0:
+		return nil, mkErr("universe.transfer-prune-interval must be " +
+			"positive")
+	}
+
+	// If Tor is active, all outbound universe and proof courier
+	// connections are made through its SOCKS5 proxy. We only switch the
+	// network after the listeners were normalized above, as those must
+	// never be resolved through the proxy.
+	if cfg.Tor.Active {
+		if cfg.Tor.StreamIsolation &&
+			cfg.Tor.SkipProxyForClearNetTargets {
+
+			return nil, mkErr("tor.streamisolation and " +
+				"tor.skip-proxy-for-clearnet-targets can't be " +
+				"used together")
+		}
+
+		socks, err := lncfg.ParseAddressString(
+			cfg.Tor.SOCKS, strconv.Itoa(defaultTorSOCKSPort),
+			cfg.net.ResolveTCPAddr,
+		)
+		if err != nil {
+			return nil, mkErr("error parsing tor.socks address: %v",
+				err)
+		}
+
+		skipClearNet := cfg.Tor.SkipProxyForClearNetTargets
+		cfg.net = &tor.ProxyNet{
+			SOCKS:                       socks.String(),
+			StreamIsolation:             cfg.Tor.StreamIsolation,
+			SkipProxyForClearNetTargets: skipClearNet,
+		}
+	}
+
+	// Validate the experimental command line config parameters.
+	err = cfg.Experimental.Validate()
+	if err != nil {
+		return nil, mkErr("error validating experimental config: %v",
+			err)
+	}
+
 	// All good, return the sanitized result.
 	return &cfg, nil
 }
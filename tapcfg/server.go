@@ -131,6 +131,15 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 		uniStatsDB, defaultClock, statsOpts...,
 	)
 
+	admissionStore := tapdb.NewTransactionExecutor(db,
+		func(tx *sql.Tx) tapdb.AdmissionPolicyStore {
+			return db.WithTx(tx)
+		},
+	)
+	universeAdmission := universe.NewAdmissionController(
+		tapdb.NewUniverseAdmissionDB(admissionStore),
+	)
+
 	headerVerifier := tapgarden.GenHeaderVerifier(
 		context.Background(), chainBridge,
 	)
@@ -148,6 +157,7 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 		GroupVerifier:  groupVerifier,
 		Multiverse:     multiverse,
 		UniverseStats:  universeStats,
+		Admission:      universeAdmission,
 	}
 
 	federationStore := tapdb.NewTransactionExecutor(db,
@@ -159,6 +169,13 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 		federationStore, defaultClock,
 	)
 
+	rfqQuoteStore := tapdb.NewTransactionExecutor(db,
+		func(tx *sql.Tx) tapdb.RfqQuoteStore {
+			return db.WithTx(tx)
+		},
+	)
+	rfqStore := tapdb.NewRfqStore(rfqQuoteStore, defaultClock)
+
 	proofFileStore, err := proof.NewFileArchiver(cfg.networkDir)
 	if err != nil {
 		return nil, fmt.Errorf("unable to open disk archive: %w", err)
@@ -254,11 +271,38 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 
 	baseUni := universe.NewArchive(uniCfg)
 
+	// All connections to remote universe servers are made over the
+	// configured network, which routes them through Tor if it is active.
+	newRemoteDiffEngine := func(
+		addr universe.ServerAddr) (universe.DiffEngine, error) {
+
+		return tap.NewRpcUniverseDiff(addr, cfg.net)
+	}
+	newRemoteRegistrar := func(
+		addr universe.ServerAddr) (universe.Registrar, error) {
+
+		return tap.NewRpcUniverseRegistrar(addr, cfg.net)
+	}
+
+	// The health of the federation servers is tracked by both the syncer
+	// and the federation envoy.
+	serverHealth := universe.NewServerHealthTracker(
+		universe.QuarantineConfig{
+			FailureThreshold: cfg.Universe.QuarantineThreshold,
+			InitialBackoff:   cfg.Universe.QuarantineInitialBackoff,
+			MaxBackoff:       cfg.Universe.QuarantineMaxBackoff,
+		},
+	)
+
 	universeSyncer := universe.NewSimpleSyncer(universe.SimpleSyncCfg{
 		LocalDiffEngine:     baseUni,
-		NewRemoteDiffEngine: tap.NewRpcUniverseDiff,
+		NewRemoteDiffEngine: newRemoteDiffEngine,
 		LocalRegistrar:      baseUni,
 		SyncBatchSize:       defaultUniverseSyncBatchSize,
+		DivergenceLog:       federationDB,
+		PrunedLeaves:        multiverse,
+		Admission:           universeAdmission,
+		ServerHealth:        serverHealth,
 	})
 
 	var runtimeIDBytes [8]byte
@@ -274,15 +318,63 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 			UniverseSyncer:          universeSyncer,
 			LocalRegistrar:          baseUni,
 			SyncInterval:            cfg.Universe.SyncInterval,
-			NewRemoteRegistrar:      tap.NewRpcUniverseRegistrar,
+			NewRemoteRegistrar:      newRemoteRegistrar,
 			StaticFederationMembers: federationMembers,
 			ServerChecker: func(addr universe.ServerAddr) error {
 				return tap.CheckFederationServer(
 					runtimeID, universe.DefaultTimeout,
-					addr,
+					addr, cfg.net,
 				)
 			},
 			ErrChan: mainErrChan,
+			// There's no point in checking the push queue less
+			// often than the smallest backoff.
+			PushRetry: universe.PushRetryConfig{
+				CheckInterval: min(
+					universe.DefaultPushRetryCheckInterval,
+					cfg.Universe.PushRetryInitialBackoff,
+				),
+				InitialBackoff: cfg.Universe.
+					PushRetryInitialBackoff,
+				MaxBackoff:  cfg.Universe.PushRetryMaxBackoff,
+				MaxAttempts: cfg.Universe.PushRetryMaxAttempts,
+			},
+			ServerHealth: serverHealth,
+		},
+	)
+
+	universeCommitDB := tapdb.NewTransactionExecutor(db,
+		func(tx *sql.Tx) tapdb.UniverseCommitStore {
+			return db.WithTx(tx)
+		},
+	)
+	universeCanonical := universe.NewCanonicalUniverse(
+		universe.CanonicalConfig{
+			Multiverse: multiverse,
+			CommitmentStore: tapdb.NewUniverseCommitmentDB(
+				universeCommitDB, defaultClock,
+			),
+			ChainCommitter: tapgarden.NewUniverseCommitter(
+				tapgarden.UniverseCommitterConfig{
+					Wallet:      walletAnchor,
+					ChainBridge: chainBridge,
+					KeyRing:     keyRing,
+					ChainParams: &tapChainParams,
+				},
+			),
+			CommitInterval: cfg.Universe.CommitInterval,
+		},
+	)
+
+	universePruner := universe.NewTransferPruner(
+		universe.TransferPrunerConfig{
+			Store:         multiverse,
+			CurrentHeight: chainBridge.CurrentHeight,
+			Policy: universe.TransferRetentionPolicy{
+				MaxDepth: cfg.Universe.TransferRetentionDepth,
+				MaxAge:   cfg.Universe.TransferRetentionAge,
+			},
+			PruneInterval: cfg.Universe.TransferPruneInterval,
 		},
 	)
 
@@ -320,20 +412,82 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 		UniverseRpcCfg: cfg.UniverseRpcCourier,
 		TransferLog:    assetStore,
 		LocalArchive:   proofArchive,
+		Net:            cfg.net,
 	})
 
 	multiNotifier := proof.NewMultiArchiveNotifier(assetStore, multiverse)
 
-	// TODO(ffranr): Replace the mock price oracle with a real one.
-	priceOracle := rfq.NewMockPriceOracle(3600)
+	// If a price oracle RPC server address was configured, we'll use it to
+	// price quotes. If multiple price oracle sources were configured, we'll
+	// use the median of their prices instead. Otherwise, we fall back to
+	// the mock price oracle.
+	var priceOracle rfq.PriceOracle
+	rfqCfg := cfg.Experimental.Rfq
+	switch {
+	case len(rfqCfg.PriceOracleSources) > 0:
+		cfgLogger.Infof("Using composite price oracle with %d sources",
+			len(rfqCfg.PriceOracleSources))
+
+		sources := make([]rfq.PriceOracle, 0,
+			len(rfqCfg.PriceOracleSources))
+		for _, sourceAddr := range rfqCfg.PriceOracleSources {
+			source, err := rfq.NewPriceOracleSource(
+				sourceAddr, rfqCfg.OracleTLSConfig(),
+			)
+			if err != nil {
+				return nil, fmt.Errorf("unable to create "+
+					"price oracle source %v: %w",
+					sourceAddr, err)
+			}
+
+			sources = append(sources, source)
+		}
+
+		priceOracle, err = rfq.NewCompositePriceOracle(
+			rfq.CompositeOracleCfg{
+				Sources:      sources,
+				MinSources:   rfqCfg.PriceOracleMinSources,
+				MaxSpreadPpm: rfqCfg.PriceOracleMaxSpreadPpm,
+			},
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to create composite "+
+				"price oracle: %w", err)
+		}
+
+	case rfqCfg.PriceOracleAddress != "":
+		cfgLogger.Infof("Connecting to price oracle at: %v",
+			rfqCfg.PriceOracleAddress)
+
+		priceOracle, err = rfq.NewRpcPriceOracle(
+			rfqCfg.PriceOracleAddress, rfqCfg.OracleTLSConfig(),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to create price "+
+				"oracle: %w", err)
+		}
+
+	default:
+		cfgLogger.Warnf("No price oracle address configured, using " +
+			"mock price oracle")
+
+		priceOracle = rfq.NewMockPriceOracle(3600)
+	}
 
 	// Construct the RFQ manager.
 	rfqManager, err := rfq.NewManager(
 		rfq.ManagerCfg{
-			PeerMessenger:   msgTransportClient,
-			HtlcInterceptor: lndRouterClient,
-			PriceOracle:     priceOracle,
-			ErrChan:         mainErrChan,
+			PeerMessenger:     msgTransportClient,
+			HtlcInterceptor:   lndRouterClient,
+			PriceOracle:       priceOracle,
+			QuoteStore:        rfqStore,
+			QuoteLedger:       rfqStore,
+			QuoteLogRetention: rfqCfg.QuoteLogRetention,
+			HtlcEvents:        lndRouterClient,
+			Signer:            lndServices.Signer,
+			ExposureLimits:    rfqCfg.ExposureLimits(),
+			GroupLookup:       tapdbAddrBook,
+			ErrChan:           mainErrChan,
 		},
 	)
 	if err != nil {
@@ -343,6 +497,7 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 	return &tap.Config{
 		DebugLevel:   cfg.DebugLevel,
 		RuntimeID:    runtimeID,
+		Net:          cfg.net,
 		Lnd:          lndServices,
 		ChainParams:  address.ParamsForChain(cfg.ActiveNetParams.Name),
 		ReOrgWatcher: reOrgWatcher,
@@ -408,6 +563,9 @@ func genServerConfig(cfg *Config, cfgLogger btclog.Logger,
 		UniverseArchive:          baseUni,
 		UniverseSyncer:           universeSyncer,
 		UniverseFederation:       universeFederation,
+		UniverseAdmission:        universeAdmission,
+		UniverseCanonical:        universeCanonical,
+		UniversePruner:           universePruner,
 		UniverseStats:            universeStats,
 		UniversePublicAccess:     cfg.Universe.PublicAccess,
 		UniverseQueriesPerSecond: cfg.Universe.UniverseQueriesPerSecond,
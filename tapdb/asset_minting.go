@@ -55,6 +55,13 @@ type (
 	// AssetSeedlingTuple is used to look up the ID of a seedling.
 	AssetSeedlingTuple = sqlc.FetchSeedlingIDParams
 
+	// AssetSeedlingDelete is used to delete a seedling from a batch.
+	AssetSeedlingDelete = sqlc.DeleteAssetSeedlingParams
+
+	// AssetSeedlingUpdate is used to update the fields of a seedling that
+	// can be changed while its batch is pending.
+	AssetSeedlingUpdate = sqlc.UpdateAssetSeedlingParams
+
 	// MintingBatchTuple is used to update a batch state based on the raw
 	// key.
 	MintingBatchTuple = sqlc.UpdateMintingBatchStateParams
@@ -160,6 +167,13 @@ type PendingAssetStore interface {
 	InsertAssetSeedlingIntoBatch(ctx context.Context,
 		arg AssetSeedlingItem) error
 
+	// DeleteAssetSeedling deletes an asset seedling from a batch based on
+	// the batch key and the name of the seedling.
+	DeleteAssetSeedling(ctx context.Context, arg AssetSeedlingDelete) error
+
+	// UpdateAssetSeedling updates an existing asset seedling.
+	UpdateAssetSeedling(ctx context.Context, arg AssetSeedlingUpdate) error
+
 	// AllMintingBatches is used to fetch all minting batches.
 	AllMintingBatches(ctx context.Context) ([]MintingBatchA, error)
 
@@ -205,6 +219,10 @@ type PendingAssetStore interface {
 	UpsertManagedUTXO(ctx context.Context, arg RawManagedUTXO) (int64,
 		error)
 
+	// DeleteManagedUTXO deletes the managed utxo identified by the passed
+	// serialized outpoint.
+	DeleteManagedUTXO(ctx context.Context, outpoint []byte) error
+
 	// AnchorPendingAssets associated an asset on disk with the transaction
 	// that once confirmed will mint the asset.
 	AnchorPendingAssets(ctx context.Context, arg AssetAnchor) error
@@ -333,6 +351,7 @@ func (a *AssetMintingStore) CommitMintingBatch(ctx context.Context,
 			BatchID:          batchID,
 			HeightHint:       int32(newBatch.HeightHint),
 			CreationTimeUnix: newBatch.CreationTime.UTC(),
+			BatchName:        sqlStr(newBatch.Name),
 		}); err != nil {
 			return fmt.Errorf("unable to insert minting "+
 				"batch: %w", err)
@@ -590,6 +609,75 @@ func (a *AssetMintingStore) AddSeedlingsToBatch(ctx context.Context,
 	})
 }
 
+// RemoveSeedlingFromBatch removes the seedling with the given name from an
+// existing batch.
+func (a *AssetMintingStore) RemoveSeedlingFromBatch(ctx context.Context,
+	batchKey *btcec.PublicKey, assetName string) error {
+
+	var writeTxOpts AssetStoreTxOptions
+	return a.db.ExecTx(ctx, &writeTxOpts, func(q PendingAssetStore) error {
+		return q.DeleteAssetSeedling(ctx, AssetSeedlingDelete{
+			BatchKey:     batchKey.SerializeCompressed(),
+			SeedlingName: assetName,
+		})
+	})
+}
+
+// UpdateSeedlingInBatch updates the amount, metadata and group settings of an
+// existing seedling in a batch. The seedling is identified by its name.
+func (a *AssetMintingStore) UpdateSeedlingInBatch(ctx context.Context,
+	batchKey *btcec.PublicKey, seedling *tapgarden.Seedling) error {
+
+	rawBatchKey := batchKey.SerializeCompressed()
+
+	tapscriptRootSize := len(seedling.GroupTapscriptRoot)
+	if tapscriptRootSize != 0 && tapscriptRootSize != sha256.Size {
+		return ErrTapscriptRootSize
+	}
+
+	var writeTxOpts AssetStoreTxOptions
+	return a.db.ExecTx(ctx, &writeTxOpts, func(q PendingAssetStore) error {
+		// We update the existing seedling in place, so any seedlings
+		// that use it as their group anchor keep referencing it.
+		seedlingID, err := fetchSeedlingID(
+			ctx, q, rawBatchKey, seedling.AssetName,
+		)
+		if err != nil {
+			return fmt.Errorf("unable to fetch seedling: %w", err)
+		}
+
+		assetMetaID, err := maybeUpsertAssetMeta(
+			ctx, q, nil, seedling.Meta,
+		)
+		if err != nil {
+			return err
+		}
+
+		optionalDbIDs, err := insertOptionalSeedlingParams(
+			ctx, q, rawBatchKey, seedling,
+		)
+		if err != nil {
+			return err
+		}
+
+		err = q.UpdateAssetSeedling(ctx, AssetSeedlingUpdate{
+			AssetSupply:        int64(seedling.Amount),
+			AssetMetaID:        assetMetaID,
+			EmissionEnabled:    seedling.EnableEmission,
+			GroupGenesisID:     optionalDbIDs.GroupGenesisID,
+			GroupAnchorID:      optionalDbIDs.GroupAnchorID,
+			GroupInternalKeyID: optionalDbIDs.GroupInternalKeyID,
+			GroupTapscriptRoot: seedling.GroupTapscriptRoot,
+			SeedlingID:         seedlingID,
+		})
+		if err != nil {
+			return fmt.Errorf("unable to update seedling: %w", err)
+		}
+
+		return nil
+	})
+}
+
 // fetchSeedlingID attempts to fetch the ID for a seedling from a specific
 // batch. This is performed within the context of a greater DB transaction.
 func fetchSeedlingID(ctx context.Context, q PendingAssetStore, batchKey []byte,
@@ -1052,6 +1140,7 @@ func marshalMintingBatch(ctx context.Context, q PendingAssetStore,
 			},
 			PubKey: batchKey,
 		},
+		Name:         dbBatch.BatchName.String,
 		HeightHint:   uint32(dbBatch.HeightHint),
 		CreationTime: dbBatch.CreationTimeUnix.UTC(),
 	}
@@ -1364,7 +1453,9 @@ func (a *AssetMintingStore) AddSproutsToBatch(ctx context.Context,
 // batch on disk. The anchor output index and script root are also stored to
 // ensure we can reconstruct the private key needed to sign for the batch. The
 // genesis transaction itself is inserted as a new chain transaction, which all
-// other components then reference.
+// other components then reference. If the batch already has a broadcast
+// genesis transaction that is replaced by the given one, the anchor output of
+// the replaced transaction is removed.
 //
 // TODO(roasbeef): or could just re-read assets from disk and set the script
 // root manually?
@@ -1409,13 +1500,23 @@ func (a *AssetMintingStore) CommitSignedGenesisTx(ctx context.Context,
 
 	var writeTxOpts AssetStoreTxOptions
 	return a.db.ExecTx(ctx, &writeTxOpts, func(q PendingAssetStore) error {
+		// If the batch was already broadcast, then we're replacing
+		// its genesis transaction, so we'll need to know the anchor
+		// output of the replaced transaction.
+		replacedAnchor, err := fetchBroadcastAnchor(
+			ctx, q, rawBatchKey, anchorOutputIndex,
+		)
+		if err != nil {
+			return err
+		}
+
 		// First, we'll update the genesis packet stored as part of the
 		// batch, as this packet is now fully signed.
 		var psbtBuf bytes.Buffer
 		if err := genesisPkt.Pkt.Serialize(&psbtBuf); err != nil {
 			return err
 		}
-		err := q.UpdateBatchGenesisTx(ctx, GenesisTxUpdate{
+		err = q.UpdateBatchGenesisTx(ctx, GenesisTxUpdate{
 			RawKey:        rawBatchKey,
 			MintingTxPsbt: psbtBuf.Bytes(),
 		})
@@ -1465,6 +1566,21 @@ func (a *AssetMintingStore) CommitSignedGenesisTx(ctx context.Context,
 				err)
 		}
 
+		// The assets are no longer anchored in the output of the
+		// replaced genesis transaction, so we can remove it.
+		if replacedAnchor != nil && *replacedAnchor != anchorPoint {
+			replacedOutpoint, err := encodeOutpoint(*replacedAnchor)
+			if err != nil {
+				return err
+			}
+
+			err = q.DeleteManagedUTXO(ctx, replacedOutpoint)
+			if err != nil {
+				return fmt.Errorf("unable to delete replaced "+
+					"managed utxo: %w", err)
+			}
+		}
+
 		// Next, we'll anchor the genesis point-to-point to the chain
 		// transaction we inserted above.
 		if err := q.AnchorGenesisPoint(ctx, GenesisPointAnchor{
@@ -1483,6 +1599,40 @@ func (a *AssetMintingStore) CommitSignedGenesisTx(ctx context.Context,
 	})
 }
 
+// fetchBroadcastAnchor returns the anchor outpoint of the genesis transaction
+// of a batch, if the batch was already broadcast.
+func fetchBroadcastAnchor(ctx context.Context, q PendingAssetStore,
+	rawBatchKey []byte, anchorOutputIndex uint32) (*wire.OutPoint, error) {
+
+	dbBatch, err := q.FetchMintingBatch(ctx, rawBatchKey)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch minting batch: %w", err)
+	}
+
+	if dbBatch.BatchState != int16(tapgarden.BatchStateBroadcast) ||
+		len(dbBatch.MintingTxPsbt) == 0 {
+
+		return nil, nil
+	}
+
+	genesisPkt, err := psbt.NewFromRawBytes(
+		bytes.NewReader(dbBatch.MintingTxPsbt), false,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode genesis psbt: %w", err)
+	}
+
+	genesisTx, err := psbt.Extract(genesisPkt)
+	if err != nil {
+		return nil, fmt.Errorf("unable to extract genesis tx: %w", err)
+	}
+
+	return &wire.OutPoint{
+		Hash:  genesisTx.TxHash(),
+		Index: anchorOutputIndex,
+	}, nil
+}
+
 // MarkBatchConfirmed stores final confirmation information for a batch on
 // disk.
 func (a *AssetMintingStore) MarkBatchConfirmed(ctx context.Context,
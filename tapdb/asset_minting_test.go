@@ -81,6 +81,7 @@ func assertBatchEqual(t *testing.T, a, b *tapgarden.MintingBatch) {
 	require.Equal(t, a.State(), b.State())
 	require.Equal(t, a.TapSibling(), b.TapSibling())
 	require.Equal(t, a.BatchKey, b.BatchKey)
+	require.Equal(t, a.Name, b.Name)
 	require.Equal(t, a.Seedlings, b.Seedlings)
 	assertPsbtEqual(t, a.GenesisPacket, b.GenesisPacket)
 	require.Equal(t, a.RootAssetCommitment, b.RootAssetCommitment)
@@ -488,6 +489,127 @@ func TestCommitMintingBatchSeedlings(t *testing.T) {
 	assertSeedlingBatchLen(t, mintingBatches, 1, numSeedlings)
 }
 
+// TestMintingBatchNames tests that the name of a minting batch is stored, and
+// that the name of a pending batch is unique among all pending batches.
+func TestMintingBatchNames(t *testing.T) {
+	t.Parallel()
+
+	assetStore, _, _ := newAssetStore(t)
+
+	ctx := context.Background()
+	const numSeedlings = 2
+
+	// Batches without a name can be written multiple times.
+	for i := 0; i < 2; i++ {
+		unnamedBatch := tapgarden.RandSeedlingMintingBatch(
+			t, numSeedlings,
+		)
+		err := assetStore.CommitMintingBatch(ctx, unnamedBatch)
+		require.NoError(t, err)
+	}
+
+	// We'll now write a named batch, and make sure that we read back the
+	// same name.
+	namedBatch := tapgarden.RandSeedlingMintingBatch(t, numSeedlings)
+	namedBatch.Name = "named-batch"
+	err := assetStore.CommitMintingBatch(ctx, namedBatch)
+	require.NoError(t, err)
+
+	batchKey := namedBatch.BatchKey.PubKey
+	dbBatch, err := assetStore.FetchMintingBatch(ctx, batchKey)
+	require.NoError(t, err)
+	assertBatchEqual(t, namedBatch, dbBatch)
+
+	// Another pending batch can't use the same name.
+	duplicateBatch := tapgarden.RandSeedlingMintingBatch(t, numSeedlings)
+	duplicateBatch.Name = namedBatch.Name
+	err = assetStore.CommitMintingBatch(ctx, duplicateBatch)
+	require.Error(t, err)
+
+	// Once the named batch is no longer pending, the name can be used
+	// again.
+	require.NoError(t, assetStore.UpdateBatchState(
+		ctx, batchKey, tapgarden.BatchStateFrozen,
+	))
+	err = assetStore.CommitMintingBatch(ctx, duplicateBatch)
+	require.NoError(t, err)
+
+	mintingBatches := noError1(t, assetStore.FetchNonFinalBatches, ctx)
+	namedBatches := fn.Filter(
+		mintingBatches, func(b *tapgarden.MintingBatch) bool {
+			return b.Name == namedBatch.Name
+		},
+	)
+	require.Len(t, namedBatches, 2)
+}
+
+// TestUpdateAndRemoveSeedlings tests that single seedlings of a pending batch
+// can be updated and removed.
+func TestUpdateAndRemoveSeedlings(t *testing.T) {
+	t.Parallel()
+
+	assetStore, _, _ := newAssetStore(t)
+
+	ctx := context.Background()
+	const numSeedlings = 4
+
+	// First, we'll write a new minting batch with a set of random
+	// seedlings to disk.
+	mintingBatch := tapgarden.RandSeedlingMintingBatch(t, numSeedlings)
+	err := assetStore.CommitMintingBatch(ctx, mintingBatch)
+	require.NoError(t, err)
+
+	batchKey := mintingBatch.BatchKey.PubKey
+	seedlingNames := maps.Keys(mintingBatch.Seedlings)
+
+	// Removing a seedling should leave the rest of the batch untouched.
+	removedName := seedlingNames[0]
+	err = assetStore.RemoveSeedlingFromBatch(ctx, batchKey, removedName)
+	require.NoError(t, err)
+	delete(mintingBatch.Seedlings, removedName)
+
+	dbBatch, err := assetStore.FetchMintingBatch(ctx, batchKey)
+	require.NoError(t, err)
+	assertBatchEqual(t, mintingBatch, dbBatch)
+
+	// Next, we'll update the amount and metadata of one seedling, and make
+	// it a group anchor for another seedling.
+	anchor := *mintingBatch.Seedlings[seedlingNames[1]]
+	anchor.Amount++
+	anchor.Meta = &proof.MetaReveal{
+		Data: test.RandBytes(32),
+	}
+	anchor.EnableEmission = true
+	groupInternalKey, _ := test.RandKeyDesc(t)
+	anchor.GroupInternalKey = &groupInternalKey
+	anchor.GroupTapscriptRoot = test.RandBytes(32)
+
+	err = assetStore.UpdateSeedlingInBatch(ctx, batchKey, &anchor)
+	require.NoError(t, err)
+	mintingBatch.Seedlings[anchor.AssetName] = &anchor
+
+	member := *mintingBatch.Seedlings[seedlingNames[2]]
+	member.EnableEmission = false
+	member.GroupAnchor = &anchor.AssetName
+
+	err = assetStore.UpdateSeedlingInBatch(ctx, batchKey, &member)
+	require.NoError(t, err)
+	mintingBatch.Seedlings[member.AssetName] = &member
+
+	dbBatch, err = assetStore.FetchMintingBatch(ctx, batchKey)
+	require.NoError(t, err)
+	assertBatchEqual(t, mintingBatch, dbBatch)
+
+	// Updating a seedling that isn't part of the batch should fail.
+	err = assetStore.UpdateSeedlingInBatch(
+		ctx, batchKey, &tapgarden.Seedling{
+			AssetName: removedName,
+			Amount:    1,
+		},
+	)
+	require.ErrorContains(t, err, "unable to fetch seedling")
+}
+
 // seedlingsToAssetRoot maps a set of seedlings to an asset root.
 //
 // TODO(roasbeef): same func in tapgarden can just re-use?
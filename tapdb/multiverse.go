@@ -7,6 +7,7 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"math"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -31,6 +32,10 @@ const (
 	// transferMultiverseNS is the namespace used for the multiverse
 	// issuance proofs.
 	transferMultiverseNS = "multiverse-transfer"
+
+	// transferPruneBatchSize is the number of transfer leaves that are
+	// indexed or pruned in a single database transaction.
+	transferPruneBatchSize = 500
 )
 
 var (
@@ -54,6 +59,28 @@ type (
 	// QueryMultiverseLeaves is used to query for a set of leaves based on
 	// the proof type and asset ID (or group key)
 	QueryMultiverseLeaves = sqlc.QueryMultiverseLeavesParams
+
+	// UnanchoredLeavesQuery is used to query for the transfer leaves that
+	// don't have their anchor block tracked yet.
+	UnanchoredLeavesQuery = sqlc.QueryUnanchoredTransferLeavesParams
+
+	// UnanchoredLeaf is a transfer leaf that doesn't have its anchor
+	// block tracked yet.
+	UnanchoredLeaf = sqlc.QueryUnanchoredTransferLeavesRow
+
+	// PrunableLeavesQuery is used to query for the superseded transfer
+	// leaves that can be pruned.
+	PrunableLeavesQuery = sqlc.QueryPrunableTransferLeavesParams
+
+	// PrunableLeaf is a superseded transfer leaf that can be pruned.
+	PrunableLeaf = sqlc.QueryPrunableTransferLeavesRow
+
+	// PrunedLeaf is the tombstone of a transfer leaf that was pruned.
+	PrunedLeaf = sqlc.InsertUniversePrunedLeafParams
+
+	// PrunedLeafQuery is used to look up the tombstone of a pruned
+	// transfer leaf.
+	PrunedLeafQuery = sqlc.FetchUniversePrunedLeafParams
 )
 
 // BaseMultiverseStore is used to interact with a set of base universe
@@ -75,6 +102,39 @@ type BaseMultiverseStore interface {
 	// given target namespace (proof type in this case).
 	FetchMultiverseRoot(ctx context.Context,
 		proofNamespace string) (MultiverseRoot, error)
+
+	// QueryUnanchoredTransferLeaves returns the transfer leaves that don't
+	// have their anchor block tracked yet, along with their proofs.
+	QueryUnanchoredTransferLeaves(ctx context.Context,
+		arg UnanchoredLeavesQuery) ([]UnanchoredLeaf, error)
+
+	// QueryPrunableTransferLeaves returns the superseded transfer leaves
+	// that are anchored at or below the max block height, or in a block
+	// older than the min block timestamp.
+	QueryPrunableTransferLeaves(ctx context.Context,
+		arg PrunableLeavesQuery) ([]PrunableLeaf, error)
+
+	// DeleteFederationProofSyncLogsForLeaf deletes all proof sync log
+	// entries of a universe leaf.
+	DeleteFederationProofSyncLogsForLeaf(ctx context.Context,
+		leafID int64) error
+
+	// DeleteUniverseLeaf deletes a single universe leaf.
+	DeleteUniverseLeaf(ctx context.Context, id int64) error
+
+	// DeleteUnreachableNodes deletes all MS-SMT nodes of a namespace that
+	// can't be reached from the root of the tree.
+	DeleteUnreachableNodes(ctx context.Context,
+		namespace string) (int64, error)
+
+	// InsertUniversePrunedLeaf stores the tombstone of a pruned transfer
+	// leaf.
+	InsertUniversePrunedLeaf(ctx context.Context, arg PrunedLeaf) error
+
+	// FetchUniversePrunedLeaf returns the ID of the tombstone of a pruned
+	// transfer leaf.
+	FetchUniversePrunedLeaf(ctx context.Context,
+		arg PrunedLeafQuery) (int64, error)
 }
 
 // BaseMultiverseOptions is the set of options for multiverse queries.
@@ -514,16 +574,24 @@ type MultiverseStore struct {
 	// proofs. And since the custodian is only interested in transfer
 	// proofs, we only signal on transfer proofs.
 	transferProofDistributor *fn.EventDistributor[proof.Blob]
+
+	// leafEventDistributor is an event distributor that will be used to
+	// notify subscribers about every new proof leaf that is added to the
+	// multiverse, regardless of its proof type.
+	leafEventDistributor *fn.EventDistributor[*universe.LeafEvent]
 }
 
 // NewMultiverseStore creates a new multiverse DB store handle.
 func NewMultiverseStore(db BatchedMultiverse) *MultiverseStore {
+	leafEventDistributor := fn.NewEventDistributor[*universe.LeafEvent]()
+
 	return &MultiverseStore{
 		db:                       db,
 		rootNodeCache:            newRootNodeCache(),
 		proofCache:               newProofCache(),
 		leafKeysCache:            newUniverseLeafCache(),
 		transferProofDistributor: fn.NewEventDistributor[proof.Blob](),
+		leafEventDistributor:     leafEventDistributor,
 	}
 }
 
@@ -692,6 +760,32 @@ func (b *MultiverseStore) UniverseLeafKeys(ctx context.Context,
 	return leafKeys, nil
 }
 
+// UniverseTreeNodes returns the nodes of the given universe tree at the given
+// paths.
+func (b *MultiverseStore) UniverseTreeNodes(ctx context.Context,
+	id universe.Identifier,
+	paths []universe.TreeNodePath) ([]universe.TreeNode, error) {
+
+	var nodes []universe.TreeNode
+
+	readTx := NewBaseUniverseReadTx()
+	dbErr := b.db.ExecTx(ctx, &readTx, func(db BaseMultiverseStore) error {
+		dbNodes, err := universeTreeNodes(ctx, db, id.String(), paths)
+		if err != nil {
+			return err
+		}
+
+		nodes = dbNodes
+
+		return nil
+	})
+	if dbErr != nil {
+		return nil, dbErr
+	}
+
+	return nodes, nil
+}
+
 // RootNodes returns the complete set of known base universe root nodes for the
 // set of base universes tracked in the multiverse.
 func (b *MultiverseStore) RootNodes(ctx context.Context,
@@ -846,11 +940,6 @@ func (b *MultiverseStore) FetchProofLeaf(ctx context.Context,
 		proofs []*universe.Proof
 	)
 
-	multiverseNS, err := namespaceForProof(id.ProofType)
-	if err != nil {
-		return nil, err
-	}
-
 	dbErr := b.db.ExecTx(ctx, &readTx, func(dbTx BaseMultiverseStore) error {
 		var err error
 		proofs, err = universeFetchProofLeaf(
@@ -861,26 +950,8 @@ func (b *MultiverseStore) FetchProofLeaf(ctx context.Context,
 		}
 
 		// Populate multiverse specific fields of proofs.
-		//
-		// Retrieve a handle to the multiverse MS-SMT tree.
-		multiverseTree := mssmt.NewCompactedTree(
-			newTreeStoreWrapperTx(dbTx, multiverseNS),
-		)
-
-		multiverseRoot, err := multiverseTree.Root(ctx)
-		if err != nil {
-			return err
-		}
-
-		// Use asset ID (or asset group hash) as the upper tree leaf
-		// node key. This is the same as the asset specific universe ID.
-		leafNodeKey := id.Bytes()
-
-		// Retrieve the multiverse inclusion proof for the asset
-		// specific universe.
-		multiverseInclusionProof, err := multiverseTree.MerkleProof(
-			ctx, leafNodeKey,
-		)
+		multiverseRoot, multiverseInclusionProof, err :=
+			multiverseFetchInclusionProof(ctx, id, dbTx)
 		if err != nil {
 			return err
 		}
@@ -902,6 +973,135 @@ func (b *MultiverseStore) FetchProofLeaf(ctx context.Context,
 	return proofs, nil
 }
 
+// multiverseFetchInclusionProof returns the multiverse root and the inclusion
+// proof of the given universe within the multiverse.
+func multiverseFetchInclusionProof(ctx context.Context, id universe.Identifier,
+	dbTx BaseMultiverseStore) (mssmt.Node, *mssmt.Proof, error) {
+
+	multiverseNS, err := namespaceForProof(id.ProofType)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Retrieve a handle to the multiverse MS-SMT tree.
+	multiverseTree := mssmt.NewCompactedTree(
+		newTreeStoreWrapperTx(dbTx, multiverseNS),
+	)
+
+	multiverseRoot, err := multiverseTree.Root(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Use asset ID (or asset group hash) as the upper tree leaf node key.
+	// This is the same as the asset specific universe ID.
+	leafNodeKey := id.Bytes()
+
+	// Retrieve the multiverse inclusion proof for the asset specific
+	// universe.
+	multiverseInclusionProof, err := multiverseTree.MerkleProof(
+		ctx, leafNodeKey,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return multiverseRoot, multiverseInclusionProof, nil
+}
+
+// FetchProofLeaves returns a multi-proof for the leaves at the given keys,
+// which must all have a script key specified. Keys that don't have a leaf in
+// the universe are proven to not be included. All leaves are read within a
+// single transaction, so they're all proven against the same universe root.
+func (b *MultiverseStore) FetchProofLeaves(ctx context.Context,
+	id universe.Identifier,
+	keys []universe.LeafKey) (*universe.MultiProof, error) {
+
+	for _, key := range keys {
+		if key.ScriptKey == nil {
+			return nil, fmt.Errorf("leaf key for outpoint %v is "+
+				"missing script key", key.OutPoint)
+		}
+	}
+
+	var (
+		readTx     = NewBaseUniverseReadTx()
+		multiProof *universe.MultiProof
+	)
+	dbErr := b.db.ExecTx(ctx, &readTx, func(dbTx BaseMultiverseStore) error {
+		universeTree := mssmt.NewCompactedTree(
+			newTreeStoreWrapperTx(dbTx, id.String()),
+		)
+
+		universeRoot, err := universeTree.Root(ctx)
+		if err != nil {
+			return err
+		}
+
+		var (
+			smtKeys    = make([][32]byte, len(keys))
+			leafNodes  = make([]*mssmt.LeafNode, len(keys))
+			leafProofs = make([]*mssmt.Proof, len(keys))
+			leaves     = make([]*universe.Leaf, len(keys))
+		)
+		for i, key := range keys {
+			smtKeys[i] = key.UniverseKey()
+
+			proofs, err := universeFetchProofLeaf(ctx, id, key, dbTx)
+			switch {
+			// If there's no leaf at the key, we'll prove its
+			// non-inclusion instead.
+			case errors.Is(err, universe.ErrNoUniverseProofFound):
+				leafNodes[i] = mssmt.EmptyLeafNode
+				leafProofs[i], err = universeTree.MerkleProof(
+					ctx, smtKeys[i],
+				)
+				if err != nil {
+					return err
+				}
+
+				continue
+
+			case err != nil:
+				return err
+			}
+
+			leaves[i] = proofs[0].Leaf
+			leafNodes[i] = proofs[0].Leaf.SmtLeafNode()
+			leafProofs[i] = proofs[0].UniverseInclusionProof
+		}
+
+		uniProof, _, err := mssmt.NewMultiProof(
+			smtKeys, leafNodes, leafProofs,
+		)
+		if err != nil {
+			return err
+		}
+
+		multiverseRoot, multiverseInclusionProof, err :=
+			multiverseFetchInclusionProof(ctx, id, dbTx)
+		if err != nil {
+			return err
+		}
+
+		multiProof = &universe.MultiProof{
+			LeafKeys:                 keys,
+			Leaves:                   leaves,
+			UniverseRoot:             universeRoot,
+			UniverseInclusionProof:   uniProof,
+			MultiverseRoot:           multiverseRoot,
+			MultiverseInclusionProof: multiverseInclusionProof,
+		}
+
+		return nil
+	})
+	if dbErr != nil {
+		return nil, dbErr
+	}
+
+	return multiProof, nil
+}
+
 // FetchProof fetches a proof for an asset uniquely identified by the passed
 // Locator. The returned blob contains the encoded full proof file, representing
 // the complete provenance of the asset.
@@ -986,13 +1186,14 @@ func (b *MultiverseStore) UpsertProofLeaf(ctx context.Context,
 	var (
 		writeTx       BaseMultiverseOptions
 		issuanceProof *universe.Proof
+		eventSeq      int64
 	)
 
 	execTxFunc := func(dbTx BaseMultiverseStore) error {
 		// Register issuance in the asset (group) specific universe
 		// tree.
 		var err error
-		issuanceProof, err = universeUpsertProofLeaf(
+		issuanceProof, eventSeq, err = universeUpsertProofLeaf(
 			ctx, dbTx, id, key, leaf, metaReveal,
 		)
 		if err != nil {
@@ -1020,6 +1221,12 @@ func (b *MultiverseStore) UpsertProofLeaf(ctx context.Context,
 	if id.ProofType == universe.ProofTypeTransfer {
 		b.transferProofDistributor.NotifySubscribers(leaf.RawProof)
 	}
+	b.leafEventDistributor.NotifySubscribers(&universe.LeafEvent{
+		Cursor: uint64(eventSeq),
+		ID:     id,
+		Key:    key,
+		Leaf:   leaf,
+	})
 
 	return issuanceProof, nil
 }
@@ -1029,12 +1236,13 @@ func (b *MultiverseStore) UpsertProofLeaf(ctx context.Context,
 func (b *MultiverseStore) UpsertProofLeafBatch(ctx context.Context,
 	items []*universe.Item) error {
 
-	insertProof := func(item *universe.Item,
-		dbTx BaseMultiverseStore) error {
+	leafEvents := make([]*universe.LeafEvent, len(items))
+	insertProof := func(idx int, dbTx BaseMultiverseStore) error {
+		item := items[idx]
 
 		// Upsert proof leaf into the asset (group) specific universe
 		// tree.
-		_, err := universeUpsertProofLeaf(
+		_, eventSeq, err := universeUpsertProofLeaf(
 			ctx, dbTx, item.ID, item.Key, item.Leaf,
 			item.MetaReveal,
 		)
@@ -1042,6 +1250,13 @@ func (b *MultiverseStore) UpsertProofLeafBatch(ctx context.Context,
 			return err
 		}
 
+		leafEvents[idx] = &universe.LeafEvent{
+			Cursor: uint64(eventSeq),
+			ID:     item.ID,
+			Key:    item.Key,
+			Leaf:   item.Leaf,
+		}
+
 		return nil
 	}
 
@@ -1049,8 +1264,7 @@ func (b *MultiverseStore) UpsertProofLeafBatch(ctx context.Context,
 	dbErr := b.db.ExecTx(
 		ctx, &writeTx, func(store BaseMultiverseStore) error {
 			for idx := range items {
-				item := items[idx]
-				err := insertProof(item, store)
+				err := insertProof(idx, store)
 				if err != nil {
 					return err
 				}
@@ -1078,6 +1292,7 @@ func (b *MultiverseStore) UpsertProofLeafBatch(ctx context.Context,
 			)
 		}
 	}
+	b.leafEventDistributor.NotifySubscribers(leafEvents...)
 
 	// Invalidate the root node cache for all the assets we just inserted.
 	idsToDelete := fn.NewSet(fn.Map(items, func(item *universe.Item) treeID {
@@ -1130,6 +1345,314 @@ func (b *MultiverseStore) DeleteUniverse(ctx context.Context,
 	return id.String(), dbErr
 }
 
+// indexTransferLeaves tracks the anchor block and spent outputs of the transfer
+// leaves that were inserted before these were tracked on insertion.
+func (b *MultiverseStore) indexTransferLeaves(ctx context.Context) error {
+	var (
+		writeTx   BaseMultiverseOptions
+		minLeafID int64
+		numLeaves int
+	)
+	for {
+		dbErr := b.db.ExecTx(ctx, &writeTx, func(
+			dbTx BaseMultiverseStore) error {
+
+			leaves, err := dbTx.QueryUnanchoredTransferLeaves(
+				ctx, UnanchoredLeavesQuery{
+					MinLeafID: minLeafID,
+					NumLimit:  transferPruneBatchSize,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			numLeaves = len(leaves)
+			for _, leaf := range leaves {
+				minLeafID = leaf.ID
+
+				// A leaf with a proof we can't decode is never
+				// pruned, but it shouldn't stop us from
+				// indexing the other leaves.
+				var leafProof proof.Proof
+				err := leafProof.Decode(
+					bytes.NewReader(leaf.LeafProof),
+				)
+				if err != nil {
+					log.Warnf("Unable to decode proof of "+
+						"transfer leaf %d: %v", leaf.ID,
+						err)
+					continue
+				}
+
+				err = indexTransferLeaf(
+					ctx, dbTx, leaf.ID,
+					leaf.LeafNodeNamespace, &leafProof,
+				)
+				if err != nil {
+					return err
+				}
+			}
+
+			return nil
+		})
+		if dbErr != nil {
+			return fmt.Errorf("unable to index transfer leaves: %w",
+				dbErr)
+		}
+
+		if numLeaves < transferPruneBatchSize {
+			return nil
+		}
+	}
+}
+
+// pruneLeafBatch removes the given superseded transfer leaves along with their
+// proof sync log entries, and updates the affected universe trees and the
+// transfer multiverse tree. The namespaces of the affected universes are
+// returned, along with the number of MS-SMT nodes that were removed.
+func pruneLeafBatch(ctx context.Context, dbTx BaseMultiverseStore,
+	leaves []PrunableLeaf) ([]string, uint64, error) {
+
+	universeIDs := make(map[string]universe.Identifier)
+	for _, leaf := range leaves {
+		namespace := leaf.LeafNodeNamespace
+
+		if _, ok := universeIDs[namespace]; !ok {
+			id := universe.Identifier{
+				ProofType: universe.ProofTypeTransfer,
+			}
+			if leaf.GroupKey != nil {
+				groupKey, err := schnorr.ParsePubKey(
+					leaf.GroupKey,
+				)
+				if err != nil {
+					return nil, 0, fmt.Errorf("unable to "+
+						"parse group key: %w", err)
+				}
+				id.GroupKey = groupKey
+			} else {
+				copy(id.AssetID[:], leaf.AssetID)
+			}
+
+			universeIDs[namespace] = id
+		}
+
+		universeTree := mssmt.NewCompactedTree(
+			newTreeStoreWrapperTx(dbTx, namespace),
+		)
+
+		var smtKey [32]byte
+		copy(smtKey[:], leaf.LeafNodeKey)
+		_, err := universeTree.Delete(ctx, smtKey)
+		if err != nil {
+			return nil, 0, fmt.Errorf("unable to delete transfer "+
+				"leaf: %w", err)
+		}
+
+		err = dbTx.DeleteFederationProofSyncLogsForLeaf(ctx, leaf.ID)
+		if err != nil {
+			return nil, 0, fmt.Errorf("unable to delete proof sync "+
+				"log: %w", err)
+		}
+
+		err = dbTx.DeleteUniverseLeaf(ctx, leaf.ID)
+		if err != nil {
+			return nil, 0, fmt.Errorf("unable to delete universe "+
+				"leaf: %w", err)
+		}
+
+		// We leave a tombstone for the pruned leaf, so the syncer
+		// doesn't fetch it again from servers that still have it.
+		err = dbTx.InsertUniversePrunedLeaf(ctx, PrunedLeaf{
+			LeafNodeNamespace: namespace,
+			LeafNodeKey:       leaf.LeafNodeKey,
+		})
+		if err != nil {
+			return nil, 0, fmt.Errorf("unable to store pruned "+
+				"leaf: %w", err)
+		}
+	}
+
+	multiverseTree := mssmt.NewCompactedTree(
+		newTreeStoreWrapperTx(dbTx, transferMultiverseNS),
+	)
+
+	var (
+		namespaces   = make([]string, 0, len(universeIDs))
+		nodesRemoved uint64
+	)
+	for namespace, id := range universeIDs {
+		namespaces = append(namespaces, namespace)
+
+		// The universe root changed, so we'll need to update its leaf
+		// in the multiverse tree. A superseded leaf is always followed
+		// by the leaf of the transfer that spent it, so the universe
+		// can't be empty.
+		universeTree := mssmt.NewCompactedTree(
+			newTreeStoreWrapperTx(dbTx, namespace),
+		)
+		universeRoot, err := universeTree.Root(ctx)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		_, err = multiverseTree.Insert(
+			ctx, id.Bytes(),
+			universe.NewMultiverseLeafNode(id, universeRoot),
+		)
+		if err != nil {
+			return nil, 0, fmt.Errorf("unable to update multiverse "+
+				"leaf: %w", err)
+		}
+
+		numRemoved, err := dbTx.DeleteUnreachableNodes(ctx, namespace)
+		if err != nil {
+			return nil, 0, fmt.Errorf("unable to delete "+
+				"unreachable nodes: %w", err)
+		}
+		nodesRemoved += uint64(numRemoved)
+	}
+
+	numRemoved, err := dbTx.DeleteUnreachableNodes(
+		ctx, transferMultiverseNS,
+	)
+	if err != nil {
+		return nil, 0, fmt.Errorf("unable to delete unreachable "+
+			"multiverse nodes: %w", err)
+	}
+	nodesRemoved += uint64(numRemoved)
+
+	return namespaces, nodesRemoved, nil
+}
+
+// PruneTransferLeaves removes all superseded transfer leaves that match the
+// given cutoff, and updates the affected universe and multiverse trees. MS-SMT
+// nodes that are no longer referenced by these trees are removed as well.
+// Issuance leaves are never removed.
+func (b *MultiverseStore) PruneTransferLeaves(ctx context.Context,
+	cutoff universe.TransferPruneCutoff) (*universe.TransferPruneResult,
+	error) {
+
+	// Leaves inserted before we tracked their anchor block can only be
+	// pruned once they're indexed.
+	if err := b.indexTransferLeaves(ctx); err != nil {
+		return nil, err
+	}
+
+	query := PrunableLeavesQuery{
+		NumLimit: transferPruneBatchSize,
+	}
+	cutoff.MaxBlockHeight.WhenSome(func(height uint32) {
+		query.MaxBlockHeight = sqlInt32(height)
+	})
+	cutoff.MinBlockTime.WhenSome(func(blockTime time.Time) {
+		query.MinBlockTimestamp = sql.NullTime{
+			Time:  blockTime.UTC(),
+			Valid: true,
+		}
+	})
+
+	var (
+		writeTx BaseMultiverseOptions
+		result  universe.TransferPruneResult
+	)
+	for {
+		var (
+			numLeaves    int
+			namespaces   []string
+			nodesRemoved uint64
+		)
+		dbErr := b.db.ExecTx(ctx, &writeTx, func(
+			dbTx BaseMultiverseStore) error {
+
+			leaves, err := dbTx.QueryPrunableTransferLeaves(
+				ctx, query,
+			)
+			if err != nil {
+				return err
+			}
+
+			numLeaves = len(leaves)
+			if numLeaves == 0 {
+				return nil
+			}
+
+			namespaces, nodesRemoved, err = pruneLeafBatch(
+				ctx, dbTx, leaves,
+			)
+			return err
+		})
+		if dbErr != nil {
+			return nil, fmt.Errorf("unable to prune transfer "+
+				"leaves: %w", dbErr)
+		}
+
+		result.LeavesPruned += uint64(numLeaves)
+		result.NodesRemoved += nodesRemoved
+
+		// Invalidate the caches of the universes we just pruned.
+		if len(namespaces) != 0 {
+			b.rootNodeCache.wipeCache()
+		}
+		for _, namespace := range namespaces {
+			b.proofCache.Delete(treeID(namespace))
+			b.leafKeysCache.wipeCache(treeID(namespace))
+		}
+
+		if numLeaves < transferPruneBatchSize {
+			return &result, nil
+		}
+	}
+}
+
+// PrunedLeafKeys returns the subset of the given leaf keys whose leaves were
+// pruned from the given universe.
+func (b *MultiverseStore) PrunedLeafKeys(ctx context.Context,
+	id universe.Identifier,
+	leafKeys []universe.LeafKey) ([]universe.LeafKey, error) {
+
+	// Issuance leaves are never pruned.
+	if id.ProofType != universe.ProofTypeTransfer || len(leafKeys) == 0 {
+		return nil, nil
+	}
+
+	var (
+		readTx     = NewBaseUniverseReadTx()
+		namespace  = id.String()
+		prunedKeys []universe.LeafKey
+	)
+	dbErr := b.db.ExecTx(ctx, &readTx, func(q BaseMultiverseStore) error {
+		prunedKeys = nil
+
+		for _, key := range leafKeys {
+			smtKey := key.UniverseKey()
+			query := PrunedLeafQuery{
+				LeafNodeNamespace: namespace,
+				LeafNodeKey:       smtKey[:],
+			}
+			_, err := q.FetchUniversePrunedLeaf(ctx, query)
+			switch {
+			case errors.Is(err, sql.ErrNoRows):
+				continue
+
+			case err != nil:
+				return err
+			}
+
+			prunedKeys = append(prunedKeys, key)
+		}
+
+		return nil
+	})
+	if dbErr != nil {
+		return nil, fmt.Errorf("unable to fetch pruned leaves: %w",
+			dbErr)
+	}
+
+	return prunedKeys, nil
+}
+
 // FetchLeaves returns the set of multiverse leaves for the given proof type,
 // asset ID, and group key. If both asset ID and group key is nil, all leaves
 // for the given proof type will be returned.
@@ -1274,6 +1797,141 @@ func (b *MultiverseStore) RemoveSubscriber(
 	return b.transferProofDistributor.RemoveSubscriber(subscriber)
 }
 
+// FetchLeafEvents returns the leaves that were inserted after the cursor of
+// the given query and that match its filters, in insertion order.
+func (b *MultiverseStore) FetchLeafEvents(ctx context.Context,
+	q universe.LeafEventQuery) ([]*universe.LeafEvent, error) {
+
+	dbQuery := UniverseLeafEventsQuery{
+		AfterSeq: int64(q.AfterCursor),
+		NumLimit: q.Limit,
+	}
+	if q.Limit == 0 {
+		dbQuery.NumLimit = math.MaxInt32
+	}
+	if q.AssetID != nil {
+		dbQuery.AssetID = q.AssetID[:]
+	}
+	if q.GroupKey != nil {
+		dbQuery.GroupKey = schnorr.SerializePubKey(q.GroupKey)
+	}
+	if q.ProofType != universe.ProofTypeUnspecified {
+		dbQuery.ProofType = sqlStr(q.ProofType.String())
+	}
+
+	var (
+		readTx = NewBaseMultiverseReadTx()
+		events []*universe.LeafEvent
+	)
+	dbErr := b.db.ExecTx(ctx, &readTx, func(q BaseMultiverseStore) error {
+		dbEvents, err := q.QueryUniverseLeafEvents(ctx, dbQuery)
+		if err != nil {
+			return err
+		}
+
+		events, err = fn.MapErr(dbEvents, parseLeafEvent)
+		return err
+	})
+	if dbErr != nil {
+		return nil, fmt.Errorf("unable to query leaf events: %w", dbErr)
+	}
+
+	return events, nil
+}
+
+// LatestLeafCursor returns the cursor of the most recently inserted leaf, or
+// zero if no leaves were inserted yet.
+func (b *MultiverseStore) LatestLeafCursor(ctx context.Context) (uint64,
+	error) {
+
+	var (
+		readTx   = NewBaseMultiverseReadTx()
+		eventSeq int64
+	)
+	dbErr := b.db.ExecTx(ctx, &readTx, func(q BaseMultiverseStore) error {
+		var err error
+		eventSeq, err = q.FetchLatestUniverseLeafEventSeq(ctx)
+		if errors.Is(err, sql.ErrNoRows) {
+			eventSeq = 0
+			return nil
+		}
+
+		return err
+	})
+	if dbErr != nil {
+		return 0, fmt.Errorf("unable to fetch latest leaf event: %w",
+			dbErr)
+	}
+
+	return uint64(eventSeq), nil
+}
+
+// parseLeafEvent parses a universe leaf event from its database row.
+func parseLeafEvent(dbEvent UniverseLeafEvent) (*universe.LeafEvent, error) {
+	leafKey, err := parseLeafKey(
+		dbEvent.MintingPoint, dbEvent.ScriptKeyBytes,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// We only need to obtain the asset at this point, so we'll do a
+	// sparse decode here to decode only the asset record.
+	var leafAsset asset.Asset
+	assetRecord := proof.AssetLeafRecord(&leafAsset)
+	err = proof.SparseDecode(
+		bytes.NewReader(dbEvent.LeafProof), assetRecord,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode proof: %w", err)
+	}
+
+	id := universe.Identifier{
+		AssetID: leafAsset.ID(),
+	}
+	id.ProofType, err = universe.ParseStrProofType(dbEvent.ProofType)
+	if err != nil {
+		return nil, err
+	}
+	if len(dbEvent.GroupKey) > 0 {
+		id.GroupKey, err = schnorr.ParsePubKey(dbEvent.GroupKey)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &universe.LeafEvent{
+		Cursor: uint64(dbEvent.Seq),
+		ID:     id,
+		Key:    leafKey,
+		Leaf: &universe.Leaf{
+			GenesisWithGroup: universe.GenesisWithGroup{
+				Genesis:  leafAsset.Genesis,
+				GroupKey: leafAsset.GroupKey,
+			},
+			RawProof: dbEvent.LeafProof,
+			Asset:    &leafAsset,
+			Amt:      uint64(dbEvent.LeafSum),
+		},
+	}, nil
+}
+
+// RegisterLeafSubscriber registers a subscriber that is notified about every
+// leaf that is inserted into a universe.
+func (b *MultiverseStore) RegisterLeafSubscriber(
+	receiver *fn.EventReceiver[*universe.LeafEvent]) {
+
+	b.leafEventDistributor.RegisterSubscriber(receiver)
+}
+
+// RemoveLeafSubscriber removes the given leaf subscriber and stops it from
+// processing events.
+func (b *MultiverseStore) RemoveLeafSubscriber(
+	receiver *fn.EventReceiver[*universe.LeafEvent]) error {
+
+	return b.leafEventDistributor.RemoveSubscriber(receiver)
+}
+
 // A compile-time interface to ensure MultiverseStore meets the
 // proof.NotifyArchiver interface.
 var _ proof.NotifyArchiver = (*MultiverseStore)(nil)
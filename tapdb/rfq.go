@@ -0,0 +1,1141 @@
+package tapdb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/rfq"
+	"github.com/lightninglabs/taproot-assets/rfqmsg"
+	"github.com/lightninglabs/taproot-assets/tapdb/sqlc"
+	"github.com/lightningnetwork/lnd/clock"
+	invpkg "github.com/lightningnetwork/lnd/invoices"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+const (
+	// rfqQuoteTypeBuy is the quote type of a peer accepted buy quote.
+	rfqQuoteTypeBuy = "buy"
+
+	// rfqQuoteTypeSell is the quote type of a peer accepted sell quote.
+	rfqQuoteTypeSell = "sell"
+
+	// rfqPolicyTypeAssetSale is the policy type of an asset sale policy.
+	rfqPolicyTypeAssetSale = "asset_sale"
+
+	// rfqPolicyTypeAssetPurchase is the policy type of an asset purchase
+	// policy.
+	rfqPolicyTypeAssetPurchase = "asset_purchase"
+
+	// rfqQuoteStatusRequested is the status of a logged quote which has
+	// not been answered yet.
+	rfqQuoteStatusRequested = "requested"
+
+	// rfqQuoteStatusAccepted is the status of a logged quote which was
+	// accepted.
+	rfqQuoteStatusAccepted = "accepted"
+
+	// rfqQuoteStatusRejected is the status of a logged quote which was
+	// rejected.
+	rfqQuoteStatusRejected = "rejected"
+
+	// rfqQuoteStatusExpired is the status of a logged quote which expired
+	// without being traded against.
+	rfqQuoteStatusExpired = "expired"
+
+	// rfqTradeStatusPending is the status of a trade whose HTLC hasn't
+	// been resolved yet.
+	rfqTradeStatusPending = "pending"
+
+	// rfqTradeStatusSettled is the status of a trade whose HTLC was
+	// settled.
+	rfqTradeStatusSettled = "settled"
+
+	// rfqTradeStatusFailed is the status of a trade whose HTLC was failed.
+	rfqTradeStatusFailed = "failed"
+)
+
+type (
+	// NewRfqPeerAcceptedQuote is used to insert or update a peer accepted
+	// quote.
+	NewRfqPeerAcceptedQuote = sqlc.UpsertRfqPeerAcceptedQuoteParams
+
+	// RfqPeerAcceptedQuote is a peer accepted quote returned from a query.
+	RfqPeerAcceptedQuote = sqlc.FetchRfqPeerAcceptedQuotesRow
+
+	// NewRfqPolicy is used to insert or update an HTLC policy.
+	NewRfqPolicy = sqlc.UpsertRfqPolicyParams
+
+	// RfqPolicy is an HTLC policy returned from a query.
+	RfqPolicy = sqlc.FetchRfqPoliciesRow
+
+	// NewRfqQuoteLog is used to insert a new quote into the quote ledger.
+	NewRfqQuoteLog = sqlc.InsertRfqQuoteLogParams
+
+	// RfqQuoteLogAccepted is used to mark a logged quote as accepted.
+	RfqQuoteLogAccepted = sqlc.UpdateRfqQuoteLogAcceptedParams
+
+	// RfqQuoteLogRejected is used to mark a logged quote as rejected.
+	RfqQuoteLogRejected = sqlc.UpdateRfqQuoteLogRejectedParams
+
+	// RfqQuoteLogExpired is used to mark the expired logged quotes.
+	RfqQuoteLogExpired = sqlc.MarkExpiredRfqQuoteLogsParams
+
+	// RfqQuoteLogQuery is used to query the quote ledger.
+	RfqQuoteLogQuery = sqlc.QueryRfqQuoteLogsParams
+
+	// RfqQuoteLog is a logged quote returned from a query.
+	RfqQuoteLog = sqlc.QueryRfqQuoteLogsRow
+
+	// NewRfqTrade is used to insert a new trade into the quote ledger.
+	NewRfqTrade = sqlc.InsertRfqTradeParams
+
+	// RfqTradeResolution is used to mark a pending trade as settled or
+	// failed.
+	RfqTradeResolution = sqlc.ResolveRfqTradeParams
+
+	// RfqTradeQuery is used to query the trades of the quote ledger.
+	RfqTradeQuery = sqlc.QueryRfqTradesParams
+
+	// RfqTrade is a trade returned from a query.
+	RfqTrade = sqlc.QueryRfqTradesRow
+)
+
+// RfqQuoteStore is the database interface used to persist RFQ quotes and
+// policies.
+type RfqQuoteStore interface {
+	// UpsertRfqPeerAcceptedQuote inserts or updates a peer accepted quote.
+	UpsertRfqPeerAcceptedQuote(ctx context.Context,
+		arg NewRfqPeerAcceptedQuote) error
+
+	// FetchRfqPeerAcceptedQuotes returns all peer accepted quotes with an
+	// expiry timestamp equal to or greater than the given timestamp.
+	FetchRfqPeerAcceptedQuotes(ctx context.Context,
+		minExpiry int64) ([]RfqPeerAcceptedQuote, error)
+
+	// DeleteExpiredRfqPeerAcceptedQuotes removes all peer accepted quotes
+	// with an expiry timestamp lower than the given timestamp.
+	DeleteExpiredRfqPeerAcceptedQuotes(ctx context.Context,
+		minExpiry int64) (int64, error)
+
+	// UpsertRfqPolicy inserts or updates an HTLC policy.
+	UpsertRfqPolicy(ctx context.Context, arg NewRfqPolicy) error
+
+	// FetchRfqPolicies returns all HTLC policies with an expiry timestamp
+	// equal to or greater than the given timestamp.
+	FetchRfqPolicies(ctx context.Context, minExpiry int64) ([]RfqPolicy,
+		error)
+
+	// DeleteExpiredRfqPolicies removes all HTLC policies with an expiry
+	// timestamp lower than the given timestamp.
+	DeleteExpiredRfqPolicies(ctx context.Context, minExpiry int64) (int64,
+		error)
+
+	// InsertRfqQuoteLog inserts a new quote request into the quote ledger.
+	// A quote request that is already logged is ignored.
+	InsertRfqQuoteLog(ctx context.Context, arg NewRfqQuoteLog) error
+
+	// UpdateRfqQuoteLogAccepted marks a requested quote as accepted and
+	// returns the number of updated quotes.
+	UpdateRfqQuoteLogAccepted(ctx context.Context,
+		arg RfqQuoteLogAccepted) (int64, error)
+
+	// UpdateRfqQuoteLogRejected marks a requested quote as rejected and
+	// returns the number of updated quotes.
+	UpdateRfqQuoteLogRejected(ctx context.Context,
+		arg RfqQuoteLogRejected) (int64, error)
+
+	// MarkExpiredRfqQuoteLogs marks all accepted quotes which expired
+	// without being traded against as expired.
+	MarkExpiredRfqQuoteLogs(ctx context.Context,
+		arg RfqQuoteLogExpired) (int64, error)
+
+	// QueryRfqQuoteLogs returns the logged quotes that match the query.
+	QueryRfqQuoteLogs(ctx context.Context,
+		arg RfqQuoteLogQuery) ([]RfqQuoteLog, error)
+
+	// DeleteUntradedRfqQuoteLogs removes all logged quotes which were
+	// created before the given time and never traded against.
+	DeleteUntradedRfqQuoteLogs(ctx context.Context,
+		minCreatedAt time.Time) (int64, error)
+
+	// InsertRfqTrade inserts a new trade for a logged quote and returns
+	// the number of inserted trades.
+	InsertRfqTrade(ctx context.Context, arg NewRfqTrade) (int64, error)
+
+	// ResolveRfqTrade marks a pending trade as settled or failed and
+	// returns the number of updated trades.
+	ResolveRfqTrade(ctx context.Context, arg RfqTradeResolution) (int64,
+		error)
+
+	// QueryRfqTrades returns the trades that match the query.
+	QueryRfqTrades(ctx context.Context, arg RfqTradeQuery) ([]RfqTrade,
+		error)
+}
+
+// RfqStoreTxOptions defines the set of db txn options the RfqQuoteStore
+// understands.
+type RfqStoreTxOptions struct {
+	// readOnly governs if a read only transaction is needed or not.
+	readOnly bool
+}
+
+// ReadOnly returns true if the transaction should be read only.
+//
+// NOTE: This implements the TxOptions interface.
+func (r *RfqStoreTxOptions) ReadOnly() bool {
+	return r.readOnly
+}
+
+// NewRfqStoreReadTx creates a new read transaction option set.
+func NewRfqStoreReadTx() RfqStoreTxOptions {
+	return RfqStoreTxOptions{
+		readOnly: true,
+	}
+}
+
+// BatchedRfqQuoteStore supports performing the RFQ store queries in a single
+// database transaction.
+type BatchedRfqQuoteStore interface {
+	RfqQuoteStore
+
+	BatchedTx[RfqQuoteStore]
+}
+
+// RfqStore is a persistent store for the quotes and HTLC policies of the RFQ
+// subsystem.
+type RfqStore struct {
+	db BatchedRfqQuoteStore
+
+	clock clock.Clock
+}
+
+// NewRfqStore creates a new RFQ store from the given database.
+func NewRfqStore(db BatchedRfqQuoteStore, clock clock.Clock) *RfqStore {
+	return &RfqStore{
+		db:    db,
+		clock: clock,
+	}
+}
+
+// UpsertPeerAcceptedBuyQuote stores a buy quote which was requested by our node
+// and has been accepted by a peer.
+//
+// NOTE: This is part of the rfq.QuoteStore interface.
+func (r *RfqStore) UpsertPeerAcceptedBuyQuote(ctx context.Context,
+	quote rfqmsg.BuyAccept) error {
+
+	var writeTx RfqStoreTxOptions
+	return r.db.ExecTx(ctx, &writeTx, func(db RfqQuoteStore) error {
+		assetID, groupKey := rfqAssetSpecifierBytes(
+			quote.AssetID, quote.AssetGroupKey,
+		)
+		sig := quote.Signature()
+
+		dbQuote := NewRfqPeerAcceptedQuote{
+			QuoteType:   rfqQuoteTypeBuy,
+			QuoteID:     quote.ID[:],
+			Peer:        quote.Peer[:],
+			AssetID:     assetID,
+			GroupKey:    groupKey,
+			AssetAmount: int64(quote.AssetAmount),
+			PriceMsat:   int64(quote.AskPrice),
+			Expiry:      int64(quote.Expiry),
+			Signature:   sig[:],
+		}
+
+		return db.UpsertRfqPeerAcceptedQuote(ctx, dbQuote)
+	})
+}
+
+// UpsertPeerAcceptedSellQuote stores a sell quote which was requested by our
+// node and has been accepted by a peer.
+//
+// NOTE: This is part of the rfq.QuoteStore interface.
+func (r *RfqStore) UpsertPeerAcceptedSellQuote(ctx context.Context,
+	quote rfqmsg.SellAccept) error {
+
+	var writeTx RfqStoreTxOptions
+	return r.db.ExecTx(ctx, &writeTx, func(db RfqQuoteStore) error {
+		assetID, groupKey := rfqAssetSpecifierBytes(
+			quote.AssetID, quote.AssetGroupKey,
+		)
+		sig := quote.Signature()
+
+		dbQuote := NewRfqPeerAcceptedQuote{
+			QuoteType:   rfqQuoteTypeSell,
+			QuoteID:     quote.ID[:],
+			Peer:        quote.Peer[:],
+			AssetID:     assetID,
+			GroupKey:    groupKey,
+			AssetAmount: int64(quote.AssetAmount),
+			PriceMsat:   int64(quote.BidPrice),
+			Expiry:      int64(quote.Expiry),
+			Signature:   sig[:],
+		}
+
+		return db.UpsertRfqPeerAcceptedQuote(ctx, dbQuote)
+	})
+}
+
+// FetchPeerAcceptedQuotes returns all stored peer accepted buy and sell quotes
+// which have not expired as of the given time.
+//
+// NOTE: This is part of the rfq.QuoteStore interface.
+func (r *RfqStore) FetchPeerAcceptedQuotes(ctx context.Context,
+	now time.Time) ([]rfqmsg.BuyAccept, []rfqmsg.SellAccept, error) {
+
+	var (
+		buyQuotes  []rfqmsg.BuyAccept
+		sellQuotes []rfqmsg.SellAccept
+	)
+
+	readTx := NewRfqStoreReadTx()
+	dbErr := r.db.ExecTx(ctx, &readTx, func(db RfqQuoteStore) error {
+		dbQuotes, err := db.FetchRfqPeerAcceptedQuotes(ctx, now.Unix())
+		if err != nil {
+			return err
+		}
+
+		for _, dbQuote := range dbQuotes {
+			peer, id, err := parseRfqQuoteKeys(
+				dbQuote.Peer, dbQuote.QuoteID,
+			)
+			if err != nil {
+				return err
+			}
+
+			assetID, groupKey, err := parseRfqAssetSpecifier(
+				dbQuote.AssetID, dbQuote.GroupKey,
+			)
+			if err != nil {
+				return err
+			}
+
+			sig, err := parseRfqAcceptSig(dbQuote.Signature)
+			if err != nil {
+				return err
+			}
+
+			amount := uint64(dbQuote.AssetAmount)
+			price := lnwire.MilliSatoshi(dbQuote.PriceMsat)
+			expiry := uint64(dbQuote.Expiry)
+
+			switch dbQuote.QuoteType {
+			case rfqQuoteTypeBuy:
+				quote := rfqmsg.NewBuyAccept(
+					peer, id, amount, price, expiry,
+				)
+				quote.AssetID = assetID
+				quote.AssetGroupKey = groupKey
+				quote.SetSignature(sig)
+				buyQuotes = append(buyQuotes, *quote)
+
+			case rfqQuoteTypeSell:
+				quote := rfqmsg.NewSellAccept(
+					peer, id, amount, price, expiry,
+				)
+				quote.AssetID = assetID
+				quote.AssetGroupKey = groupKey
+				quote.SetSignature(sig)
+				sellQuotes = append(sellQuotes, *quote)
+
+			default:
+				return fmt.Errorf("unknown quote type: %v",
+					dbQuote.QuoteType)
+			}
+		}
+
+		return nil
+	})
+	if dbErr != nil {
+		return nil, nil, dbErr
+	}
+
+	return buyQuotes, sellQuotes, nil
+}
+
+// UpsertAssetSalePolicy stores the buy accept message from which an asset sale
+// policy is derived.
+//
+// NOTE: This is part of the rfq.QuoteStore interface.
+func (r *RfqStore) UpsertAssetSalePolicy(ctx context.Context,
+	buyAccept rfqmsg.BuyAccept) error {
+
+	var writeTx RfqStoreTxOptions
+	return r.db.ExecTx(ctx, &writeTx, func(db RfqQuoteStore) error {
+		assetID, groupKey := rfqAssetSpecifierBytes(
+			buyAccept.AssetID, buyAccept.AssetGroupKey,
+		)
+		sig := buyAccept.Signature()
+
+		return db.UpsertRfqPolicy(ctx, NewRfqPolicy{
+			PolicyType:  rfqPolicyTypeAssetSale,
+			QuoteID:     buyAccept.ID[:],
+			Peer:        buyAccept.Peer[:],
+			AssetID:     assetID,
+			GroupKey:    groupKey,
+			AssetAmount: int64(buyAccept.AssetAmount),
+			PriceMsat:   int64(buyAccept.AskPrice),
+			Expiry:      int64(buyAccept.Expiry),
+			Signature:   sig[:],
+		})
+	})
+}
+
+// UpsertAssetPurchasePolicy stores the sell accept message from which an asset
+// purchase policy is derived.
+//
+// NOTE: This is part of the rfq.QuoteStore interface.
+func (r *RfqStore) UpsertAssetPurchasePolicy(ctx context.Context,
+	sellAccept rfqmsg.SellAccept) error {
+
+	var writeTx RfqStoreTxOptions
+	return r.db.ExecTx(ctx, &writeTx, func(db RfqQuoteStore) error {
+		assetID, groupKey := rfqAssetSpecifierBytes(
+			sellAccept.AssetID, sellAccept.AssetGroupKey,
+		)
+		sig := sellAccept.Signature()
+
+		return db.UpsertRfqPolicy(ctx, NewRfqPolicy{
+			PolicyType:  rfqPolicyTypeAssetPurchase,
+			QuoteID:     sellAccept.ID[:],
+			Peer:        sellAccept.Peer[:],
+			AssetID:     assetID,
+			GroupKey:    groupKey,
+			AssetAmount: int64(sellAccept.AssetAmount),
+			PriceMsat:   int64(sellAccept.BidPrice),
+			Expiry:      int64(sellAccept.Expiry),
+			Signature:   sig[:],
+		})
+	})
+}
+
+// FetchPolicies returns the accept messages of all stored asset sale and asset
+// purchase policies which have not expired as of the given time.
+//
+// NOTE: This is part of the rfq.QuoteStore interface.
+func (r *RfqStore) FetchPolicies(ctx context.Context,
+	now time.Time) ([]rfqmsg.BuyAccept, []rfqmsg.SellAccept, error) {
+
+	var (
+		saleQuotes     []rfqmsg.BuyAccept
+		purchaseQuotes []rfqmsg.SellAccept
+	)
+
+	readTx := NewRfqStoreReadTx()
+	dbErr := r.db.ExecTx(ctx, &readTx, func(db RfqQuoteStore) error {
+		dbPolicies, err := db.FetchRfqPolicies(ctx, now.Unix())
+		if err != nil {
+			return err
+		}
+
+		for _, dbPolicy := range dbPolicies {
+			peer, id, err := parseRfqQuoteKeys(
+				dbPolicy.Peer, dbPolicy.QuoteID,
+			)
+			if err != nil {
+				return err
+			}
+
+			assetID, groupKey, err := parseRfqAssetSpecifier(
+				dbPolicy.AssetID, dbPolicy.GroupKey,
+			)
+			if err != nil {
+				return err
+			}
+
+			sig, err := parseRfqAcceptSig(dbPolicy.Signature)
+			if err != nil {
+				return err
+			}
+
+			amount := uint64(dbPolicy.AssetAmount)
+			price := lnwire.MilliSatoshi(dbPolicy.PriceMsat)
+			expiry := uint64(dbPolicy.Expiry)
+
+			switch dbPolicy.PolicyType {
+			case rfqPolicyTypeAssetSale:
+				quote := rfqmsg.NewBuyAccept(
+					peer, id, amount, price, expiry,
+				)
+				quote.AssetID = assetID
+				quote.AssetGroupKey = groupKey
+				quote.SetSignature(sig)
+				saleQuotes = append(saleQuotes, *quote)
+
+			case rfqPolicyTypeAssetPurchase:
+				quote := rfqmsg.NewSellAccept(
+					peer, id, amount, price, expiry,
+				)
+				quote.AssetID = assetID
+				quote.AssetGroupKey = groupKey
+				quote.SetSignature(sig)
+				purchaseQuotes = append(purchaseQuotes, *quote)
+
+			default:
+				return fmt.Errorf("unknown policy type: %v",
+					dbPolicy.PolicyType)
+			}
+		}
+
+		return nil
+	})
+	if dbErr != nil {
+		return nil, nil, dbErr
+	}
+
+	return saleQuotes, purchaseQuotes, nil
+}
+
+// DeleteExpiredQuotes removes all peer accepted quotes and policies which have
+// expired as of the given time.
+//
+// NOTE: This is part of the rfq.QuoteStore interface.
+func (r *RfqStore) DeleteExpiredQuotes(ctx context.Context,
+	now time.Time) error {
+
+	var writeTx RfqStoreTxOptions
+	return r.db.ExecTx(ctx, &writeTx, func(db RfqQuoteStore) error {
+		numQuotes, err := db.DeleteExpiredRfqPeerAcceptedQuotes(
+			ctx, now.Unix(),
+		)
+		if err != nil {
+			return fmt.Errorf("unable to delete expired peer "+
+				"accepted quotes: %w", err)
+		}
+
+		numPolicies, err := db.DeleteExpiredRfqPolicies(
+			ctx, now.Unix(),
+		)
+		if err != nil {
+			return fmt.Errorf("unable to delete expired policies: "+
+				"%w", err)
+		}
+
+		log.Debugf("Deleted expired RFQ entries (quotes=%d, "+
+			"policies=%d)", numQuotes, numPolicies)
+
+		return nil
+	})
+}
+
+// LogBuyRequest records a buy quote request in the quote ledger.
+//
+// NOTE: This is part of the rfq.QuoteLedger interface.
+func (r *RfqStore) LogBuyRequest(ctx context.Context, req rfqmsg.BuyRequest,
+	incoming bool) error {
+
+	return r.logQuoteRequest(ctx, newRfqQuoteLog(
+		rfqQuoteTypeBuy, incoming, req.ID, req.Peer, req.AssetID,
+		req.AssetGroupKey, req.AssetAmount, req.BidPrice,
+	))
+}
+
+// LogSellRequest records a sell quote request in the quote ledger.
+//
+// NOTE: This is part of the rfq.QuoteLedger interface.
+func (r *RfqStore) LogSellRequest(ctx context.Context, req rfqmsg.SellRequest,
+	incoming bool) error {
+
+	return r.logQuoteRequest(ctx, newRfqQuoteLog(
+		rfqQuoteTypeSell, incoming, req.ID, req.Peer, req.AssetID,
+		req.AssetGroupKey, req.AssetAmount, req.AskPrice,
+	))
+}
+
+// newRfqQuoteLog creates the ledger entry of a quote request.
+func newRfqQuoteLog(quoteType string, incoming bool, id rfqmsg.ID,
+	peer route.Vertex, assetID *asset.ID, groupKey *btcec.PublicKey,
+	assetAmount uint64, price lnwire.MilliSatoshi) NewRfqQuoteLog {
+
+	entry := NewRfqQuoteLog{
+		QuoteID:     id[:],
+		QuoteType:   quoteType,
+		IsIncoming:  incoming,
+		Peer:        peer[:],
+		AssetAmount: int64(assetAmount),
+		PriceMsat:   int64(price),
+	}
+
+	entry.AssetID, entry.GroupKey = rfqAssetSpecifierBytes(
+		assetID, groupKey,
+	)
+
+	return entry
+}
+
+// rfqAssetSpecifierBytes returns the serialized asset ID and group key of a
+// quote. A nil slice is returned for an unset field.
+func rfqAssetSpecifierBytes(assetID *asset.ID,
+	groupKey *btcec.PublicKey) ([]byte, []byte) {
+
+	var assetIDBytes, groupKeyBytes []byte
+	if assetID != nil {
+		assetIDBytes = fn.CopySlice(assetID[:])
+	}
+	if groupKey != nil {
+		groupKeyBytes = groupKey.SerializeCompressed()
+	}
+
+	return assetIDBytes, groupKeyBytes
+}
+
+// logQuoteRequest inserts the given quote request into the quote ledger.
+func (r *RfqStore) logQuoteRequest(ctx context.Context,
+	entry NewRfqQuoteLog) error {
+
+	entry.CreatedAt = r.clock.Now().UTC()
+
+	var writeTx RfqStoreTxOptions
+	return r.db.ExecTx(ctx, &writeTx, func(db RfqQuoteStore) error {
+		return db.InsertRfqQuoteLog(ctx, entry)
+	})
+}
+
+// LogBuyAccept marks the buy quote request that the given accept message
+// responds to as accepted.
+//
+// NOTE: This is part of the rfq.QuoteLedger interface.
+func (r *RfqStore) LogBuyAccept(ctx context.Context,
+	accept rfqmsg.BuyAccept) error {
+
+	return r.logQuoteAccept(
+		ctx, accept.ID, accept.Peer, accept.AskPrice, accept.Expiry,
+		accept.Signature(),
+	)
+}
+
+// LogSellAccept marks the sell quote request that the given accept message
+// responds to as accepted.
+//
+// NOTE: This is part of the rfq.QuoteLedger interface.
+func (r *RfqStore) LogSellAccept(ctx context.Context,
+	accept rfqmsg.SellAccept) error {
+
+	return r.logQuoteAccept(
+		ctx, accept.ID, accept.Peer, accept.BidPrice, accept.Expiry,
+		accept.Signature(),
+	)
+}
+
+// logQuoteAccept marks the logged quote with the given ID as accepted.
+func (r *RfqStore) logQuoteAccept(ctx context.Context, id rfqmsg.ID,
+	peer route.Vertex, price lnwire.MilliSatoshi, expiry uint64,
+	sig [64]byte) error {
+
+	update := RfqQuoteLogAccepted{
+		PriceMsat: int64(price),
+		Expiry:    int64(expiry),
+		Signature: sig[:],
+		UpdatedAt: r.clock.Now().UTC(),
+		QuoteID:   id[:],
+		Peer:      peer[:],
+	}
+
+	var writeTx RfqStoreTxOptions
+	return r.db.ExecTx(ctx, &writeTx, func(db RfqQuoteStore) error {
+		numUpdated, err := db.UpdateRfqQuoteLogAccepted(ctx, update)
+		if err != nil {
+			return err
+		}
+
+		// A quote that isn't pending anymore keeps its status.
+		if numUpdated == 0 {
+			log.Debugf("No pending quote request with ID %x to "+
+				"mark as accepted", id[:])
+		}
+
+		return nil
+	})
+}
+
+// LogReject marks the quote request that the given reject message responds to
+// as rejected.
+//
+// NOTE: This is part of the rfq.QuoteLedger interface.
+func (r *RfqStore) LogReject(ctx context.Context, reject rfqmsg.Reject) error {
+	update := RfqQuoteLogRejected{
+		RejectCode: sqlInt32(reject.Err.Code),
+		RejectMsg:  sqlStr(reject.Err.Msg),
+		UpdatedAt:  r.clock.Now().UTC(),
+		QuoteID:    reject.ID[:],
+		Peer:       reject.Peer[:],
+	}
+
+	var writeTx RfqStoreTxOptions
+	return r.db.ExecTx(ctx, &writeTx, func(db RfqQuoteStore) error {
+		numUpdated, err := db.UpdateRfqQuoteLogRejected(ctx, update)
+		if err != nil {
+			return err
+		}
+
+		if numUpdated == 0 {
+			log.Debugf("No pending quote request with ID %x to "+
+				"mark as rejected", reject.ID[:])
+		}
+
+		return nil
+	})
+}
+
+// LogTrade records an HTLC which was accepted against the quote with the
+// given ID as a pending trade.
+//
+// NOTE: This is part of the rfq.QuoteLedger interface.
+func (r *RfqStore) LogTrade(ctx context.Context, quoteID rfqmsg.ID,
+	htlc lndclient.InterceptedHtlc, assetAmount uint64) error {
+
+	circuitKey := htlc.IncomingCircuitKey
+	trade := NewRfqTrade{
+		IncomingChanID: int64(circuitKey.ChanID.ToUint64()),
+		HtlcID:         int64(circuitKey.HtlcID),
+		AmountMsat:     int64(htlc.AmountOutMsat),
+		AssetAmount:    int64(assetAmount),
+		CreatedAt:      r.clock.Now().UTC(),
+		QuoteID:        quoteID[:],
+	}
+
+	var writeTx RfqStoreTxOptions
+	return r.db.ExecTx(ctx, &writeTx, func(db RfqQuoteStore) error {
+		numInserted, err := db.InsertRfqTrade(ctx, trade)
+		if err != nil {
+			return err
+		}
+
+		// Nothing is inserted if the HTLC was already recorded, for
+		// example because lnd replayed it after a restart.
+		if numInserted == 0 {
+			log.Debugf("Trade for quote %x not recorded "+
+				"(chan_id=%d, htlc_id=%d)", quoteID[:],
+				trade.IncomingChanID, trade.HtlcID)
+		}
+
+		return nil
+	})
+}
+
+// ResolveTrade marks the pending trade of the HTLC with the given incoming
+// circuit key as settled or failed. HTLCs without a pending trade are
+// ignored.
+//
+// NOTE: This is part of the rfq.QuoteLedger interface.
+func (r *RfqStore) ResolveTrade(ctx context.Context,
+	circuitKey invpkg.CircuitKey, settled bool) error {
+
+	status := rfqTradeStatusFailed
+	if settled {
+		status = rfqTradeStatusSettled
+	}
+
+	resolution := RfqTradeResolution{
+		Status: status,
+		ResolvedAt: sql.NullTime{
+			Time:  r.clock.Now().UTC(),
+			Valid: true,
+		},
+		IncomingChanID: int64(circuitKey.ChanID.ToUint64()),
+		HtlcID:         int64(circuitKey.HtlcID),
+	}
+
+	var writeTx RfqStoreTxOptions
+	return r.db.ExecTx(ctx, &writeTx, func(db RfqQuoteStore) error {
+		numUpdated, err := db.ResolveRfqTrade(ctx, resolution)
+		if err != nil {
+			return err
+		}
+
+		if numUpdated != 0 {
+			log.Debugf("Trade of HTLC %v %v", circuitKey, status)
+		}
+
+		return nil
+	})
+}
+
+// PruneQuotes removes the quotes which were requested before the given time
+// and which were never traded against.
+//
+// NOTE: This is part of the rfq.QuoteLedger interface.
+func (r *RfqStore) PruneQuotes(ctx context.Context,
+	createdBefore time.Time) error {
+
+	var writeTx RfqStoreTxOptions
+	return r.db.ExecTx(ctx, &writeTx, func(db RfqQuoteStore) error {
+		numDeleted, err := db.DeleteUntradedRfqQuoteLogs(
+			ctx, createdBefore.UTC(),
+		)
+		if err != nil {
+			return err
+		}
+
+		log.Debugf("Pruned %d quotes from the quote ledger",
+			numDeleted)
+
+		return nil
+	})
+}
+
+// newRfqQueryFilters converts the given ledger query to the peer and time
+// range filters of the ledger queries.
+func newRfqQueryFilters(query rfq.LedgerQuery) ([]byte, sql.NullTime,
+	sql.NullTime) {
+
+	var (
+		peer                       []byte
+		minCreatedAt, maxCreatedAt sql.NullTime
+	)
+
+	if query.Peer != nil {
+		peer = fn.CopySlice(query.Peer[:])
+	}
+	if !query.StartTime.IsZero() {
+		minCreatedAt = sql.NullTime{
+			Time:  query.StartTime.UTC(),
+			Valid: true,
+		}
+	}
+	if !query.EndTime.IsZero() {
+		maxCreatedAt = sql.NullTime{
+			Time:  query.EndTime.UTC(),
+			Valid: true,
+		}
+	}
+
+	return peer, minCreatedAt, maxCreatedAt
+}
+
+// QueryQuotes returns the quotes in the ledger which match the given query.
+// Accepted quotes which have expired as of the given time without being traded
+// against are reported as expired.
+//
+// NOTE: This is part of the rfq.QuoteLedger interface.
+func (r *RfqStore) QueryQuotes(ctx context.Context, query rfq.LedgerQuery,
+	now time.Time) ([]rfq.QuoteLogEntry, error) {
+
+	peer, minCreatedAt, maxCreatedAt := newRfqQueryFilters(query)
+
+	var entries []rfq.QuoteLogEntry
+
+	// We use a write transaction, as the status of all quotes that expired
+	// since the last query is updated first.
+	var writeTx RfqStoreTxOptions
+	dbErr := r.db.ExecTx(ctx, &writeTx, func(db RfqQuoteStore) error {
+		entries = nil
+
+		_, err := db.MarkExpiredRfqQuoteLogs(ctx, RfqQuoteLogExpired{
+			UpdatedAt: r.clock.Now().UTC(),
+			MinExpiry: now.Unix(),
+		})
+		if err != nil {
+			return fmt.Errorf("unable to mark expired quotes: %w",
+				err)
+		}
+
+		dbQuotes, err := db.QueryRfqQuoteLogs(ctx, RfqQuoteLogQuery{
+			Peer:         peer,
+			MinCreatedAt: minCreatedAt,
+			MaxCreatedAt: maxCreatedAt,
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, dbQuote := range dbQuotes {
+			entry, err := parseRfqQuoteLog(dbQuote)
+			if err != nil {
+				return err
+			}
+
+			entries = append(entries, *entry)
+		}
+
+		return nil
+	})
+	if dbErr != nil {
+		return nil, dbErr
+	}
+
+	return entries, nil
+}
+
+// parseRfqQuoteLog parses a logged quote returned from the database.
+func parseRfqQuoteLog(dbQuote RfqQuoteLog) (*rfq.QuoteLogEntry, error) {
+	peer, id, err := parseRfqQuoteKeys(dbQuote.Peer, dbQuote.QuoteID)
+	if err != nil {
+		return nil, err
+	}
+
+	quoteType, err := parseRfqQuoteType(dbQuote.QuoteType)
+	if err != nil {
+		return nil, err
+	}
+
+	assetID, groupKey, err := parseRfqAssetSpecifier(
+		dbQuote.AssetID, dbQuote.GroupKey,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	entry := &rfq.QuoteLogEntry{
+		ID:            id,
+		Type:          quoteType,
+		Incoming:      dbQuote.IsIncoming,
+		Peer:          peer,
+		AssetID:       assetID,
+		AssetGroupKey: groupKey,
+		AssetAmount:   uint64(dbQuote.AssetAmount),
+		Price:         lnwire.MilliSatoshi(dbQuote.PriceMsat),
+		Expiry:        uint64(dbQuote.Expiry),
+		CreatedAt:     dbQuote.CreatedAt.UTC(),
+		UpdatedAt:     dbQuote.UpdatedAt.UTC(),
+	}
+
+	switch dbQuote.Status {
+	case rfqQuoteStatusRequested:
+		entry.Status = rfq.QuoteStatusRequested
+
+	case rfqQuoteStatusAccepted:
+		entry.Status = rfq.QuoteStatusAccepted
+
+	case rfqQuoteStatusRejected:
+		entry.Status = rfq.QuoteStatusRejected
+
+	case rfqQuoteStatusExpired:
+		entry.Status = rfq.QuoteStatusExpired
+
+	default:
+		return nil, fmt.Errorf("unknown quote status: %v",
+			dbQuote.Status)
+	}
+
+	if len(dbQuote.Signature) != 0 {
+		var sig [64]byte
+		if len(dbQuote.Signature) != len(sig) {
+			return nil, fmt.Errorf("invalid signature length: %d",
+				len(dbQuote.Signature))
+		}
+		copy(sig[:], dbQuote.Signature)
+
+		entry.Signature = fn.Some(sig)
+	}
+
+	if dbQuote.RejectCode.Valid {
+		entry.RejectErr = fn.Some(rfqmsg.RejectErr{
+			Code: uint8(dbQuote.RejectCode.Int32),
+			Msg:  dbQuote.RejectMsg.String,
+		})
+	}
+
+	return entry, nil
+}
+
+// QueryTrades returns the trades in the ledger which match the given query.
+//
+// NOTE: This is part of the rfq.QuoteLedger interface.
+func (r *RfqStore) QueryTrades(ctx context.Context,
+	query rfq.LedgerQuery) ([]rfq.Trade, error) {
+
+	peer, minCreatedAt, maxCreatedAt := newRfqQueryFilters(query)
+
+	var trades []rfq.Trade
+
+	readTx := NewRfqStoreReadTx()
+	dbErr := r.db.ExecTx(ctx, &readTx, func(db RfqQuoteStore) error {
+		trades = nil
+
+		dbTrades, err := db.QueryRfqTrades(ctx, RfqTradeQuery{
+			Peer:         peer,
+			MinCreatedAt: minCreatedAt,
+			MaxCreatedAt: maxCreatedAt,
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, dbTrade := range dbTrades {
+			peer, id, err := parseRfqQuoteKeys(
+				dbTrade.Peer, dbTrade.QuoteID,
+			)
+			if err != nil {
+				return err
+			}
+
+			quoteType, err := parseRfqQuoteType(dbTrade.QuoteType)
+			if err != nil {
+				return err
+			}
+
+			assetID, groupKey, err := parseRfqAssetSpecifier(
+				dbTrade.AssetID, dbTrade.GroupKey,
+			)
+			if err != nil {
+				return err
+			}
+
+			status, err := parseRfqTradeStatus(dbTrade.Status)
+			if err != nil {
+				return err
+			}
+
+			var resolvedAt time.Time
+			if dbTrade.ResolvedAt.Valid {
+				resolvedAt = dbTrade.ResolvedAt.Time.UTC()
+			}
+
+			chanID := uint64(dbTrade.IncomingChanID)
+			trades = append(trades, rfq.Trade{
+				QuoteID:       id,
+				QuoteType:     quoteType,
+				Peer:          peer,
+				AssetID:       assetID,
+				AssetGroupKey: groupKey,
+				AssetAmount:   uint64(dbTrade.AssetAmount),
+				QuotePrice: lnwire.MilliSatoshi(
+					dbTrade.PriceMsat,
+				),
+				IncomingChanID: lnwire.NewShortChanIDFromInt(
+					chanID,
+				),
+				HtlcID: uint64(dbTrade.HtlcID),
+				Amount: lnwire.MilliSatoshi(
+					dbTrade.AmountMsat,
+				),
+				HtlcAssetAmount: uint64(
+					dbTrade.HtlcAssetAmount,
+				),
+				Status:     status,
+				Timestamp:  dbTrade.CreatedAt.UTC(),
+				ResolvedAt: resolvedAt,
+			})
+		}
+
+		return nil
+	})
+	if dbErr != nil {
+		return nil, dbErr
+	}
+
+	return trades, nil
+}
+
+// parseRfqQuoteType parses the type of a logged quote.
+func parseRfqQuoteType(quoteType string) (rfq.QuoteType, error) {
+	switch quoteType {
+	case rfqQuoteTypeBuy:
+		return rfq.QuoteTypeBuy, nil
+
+	case rfqQuoteTypeSell:
+		return rfq.QuoteTypeSell, nil
+
+	default:
+		return 0, fmt.Errorf("unknown quote type: %v", quoteType)
+	}
+}
+
+// parseRfqTradeStatus parses the status of a logged trade.
+func parseRfqTradeStatus(status string) (rfq.TradeStatus, error) {
+	switch status {
+	case rfqTradeStatusPending:
+		return rfq.TradeStatusPending, nil
+
+	case rfqTradeStatusSettled:
+		return rfq.TradeStatusSettled, nil
+
+	case rfqTradeStatusFailed:
+		return rfq.TradeStatusFailed, nil
+
+	default:
+		return 0, fmt.Errorf("unknown trade status: %v", status)
+	}
+}
+
+// parseRfqAssetSpecifier parses the asset ID and group key of a logged quote.
+func parseRfqAssetSpecifier(assetIDBytes, groupKeyBytes []byte) (*asset.ID,
+	*btcec.PublicKey, error) {
+
+	var (
+		assetID  *asset.ID
+		groupKey *btcec.PublicKey
+	)
+
+	if len(assetIDBytes) != 0 {
+		var id asset.ID
+		if len(assetIDBytes) != len(id) {
+			return nil, nil, fmt.Errorf("invalid asset ID "+
+				"length: %d", len(assetIDBytes))
+		}
+		copy(id[:], assetIDBytes)
+
+		assetID = &id
+	}
+
+	if len(groupKeyBytes) != 0 {
+		key, err := btcec.ParsePubKey(groupKeyBytes)
+		if err != nil {
+			return nil, nil, fmt.Errorf("unable to parse group "+
+				"key: %w", err)
+		}
+
+		groupKey = key
+	}
+
+	return assetID, groupKey, nil
+}
+
+// parseRfqAcceptSig parses the signature of a stored accept message. Quotes
+// that were stored without a signature yield an empty signature.
+func parseRfqAcceptSig(sigBytes []byte) ([64]byte, error) {
+	var sig [64]byte
+	if len(sigBytes) == 0 {
+		return sig, nil
+	}
+
+	if len(sigBytes) != len(sig) {
+		return sig, fmt.Errorf("invalid signature length: %d",
+			len(sigBytes))
+	}
+	copy(sig[:], sigBytes)
+
+	return sig, nil
+}
+
+// parseRfqQuoteKeys parses the peer public key and the quote ID of a stored
+// quote.
+func parseRfqQuoteKeys(peerBytes, idBytes []byte) (route.Vertex, rfqmsg.ID,
+	error) {
+
+	var (
+		peer route.Vertex
+		id   rfqmsg.ID
+	)
+
+	if len(peerBytes) != len(peer) {
+		return peer, id, fmt.Errorf("invalid peer length: %d",
+			len(peerBytes))
+	}
+	if len(idBytes) != len(id) {
+		return peer, id, fmt.Errorf("invalid quote ID length: %d",
+			len(idBytes))
+	}
+
+	copy(peer[:], peerBytes)
+	copy(id[:], idBytes)
+
+	return peer, id, nil
+}
+
+// A compile-time assertion to ensure that RfqStore meets the rfq.QuoteStore
+// interface.
+var _ rfq.QuoteStore = (*RfqStore)(nil)
+
+// A compile-time assertion to ensure that RfqStore meets the rfq.QuoteLedger
+// interface.
+var _ rfq.QuoteLedger = (*RfqStore)(nil)
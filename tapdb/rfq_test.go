@@ -0,0 +1,381 @@
+package tapdb
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/rfq"
+	"github.com/lightninglabs/taproot-assets/rfqmsg"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+)
+
+// newRfqStore makes a new RFQ store backed by a fresh test database.
+func newRfqStore(t *testing.T, clock clock.Clock) *RfqStore {
+	db := NewTestDB(t)
+
+	rfqDB := NewTransactionExecutor(db, func(tx *sql.Tx) RfqQuoteStore {
+		return db.WithTx(tx)
+	})
+
+	return NewRfqStore(rfqDB, clock)
+}
+
+// randRfqID returns a random RFQ message ID.
+func randRfqID() rfqmsg.ID {
+	var id rfqmsg.ID
+	copy(id[:], test.RandBytes(len(id)))
+
+	return id
+}
+
+// randRfqSig returns a random accept message signature.
+func randRfqSig() [64]byte {
+	var sig [64]byte
+	copy(sig[:], test.RandBytes(64))
+
+	return sig
+}
+
+// randRfqPeer returns a random peer public key.
+func randRfqPeer(t *testing.T) route.Vertex {
+	return route.NewVertex(test.RandPubKey(t))
+}
+
+// TestRfqStorePeerAcceptedQuotes tests that peer accepted quotes can be stored,
+// updated, fetched and deleted once expired.
+func TestRfqStorePeerAcceptedQuotes(t *testing.T) {
+	t.Parallel()
+
+	store := newRfqStore(t, clock.NewDefaultClock())
+	ctx := context.Background()
+
+	now := time.Now()
+	future := uint64(now.Add(time.Hour).Unix())
+	past := uint64(now.Add(-time.Hour).Unix())
+
+	// With an empty database, no quotes should be returned.
+	buyQuotes, sellQuotes, err := store.FetchPeerAcceptedQuotes(ctx, now)
+	require.NoError(t, err)
+	require.Empty(t, buyQuotes)
+	require.Empty(t, sellQuotes)
+
+	// We'll now insert an active buy quote, an active sell quote and an
+	// expired buy quote.
+	buyQuote := rfqmsg.NewBuyAccept(
+		randRfqPeer(t), randRfqID(), 100, lnwire.MilliSatoshi(5_000),
+		future,
+	)
+	sellQuote := rfqmsg.NewSellAccept(
+		randRfqPeer(t), randRfqID(), 200, lnwire.MilliSatoshi(7_000),
+		future,
+	)
+	expiredQuote := rfqmsg.NewBuyAccept(
+		randRfqPeer(t), randRfqID(), 300, lnwire.MilliSatoshi(9_000),
+		past,
+	)
+
+	// The asset specifier and the signature of the accept messages must
+	// be restored as well.
+	buyAssetID := asset.RandID(t)
+	buyQuote.AssetID = &buyAssetID
+	buyQuote.SetSignature(randRfqSig())
+	sellQuote.AssetGroupKey = test.RandPubKey(t)
+	sellQuote.SetSignature(randRfqSig())
+
+	require.NoError(t, store.UpsertPeerAcceptedBuyQuote(ctx, *buyQuote))
+	require.NoError(t, store.UpsertPeerAcceptedSellQuote(ctx, *sellQuote))
+	require.NoError(t, store.UpsertPeerAcceptedBuyQuote(ctx, *expiredQuote))
+
+	// Only the active quotes should be returned.
+	buyQuotes, sellQuotes, err = store.FetchPeerAcceptedQuotes(ctx, now)
+	require.NoError(t, err)
+	require.Equal(t, []rfqmsg.BuyAccept{*buyQuote}, buyQuotes)
+	require.Equal(t, []rfqmsg.SellAccept{*sellQuote}, sellQuotes)
+
+	// Upserting a quote with the same ID should update the existing entry
+	// rather than adding a new one.
+	buyQuote.AskPrice = lnwire.MilliSatoshi(6_000)
+	require.NoError(t, store.UpsertPeerAcceptedBuyQuote(ctx, *buyQuote))
+
+	buyQuotes, _, err = store.FetchPeerAcceptedQuotes(ctx, now)
+	require.NoError(t, err)
+	require.Equal(t, []rfqmsg.BuyAccept{*buyQuote}, buyQuotes)
+
+	// Deleting the expired quotes should only remove the expired quote.
+	// Fetching with an earlier time would otherwise still return it.
+	require.NoError(t, store.DeleteExpiredQuotes(ctx, now))
+
+	earlier := now.Add(-2 * time.Hour)
+	buyQuotes, sellQuotes, err = store.FetchPeerAcceptedQuotes(
+		ctx, earlier,
+	)
+	require.NoError(t, err)
+	require.Equal(t, []rfqmsg.BuyAccept{*buyQuote}, buyQuotes)
+	require.Equal(t, []rfqmsg.SellAccept{*sellQuote}, sellQuotes)
+}
+
+// TestRfqStorePolicies tests that the accept messages of HTLC policies can be
+// stored, fetched and deleted once expired.
+func TestRfqStorePolicies(t *testing.T) {
+	t.Parallel()
+
+	store := newRfqStore(t, clock.NewDefaultClock())
+	ctx := context.Background()
+
+	now := time.Now()
+	future := uint64(now.Add(time.Hour).Unix())
+	past := uint64(now.Add(-time.Hour).Unix())
+
+	saleQuote := rfqmsg.NewBuyAccept(
+		randRfqPeer(t), randRfqID(), 100, lnwire.MilliSatoshi(5_000),
+		future,
+	)
+	purchaseQuote := rfqmsg.NewSellAccept(
+		randRfqPeer(t), randRfqID(), 200, lnwire.MilliSatoshi(7_000),
+		future,
+	)
+	expiredQuote := rfqmsg.NewSellAccept(
+		randRfqPeer(t), randRfqID(), 300, lnwire.MilliSatoshi(9_000),
+		past,
+	)
+
+	saleAssetID := asset.RandID(t)
+	saleQuote.AssetID = &saleAssetID
+	saleQuote.SetSignature(randRfqSig())
+	purchaseQuote.AssetGroupKey = test.RandPubKey(t)
+	purchaseQuote.SetSignature(randRfqSig())
+
+	require.NoError(t, store.UpsertAssetSalePolicy(ctx, *saleQuote))
+	require.NoError(t, store.UpsertAssetPurchasePolicy(ctx, *purchaseQuote))
+	require.NoError(t, store.UpsertAssetPurchasePolicy(ctx, *expiredQuote))
+
+	// Policies are stored separately from the peer accepted quotes.
+	buyQuotes, sellQuotes, err := store.FetchPeerAcceptedQuotes(ctx, now)
+	require.NoError(t, err)
+	require.Empty(t, buyQuotes)
+	require.Empty(t, sellQuotes)
+
+	// Only the active policies should be returned.
+	saleQuotes, purchaseQuotes, err := store.FetchPolicies(ctx, now)
+	require.NoError(t, err)
+	require.Equal(t, []rfqmsg.BuyAccept{*saleQuote}, saleQuotes)
+	require.Equal(t, []rfqmsg.SellAccept{*purchaseQuote}, purchaseQuotes)
+
+	// Once the expired policies are deleted, the expired policy should no
+	// longer be returned even for an earlier time.
+	require.NoError(t, store.DeleteExpiredQuotes(ctx, now))
+
+	earlier := now.Add(-2 * time.Hour)
+	saleQuotes, purchaseQuotes, err = store.FetchPolicies(ctx, earlier)
+	require.NoError(t, err)
+	require.Equal(t, []rfqmsg.BuyAccept{*saleQuote}, saleQuotes)
+	require.Equal(t, []rfqmsg.SellAccept{*purchaseQuote}, purchaseQuotes)
+}
+
+// TestRfqStoreQuoteLedger tests that the lifecycle of quotes and the trades
+// made against them are recorded in the quote ledger.
+func TestRfqStoreQuoteLedger(t *testing.T) {
+	t.Parallel()
+
+	start := time.Unix(time.Now().Unix(), 0)
+	testClock := clock.NewTestClock(start)
+	store := newRfqStore(t, testClock)
+	ctx := context.Background()
+
+	peerA := randRfqPeer(t)
+	peerB := randRfqPeer(t)
+	assetID := asset.RandID(t)
+	groupKey := test.RandPubKey(t)
+
+	// Peer A requests a buy quote from us, which we accept.
+	buyReq, err := rfqmsg.NewBuyRequest(
+		peerA, &assetID, nil, 100, 5000, 0,
+	)
+	require.NoError(t, err)
+	require.NoError(t, store.LogBuyRequest(ctx, *buyReq, true))
+
+	// Logging the same request twice is a no-op.
+	require.NoError(t, store.LogBuyRequest(ctx, *buyReq, true))
+
+	expiry := uint64(start.Add(time.Hour).Unix())
+	buyAccept := rfqmsg.NewBuyAccept(peerA, buyReq.ID, 100, 6000, expiry)
+	buyAccept.SetSignature([64]byte{1, 2, 3})
+	require.NoError(t, store.LogBuyAccept(ctx, *buyAccept))
+
+	// An HTLC is accepted against the quote. A replay of the same HTLC is
+	// only recorded once.
+	htlc := lndclient.InterceptedHtlc{
+		AmountOutMsat: 6000,
+	}
+	htlc.IncomingCircuitKey.ChanID = lnwire.NewShortChanIDFromInt(123)
+	htlc.IncomingCircuitKey.HtlcID = 7
+	require.NoError(t, store.LogTrade(ctx, buyReq.ID, htlc, 100))
+	require.NoError(t, store.LogTrade(ctx, buyReq.ID, htlc, 100))
+
+	// The HTLC is settled. Once resolved, the outcome of a trade can't be
+	// changed anymore, and HTLCs without a trade are ignored.
+	testClock.SetTime(start.Add(time.Minute))
+	circuitKey := htlc.IncomingCircuitKey
+	require.NoError(t, store.ResolveTrade(ctx, circuitKey, true))
+	require.NoError(t, store.ResolveTrade(ctx, circuitKey, false))
+
+	unknownKey := circuitKey
+	unknownKey.HtlcID++
+	require.NoError(t, store.ResolveTrade(ctx, unknownKey, true))
+
+	// An hour later, we request a sell quote from peer B, which rejects
+	// it. Peer A can't reject the quote on behalf of peer B.
+	testClock.SetTime(start.Add(time.Hour))
+	sellReq, err := rfqmsg.NewSellRequest(
+		peerB, nil, groupKey, 50, 2000, 0,
+	)
+	require.NoError(t, err)
+	require.NoError(t, store.LogSellRequest(ctx, *sellReq, false))
+
+	reject := rfqmsg.NewReject(
+		peerA, sellReq.ID, rfqmsg.ErrNoSuitableBuyOffer,
+	)
+	require.NoError(t, store.LogReject(ctx, *reject))
+
+	reject = rfqmsg.NewReject(
+		peerB, sellReq.ID, rfqmsg.ErrNoSuitableBuyOffer,
+	)
+	require.NoError(t, store.LogReject(ctx, *reject))
+
+	// Finally, peer B requests another buy quote which we accept, but
+	// which expires without being traded against.
+	testClock.SetTime(start.Add(2 * time.Hour))
+	expiringReq, err := rfqmsg.NewBuyRequest(
+		peerB, &assetID, nil, 10, 0, 0,
+	)
+	require.NoError(t, err)
+	require.NoError(t, store.LogBuyRequest(ctx, *expiringReq, true))
+
+	expiringAccept := rfqmsg.NewBuyAccept(
+		peerB, expiringReq.ID, 10, 700,
+		uint64(start.Add(3*time.Hour).Unix()),
+	)
+	require.NoError(t, store.LogBuyAccept(ctx, *expiringAccept))
+
+	// Before the quote of peer B expires, all quotes are listed with
+	// their current status.
+	now := start.Add(150 * time.Minute)
+	quotes, err := store.QueryQuotes(ctx, rfq.LedgerQuery{}, now)
+	require.NoError(t, err)
+	require.Len(t, quotes, 3)
+
+	require.Equal(t, rfq.QuoteLogEntry{
+		ID:          buyReq.ID,
+		Type:        rfq.QuoteTypeBuy,
+		Incoming:    true,
+		Peer:        peerA,
+		AssetID:     &assetID,
+		AssetAmount: 100,
+		Price:       6000,
+		Expiry:      expiry,
+		Status:      rfq.QuoteStatusAccepted,
+		Signature:   fn.Some([64]byte{1, 2, 3}),
+		CreatedAt:   start.UTC(),
+		UpdatedAt:   start.UTC(),
+	}, quotes[0])
+
+	require.Equal(t, sellReq.ID, quotes[1].ID)
+	require.Equal(t, rfq.QuoteTypeSell, quotes[1].Type)
+	require.False(t, quotes[1].Incoming)
+	require.Nil(t, quotes[1].AssetID)
+	require.True(t, groupKey.IsEqual(quotes[1].AssetGroupKey))
+	require.Equal(t, rfq.QuoteStatusRejected, quotes[1].Status)
+	require.Equal(
+		t, fn.Some(rfqmsg.ErrNoSuitableBuyOffer), quotes[1].RejectErr,
+	)
+
+	require.Equal(t, rfq.QuoteStatusAccepted, quotes[2].Status)
+
+	// Once the quote of peer B has expired, it is reported as expired.
+	// The quote of peer A expired as well, but was traded against.
+	now = start.Add(4 * time.Hour)
+	quotes, err = store.QueryQuotes(
+		ctx, rfq.LedgerQuery{Peer: &peerB}, now,
+	)
+	require.NoError(t, err)
+	require.Len(t, quotes, 2)
+	require.Equal(t, rfq.QuoteStatusRejected, quotes[0].Status)
+	require.Equal(t, rfq.QuoteStatusExpired, quotes[1].Status)
+
+	quotes, err = store.QueryQuotes(
+		ctx, rfq.LedgerQuery{Peer: &peerA}, now,
+	)
+	require.NoError(t, err)
+	require.Len(t, quotes, 1)
+	require.Equal(t, rfq.QuoteStatusAccepted, quotes[0].Status)
+
+	// The time range filter includes the start and excludes the end.
+	quotes, err = store.QueryQuotes(ctx, rfq.LedgerQuery{
+		StartTime: start.Add(time.Hour),
+		EndTime:   start.Add(2 * time.Hour),
+	}, now)
+	require.NoError(t, err)
+	require.Len(t, quotes, 1)
+	require.Equal(t, sellReq.ID, quotes[0].ID)
+
+	// The single trade is reported with the terms of its quote.
+	trades, err := store.QueryTrades(ctx, rfq.LedgerQuery{})
+	require.NoError(t, err)
+	require.Equal(t, []rfq.Trade{{
+		QuoteID:         buyReq.ID,
+		QuoteType:       rfq.QuoteTypeBuy,
+		Peer:            peerA,
+		AssetID:         &assetID,
+		AssetAmount:     100,
+		QuotePrice:      6000,
+		IncomingChanID:  lnwire.NewShortChanIDFromInt(123),
+		HtlcID:          7,
+		Amount:          6000,
+		HtlcAssetAmount: 100,
+		Status:          rfq.TradeStatusSettled,
+		Timestamp:       start.UTC(),
+		ResolvedAt:      start.Add(time.Minute).UTC(),
+	}}, trades)
+
+	trades, err = store.QueryTrades(ctx, rfq.LedgerQuery{Peer: &peerB})
+	require.NoError(t, err)
+	require.Empty(t, trades)
+
+	trades, err = store.QueryTrades(ctx, rfq.LedgerQuery{
+		StartTime: start.Add(time.Second),
+	})
+	require.NoError(t, err)
+	require.Empty(t, trades)
+
+	// Pruning removes the quotes which were never traded against, but
+	// keeps the quote of the trade.
+	err = store.PruneQuotes(ctx, start.Add(90*time.Minute))
+	require.NoError(t, err)
+
+	quotes, err = store.QueryQuotes(ctx, rfq.LedgerQuery{}, now)
+	require.NoError(t, err)
+	require.Len(t, quotes, 2)
+	require.Equal(t, buyReq.ID, quotes[0].ID)
+	require.Equal(t, expiringReq.ID, quotes[1].ID)
+
+	err = store.PruneQuotes(ctx, now)
+	require.NoError(t, err)
+
+	quotes, err = store.QueryQuotes(ctx, rfq.LedgerQuery{}, now)
+	require.NoError(t, err)
+	require.Len(t, quotes, 1)
+	require.Equal(t, buyReq.ID, quotes[0].ID)
+
+	trades, err = store.QueryTrades(ctx, rfq.LedgerQuery{})
+	require.NoError(t, err)
+	require.Len(t, trades, 1)
+}
@@ -87,7 +87,7 @@ func (q *Queries) AllInternalKeys(ctx context.Context) ([]InternalKey, error) {
 }
 
 const allMintingBatches = `-- name: AllMintingBatches :many
-SELECT batch_id, batch_state, minting_tx_psbt, change_output_index, genesis_id, height_hint, creation_time_unix, tapscript_sibling, key_id, raw_key, key_family, key_index 
+SELECT batch_id, batch_state, minting_tx_psbt, change_output_index, genesis_id, height_hint, creation_time_unix, tapscript_sibling, batch_name, key_id, raw_key, key_family, key_index 
 FROM asset_minting_batches
 JOIN internal_keys 
 ON asset_minting_batches.batch_id = internal_keys.key_id
@@ -102,6 +102,7 @@ type AllMintingBatchesRow struct {
 	HeightHint        int32
 	CreationTimeUnix  time.Time
 	TapscriptSibling  []byte
+	BatchName         sql.NullString
 	KeyID             int64
 	RawKey            []byte
 	KeyFamily         int32
@@ -126,6 +127,7 @@ func (q *Queries) AllMintingBatches(ctx context.Context) ([]AllMintingBatchesRow
 			&i.HeightHint,
 			&i.CreationTimeUnix,
 			&i.TapscriptSibling,
+			&i.BatchName,
 			&i.KeyID,
 			&i.RawKey,
 			&i.KeyFamily,
@@ -438,6 +440,32 @@ func (q *Queries) ConfirmChainTx(ctx context.Context, arg ConfirmChainTxParams)
 	return err
 }
 
+const deleteAssetSeedling = `-- name: DeleteAssetSeedling :exec
+WITH target_key_id AS (
+    -- We use this CTE to fetch the key_id of the internal key that's
+    -- associated with a given batch, so we only delete the seedling with the
+    -- given name from the specified batch.
+    SELECT key_id
+    FROM internal_keys keys
+    WHERE keys.raw_key = $1
+)
+DELETE FROM asset_seedlings
+WHERE (
+    asset_seedlings.batch_id IN (SELECT key_id FROM target_key_id) AND
+    asset_seedlings.asset_name = $2
+)
+`
+
+type DeleteAssetSeedlingParams struct {
+	BatchKey     []byte
+	SeedlingName string
+}
+
+func (q *Queries) DeleteAssetSeedling(ctx context.Context, arg DeleteAssetSeedlingParams) error {
+	_, err := q.db.ExecContext(ctx, deleteAssetSeedling, arg.BatchKey, arg.SeedlingName)
+	return err
+}
+
 const deleteExpiredUTXOLeases = `-- name: DeleteExpiredUTXOLeases :exec
 UPDATE managed_utxos
 SET lease_owner = NULL, lease_expiry = NULL
@@ -1389,7 +1417,7 @@ WITH target_batch AS (
         ON batches.batch_id = keys.key_id
     WHERE keys.raw_key = $1
 )
-SELECT batch_id, batch_state, minting_tx_psbt, change_output_index, genesis_id, height_hint, creation_time_unix, tapscript_sibling, key_id, raw_key, key_family, key_index
+SELECT batch_id, batch_state, minting_tx_psbt, change_output_index, genesis_id, height_hint, creation_time_unix, tapscript_sibling, batch_name, key_id, raw_key, key_family, key_index
 FROM asset_minting_batches batches
 JOIN internal_keys keys
     ON batches.batch_id = keys.key_id
@@ -1405,6 +1433,7 @@ type FetchMintingBatchRow struct {
 	HeightHint        int32
 	CreationTimeUnix  time.Time
 	TapscriptSibling  []byte
+	BatchName         sql.NullString
 	KeyID             int64
 	RawKey            []byte
 	KeyFamily         int32
@@ -1423,6 +1452,7 @@ func (q *Queries) FetchMintingBatch(ctx context.Context, rawKey []byte) (FetchMi
 		&i.HeightHint,
 		&i.CreationTimeUnix,
 		&i.TapscriptSibling,
+		&i.BatchName,
 		&i.KeyID,
 		&i.RawKey,
 		&i.KeyFamily,
@@ -1432,7 +1462,7 @@ func (q *Queries) FetchMintingBatch(ctx context.Context, rawKey []byte) (FetchMi
 }
 
 const fetchMintingBatchesByInverseState = `-- name: FetchMintingBatchesByInverseState :many
-SELECT batch_id, batch_state, minting_tx_psbt, change_output_index, genesis_id, height_hint, creation_time_unix, tapscript_sibling, key_id, raw_key, key_family, key_index
+SELECT batch_id, batch_state, minting_tx_psbt, change_output_index, genesis_id, height_hint, creation_time_unix, tapscript_sibling, batch_name, key_id, raw_key, key_family, key_index
 FROM asset_minting_batches batches
 JOIN internal_keys keys
     ON batches.batch_id = keys.key_id
@@ -1448,6 +1478,7 @@ type FetchMintingBatchesByInverseStateRow struct {
 	HeightHint        int32
 	CreationTimeUnix  time.Time
 	TapscriptSibling  []byte
+	BatchName         sql.NullString
 	KeyID             int64
 	RawKey            []byte
 	KeyFamily         int32
@@ -1472,6 +1503,7 @@ func (q *Queries) FetchMintingBatchesByInverseState(ctx context.Context, batchSt
 			&i.HeightHint,
 			&i.CreationTimeUnix,
 			&i.TapscriptSibling,
+			&i.BatchName,
 			&i.KeyID,
 			&i.RawKey,
 			&i.KeyFamily,
@@ -1968,18 +2000,24 @@ func (q *Queries) InsertNewAsset(ctx context.Context, arg InsertNewAssetParams)
 
 const newMintingBatch = `-- name: NewMintingBatch :exec
 INSERT INTO asset_minting_batches (
-    batch_state, batch_id, height_hint, creation_time_unix
-) VALUES (0, $1, $2, $3)
+    batch_state, batch_id, height_hint, creation_time_unix, batch_name
+) VALUES (0, $1, $2, $3, $4)
 `
 
 type NewMintingBatchParams struct {
 	BatchID          int64
 	HeightHint       int32
 	CreationTimeUnix time.Time
+	BatchName        sql.NullString
 }
 
 func (q *Queries) NewMintingBatch(ctx context.Context, arg NewMintingBatchParams) error {
-	_, err := q.db.ExecContext(ctx, newMintingBatch, arg.BatchID, arg.HeightHint, arg.CreationTimeUnix)
+	_, err := q.db.ExecContext(ctx, newMintingBatch,
+		arg.BatchID,
+		arg.HeightHint,
+		arg.CreationTimeUnix,
+		arg.BatchName,
+	)
 	return err
 }
 
@@ -2334,6 +2372,43 @@ func (q *Queries) SetAssetSpent(ctx context.Context, arg SetAssetSpentParams) (i
 	return asset_id, err
 }
 
+const updateAssetSeedling = `-- name: UpdateAssetSeedling :exec
+UPDATE asset_seedlings
+SET asset_supply = $1,
+    asset_meta_id = $2,
+    emission_enabled = $3,
+    group_genesis_id = $4,
+    group_anchor_id = $5,
+    group_internal_key_id = $6,
+    group_tapscript_root = $7
+WHERE seedling_id = $8
+`
+
+type UpdateAssetSeedlingParams struct {
+	AssetSupply        int64
+	AssetMetaID        int64
+	EmissionEnabled    bool
+	GroupGenesisID     sql.NullInt64
+	GroupAnchorID      sql.NullInt64
+	GroupInternalKeyID sql.NullInt64
+	GroupTapscriptRoot []byte
+	SeedlingID         int64
+}
+
+func (q *Queries) UpdateAssetSeedling(ctx context.Context, arg UpdateAssetSeedlingParams) error {
+	_, err := q.db.ExecContext(ctx, updateAssetSeedling,
+		arg.AssetSupply,
+		arg.AssetMetaID,
+		arg.EmissionEnabled,
+		arg.GroupGenesisID,
+		arg.GroupAnchorID,
+		arg.GroupInternalKeyID,
+		arg.GroupTapscriptRoot,
+		arg.SeedlingID,
+	)
+	return err
+}
+
 const updateBatchGenesisTx = `-- name: UpdateBatchGenesisTx :exec
 WITH target_batch AS (
     SELECT batch_id
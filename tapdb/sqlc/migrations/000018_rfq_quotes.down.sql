@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS rfq_policies_expiry_idx;
+DROP TABLE IF EXISTS rfq_policies;
+
+DROP INDEX IF EXISTS rfq_peer_accepted_quotes_expiry_idx;
+DROP TABLE IF EXISTS rfq_peer_accepted_quotes;
@@ -0,0 +1,88 @@
+-- rfq_peer_accepted_quotes stores the quotes that our node requested and that
+-- have been accepted by a peer. These quotes are exclusively used by our node
+-- to buy or sell assets via the peer that accepted them.
+CREATE TABLE IF NOT EXISTS rfq_peer_accepted_quotes (
+    id BIGINT PRIMARY KEY,
+
+    -- The type of the accepted quote. A buy quote allows our node to buy an
+    -- asset from the peer, a sell quote allows our node to sell an asset to
+    -- the peer.
+    quote_type TEXT NOT NULL CHECK(quote_type IN ('buy', 'sell')),
+
+    -- The unique ID of the quote request that the accept message responds
+    -- to.
+    quote_id BLOB UNIQUE NOT NULL CHECK(length(quote_id) = 32),
+
+    -- The public key of the peer that accepted the quote.
+    peer BLOB NOT NULL CHECK(length(peer) = 33),
+
+    -- The amount of the asset that the quote is for.
+    asset_amount BIGINT NOT NULL,
+
+    -- The accepted price for the asset amount in millisatoshi.
+    price_msat BIGINT NOT NULL,
+
+    -- The unix timestamp in seconds after which the quote is no longer
+    -- valid.
+    expiry BIGINT NOT NULL,
+
+    -- The ID of the asset that the quote is for, if the quote was requested
+    -- for a specific asset.
+    asset_id BLOB CHECK(length(asset_id) = 32),
+
+    -- The key of the asset group that the quote is for, if the quote was
+    -- requested for an asset group.
+    group_key BLOB CHECK(length(group_key) = 33),
+
+    -- The signature of the accept message by the node key of the peer that
+    -- accepted the quote.
+    signature BLOB CHECK(length(signature) = 64)
+);
+
+CREATE INDEX IF NOT EXISTS rfq_peer_accepted_quotes_expiry_idx
+ON rfq_peer_accepted_quotes (expiry);
+
+-- rfq_policies stores the HTLC policies that our node registered after
+-- accepting a quote request from a peer. Each policy is derived from the
+-- accept message that our node sent to the peer.
+CREATE TABLE IF NOT EXISTS rfq_policies (
+    id BIGINT PRIMARY KEY,
+
+    -- The type of the policy. An asset sale policy is registered after our
+    -- node accepted a buy request, an asset purchase policy is registered
+    -- after our node accepted a sell request.
+    policy_type TEXT NOT NULL CHECK(
+        policy_type IN ('asset_sale', 'asset_purchase')
+    ),
+
+    -- The unique ID of the accepted quote that the policy enforces. The
+    -- short channel ID of the policy is derived from this ID.
+    quote_id BLOB UNIQUE NOT NULL CHECK(length(quote_id) = 32),
+
+    -- The public key of the peer that requested the quote.
+    peer BLOB NOT NULL CHECK(length(peer) = 33),
+
+    -- The amount of the asset that the policy applies to.
+    asset_amount BIGINT NOT NULL,
+
+    -- The accepted price for the asset amount in millisatoshi.
+    price_msat BIGINT NOT NULL,
+
+    -- The unix timestamp in seconds after which the policy is no longer
+    -- valid.
+    expiry BIGINT NOT NULL,
+
+    -- The ID of the asset that the policy applies to, if the quote was
+    -- requested for a specific asset.
+    asset_id BLOB CHECK(length(asset_id) = 32),
+
+    -- The key of the asset group that the policy applies to, if the quote
+    -- was requested for an asset group.
+    group_key BLOB CHECK(length(group_key) = 33),
+
+    -- The signature of the accept message by our node key that the policy
+    -- is derived from.
+    signature BLOB CHECK(length(signature) = 64)
+);
+
+CREATE INDEX IF NOT EXISTS rfq_policies_expiry_idx ON rfq_policies (expiry);
@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS rfq_trades_created_at_idx;
+DROP TABLE IF EXISTS rfq_trades;
+
+DROP INDEX IF EXISTS rfq_quote_log_peer_idx;
+DROP INDEX IF EXISTS rfq_quote_log_created_at_idx;
+DROP TABLE IF EXISTS rfq_quote_log;
@@ -0,0 +1,94 @@
+-- rfq_quote_log is the ledger of all quotes that our node requested from peers
+-- or that peers requested from our node. Each entry tracks the lifecycle of a
+-- single quote from the request to its final status.
+CREATE TABLE IF NOT EXISTS rfq_quote_log (
+    id BIGINT PRIMARY KEY,
+
+    -- The unique ID of the quote request.
+    quote_id BLOB UNIQUE NOT NULL CHECK(length(quote_id) = 32),
+
+    -- The type of the quote. A buy quote is requested by the party that
+    -- wants to buy an asset, a sell quote by the party that wants to sell an
+    -- asset.
+    quote_type TEXT NOT NULL CHECK(quote_type IN ('buy', 'sell')),
+
+    -- Whether the quote was requested by the peer and answered by our node.
+    is_incoming BOOLEAN NOT NULL,
+
+    -- The public key of the counterparty peer.
+    peer BLOB NOT NULL CHECK(length(peer) = 33),
+
+    -- The ID of the subject asset. Either the asset ID or the group key is
+    -- set.
+    asset_id BLOB CHECK(length(asset_id) = 32),
+
+    -- The group key of the subject asset.
+    group_key BLOB CHECK(length(group_key) = 33),
+
+    -- The amount of the asset that the quote is for.
+    asset_amount BIGINT NOT NULL,
+
+    -- The price for the asset amount in millisatoshi. This is the price
+    -- suggested in the request until the quote is accepted, after which it
+    -- is the accepted price.
+    price_msat BIGINT NOT NULL,
+
+    -- The unix timestamp in seconds after which the accepted quote is no
+    -- longer valid. This is zero until the quote is accepted.
+    expiry BIGINT NOT NULL DEFAULT 0,
+
+    -- The status of the quote.
+    status TEXT NOT NULL CHECK(
+        status IN ('requested', 'accepted', 'rejected', 'expired')
+    ),
+
+    -- The signature of the accept message, if the quote was accepted.
+    signature BLOB CHECK(length(signature) = 64),
+
+    -- The error code and message of the reject message, if the quote was
+    -- rejected.
+    reject_code INTEGER,
+    reject_msg TEXT,
+
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS rfq_quote_log_created_at_idx
+ON rfq_quote_log (created_at);
+
+CREATE INDEX IF NOT EXISTS rfq_quote_log_peer_idx ON rfq_quote_log (peer);
+
+-- rfq_trades records the HTLCs that were accepted by our node as compliant
+-- with the policy of an accepted quote.
+CREATE TABLE IF NOT EXISTS rfq_trades (
+    id BIGINT PRIMARY KEY,
+
+    -- The quote that the HTLC was accepted against.
+    quote_log_id BIGINT NOT NULL REFERENCES rfq_quote_log(id),
+
+    -- The incoming channel ID and HTLC index uniquely identify the HTLC.
+    incoming_chan_id BIGINT NOT NULL,
+    htlc_id BIGINT NOT NULL,
+
+    -- The outgoing amount of the HTLC in millisatoshi.
+    amount_msat BIGINT NOT NULL,
+
+    created_at TIMESTAMP NOT NULL,
+
+    -- The asset amount that the HTLC pays for. This is the share of the
+    -- quote's asset amount that corresponds to the HTLC amount.
+    asset_amount BIGINT NOT NULL,
+
+    -- The status of the trade. A trade is pending from the moment its HTLC
+    -- is accepted until the HTLC is either settled or failed.
+    status TEXT NOT NULL CHECK(status IN ('pending', 'settled', 'failed')),
+
+    -- The time at which the HTLC of the trade was settled or failed.
+    resolved_at TIMESTAMP,
+
+    UNIQUE(incoming_chan_id, htlc_id)
+);
+
+CREATE INDEX IF NOT EXISTS rfq_trades_created_at_idx
+ON rfq_trades (created_at);
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS universe_pending_commitments;
+DROP INDEX IF EXISTS universe_commitment_leaves_leaf_idx;
+DROP TABLE IF EXISTS universe_commitment_leaves;
+DROP TABLE IF EXISTS universe_commitments;
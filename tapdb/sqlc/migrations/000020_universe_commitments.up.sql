@@ -0,0 +1,89 @@
+-- universe_commitments stores the on-chain commitments of the issuance
+-- multiverse root. Each commitment anchors the root in a P2TR output whose
+-- output key commits to a tapscript leaf that contains the root.
+CREATE TABLE IF NOT EXISTS universe_commitments (
+    id BIGINT PRIMARY KEY,
+
+    -- The hash and sum of the committed multiverse root.
+    root_hash BLOB NOT NULL CHECK(length(root_hash) = 32),
+    root_sum BIGINT NOT NULL,
+
+    -- The transaction that anchors the root, and the index of the anchor
+    -- output within it.
+    anchor_txid BLOB NOT NULL CHECK(length(anchor_txid) = 32),
+    anchor_tx BLOB NOT NULL,
+    output_index INTEGER NOT NULL,
+
+    -- The internal key of the anchor output.
+    internal_key_id BIGINT NOT NULL REFERENCES internal_keys(key_id),
+
+    -- The block that the anchor transaction confirmed in, and the merkle
+    -- proof of the transaction within that block.
+    block_height INTEGER NOT NULL,
+    block_header BLOB NOT NULL,
+    merkle_proof BLOB NOT NULL,
+
+    created_at TIMESTAMP NOT NULL,
+
+    UNIQUE(anchor_txid, output_index)
+);
+
+-- universe_commitment_leaves stores a snapshot of the multiverse leaves that
+-- make up the committed root of a commitment. This allows us to create
+-- inclusion proofs for a committed root after the multiverse has changed.
+CREATE TABLE IF NOT EXISTS universe_commitment_leaves (
+    id BIGINT PRIMARY KEY,
+
+    commitment_id BIGINT NOT NULL REFERENCES universe_commitments(id)
+        ON DELETE CASCADE,
+
+    -- The universe of the leaf. Either the asset ID or the 32 byte schnorr
+    -- group key is set.
+    asset_id BLOB CHECK(length(asset_id) = 32),
+    group_key BLOB CHECK(length(group_key) = 32),
+
+    -- The key of the leaf within the multiverse tree.
+    leaf_node_key BLOB NOT NULL,
+
+    -- The value and sum of the leaf. The value is the root hash of the
+    -- universe.
+    leaf_value BLOB NOT NULL,
+    leaf_sum BIGINT NOT NULL,
+
+    CHECK (
+        (asset_id IS NOT NULL AND group_key IS NULL) OR
+        (asset_id IS NULL AND group_key IS NOT NULL)
+    ),
+
+    UNIQUE(commitment_id, leaf_node_key)
+);
+
+CREATE INDEX IF NOT EXISTS universe_commitment_leaves_leaf_idx
+ON universe_commitment_leaves (leaf_node_key, leaf_value);
+
+-- universe_pending_commitments stores the signed transactions of universe
+-- commitments that were published, but haven't confirmed yet. The
+-- transaction is stored before it is published, so that we keep track of the
+-- anchor output across restarts. Once the transaction confirms, the pending
+-- commitment is replaced by an entry in universe_commitments.
+CREATE TABLE IF NOT EXISTS universe_pending_commitments (
+    id BIGINT PRIMARY KEY,
+
+    -- The hash and sum of the committed multiverse root.
+    root_hash BLOB NOT NULL CHECK(length(root_hash) = 32),
+    root_sum BIGINT NOT NULL,
+
+    -- The transaction that anchors the root, and the index of the anchor
+    -- output within it.
+    anchor_txid BLOB UNIQUE NOT NULL CHECK(length(anchor_txid) = 32),
+    anchor_tx BLOB NOT NULL,
+    output_index INTEGER NOT NULL,
+
+    -- The internal key of the anchor output.
+    internal_key_id BIGINT NOT NULL REFERENCES internal_keys(key_id),
+
+    -- The block height at which the transaction was created.
+    height_hint INTEGER NOT NULL,
+
+    created_at TIMESTAMP NOT NULL
+);
@@ -0,0 +1,51 @@
+-- Proof pushes that reached the maximum number of attempts are marked with
+-- the terminal 'failed' status, so they aren't retried anymore. SQLite doesn't
+-- allow us to alter the check constraint of the status column, so we recreate
+-- the table instead. No other table references the log entries, so we let
+-- the database assign new IDs to them.
+CREATE TABLE IF NOT EXISTS federation_proof_sync_log_new (
+    id BIGINT PRIMARY KEY,
+
+    -- The status of the proof sync attempt.
+    status TEXT NOT NULL CHECK(status IN ('pending', 'complete', 'failed')),
+
+    -- The timestamp of when the log entry for the associated proof was last
+    -- updated.
+    timestamp TIMESTAMP NOT NULL,
+
+    -- The number of attempts that have been made to sync the proof.
+    attempt_counter BIGINT NOT NULL DEFAULT 0,
+
+    -- The direction of the proof sync attempt.
+    sync_direction TEXT NOT NULL CHECK(sync_direction IN ('push', 'pull')),
+
+    -- The ID of the subject proof leaf.
+    proof_leaf_id BIGINT NOT NULL REFERENCES universe_leaves(id),
+
+    -- The ID of the universe that the proof leaf belongs to.
+    universe_root_id BIGINT NOT NULL REFERENCES universe_roots(id),
+
+    -- The ID of the server that the proof will be/was synced to.
+    servers_id BIGINT NOT NULL REFERENCES universe_servers(id)
+);
+
+INSERT INTO federation_proof_sync_log_new (
+    status, timestamp, attempt_counter, sync_direction, proof_leaf_id,
+    universe_root_id, servers_id
+)
+SELECT status, timestamp, attempt_counter, sync_direction, proof_leaf_id,
+    universe_root_id, servers_id
+FROM federation_proof_sync_log
+ORDER BY id;
+
+DROP TABLE federation_proof_sync_log;
+
+ALTER TABLE federation_proof_sync_log_new RENAME TO federation_proof_sync_log;
+
+CREATE UNIQUE INDEX federation_proof_sync_log_unique_index_proof_leaf_id_servers_id
+ON federation_proof_sync_log (
+    sync_direction,
+    proof_leaf_id,
+    universe_root_id,
+    servers_id
+);
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS universe_leaf_events_leaf_id_idx;
+DROP TABLE IF EXISTS universe_leaf_events;
@@ -0,0 +1,17 @@
+-- universe_leaf_events records every upsert of a universe leaf. The sequence
+-- number is assigned within the write transaction of the upsert as one more
+-- than the highest sequence number so far. As write transactions are
+-- serializable, the sequence numbers are gap-free and follow the commit order,
+-- which makes them safe to use as a cursor for leaf subscriptions.
+CREATE TABLE IF NOT EXISTS universe_leaf_events (
+    id BIGINT PRIMARY KEY,
+
+    seq BIGINT UNIQUE NOT NULL,
+
+    -- The leaf is set to NULL if it is deleted, so the event keeps its
+    -- sequence number and it is never handed out again.
+    leaf_id BIGINT REFERENCES universe_leaves(id) ON DELETE SET NULL
+);
+
+CREATE INDEX IF NOT EXISTS universe_leaf_events_leaf_id_idx
+    ON universe_leaf_events (leaf_id);
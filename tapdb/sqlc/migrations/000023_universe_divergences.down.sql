@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS universe_divergence_leaves;
+DROP INDEX IF EXISTS universe_divergences_server_idx;
+DROP TABLE IF EXISTS universe_divergences;
@@ -0,0 +1,50 @@
+-- universe_divergences records universes whose local root still didn't match
+-- the root of a federation server after syncing with that server. This can be
+-- caused by a server that equivocates or by a corrupted local or remote
+-- universe.
+CREATE TABLE IF NOT EXISTS universe_divergences (
+    id BIGINT PRIMARY KEY,
+
+    -- The host of the universe server we synced with. We don't reference
+    -- the universe_servers table, as the divergence should be kept around
+    -- even if the server is removed from the federation.
+    server_host TEXT NOT NULL,
+
+    -- The universe that diverged. Either the asset ID or the compressed
+    -- group key is set.
+    asset_id BLOB CHECK(length(asset_id) = 32),
+    group_key BLOB CHECK(LENGTH(group_key) = 33),
+    proof_type TEXT NOT NULL CHECK(proof_type IN ('issuance', 'transfer')),
+
+    -- The hash and sum of the local and remote universe roots after the
+    -- sync.
+    local_root_hash BLOB NOT NULL CHECK(length(local_root_hash) = 32),
+    local_root_sum BIGINT NOT NULL,
+    remote_root_hash BLOB NOT NULL CHECK(length(remote_root_hash) = 32),
+    remote_root_sum BIGINT NOT NULL,
+
+    detected_at TIMESTAMP NOT NULL,
+
+    CHECK (
+        (asset_id IS NOT NULL AND group_key IS NULL) OR
+        (asset_id IS NULL AND group_key IS NOT NULL)
+    )
+);
+
+CREATE INDEX IF NOT EXISTS universe_divergences_server_idx
+ON universe_divergences (server_host);
+
+-- universe_divergence_leaves stores the keys of the leaves that caused a
+-- divergence. These are leaves that the remote server has, but that are
+-- either missing from the local universe or have a different value locally.
+CREATE TABLE IF NOT EXISTS universe_divergence_leaves (
+    id BIGINT PRIMARY KEY,
+
+    divergence_id BIGINT NOT NULL REFERENCES universe_divergences(id)
+        ON DELETE CASCADE,
+
+    minting_point BLOB NOT NULL,
+    script_key_bytes BLOB NOT NULL CHECK(length(script_key_bytes) = 32),
+
+    UNIQUE(divergence_id, minting_point, script_key_bytes)
+);
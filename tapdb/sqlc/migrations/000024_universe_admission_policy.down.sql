@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS universe_rejected_leaves;
+DROP TABLE IF EXISTS universe_admission_limits;
+DROP TABLE IF EXISTS universe_admission_rules;
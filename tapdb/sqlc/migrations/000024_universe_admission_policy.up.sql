@@ -0,0 +1,45 @@
+-- universe_admission_rules stores the allow and deny rules of the admission
+-- policy for proofs that remote clients insert into the universe.
+CREATE TABLE IF NOT EXISTS universe_admission_rules (
+    id BIGINT PRIMARY KEY,
+
+    rule_type TEXT NOT NULL CHECK(rule_type IN ('allow', 'deny')),
+
+    -- The asset ID or compressed group key the rule applies to. Exactly one
+    -- of them is set.
+    asset_id BLOB CHECK(length(asset_id) = 32),
+    group_key BLOB CHECK(LENGTH(group_key) = 33),
+
+    CHECK (
+        (asset_id IS NOT NULL AND group_key IS NULL) OR
+        (asset_id IS NULL AND group_key IS NOT NULL)
+    )
+);
+
+-- universe_admission_limits stores the limits of the admission policy. The
+-- table only ever contains a single row.
+CREATE TABLE IF NOT EXISTS universe_admission_limits (
+    id BIGINT PRIMARY KEY CHECK (id = 1),
+
+    -- The maximum size in bytes of an inserted proof, zero means no limit.
+    max_proof_size BIGINT NOT NULL,
+
+    -- The maximum number of proofs a single client can insert per minute,
+    -- zero means no limit.
+    max_inserts_per_minute BIGINT NOT NULL
+);
+
+-- universe_rejected_leaves stores a tombstone for each leaf that the syncer
+-- fetched from a federation server and that was rejected by the admission
+-- policy. The syncer uses it to skip these leaves on later syncs instead of
+-- fetching them again. The tombstones are removed whenever the policy is
+-- replaced, so the leaves are reconsidered under the new policy.
+CREATE TABLE IF NOT EXISTS universe_rejected_leaves (
+    id BIGINT PRIMARY KEY,
+
+    leaf_node_namespace VARCHAR NOT NULL,
+
+    leaf_node_key BLOB NOT NULL,
+
+    UNIQUE(leaf_node_namespace, leaf_node_key)
+);
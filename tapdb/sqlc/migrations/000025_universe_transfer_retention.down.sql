@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS universe_pruned_leaves;
+DROP TABLE IF EXISTS universe_transfer_spends;
+DROP INDEX IF EXISTS universe_transfer_anchors_height_idx;
+DROP TABLE IF EXISTS universe_transfer_anchors;
@@ -0,0 +1,46 @@
+-- universe_transfer_anchors stores the block that the proof of each transfer
+-- leaf is anchored in. It is used to decide which transfer leaves are old
+-- enough to be pruned under the transfer retention policy.
+CREATE TABLE IF NOT EXISTS universe_transfer_anchors (
+    id BIGINT PRIMARY KEY,
+
+    leaf_id BIGINT UNIQUE NOT NULL REFERENCES universe_leaves(id) ON DELETE CASCADE,
+
+    block_height INTEGER NOT NULL,
+
+    block_timestamp TIMESTAMP NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS universe_transfer_anchors_height_idx
+ON universe_transfer_anchors (block_height);
+
+-- universe_transfer_spends stores the asset outputs of a transfer universe
+-- that were spent by a later transfer in the same universe. The transfer
+-- leaves of these outputs are superseded and can be pruned. We don't reference
+-- the spending leaf, as an output stays spent even after the spending leaf
+-- itself was pruned.
+CREATE TABLE IF NOT EXISTS universe_transfer_spends (
+    id BIGINT PRIMARY KEY,
+
+    leaf_node_namespace VARCHAR NOT NULL,
+
+    minting_point BLOB NOT NULL,
+
+    script_key_bytes BLOB NOT NULL CHECK(LENGTH(script_key_bytes) = 32),
+
+    UNIQUE(minting_point, script_key_bytes, leaf_node_namespace)
+);
+
+-- universe_pruned_leaves stores a tombstone for each transfer leaf that was
+-- pruned under the transfer retention policy. The syncer uses it to skip the
+-- pruned leaves that are still part of the trees of federation servers, so
+-- they aren't synced again.
+CREATE TABLE IF NOT EXISTS universe_pruned_leaves (
+    id BIGINT PRIMARY KEY,
+
+    leaf_node_namespace VARCHAR NOT NULL,
+
+    leaf_node_key BLOB NOT NULL,
+
+    UNIQUE(leaf_node_namespace, leaf_node_key)
+);
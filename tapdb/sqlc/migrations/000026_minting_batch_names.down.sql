@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS pending_batch_name_unique;
+ALTER TABLE asset_minting_batches DROP COLUMN batch_name;
@@ -0,0 +1,9 @@
+-- The batch name is an optional, human readable identifier of a minting batch.
+-- It allows several pending batches to be used concurrently, with each new
+-- seedling being added to the batch with the given name.
+ALTER TABLE asset_minting_batches ADD COLUMN batch_name TEXT;
+
+-- The name of a batch must be unique among all pending batches. Once a batch
+-- is frozen, its name can be used for a new batch.
+CREATE UNIQUE INDEX IF NOT EXISTS pending_batch_name_unique
+ON asset_minting_batches (batch_name) WHERE batch_state = 0;
@@ -78,6 +78,7 @@ type AssetMintingBatch struct {
 	HeightHint        int32
 	CreationTimeUnix  time.Time
 	TapscriptSibling  []byte
+	BatchName         sql.NullString
 }
 
 type AssetProof struct {
@@ -301,6 +302,63 @@ type ProofTransferLog struct {
 	TimeUnix         time.Time
 }
 
+type RfqPeerAcceptedQuote struct {
+	ID          int64
+	QuoteType   string
+	QuoteID     []byte
+	Peer        []byte
+	AssetAmount int64
+	PriceMsat   int64
+	Expiry      int64
+	AssetID     []byte
+	GroupKey    []byte
+	Signature   []byte
+}
+
+type RfqPolicy struct {
+	ID          int64
+	PolicyType  string
+	QuoteID     []byte
+	Peer        []byte
+	AssetAmount int64
+	PriceMsat   int64
+	Expiry      int64
+	AssetID     []byte
+	GroupKey    []byte
+	Signature   []byte
+}
+
+type RfqQuoteLog struct {
+	ID          int64
+	QuoteID     []byte
+	QuoteType   string
+	IsIncoming  bool
+	Peer        []byte
+	AssetID     []byte
+	GroupKey    []byte
+	AssetAmount int64
+	PriceMsat   int64
+	Expiry      int64
+	Status      string
+	Signature   []byte
+	RejectCode  sql.NullInt32
+	RejectMsg   sql.NullString
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+type RfqTrade struct {
+	ID             int64
+	QuoteLogID     int64
+	IncomingChanID int64
+	HtlcID         int64
+	AmountMsat     int64
+	CreatedAt      time.Time
+	AssetAmount    int64
+	Status         string
+	ResolvedAt     sql.NullTime
+}
+
 type ScriptKey struct {
 	ScriptKeyID      int64
 	InternalKeyID    int64
@@ -326,6 +384,63 @@ type TapscriptRoot struct {
 	BranchOnly bool
 }
 
+type UniverseAdmissionLimit struct {
+	ID                  int64
+	MaxProofSize        int64
+	MaxInsertsPerMinute int64
+}
+
+type UniverseAdmissionRule struct {
+	ID       int64
+	RuleType string
+	AssetID  []byte
+	GroupKey []byte
+}
+
+type UniverseCommitment struct {
+	ID            int64
+	RootHash      []byte
+	RootSum       int64
+	AnchorTxid    []byte
+	AnchorTx      []byte
+	OutputIndex   int32
+	InternalKeyID int64
+	BlockHeight   int32
+	BlockHeader   []byte
+	MerkleProof   []byte
+	CreatedAt     time.Time
+}
+
+type UniverseCommitmentLeafe struct {
+	ID           int64
+	CommitmentID int64
+	AssetID      []byte
+	GroupKey     []byte
+	LeafNodeKey  []byte
+	LeafValue    []byte
+	LeafSum      int64
+}
+
+type UniverseDivergence struct {
+	ID             int64
+	ServerHost     string
+	AssetID        []byte
+	GroupKey       []byte
+	ProofType      string
+	LocalRootHash  []byte
+	LocalRootSum   int64
+	RemoteRootHash []byte
+	RemoteRootSum  int64
+	DetectedAt     time.Time
+}
+
+type UniverseDivergenceLeafe struct {
+	ID             int64
+	DivergenceID   int64
+	MintingPoint   []byte
+	ScriptKeyBytes []byte
+}
+
 type UniverseEvent struct {
 	EventID        int64
 	EventType      string
@@ -334,6 +449,12 @@ type UniverseEvent struct {
 	EventTimestamp int64
 }
 
+type UniverseLeafEvent struct {
+	ID     int64
+	Seq    int64
+	LeafID sql.NullInt64
+}
+
 type UniverseLeafe struct {
 	ID                int64
 	AssetGenesisID    int64
@@ -344,6 +465,30 @@ type UniverseLeafe struct {
 	LeafNodeNamespace string
 }
 
+type UniversePendingCommitment struct {
+	ID            int64
+	RootHash      []byte
+	RootSum       int64
+	AnchorTxid    []byte
+	AnchorTx      []byte
+	OutputIndex   int32
+	InternalKeyID int64
+	HeightHint    int32
+	CreatedAt     time.Time
+}
+
+type UniversePrunedLeafe struct {
+	ID                int64
+	LeafNodeNamespace string
+	LeafNodeKey       []byte
+}
+
+type UniverseRejectedLeafe struct {
+	ID                int64
+	LeafNodeNamespace string
+	LeafNodeKey       []byte
+}
+
 type UniverseRoot struct {
 	ID            int64
 	NamespaceRoot string
@@ -365,3 +510,17 @@ type UniverseStat struct {
 	GroupKey         []byte
 	ProofType        string
 }
+
+type UniverseTransferAnchor struct {
+	ID             int64
+	LeafID         int64
+	BlockHeight    int32
+	BlockTimestamp time.Time
+}
+
+type UniverseTransferSpend struct {
+	ID                int64
+	LeafNodeNamespace string
+	MintingPoint      []byte
+	ScriptKeyBytes    []byte
+}
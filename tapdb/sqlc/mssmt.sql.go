@@ -50,6 +50,34 @@ func (q *Queries) DeleteRoot(ctx context.Context, namespace string) (int64, erro
 	return result.RowsAffected()
 }
 
+const deleteUnreachableNodes = `-- name: DeleteUnreachableNodes :execrows
+WITH RECURSIVE reachable_nodes (hash_key, l_hash_key, r_hash_key) AS (
+    SELECT nodes.hash_key, nodes.l_hash_key, nodes.r_hash_key
+    FROM mssmt_nodes nodes
+    JOIN mssmt_roots roots
+        ON roots.root_hash = nodes.hash_key AND
+            roots.namespace = nodes.namespace
+    WHERE nodes.namespace = $1
+  UNION
+    SELECT c.hash_key, c.l_hash_key, c.r_hash_key
+    FROM mssmt_nodes c
+    JOIN reachable_nodes r
+        ON c.hash_key = r.l_hash_key OR c.hash_key = r.r_hash_key
+    WHERE c.namespace = $1
+)
+DELETE FROM mssmt_nodes
+WHERE namespace = $1 AND
+    hash_key NOT IN (SELECT hash_key FROM reachable_nodes)
+`
+
+func (q *Queries) DeleteUnreachableNodes(ctx context.Context, namespace string) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteUnreachableNodes, namespace)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const fetchAllNodes = `-- name: FetchAllNodes :many
 SELECT hash_key, l_hash_key, r_hash_key, key, value, sum, namespace FROM mssmt_nodes
 `
@@ -24,21 +24,37 @@ type Querier interface {
 	ConfirmChainAnchorTx(ctx context.Context, arg ConfirmChainAnchorTxParams) error
 	ConfirmChainTx(ctx context.Context, arg ConfirmChainTxParams) error
 	DeleteAllNodes(ctx context.Context, namespace string) (int64, error)
+	DeleteAssetSeedling(ctx context.Context, arg DeleteAssetSeedlingParams) error
 	DeleteAssetWitnesses(ctx context.Context, assetID int64) error
+	DeleteExpiredRfqPeerAcceptedQuotes(ctx context.Context, minExpiry int64) (int64, error)
+	DeleteExpiredRfqPolicies(ctx context.Context, minExpiry int64) (int64, error)
 	DeleteExpiredUTXOLeases(ctx context.Context, now sql.NullTime) error
 	DeleteFederationProofSyncLog(ctx context.Context, arg DeleteFederationProofSyncLogParams) error
+	DeleteFederationProofSyncLogsForLeaf(ctx context.Context, leafID int64) error
 	DeleteManagedUTXO(ctx context.Context, outpoint []byte) error
 	DeleteMultiverseLeaf(ctx context.Context, arg DeleteMultiverseLeafParams) error
 	DeleteNode(ctx context.Context, arg DeleteNodeParams) (int64, error)
 	DeleteRoot(ctx context.Context, namespace string) (int64, error)
+	// Removes the multiverse leaves of all commitments except the given one.
+	DeleteStaleUniverseCommitmentLeaves(ctx context.Context, commitmentID int64) error
 	DeleteTapscriptTreeEdges(ctx context.Context, rootHash []byte) error
 	DeleteTapscriptTreeNodes(ctx context.Context) error
 	DeleteTapscriptTreeRoot(ctx context.Context, rootHash []byte) error
+	DeleteUniverseLeaf(ctx context.Context, id int64) error
+	DeleteUniversePrunedLeaves(ctx context.Context, namespace string) error
+	DeleteUniverseTransferSpends(ctx context.Context, namespace string) error
+	DeleteUnreachableNodes(ctx context.Context, namespace string) (int64, error)
 	DeleteUTXOLease(ctx context.Context, outpoint []byte) error
+	DeleteUniverseAdmissionRules(ctx context.Context) error
 	DeleteUniverseEvents(ctx context.Context, namespaceRoot string) error
 	DeleteUniverseLeaves(ctx context.Context, namespace string) error
+	DeleteUniversePendingCommitment(ctx context.Context, anchorTxid []byte) error
+	DeleteUniverseRejectedLeaves(ctx context.Context) error
 	DeleteUniverseRoot(ctx context.Context, namespaceRoot string) error
 	DeleteUniverseServer(ctx context.Context, arg DeleteUniverseServerParams) error
+	// Quotes which were created before the given time and never traded against
+	// are removed from the ledger.
+	DeleteUntradedRfqQuoteLogs(ctx context.Context, minCreatedAt time.Time) (int64, error)
 	FetchAddrByTaprootOutputKey(ctx context.Context, taprootOutputKey []byte) (FetchAddrByTaprootOutputKeyRow, error)
 	FetchAddrEvent(ctx context.Context, id int64) (FetchAddrEventRow, error)
 	FetchAddrEventByAddrKeyAndOutpoint(ctx context.Context, arg FetchAddrEventByAddrKeyAndOutpointParams) (FetchAddrEventByAddrKeyAndOutpointRow, error)
@@ -69,11 +85,17 @@ type Querier interface {
 	FetchGroupByGroupKey(ctx context.Context, groupKey []byte) (FetchGroupByGroupKeyRow, error)
 	FetchGroupedAssets(ctx context.Context) ([]FetchGroupedAssetsRow, error)
 	FetchInternalKeyLocator(ctx context.Context, rawKey []byte) (FetchInternalKeyLocatorRow, error)
+	FetchLatestUniverseLeafEventSeq(ctx context.Context) (int64, error)
+	// Returns the most recent commitment whose snapshot contains the given
+	// multiverse leaf.
+	FetchLeafCommitmentID(ctx context.Context, arg FetchLeafCommitmentIDParams) (int64, error)
 	FetchManagedUTXO(ctx context.Context, arg FetchManagedUTXOParams) (FetchManagedUTXORow, error)
 	FetchManagedUTXOs(ctx context.Context) ([]FetchManagedUTXOsRow, error)
 	FetchMintingBatch(ctx context.Context, rawKey []byte) (FetchMintingBatchRow, error)
 	FetchMintingBatchesByInverseState(ctx context.Context, batchState int16) ([]FetchMintingBatchesByInverseStateRow, error)
 	FetchMultiverseRoot(ctx context.Context, namespaceRoot string) (FetchMultiverseRootRow, error)
+	FetchRfqPeerAcceptedQuotes(ctx context.Context, minExpiry int64) ([]FetchRfqPeerAcceptedQuotesRow, error)
+	FetchRfqPolicies(ctx context.Context, minExpiry int64) ([]FetchRfqPoliciesRow, error)
 	FetchRootNode(ctx context.Context, namespace string) (MssmtNode, error)
 	FetchScriptKeyByTweakedKey(ctx context.Context, tweakedScriptKey []byte) (FetchScriptKeyByTweakedKeyRow, error)
 	FetchScriptKeyIDByTweakedKey(ctx context.Context, tweakedScriptKey []byte) (int64, error)
@@ -84,7 +106,14 @@ type Querier interface {
 	FetchTapscriptTree(ctx context.Context, rootHash []byte) ([]FetchTapscriptTreeRow, error)
 	FetchTransferInputs(ctx context.Context, transferID int64) ([]FetchTransferInputsRow, error)
 	FetchTransferOutputs(ctx context.Context, transferID int64) ([]FetchTransferOutputsRow, error)
+	FetchUniverseAdmissionLimits(ctx context.Context) (FetchUniverseAdmissionLimitsRow, error)
+	FetchUniverseCommitmentLeaves(ctx context.Context, commitmentID int64) ([]FetchUniverseCommitmentLeavesRow, error)
+	FetchUniverseDivergenceLeaves(ctx context.Context, divergenceID int64) ([]FetchUniverseDivergenceLeavesRow, error)
 	FetchUniverseKeys(ctx context.Context, arg FetchUniverseKeysParams) ([]FetchUniverseKeysRow, error)
+	FetchUniverseLeafKey(ctx context.Context, arg FetchUniverseLeafKeyParams) (FetchUniverseLeafKeyRow, error)
+	FetchUniversePendingCommitments(ctx context.Context) ([]FetchUniversePendingCommitmentsRow, error)
+	FetchUniversePrunedLeaf(ctx context.Context, arg FetchUniversePrunedLeafParams) (int64, error)
+	FetchUniverseRejectedLeaf(ctx context.Context, arg FetchUniverseRejectedLeafParams) (int64, error)
 	FetchUniverseRoot(ctx context.Context, namespace string) (FetchUniverseRootRow, error)
 	GenesisAssets(ctx context.Context) ([]GenesisAsset, error)
 	GenesisPoints(ctx context.Context) ([]GenesisPoint, error)
@@ -103,10 +132,25 @@ type Querier interface {
 	InsertNewProofEvent(ctx context.Context, arg InsertNewProofEventParams) error
 	InsertNewSyncEvent(ctx context.Context, arg InsertNewSyncEventParams) error
 	InsertPassiveAsset(ctx context.Context, arg InsertPassiveAssetParams) error
+	InsertRfqQuoteLog(ctx context.Context, arg InsertRfqQuoteLogParams) error
+	InsertRfqTrade(ctx context.Context, arg InsertRfqTradeParams) (int64, error)
 	InsertRootKey(ctx context.Context, arg InsertRootKeyParams) error
+	InsertUniverseAdmissionRule(ctx context.Context, arg InsertUniverseAdmissionRuleParams) error
+	InsertUniverseCommitment(ctx context.Context, arg InsertUniverseCommitmentParams) (int64, error)
+	InsertUniverseCommitmentLeaf(ctx context.Context, arg InsertUniverseCommitmentLeafParams) error
+	InsertUniverseDivergence(ctx context.Context, arg InsertUniverseDivergenceParams) (int64, error)
+	InsertUniverseDivergenceLeaf(ctx context.Context, arg InsertUniverseDivergenceLeafParams) error
+	InsertUniverseLeafEvent(ctx context.Context, leafID sql.NullInt64) (int64, error)
+	InsertUniversePendingCommitment(ctx context.Context, arg InsertUniversePendingCommitmentParams) error
+	InsertUniversePrunedLeaf(ctx context.Context, arg InsertUniversePrunedLeafParams) error
+	InsertUniverseRejectedLeaf(ctx context.Context, arg InsertUniverseRejectedLeafParams) error
 	InsertUniverseServer(ctx context.Context, arg InsertUniverseServerParams) error
+	InsertUniverseTransferSpend(ctx context.Context, arg InsertUniverseTransferSpendParams) error
 	LogProofTransferAttempt(ctx context.Context, arg LogProofTransferAttemptParams) error
 	LogServerSync(ctx context.Context, arg LogServerSyncParams) error
+	// Accepted quotes which expired without any HTLC being settled against them
+	// are marked as expired. Trades which are still pending may yet settle.
+	MarkExpiredRfqQuoteLogs(ctx context.Context, arg MarkExpiredRfqQuoteLogsParams) (int64, error)
 	NewMintingBatch(ctx context.Context, arg NewMintingBatchParams) error
 	// We use a LEFT JOIN here as not every asset has a group key, so this'll
 	// generate rows that have NULL values for the group key fields if an asset
@@ -139,19 +183,31 @@ type Querier interface {
 	QueryMultiverseLeaves(ctx context.Context, arg QueryMultiverseLeavesParams) ([]QueryMultiverseLeavesRow, error)
 	QueryPassiveAssets(ctx context.Context, transferID int64) ([]QueryPassiveAssetsRow, error)
 	QueryProofTransferAttempts(ctx context.Context, arg QueryProofTransferAttemptsParams) ([]time.Time, error)
+	QueryPrunableTransferLeaves(ctx context.Context, arg QueryPrunableTransferLeavesParams) ([]QueryPrunableTransferLeavesRow, error)
+	QueryRfqQuoteLogs(ctx context.Context, arg QueryRfqQuoteLogsParams) ([]QueryRfqQuoteLogsRow, error)
+	QueryRfqTrades(ctx context.Context, arg QueryRfqTradesParams) ([]QueryRfqTradesRow, error)
+	QueryUnanchoredTransferLeaves(ctx context.Context, arg QueryUnanchoredTransferLeavesParams) ([]QueryUnanchoredTransferLeavesRow, error)
+	QueryUniverseAdmissionRules(ctx context.Context) ([]QueryUniverseAdmissionRulesRow, error)
 	// TODO(roasbeef): use the universe id instead for the grouping? so namespace
 	// root, simplifies queries
 	QueryUniverseAssetStats(ctx context.Context, arg QueryUniverseAssetStatsParams) ([]QueryUniverseAssetStatsRow, error)
+	QueryUniverseCommitments(ctx context.Context, arg QueryUniverseCommitmentsParams) ([]QueryUniverseCommitmentsRow, error)
+	QueryUniverseDivergences(ctx context.Context, arg QueryUniverseDivergencesParams) ([]UniverseDivergence, error)
+	QueryUniverseLeafEvents(ctx context.Context, arg QueryUniverseLeafEventsParams) ([]QueryUniverseLeafEventsRow, error)
 	QueryUniverseLeaves(ctx context.Context, arg QueryUniverseLeavesParams) ([]QueryUniverseLeavesRow, error)
 	QueryUniverseServers(ctx context.Context, arg QueryUniverseServersParams) ([]UniverseServer, error)
 	QueryUniverseStats(ctx context.Context) (QueryUniverseStatsRow, error)
 	ReAnchorPassiveAssets(ctx context.Context, arg ReAnchorPassiveAssetsParams) error
+	ResolveRfqTrade(ctx context.Context, arg ResolveRfqTradeParams) (int64, error)
 	SetAddrManaged(ctx context.Context, arg SetAddrManagedParams) error
 	SetAssetSpent(ctx context.Context, arg SetAssetSpentParams) (int64, error)
 	UniverseLeaves(ctx context.Context) ([]UniverseLeafe, error)
 	UniverseRoots(ctx context.Context, arg UniverseRootsParams) ([]UniverseRootsRow, error)
+	UpdateAssetSeedling(ctx context.Context, arg UpdateAssetSeedlingParams) error
 	UpdateBatchGenesisTx(ctx context.Context, arg UpdateBatchGenesisTxParams) error
 	UpdateMintingBatchState(ctx context.Context, arg UpdateMintingBatchStateParams) error
+	UpdateRfqQuoteLogAccepted(ctx context.Context, arg UpdateRfqQuoteLogAcceptedParams) (int64, error)
+	UpdateRfqQuoteLogRejected(ctx context.Context, arg UpdateRfqQuoteLogRejectedParams) (int64, error)
 	UpdateUTXOLease(ctx context.Context, arg UpdateUTXOLeaseParams) error
 	UpsertAddrEvent(ctx context.Context, arg UpsertAddrEventParams) (int64, error)
 	UpsertAssetGroupKey(ctx context.Context, arg UpsertAssetGroupKeyParams) (int64, error)
@@ -170,13 +226,17 @@ type Querier interface {
 	UpsertManagedUTXO(ctx context.Context, arg UpsertManagedUTXOParams) (int64, error)
 	UpsertMultiverseLeaf(ctx context.Context, arg UpsertMultiverseLeafParams) (int64, error)
 	UpsertMultiverseRoot(ctx context.Context, arg UpsertMultiverseRootParams) (int64, error)
+	UpsertRfqPeerAcceptedQuote(ctx context.Context, arg UpsertRfqPeerAcceptedQuoteParams) error
+	UpsertRfqPolicy(ctx context.Context, arg UpsertRfqPolicyParams) error
 	UpsertRootNode(ctx context.Context, arg UpsertRootNodeParams) error
 	UpsertScriptKey(ctx context.Context, arg UpsertScriptKeyParams) (int64, error)
 	UpsertTapscriptTreeEdge(ctx context.Context, arg UpsertTapscriptTreeEdgeParams) (int64, error)
 	UpsertTapscriptTreeNode(ctx context.Context, rawNode []byte) (int64, error)
 	UpsertTapscriptTreeRootHash(ctx context.Context, arg UpsertTapscriptTreeRootHashParams) (int64, error)
-	UpsertUniverseLeaf(ctx context.Context, arg UpsertUniverseLeafParams) error
+	UpsertUniverseAdmissionLimits(ctx context.Context, arg UpsertUniverseAdmissionLimitsParams) error
+	UpsertUniverseLeaf(ctx context.Context, arg UpsertUniverseLeafParams) (int64, error)
 	UpsertUniverseRoot(ctx context.Context, arg UpsertUniverseRootParams) (int64, error)
+	UpsertUniverseTransferAnchor(ctx context.Context, arg UpsertUniverseTransferAnchorParams) error
 }
 
 var _ Querier = (*Queries)(nil)
@@ -10,8 +10,8 @@ RETURNING key_id;
 
 -- name: NewMintingBatch :exec
 INSERT INTO asset_minting_batches (
-    batch_state, batch_id, height_hint, creation_time_unix
-) VALUES (0, $1, $2, $3);
+    batch_state, batch_id, height_hint, creation_time_unix, batch_name
+) VALUES (0, $1, $2, $3, sqlc.narg('batch_name'));
 
 -- name: FetchMintingBatchesByInverseState :many
 SELECT *
@@ -84,6 +84,32 @@ WHERE (
     asset_seedlings.asset_name = @seedling_name
 );
 
+-- name: DeleteAssetSeedling :exec
+WITH target_key_id AS (
+    -- We use this CTE to fetch the key_id of the internal key that's
+    -- associated with a given batch, so we only delete the seedling with the
+    -- given name from the specified batch.
+    SELECT key_id
+    FROM internal_keys keys
+    WHERE keys.raw_key = @batch_key
+)
+DELETE FROM asset_seedlings
+WHERE (
+    asset_seedlings.batch_id IN (SELECT key_id FROM target_key_id) AND
+    asset_seedlings.asset_name = @seedling_name
+);
+
+-- name: UpdateAssetSeedling :exec
+UPDATE asset_seedlings
+SET asset_supply = @asset_supply,
+    asset_meta_id = @asset_meta_id,
+    emission_enabled = @emission_enabled,
+    group_genesis_id = sqlc.narg('group_genesis_id'),
+    group_anchor_id = sqlc.narg('group_anchor_id'),
+    group_internal_key_id = sqlc.narg('group_internal_key_id'),
+    group_tapscript_root = @group_tapscript_root
+WHERE seedling_id = @seedling_id;
+
 -- name: FetchSeedlingByID :one
 SELECT *
 FROM asset_seedlings
@@ -75,3 +75,22 @@ INSERT INTO mssmt_roots (
 
 -- name: FetchAllNodes :many
 SELECT * FROM mssmt_nodes;
+
+-- name: DeleteUnreachableNodes :execrows
+WITH RECURSIVE reachable_nodes (hash_key, l_hash_key, r_hash_key) AS (
+    SELECT nodes.hash_key, nodes.l_hash_key, nodes.r_hash_key
+    FROM mssmt_nodes nodes
+    JOIN mssmt_roots roots
+        ON roots.root_hash = nodes.hash_key AND
+            roots.namespace = nodes.namespace
+    WHERE nodes.namespace = @namespace
+  UNION
+    SELECT c.hash_key, c.l_hash_key, c.r_hash_key
+    FROM mssmt_nodes c
+    JOIN reachable_nodes r
+        ON c.hash_key = r.l_hash_key OR c.hash_key = r.r_hash_key
+    WHERE c.namespace = @namespace
+)
+DELETE FROM mssmt_nodes
+WHERE namespace = @namespace AND
+    hash_key NOT IN (SELECT hash_key FROM reachable_nodes);
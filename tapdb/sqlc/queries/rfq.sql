@@ -0,0 +1,149 @@
+-- name: UpsertRfqPeerAcceptedQuote :exec
+INSERT INTO rfq_peer_accepted_quotes (
+    quote_type, quote_id, peer, asset_id, group_key, asset_amount, price_msat,
+    expiry, signature
+) VALUES (
+    @quote_type, @quote_id, @peer, @asset_id, @group_key, @asset_amount,
+    @price_msat, @expiry, @signature
+)
+ON CONFLICT (quote_id)
+    DO UPDATE SET
+    quote_type = EXCLUDED.quote_type,
+    peer = EXCLUDED.peer,
+    asset_id = EXCLUDED.asset_id,
+    group_key = EXCLUDED.group_key,
+    asset_amount = EXCLUDED.asset_amount,
+    price_msat = EXCLUDED.price_msat,
+    expiry = EXCLUDED.expiry,
+    signature = EXCLUDED.signature;
+
+-- name: FetchRfqPeerAcceptedQuotes :many
+SELECT quote_type, quote_id, peer, asset_id, group_key, asset_amount,
+    price_msat, expiry, signature
+FROM rfq_peer_accepted_quotes
+WHERE expiry >= @min_expiry
+ORDER BY id;
+
+-- name: DeleteExpiredRfqPeerAcceptedQuotes :execrows
+DELETE FROM rfq_peer_accepted_quotes
+WHERE expiry < @min_expiry;
+
+-- name: UpsertRfqPolicy :exec
+INSERT INTO rfq_policies (
+    policy_type, quote_id, peer, asset_id, group_key, asset_amount, price_msat,
+    expiry, signature
+) VALUES (
+    @policy_type, @quote_id, @peer, @asset_id, @group_key, @asset_amount,
+    @price_msat, @expiry, @signature
+)
+ON CONFLICT (quote_id)
+    DO UPDATE SET
+    policy_type = EXCLUDED.policy_type,
+    peer = EXCLUDED.peer,
+    asset_id = EXCLUDED.asset_id,
+    group_key = EXCLUDED.group_key,
+    asset_amount = EXCLUDED.asset_amount,
+    price_msat = EXCLUDED.price_msat,
+    expiry = EXCLUDED.expiry,
+    signature = EXCLUDED.signature;
+
+-- name: FetchRfqPolicies :many
+SELECT policy_type, quote_id, peer, asset_id, group_key, asset_amount,
+    price_msat, expiry, signature
+FROM rfq_policies
+WHERE expiry >= @min_expiry
+ORDER BY id;
+
+-- name: DeleteExpiredRfqPolicies :execrows
+DELETE FROM rfq_policies
+WHERE expiry < @min_expiry;
+
+-- name: InsertRfqQuoteLog :exec
+INSERT INTO rfq_quote_log (
+    quote_id, quote_type, is_incoming, peer, asset_id, group_key,
+    asset_amount, price_msat, status, created_at, updated_at
+) VALUES (
+    @quote_id, @quote_type, @is_incoming, @peer, @asset_id, @group_key,
+    @asset_amount, @price_msat, 'requested', @created_at, @created_at
+)
+ON CONFLICT (quote_id)
+    -- A quote request is only logged once.
+    DO NOTHING;
+
+-- name: UpdateRfqQuoteLogAccepted :execrows
+UPDATE rfq_quote_log
+SET status = 'accepted', price_msat = @price_msat, expiry = @expiry,
+    signature = @signature, updated_at = @updated_at
+WHERE quote_id = @quote_id AND peer = @peer AND status = 'requested';
+
+-- name: UpdateRfqQuoteLogRejected :execrows
+UPDATE rfq_quote_log
+SET status = 'rejected', reject_code = @reject_code,
+    reject_msg = @reject_msg, updated_at = @updated_at
+WHERE quote_id = @quote_id AND peer = @peer AND status = 'requested';
+
+-- name: MarkExpiredRfqQuoteLogs :execrows
+-- Accepted quotes which expired without any HTLC being settled against them
+-- are marked as expired. Trades which are still pending may yet settle.
+UPDATE rfq_quote_log
+SET status = 'expired', updated_at = @updated_at
+WHERE status = 'accepted' AND expiry < @min_expiry AND
+    id NOT IN (
+        SELECT quote_log_id FROM rfq_trades WHERE status != 'failed'
+    );
+
+-- name: DeleteUntradedRfqQuoteLogs :execrows
+-- Quotes which were created before the given time and never traded against
+-- are removed from the ledger.
+DELETE FROM rfq_quote_log
+WHERE created_at < @min_created_at AND
+    id NOT IN (SELECT quote_log_id FROM rfq_trades);
+
+-- name: QueryRfqQuoteLogs :many
+SELECT quote_id, quote_type, is_incoming, peer, asset_id, group_key,
+    asset_amount, price_msat, expiry, status, signature, reject_code,
+    reject_msg, created_at, updated_at
+FROM rfq_quote_log
+WHERE
+    (peer = sqlc.narg('peer') OR sqlc.narg('peer') IS NULL) AND
+    (created_at >= sqlc.narg('min_created_at')
+        OR sqlc.narg('min_created_at') IS NULL) AND
+    (created_at < sqlc.narg('max_created_at')
+        OR sqlc.narg('max_created_at') IS NULL)
+ORDER BY id;
+
+-- name: InsertRfqTrade :execrows
+INSERT INTO rfq_trades (
+    quote_log_id, incoming_chan_id, htlc_id, amount_msat, asset_amount,
+    status, created_at
+)
+SELECT id, @incoming_chan_id, @htlc_id, @amount_msat, @asset_amount,
+    'pending', @created_at
+FROM rfq_quote_log
+WHERE quote_id = @quote_id
+ON CONFLICT (incoming_chan_id, htlc_id)
+    -- An HTLC is only recorded once, even if it is replayed by lnd.
+    DO NOTHING;
+
+-- name: QueryRfqTrades :many
+SELECT quotes.quote_id, quotes.quote_type, quotes.peer, quotes.asset_id,
+    quotes.group_key, quotes.asset_amount, quotes.price_msat,
+    trades.incoming_chan_id, trades.htlc_id, trades.amount_msat,
+    trades.asset_amount AS htlc_asset_amount, trades.status,
+    trades.created_at, trades.resolved_at
+FROM rfq_trades trades
+JOIN rfq_quote_log quotes
+    ON trades.quote_log_id = quotes.id
+WHERE
+    (quotes.peer = sqlc.narg('peer') OR sqlc.narg('peer') IS NULL) AND
+    (trades.created_at >= sqlc.narg('min_created_at')
+        OR sqlc.narg('min_created_at') IS NULL) AND
+    (trades.created_at < sqlc.narg('max_created_at')
+        OR sqlc.narg('max_created_at') IS NULL)
+ORDER BY trades.id;
+
+-- name: ResolveRfqTrade :execrows
+UPDATE rfq_trades
+SET status = @status, resolved_at = @resolved_at
+WHERE incoming_chan_id = @incoming_chan_id AND htlc_id = @htlc_id AND
+    status = 'pending';
@@ -36,7 +36,7 @@ WHERE universe_root_id = (SELECT id from root_id);
 DELETE FROM universe_roots
 WHERE namespace_root = @namespace_root;
 
--- name: UpsertUniverseLeaf :exec
+-- name: UpsertUniverseLeaf :one
 INSERT INTO universe_leaves (
     asset_genesis_id, script_key_bytes, universe_root_id, leaf_node_key, 
     leaf_node_namespace, minting_point
@@ -47,7 +47,8 @@ INSERT INTO universe_leaves (
     -- This is a NOP, minting_point and script_key_bytes are the unique fields
     -- that caused the conflict.
     DO UPDATE SET minting_point = EXCLUDED.minting_point,
-                  script_key_bytes = EXCLUDED.script_key_bytes;
+                  script_key_bytes = EXCLUDED.script_key_bytes
+RETURNING id;
 
 -- name: DeleteUniverseLeaves :exec
 DELETE FROM universe_leaves
@@ -79,6 +80,49 @@ ORDER BY
     CASE WHEN sqlc.narg('sort_direction') = 1 THEN leaves.id END DESC
 LIMIT @num_limit OFFSET @num_offset;
 
+-- name: FetchUniverseLeafKey :one
+SELECT leaves.minting_point, leaves.script_key_bytes
+FROM universe_leaves leaves
+WHERE leaves.leaf_node_namespace = @namespace AND
+      leaves.leaf_node_key = @leaf_node_key;
+
+-- name: InsertUniverseLeafEvent :one
+INSERT INTO universe_leaf_events (seq, leaf_id)
+VALUES (
+    (SELECT COALESCE(MAX(seq), 0) + 1 FROM universe_leaf_events), @leaf_id
+)
+RETURNING seq;
+
+-- name: QueryUniverseLeafEvents :many
+SELECT events.seq, leaves.minting_point, leaves.script_key_bytes,
+       nodes.value AS leaf_proof, nodes.sum AS leaf_sum,
+       roots.group_key, roots.proof_type
+FROM universe_leaf_events events
+JOIN universe_leaves leaves
+    ON events.leaf_id = leaves.id
+JOIN mssmt_nodes nodes
+    ON leaves.leaf_node_key = nodes.key AND
+        leaves.leaf_node_namespace = nodes.namespace
+JOIN universe_roots roots
+    ON leaves.universe_root_id = roots.id
+JOIN genesis_assets gen
+    ON leaves.asset_genesis_id = gen.gen_asset_id
+WHERE events.seq > @after_seq AND
+    (gen.asset_id = sqlc.narg('asset_id') OR
+        sqlc.narg('asset_id') IS NULL) AND
+    (roots.group_key = sqlc.narg('group_key') OR
+        sqlc.narg('group_key') IS NULL) AND
+    (roots.proof_type = sqlc.narg('proof_type') OR
+        sqlc.narg('proof_type') IS NULL)
+ORDER BY events.seq ASC
+LIMIT @num_limit;
+
+-- name: FetchLatestUniverseLeafEventSeq :one
+SELECT seq
+FROM universe_leaf_events
+ORDER BY seq DESC
+LIMIT 1;
+
 -- name: UniverseLeaves :many
 SELECT * FROM universe_leaves;
 
@@ -529,3 +573,250 @@ JOIN multiverse_roots r
 WHERE r.proof_type = @proof_type AND
       (l.asset_id = @asset_id OR @asset_id IS NULL) AND
       (l.group_key = @group_key OR @group_key IS NULL);
+
+-- name: InsertUniverseCommitment :one
+INSERT INTO universe_commitments (
+    root_hash, root_sum, anchor_txid, anchor_tx, output_index,
+    internal_key_id, block_height, block_header, merkle_proof, created_at
+) VALUES (
+    @root_hash, @root_sum, @anchor_txid, @anchor_tx, @output_index,
+    @internal_key_id, @block_height, @block_header, @merkle_proof,
+    @created_at
+)
+RETURNING id;
+
+-- name: InsertUniverseCommitmentLeaf :exec
+INSERT INTO universe_commitment_leaves (
+    commitment_id, asset_id, group_key, leaf_node_key, leaf_value, leaf_sum
+) VALUES (
+    @commitment_id, @asset_id, @group_key, @leaf_node_key, @leaf_value,
+    @leaf_sum
+);
+
+-- name: QueryUniverseCommitments :many
+SELECT c.id, c.root_hash, c.root_sum, c.anchor_tx, c.output_index,
+       c.block_height, c.block_header, c.merkle_proof,
+       keys.raw_key AS internal_key, keys.key_family, keys.key_index
+FROM universe_commitments c
+JOIN internal_keys keys
+    ON c.internal_key_id = keys.key_id
+WHERE (c.id = sqlc.narg('commitment_id') OR
+       sqlc.narg('commitment_id') IS NULL)
+ORDER BY c.id DESC
+LIMIT @num_limit;
+
+-- name: FetchLeafCommitmentID :one
+-- Returns the most recent commitment whose snapshot contains the given
+-- multiverse leaf.
+SELECT commitment_id
+FROM universe_commitment_leaves
+WHERE leaf_node_key = @leaf_node_key AND leaf_value = @leaf_value AND
+      leaf_sum = @leaf_sum
+ORDER BY commitment_id DESC
+LIMIT 1;
+
+-- name: FetchUniverseCommitmentLeaves :many
+SELECT asset_id, group_key, leaf_value, leaf_sum
+FROM universe_commitment_leaves
+WHERE commitment_id = @commitment_id
+ORDER BY id;
+
+-- name: DeleteStaleUniverseCommitmentLeaves :exec
+-- Removes the multiverse leaves of all commitments except the given one.
+DELETE FROM universe_commitment_leaves
+WHERE commitment_id != @commitment_id;
+
+-- name: InsertUniversePendingCommitment :exec
+INSERT INTO universe_pending_commitments (
+    root_hash, root_sum, anchor_txid, anchor_tx, output_index,
+    internal_key_id, height_hint, created_at
+) VALUES (
+    @root_hash, @root_sum, @anchor_txid, @anchor_tx, @output_index,
+    @internal_key_id, @height_hint, @created_at
+)
+ON CONFLICT (anchor_txid) DO NOTHING;
+
+-- name: FetchUniversePendingCommitments :many
+SELECT p.root_hash, p.root_sum, p.anchor_tx, p.output_index, p.height_hint,
+       keys.raw_key AS internal_key, keys.key_family, keys.key_index
+FROM universe_pending_commitments p
+JOIN internal_keys keys
+    ON p.internal_key_id = keys.key_id
+ORDER BY p.id;
+
+-- name: DeleteUniversePendingCommitment :exec
+DELETE FROM universe_pending_commitments
+WHERE anchor_txid = @anchor_txid;
+
+-- name: InsertUniverseDivergence :one
+INSERT INTO universe_divergences (
+    server_host, asset_id, group_key, proof_type, local_root_hash,
+    local_root_sum, remote_root_hash, remote_root_sum, detected_at
+) VALUES (
+    @server_host, @asset_id, @group_key, @proof_type, @local_root_hash,
+    @local_root_sum, @remote_root_hash, @remote_root_sum, @detected_at
+)
+RETURNING id;
+
+-- name: InsertUniverseDivergenceLeaf :exec
+INSERT INTO universe_divergence_leaves (
+    divergence_id, minting_point, script_key_bytes
+) VALUES (
+    @divergence_id, @minting_point, @script_key_bytes
+) ON CONFLICT DO NOTHING;
+
+-- name: QueryUniverseDivergences :many
+SELECT id, server_host, asset_id, group_key, proof_type, local_root_hash,
+       local_root_sum, remote_root_hash, remote_root_sum, detected_at
+FROM universe_divergences
+WHERE (server_host = sqlc.narg('server_host') OR
+       sqlc.narg('server_host') IS NULL)
+ORDER BY id DESC
+LIMIT @num_limit OFFSET @num_offset;
+
+-- name: FetchUniverseDivergenceLeaves :many
+SELECT minting_point, script_key_bytes
+FROM universe_divergence_leaves
+WHERE divergence_id = @divergence_id
+ORDER BY id;
+
+-- name: DeleteUniverseAdmissionRules :exec
+DELETE FROM universe_admission_rules;
+
+-- name: InsertUniverseAdmissionRule :exec
+INSERT INTO universe_admission_rules (
+    rule_type, asset_id, group_key
+) VALUES (
+    @rule_type, @asset_id, @group_key
+);
+
+-- name: QueryUniverseAdmissionRules :many
+SELECT rule_type, asset_id, group_key
+FROM universe_admission_rules
+ORDER BY id;
+
+-- name: UpsertUniverseAdmissionLimits :exec
+INSERT INTO universe_admission_limits (
+    id, max_proof_size, max_inserts_per_minute
+) VALUES (
+    1, @max_proof_size, @max_inserts_per_minute
+)
+ON CONFLICT (id)
+    DO UPDATE SET
+        max_proof_size = EXCLUDED.max_proof_size,
+        max_inserts_per_minute = EXCLUDED.max_inserts_per_minute;
+
+-- name: FetchUniverseAdmissionLimits :one
+SELECT max_proof_size, max_inserts_per_minute
+FROM universe_admission_limits
+WHERE id = 1;
+
+-- name: InsertUniverseRejectedLeaf :exec
+INSERT INTO universe_rejected_leaves (
+    leaf_node_namespace, leaf_node_key
+) VALUES (
+    @leaf_node_namespace, @leaf_node_key
+) ON CONFLICT DO NOTHING;
+
+-- name: FetchUniverseRejectedLeaf :one
+SELECT id
+FROM universe_rejected_leaves
+WHERE leaf_node_namespace = @leaf_node_namespace AND
+    leaf_node_key = @leaf_node_key;
+
+-- name: DeleteUniverseRejectedLeaves :exec
+DELETE FROM universe_rejected_leaves;
+
+-- name: UpsertUniverseTransferAnchor :exec
+INSERT INTO universe_transfer_anchors (
+    leaf_id, block_height, block_timestamp
+) VALUES (
+    @leaf_id, @block_height, @block_timestamp
+)
+ON CONFLICT (leaf_id)
+    DO UPDATE SET
+        block_height = EXCLUDED.block_height,
+        block_timestamp = EXCLUDED.block_timestamp;
+
+-- name: InsertUniverseTransferSpend :exec
+INSERT INTO universe_transfer_spends (
+    leaf_node_namespace, minting_point, script_key_bytes
+) VALUES (
+    @leaf_node_namespace, @minting_point, @script_key_bytes
+) ON CONFLICT DO NOTHING;
+
+-- name: DeleteUniverseTransferSpends :exec
+DELETE FROM universe_transfer_spends
+WHERE leaf_node_namespace = @namespace;
+
+-- name: QueryUnanchoredTransferLeaves :many
+SELECT leaves.id, leaves.leaf_node_namespace, nodes.value AS leaf_proof
+FROM universe_leaves leaves
+JOIN universe_roots roots
+    ON leaves.universe_root_id = roots.id
+JOIN mssmt_nodes nodes
+    ON leaves.leaf_node_key = nodes.key AND
+        leaves.leaf_node_namespace = nodes.namespace
+LEFT JOIN universe_transfer_anchors anchors
+    ON anchors.leaf_id = leaves.id
+WHERE roots.proof_type = 'transfer' AND
+    anchors.leaf_id IS NULL AND
+    leaves.id > @min_leaf_id
+ORDER BY leaves.id
+LIMIT @num_limit;
+
+-- name: QueryPrunableTransferLeaves :many
+SELECT leaves.id, leaves.leaf_node_key, leaves.leaf_node_namespace,
+       roots.asset_id, roots.group_key
+FROM universe_leaves leaves
+JOIN universe_roots roots
+    ON leaves.universe_root_id = roots.id
+JOIN universe_transfer_anchors anchors
+    ON anchors.leaf_id = leaves.id
+WHERE roots.proof_type = 'transfer' AND
+    (anchors.block_height <= sqlc.narg('max_block_height') OR
+        anchors.block_timestamp < sqlc.narg('min_block_timestamp')) AND
+    -- Only superseded leaves can be pruned, the leaves of unspent outputs
+    -- are always kept.
+    EXISTS (
+        SELECT 1
+        FROM universe_transfer_spends spends
+        WHERE spends.minting_point = leaves.minting_point AND
+            spends.script_key_bytes = leaves.script_key_bytes AND
+            spends.leaf_node_namespace = leaves.leaf_node_namespace
+    ) AND
+    -- Leaves with a pending push to a federation server are kept, so that
+    -- the push can still be retried.
+    NOT EXISTS (
+        SELECT 1
+        FROM federation_proof_sync_log log
+        WHERE log.proof_leaf_id = leaves.id AND
+            log.status = 'pending'
+    )
+ORDER BY leaves.id
+LIMIT @num_limit;
+
+-- name: DeleteFederationProofSyncLogsForLeaf :exec
+DELETE FROM federation_proof_sync_log
+WHERE proof_leaf_id = @leaf_id;
+
+-- name: DeleteUniverseLeaf :exec
+DELETE FROM universe_leaves
+WHERE id = @id;
+
+-- name: InsertUniversePrunedLeaf :exec
+INSERT INTO universe_pruned_leaves (
+    leaf_node_namespace, leaf_node_key
+) VALUES (
+    @leaf_node_namespace, @leaf_node_key
+) ON CONFLICT DO NOTHING;
+
+-- name: FetchUniversePrunedLeaf :one
+SELECT id
+FROM universe_pruned_leaves
+WHERE leaf_node_namespace = @leaf_node_namespace AND
+    leaf_node_key = @leaf_node_key;
+
+-- name: DeleteUniversePrunedLeaves :exec
+DELETE FROM universe_pruned_leaves
+WHERE leaf_node_namespace = @namespace;
@@ -49,6 +49,16 @@ func (q *Queries) DeleteFederationProofSyncLog(ctx context.Context, arg DeleteFe
 	return err
 }
 
+const deleteFederationProofSyncLogsForLeaf = `-- name: DeleteFederationProofSyncLogsForLeaf :exec
+DELETE FROM federation_proof_sync_log
+WHERE proof_leaf_id = $1
+`
+
+func (q *Queries) DeleteFederationProofSyncLogsForLeaf(ctx context.Context, leafID int64) error {
+	_, err := q.db.ExecContext(ctx, deleteFederationProofSyncLogsForLeaf, leafID)
+	return err
+}
+
 const deleteMultiverseLeaf = `-- name: DeleteMultiverseLeaf :exec
 DELETE FROM multiverse_leaves
 WHERE leaf_node_namespace = $1 AND leaf_node_key = $2
@@ -64,6 +74,26 @@ func (q *Queries) DeleteMultiverseLeaf(ctx context.Context, arg DeleteMultiverse
 	return err
 }
 
+const deleteStaleUniverseCommitmentLeaves = `-- name: DeleteStaleUniverseCommitmentLeaves :exec
+DELETE FROM universe_commitment_leaves
+WHERE commitment_id != $1
+`
+
+// Removes the multiverse leaves of all commitments except the given one.
+func (q *Queries) DeleteStaleUniverseCommitmentLeaves(ctx context.Context, commitmentID int64) error {
+	_, err := q.db.ExecContext(ctx, deleteStaleUniverseCommitmentLeaves, commitmentID)
+	return err
+}
+
+const deleteUniverseAdmissionRules = `-- name: DeleteUniverseAdmissionRules :exec
+DELETE FROM universe_admission_rules
+`
+
+func (q *Queries) DeleteUniverseAdmissionRules(ctx context.Context) error {
+	_, err := q.db.ExecContext(ctx, deleteUniverseAdmissionRules)
+	return err
+}
+
 const deleteUniverseEvents = `-- name: DeleteUniverseEvents :exec
 WITH root_id AS (
     SELECT id
@@ -79,6 +109,16 @@ func (q *Queries) DeleteUniverseEvents(ctx context.Context, namespaceRoot string
 	return err
 }
 
+const deleteUniverseLeaf = `-- name: DeleteUniverseLeaf :exec
+DELETE FROM universe_leaves
+WHERE id = $1
+`
+
+func (q *Queries) DeleteUniverseLeaf(ctx context.Context, id int64) error {
+	_, err := q.db.ExecContext(ctx, deleteUniverseLeaf, id)
+	return err
+}
+
 const deleteUniverseLeaves = `-- name: DeleteUniverseLeaves :exec
 DELETE FROM universe_leaves
 WHERE leaf_node_namespace = $1
@@ -89,6 +129,35 @@ func (q *Queries) DeleteUniverseLeaves(ctx context.Context, namespace string) er
 	return err
 }
 
+const deleteUniversePendingCommitment = `-- name: DeleteUniversePendingCommitment :exec
+DELETE FROM universe_pending_commitments
+WHERE anchor_txid = $1
+`
+
+func (q *Queries) DeleteUniversePendingCommitment(ctx context.Context, anchorTxid []byte) error {
+	_, err := q.db.ExecContext(ctx, deleteUniversePendingCommitment, anchorTxid)
+	return err
+}
+
+const deleteUniversePrunedLeaves = `-- name: DeleteUniversePrunedLeaves :exec
+DELETE FROM universe_pruned_leaves
+WHERE leaf_node_namespace = $1
+`
+
+func (q *Queries) DeleteUniversePrunedLeaves(ctx context.Context, namespace string) error {
+	_, err := q.db.ExecContext(ctx, deleteUniversePrunedLeaves, namespace)
+	return err
+}
+
+const deleteUniverseRejectedLeaves = `-- name: DeleteUniverseRejectedLeaves :exec
+DELETE FROM universe_rejected_leaves
+`
+
+func (q *Queries) DeleteUniverseRejectedLeaves(ctx context.Context) error {
+	_, err := q.db.ExecContext(ctx, deleteUniverseRejectedLeaves)
+	return err
+}
+
 const deleteUniverseRoot = `-- name: DeleteUniverseRoot :exec
 DELETE FROM universe_roots
 WHERE namespace_root = $1
@@ -114,6 +183,54 @@ func (q *Queries) DeleteUniverseServer(ctx context.Context, arg DeleteUniverseSe
 	return err
 }
 
+const deleteUniverseTransferSpends = `-- name: DeleteUniverseTransferSpends :exec
+DELETE FROM universe_transfer_spends
+WHERE leaf_node_namespace = $1
+`
+
+func (q *Queries) DeleteUniverseTransferSpends(ctx context.Context, namespace string) error {
+	_, err := q.db.ExecContext(ctx, deleteUniverseTransferSpends, namespace)
+	return err
+}
+
+const fetchLatestUniverseLeafEventSeq = `-- name: FetchLatestUniverseLeafEventSeq :one
+SELECT seq
+FROM universe_leaf_events
+ORDER BY seq DESC
+LIMIT 1
+`
+
+func (q *Queries) FetchLatestUniverseLeafEventSeq(ctx context.Context) (int64, error) {
+	row := q.db.QueryRowContext(ctx, fetchLatestUniverseLeafEventSeq)
+	var seq int64
+	err := row.Scan(&seq)
+	return seq, err
+}
+
+const fetchLeafCommitmentID = `-- name: FetchLeafCommitmentID :one
+SELECT commitment_id
+FROM universe_commitment_leaves
+WHERE leaf_node_key = $1 AND leaf_value = $2 AND
+      leaf_sum = $3
+ORDER BY commitment_id DESC
+LIMIT 1
+`
+
+type FetchLeafCommitmentIDParams struct {
+	LeafNodeKey []byte
+	LeafValue   []byte
+	LeafSum     int64
+}
+
+// Returns the most recent commitment whose snapshot contains the given
+// multiverse leaf.
+func (q *Queries) FetchLeafCommitmentID(ctx context.Context, arg FetchLeafCommitmentIDParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, fetchLeafCommitmentID, arg.LeafNodeKey, arg.LeafValue, arg.LeafSum)
+	var commitment_id int64
+	err := row.Scan(&commitment_id)
+	return commitment_id, err
+}
+
 const fetchMultiverseRoot = `-- name: FetchMultiverseRoot :one
 SELECT proof_type, n.hash_key as multiverse_root_hash, n.sum as multiverse_root_sum
 FROM multiverse_roots r
@@ -138,6 +255,101 @@ func (q *Queries) FetchMultiverseRoot(ctx context.Context, namespaceRoot string)
 	return i, err
 }
 
+const fetchUniverseAdmissionLimits = `-- name: FetchUniverseAdmissionLimits :one
+SELECT max_proof_size, max_inserts_per_minute
+FROM universe_admission_limits
+WHERE id = 1
+`
+
+type FetchUniverseAdmissionLimitsRow struct {
+	MaxProofSize        int64
+	MaxInsertsPerMinute int64
+}
+
+func (q *Queries) FetchUniverseAdmissionLimits(ctx context.Context) (FetchUniverseAdmissionLimitsRow, error) {
+	row := q.db.QueryRowContext(ctx, fetchUniverseAdmissionLimits)
+	var i FetchUniverseAdmissionLimitsRow
+	err := row.Scan(&i.MaxProofSize, &i.MaxInsertsPerMinute)
+	return i, err
+}
+
+const fetchUniverseCommitmentLeaves = `-- name: FetchUniverseCommitmentLeaves :many
+SELECT asset_id, group_key, leaf_value, leaf_sum
+FROM universe_commitment_leaves
+WHERE commitment_id = $1
+ORDER BY id
+`
+
+type FetchUniverseCommitmentLeavesRow struct {
+	AssetID   []byte
+	GroupKey  []byte
+	LeafValue []byte
+	LeafSum   int64
+}
+
+func (q *Queries) FetchUniverseCommitmentLeaves(ctx context.Context, commitmentID int64) ([]FetchUniverseCommitmentLeavesRow, error) {
+	rows, err := q.db.QueryContext(ctx, fetchUniverseCommitmentLeaves, commitmentID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []FetchUniverseCommitmentLeavesRow
+	for rows.Next() {
+		var i FetchUniverseCommitmentLeavesRow
+		if err := rows.Scan(
+			&i.AssetID,
+			&i.GroupKey,
+			&i.LeafValue,
+			&i.LeafSum,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const fetchUniverseDivergenceLeaves = `-- name: FetchUniverseDivergenceLeaves :many
+SELECT minting_point, script_key_bytes
+FROM universe_divergence_leaves
+WHERE divergence_id = $1
+ORDER BY id
+`
+
+type FetchUniverseDivergenceLeavesRow struct {
+	MintingPoint   []byte
+	ScriptKeyBytes []byte
+}
+
+func (q *Queries) FetchUniverseDivergenceLeaves(ctx context.Context, divergenceID int64) ([]FetchUniverseDivergenceLeavesRow, error) {
+	rows, err := q.db.QueryContext(ctx, fetchUniverseDivergenceLeaves, divergenceID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []FetchUniverseDivergenceLeavesRow
+	for rows.Next() {
+		var i FetchUniverseDivergenceLeavesRow
+		if err := rows.Scan(&i.MintingPoint, &i.ScriptKeyBytes); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const fetchUniverseKeys = `-- name: FetchUniverseKeys :many
 SELECT leaves.minting_point, leaves.script_key_bytes
 FROM universe_leaves leaves
@@ -188,6 +400,120 @@ func (q *Queries) FetchUniverseKeys(ctx context.Context, arg FetchUniverseKeysPa
 	return items, nil
 }
 
+const fetchUniverseLeafKey = `-- name: FetchUniverseLeafKey :one
+SELECT leaves.minting_point, leaves.script_key_bytes
+FROM universe_leaves leaves
+WHERE leaves.leaf_node_namespace = $1 AND
+      leaves.leaf_node_key = $2
+`
+
+type FetchUniverseLeafKeyParams struct {
+	Namespace   string
+	LeafNodeKey []byte
+}
+
+type FetchUniverseLeafKeyRow struct {
+	MintingPoint   []byte
+	ScriptKeyBytes []byte
+}
+
+func (q *Queries) FetchUniverseLeafKey(ctx context.Context, arg FetchUniverseLeafKeyParams) (FetchUniverseLeafKeyRow, error) {
+	row := q.db.QueryRowContext(ctx, fetchUniverseLeafKey, arg.Namespace, arg.LeafNodeKey)
+	var i FetchUniverseLeafKeyRow
+	err := row.Scan(&i.MintingPoint, &i.ScriptKeyBytes)
+	return i, err
+}
+
+const fetchUniversePendingCommitments = `-- name: FetchUniversePendingCommitments :many
+SELECT p.root_hash, p.root_sum, p.anchor_tx, p.output_index, p.height_hint,
+       keys.raw_key AS internal_key, keys.key_family, keys.key_index
+FROM universe_pending_commitments p
+JOIN internal_keys keys
+    ON p.internal_key_id = keys.key_id
+ORDER BY p.id
+`
+
+type FetchUniversePendingCommitmentsRow struct {
+	RootHash    []byte
+	RootSum     int64
+	AnchorTx    []byte
+	OutputIndex int32
+	HeightHint  int32
+	InternalKey []byte
+	KeyFamily   int32
+	KeyIndex    int32
+}
+
+func (q *Queries) FetchUniversePendingCommitments(ctx context.Context) ([]FetchUniversePendingCommitmentsRow, error) {
+	rows, err := q.db.QueryContext(ctx, fetchUniversePendingCommitments)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []FetchUniversePendingCommitmentsRow
+	for rows.Next() {
+		var i FetchUniversePendingCommitmentsRow
+		if err := rows.Scan(
+			&i.RootHash,
+			&i.RootSum,
+			&i.AnchorTx,
+			&i.OutputIndex,
+			&i.HeightHint,
+			&i.InternalKey,
+			&i.KeyFamily,
+			&i.KeyIndex,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const fetchUniversePrunedLeaf = `-- name: FetchUniversePrunedLeaf :one
+SELECT id
+FROM universe_pruned_leaves
+WHERE leaf_node_namespace = $1 AND
+    leaf_node_key = $2
+`
+
+type FetchUniversePrunedLeafParams struct {
+	LeafNodeNamespace string
+	LeafNodeKey       []byte
+}
+
+func (q *Queries) FetchUniversePrunedLeaf(ctx context.Context, arg FetchUniversePrunedLeafParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, fetchUniversePrunedLeaf, arg.LeafNodeNamespace, arg.LeafNodeKey)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
+
+const fetchUniverseRejectedLeaf = `-- name: FetchUniverseRejectedLeaf :one
+SELECT id
+FROM universe_rejected_leaves
+WHERE leaf_node_namespace = $1 AND
+    leaf_node_key = $2
+`
+
+type FetchUniverseRejectedLeafParams struct {
+	LeafNodeNamespace string
+	LeafNodeKey       []byte
+}
+
+func (q *Queries) FetchUniverseRejectedLeaf(ctx context.Context, arg FetchUniverseRejectedLeafParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, fetchUniverseRejectedLeaf, arg.LeafNodeNamespace, arg.LeafNodeKey)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
+
 const fetchUniverseRoot = `-- name: FetchUniverseRoot :one
 SELECT universe_roots.asset_id, group_key, proof_type,
        mssmt_nodes.hash_key root_hash, mssmt_nodes.sum root_sum,
@@ -324,6 +650,244 @@ func (q *Queries) InsertNewSyncEvent(ctx context.Context, arg InsertNewSyncEvent
 	return err
 }
 
+const insertUniverseAdmissionRule = `-- name: InsertUniverseAdmissionRule :exec
+INSERT INTO universe_admission_rules (
+    rule_type, asset_id, group_key
+) VALUES (
+    $1, $2, $3
+)
+`
+
+type InsertUniverseAdmissionRuleParams struct {
+	RuleType string
+	AssetID  []byte
+	GroupKey []byte
+}
+
+func (q *Queries) InsertUniverseAdmissionRule(ctx context.Context, arg InsertUniverseAdmissionRuleParams) error {
+	_, err := q.db.ExecContext(ctx, insertUniverseAdmissionRule, arg.RuleType, arg.AssetID, arg.GroupKey)
+	return err
+}
+
+const insertUniverseCommitment = `-- name: InsertUniverseCommitment :one
+INSERT INTO universe_commitments (
+    root_hash, root_sum, anchor_txid, anchor_tx, output_index,
+    internal_key_id, block_height, block_header, merkle_proof, created_at
+) VALUES (
+    $1, $2, $3, $4, $5,
+    $6, $7, $8, $9,
+    $10
+)
+RETURNING id
+`
+
+type InsertUniverseCommitmentParams struct {
+	RootHash      []byte
+	RootSum       int64
+	AnchorTxid    []byte
+	AnchorTx      []byte
+	OutputIndex   int32
+	InternalKeyID int64
+	BlockHeight   int32
+	BlockHeader   []byte
+	MerkleProof   []byte
+	CreatedAt     time.Time
+}
+
+func (q *Queries) InsertUniverseCommitment(ctx context.Context, arg InsertUniverseCommitmentParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, insertUniverseCommitment,
+		arg.RootHash,
+		arg.RootSum,
+		arg.AnchorTxid,
+		arg.AnchorTx,
+		arg.OutputIndex,
+		arg.InternalKeyID,
+		arg.BlockHeight,
+		arg.BlockHeader,
+		arg.MerkleProof,
+		arg.CreatedAt,
+	)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
+
+const insertUniverseCommitmentLeaf = `-- name: InsertUniverseCommitmentLeaf :exec
+INSERT INTO universe_commitment_leaves (
+    commitment_id, asset_id, group_key, leaf_node_key, leaf_value, leaf_sum
+) VALUES (
+    $1, $2, $3, $4, $5,
+    $6
+)
+`
+
+type InsertUniverseCommitmentLeafParams struct {
+	CommitmentID int64
+	AssetID      []byte
+	GroupKey     []byte
+	LeafNodeKey  []byte
+	LeafValue    []byte
+	LeafSum      int64
+}
+
+func (q *Queries) InsertUniverseCommitmentLeaf(ctx context.Context, arg InsertUniverseCommitmentLeafParams) error {
+	_, err := q.db.ExecContext(ctx, insertUniverseCommitmentLeaf,
+		arg.CommitmentID,
+		arg.AssetID,
+		arg.GroupKey,
+		arg.LeafNodeKey,
+		arg.LeafValue,
+		arg.LeafSum,
+	)
+	return err
+}
+
+const insertUniverseDivergence = `-- name: InsertUniverseDivergence :one
+INSERT INTO universe_divergences (
+    server_host, asset_id, group_key, proof_type, local_root_hash,
+    local_root_sum, remote_root_hash, remote_root_sum, detected_at
+) VALUES (
+    $1, $2, $3, $4, $5,
+    $6, $7, $8, $9
+)
+RETURNING id
+`
+
+type InsertUniverseDivergenceParams struct {
+	ServerHost     string
+	AssetID        []byte
+	GroupKey       []byte
+	ProofType      string
+	LocalRootHash  []byte
+	LocalRootSum   int64
+	RemoteRootHash []byte
+	RemoteRootSum  int64
+	DetectedAt     time.Time
+}
+
+func (q *Queries) InsertUniverseDivergence(ctx context.Context, arg InsertUniverseDivergenceParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, insertUniverseDivergence,
+		arg.ServerHost,
+		arg.AssetID,
+		arg.GroupKey,
+		arg.ProofType,
+		arg.LocalRootHash,
+		arg.LocalRootSum,
+		arg.RemoteRootHash,
+		arg.RemoteRootSum,
+		arg.DetectedAt,
+	)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
+
+const insertUniverseDivergenceLeaf = `-- name: InsertUniverseDivergenceLeaf :exec
+INSERT INTO universe_divergence_leaves (
+    divergence_id, minting_point, script_key_bytes
+) VALUES (
+    $1, $2, $3
+) ON CONFLICT DO NOTHING
+`
+
+type InsertUniverseDivergenceLeafParams struct {
+	DivergenceID   int64
+	MintingPoint   []byte
+	ScriptKeyBytes []byte
+}
+
+func (q *Queries) InsertUniverseDivergenceLeaf(ctx context.Context, arg InsertUniverseDivergenceLeafParams) error {
+	_, err := q.db.ExecContext(ctx, insertUniverseDivergenceLeaf, arg.DivergenceID, arg.MintingPoint, arg.ScriptKeyBytes)
+	return err
+}
+
+const insertUniverseLeafEvent = `-- name: InsertUniverseLeafEvent :one
+INSERT INTO universe_leaf_events (seq, leaf_id)
+VALUES (
+    (SELECT COALESCE(MAX(seq), 0) + 1 FROM universe_leaf_events), $1
+)
+RETURNING seq
+`
+
+func (q *Queries) InsertUniverseLeafEvent(ctx context.Context, leafID sql.NullInt64) (int64, error) {
+	row := q.db.QueryRowContext(ctx, insertUniverseLeafEvent, leafID)
+	var seq int64
+	err := row.Scan(&seq)
+	return seq, err
+}
+
+const insertUniversePendingCommitment = `-- name: InsertUniversePendingCommitment :exec
+INSERT INTO universe_pending_commitments (
+    root_hash, root_sum, anchor_txid, anchor_tx, output_index,
+    internal_key_id, height_hint, created_at
+) VALUES (
+    $1, $2, $3, $4, $5,
+    $6, $7, $8
+)
+ON CONFLICT (anchor_txid) DO NOTHING
+`
+
+type InsertUniversePendingCommitmentParams struct {
+	RootHash      []byte
+	RootSum       int64
+	AnchorTxid    []byte
+	AnchorTx      []byte
+	OutputIndex   int32
+	InternalKeyID int64
+	HeightHint    int32
+	CreatedAt     time.Time
+}
+
+func (q *Queries) InsertUniversePendingCommitment(ctx context.Context, arg InsertUniversePendingCommitmentParams) error {
+	_, err := q.db.ExecContext(ctx, insertUniversePendingCommitment,
+		arg.RootHash,
+		arg.RootSum,
+		arg.AnchorTxid,
+		arg.AnchorTx,
+		arg.OutputIndex,
+		arg.InternalKeyID,
+		arg.HeightHint,
+		arg.CreatedAt,
+	)
+	return err
+}
+
+const insertUniversePrunedLeaf = `-- name: InsertUniversePrunedLeaf :exec
+INSERT INTO universe_pruned_leaves (
+    leaf_node_namespace, leaf_node_key
+) VALUES (
+    $1, $2
+) ON CONFLICT DO NOTHING
+`
+
+type InsertUniversePrunedLeafParams struct {
+	LeafNodeNamespace string
+	LeafNodeKey       []byte
+}
+
+func (q *Queries) InsertUniversePrunedLeaf(ctx context.Context, arg InsertUniversePrunedLeafParams) error {
+	_, err := q.db.ExecContext(ctx, insertUniversePrunedLeaf, arg.LeafNodeNamespace, arg.LeafNodeKey)
+	return err
+}
+
+const insertUniverseRejectedLeaf = `-- name: InsertUniverseRejectedLeaf :exec
+INSERT INTO universe_rejected_leaves (
+    leaf_node_namespace, leaf_node_key
+) VALUES (
+    $1, $2
+) ON CONFLICT DO NOTHING
+`
+
+type InsertUniverseRejectedLeafParams struct {
+	LeafNodeNamespace string
+	LeafNodeKey       []byte
+}
+
+func (q *Queries) InsertUniverseRejectedLeaf(ctx context.Context, arg InsertUniverseRejectedLeafParams) error {
+	_, err := q.db.ExecContext(ctx, insertUniverseRejectedLeaf, arg.LeafNodeNamespace, arg.LeafNodeKey)
+	return err
+}
+
 const insertUniverseServer = `-- name: InsertUniverseServer :exec
 INSERT INTO universe_servers(
     server_host, last_sync_time
@@ -342,6 +906,25 @@ func (q *Queries) InsertUniverseServer(ctx context.Context, arg InsertUniverseSe
 	return err
 }
 
+const insertUniverseTransferSpend = `-- name: InsertUniverseTransferSpend :exec
+INSERT INTO universe_transfer_spends (
+    leaf_node_namespace, minting_point, script_key_bytes
+) VALUES (
+    $1, $2, $3
+) ON CONFLICT DO NOTHING
+`
+
+type InsertUniverseTransferSpendParams struct {
+	LeafNodeNamespace string
+	MintingPoint      []byte
+	ScriptKeyBytes    []byte
+}
+
+func (q *Queries) InsertUniverseTransferSpend(ctx context.Context, arg InsertUniverseTransferSpendParams) error {
+	_, err := q.db.ExecContext(ctx, insertUniverseTransferSpend, arg.LeafNodeNamespace, arg.MintingPoint, arg.ScriptKeyBytes)
+	return err
+}
+
 const logServerSync = `-- name: LogServerSync :exec
 UPDATE universe_servers
 SET last_sync_time = $1
@@ -702,6 +1285,167 @@ func (q *Queries) QueryMultiverseLeaves(ctx context.Context, arg QueryMultiverse
 	return items, nil
 }
 
+const queryPrunableTransferLeaves = `-- name: QueryPrunableTransferLeaves :many
+SELECT leaves.id, leaves.leaf_node_key, leaves.leaf_node_namespace,
+       roots.asset_id, roots.group_key
+FROM universe_leaves leaves
+JOIN universe_roots roots
+    ON leaves.universe_root_id = roots.id
+JOIN universe_transfer_anchors anchors
+    ON anchors.leaf_id = leaves.id
+WHERE roots.proof_type = 'transfer' AND
+    (anchors.block_height <= $1 OR
+        anchors.block_timestamp < $2) AND
+    -- Only superseded leaves can be pruned, the leaves of unspent outputs
+    -- are always kept.
+    EXISTS (
+        SELECT 1
+        FROM universe_transfer_spends spends
+        WHERE spends.minting_point = leaves.minting_point AND
+            spends.script_key_bytes = leaves.script_key_bytes AND
+            spends.leaf_node_namespace = leaves.leaf_node_namespace
+    ) AND
+    -- Leaves with a pending push to a federation server are kept, so that
+    -- the push can still be retried.
+    NOT EXISTS (
+        SELECT 1
+        FROM federation_proof_sync_log log
+        WHERE log.proof_leaf_id = leaves.id AND
+            log.status = 'pending'
+    )
+ORDER BY leaves.id
+LIMIT $3
+`
+
+type QueryPrunableTransferLeavesParams struct {
+	MaxBlockHeight    sql.NullInt32
+	MinBlockTimestamp sql.NullTime
+	NumLimit          int32
+}
+
+type QueryPrunableTransferLeavesRow struct {
+	ID                int64
+	LeafNodeKey       []byte
+	LeafNodeNamespace string
+	AssetID           []byte
+	GroupKey          []byte
+}
+
+func (q *Queries) QueryPrunableTransferLeaves(ctx context.Context, arg QueryPrunableTransferLeavesParams) ([]QueryPrunableTransferLeavesRow, error) {
+	rows, err := q.db.QueryContext(ctx, queryPrunableTransferLeaves, arg.MaxBlockHeight, arg.MinBlockTimestamp, arg.NumLimit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []QueryPrunableTransferLeavesRow
+	for rows.Next() {
+		var i QueryPrunableTransferLeavesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.LeafNodeKey,
+			&i.LeafNodeNamespace,
+			&i.AssetID,
+			&i.GroupKey,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const queryUnanchoredTransferLeaves = `-- name: QueryUnanchoredTransferLeaves :many
+SELECT leaves.id, leaves.leaf_node_namespace, nodes.value AS leaf_proof
+FROM universe_leaves leaves
+JOIN universe_roots roots
+    ON leaves.universe_root_id = roots.id
+JOIN mssmt_nodes nodes
+    ON leaves.leaf_node_key = nodes.key AND
+        leaves.leaf_node_namespace = nodes.namespace
+LEFT JOIN universe_transfer_anchors anchors
+    ON anchors.leaf_id = leaves.id
+WHERE roots.proof_type = 'transfer' AND
+    anchors.leaf_id IS NULL AND
+    leaves.id > $1
+ORDER BY leaves.id
+LIMIT $2
+`
+
+type QueryUnanchoredTransferLeavesParams struct {
+	MinLeafID int64
+	NumLimit  int32
+}
+
+type QueryUnanchoredTransferLeavesRow struct {
+	ID                int64
+	LeafNodeNamespace string
+	LeafProof         []byte
+}
+
+func (q *Queries) QueryUnanchoredTransferLeaves(ctx context.Context, arg QueryUnanchoredTransferLeavesParams) ([]QueryUnanchoredTransferLeavesRow, error) {
+	rows, err := q.db.QueryContext(ctx, queryUnanchoredTransferLeaves, arg.MinLeafID, arg.NumLimit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []QueryUnanchoredTransferLeavesRow
+	for rows.Next() {
+		var i QueryUnanchoredTransferLeavesRow
+		if err := rows.Scan(&i.ID, &i.LeafNodeNamespace, &i.LeafProof); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const queryUniverseAdmissionRules = `-- name: QueryUniverseAdmissionRules :many
+SELECT rule_type, asset_id, group_key
+FROM universe_admission_rules
+ORDER BY id
+`
+
+type QueryUniverseAdmissionRulesRow struct {
+	RuleType string
+	AssetID  []byte
+	GroupKey []byte
+}
+
+func (q *Queries) QueryUniverseAdmissionRules(ctx context.Context) ([]QueryUniverseAdmissionRulesRow, error) {
+	rows, err := q.db.QueryContext(ctx, queryUniverseAdmissionRules)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []QueryUniverseAdmissionRulesRow
+	for rows.Next() {
+		var i QueryUniverseAdmissionRulesRow
+		if err := rows.Scan(&i.RuleType, &i.AssetID, &i.GroupKey); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const queryUniverseAssetStats = `-- name: QueryUniverseAssetStats :many
 
 WITH asset_supply AS (
@@ -864,6 +1608,203 @@ func (q *Queries) QueryUniverseAssetStats(ctx context.Context, arg QueryUniverse
 	return items, nil
 }
 
+const queryUniverseCommitments = `-- name: QueryUniverseCommitments :many
+SELECT c.id, c.root_hash, c.root_sum, c.anchor_tx, c.output_index,
+       c.block_height, c.block_header, c.merkle_proof,
+       keys.raw_key AS internal_key, keys.key_family, keys.key_index
+FROM universe_commitments c
+JOIN internal_keys keys
+    ON c.internal_key_id = keys.key_id
+WHERE (c.id = $1 OR
+       $1 IS NULL)
+ORDER BY c.id DESC
+LIMIT $2
+`
+
+type QueryUniverseCommitmentsParams struct {
+	CommitmentID sql.NullInt64
+	NumLimit     int32
+}
+
+type QueryUniverseCommitmentsRow struct {
+	ID          int64
+	RootHash    []byte
+	RootSum     int64
+	AnchorTx    []byte
+	OutputIndex int32
+	BlockHeight int32
+	BlockHeader []byte
+	MerkleProof []byte
+	InternalKey []byte
+	KeyFamily   int32
+	KeyIndex    int32
+}
+
+func (q *Queries) QueryUniverseCommitments(ctx context.Context, arg QueryUniverseCommitmentsParams) ([]QueryUniverseCommitmentsRow, error) {
+	rows, err := q.db.QueryContext(ctx, queryUniverseCommitments, arg.CommitmentID, arg.NumLimit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []QueryUniverseCommitmentsRow
+	for rows.Next() {
+		var i QueryUniverseCommitmentsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.RootHash,
+			&i.RootSum,
+			&i.AnchorTx,
+			&i.OutputIndex,
+			&i.BlockHeight,
+			&i.BlockHeader,
+			&i.MerkleProof,
+			&i.InternalKey,
+			&i.KeyFamily,
+			&i.KeyIndex,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const queryUniverseDivergences = `-- name: QueryUniverseDivergences :many
+SELECT id, server_host, asset_id, group_key, proof_type, local_root_hash,
+       local_root_sum, remote_root_hash, remote_root_sum, detected_at
+FROM universe_divergences
+WHERE (server_host = $1 OR
+       $1 IS NULL)
+ORDER BY id DESC
+LIMIT $3 OFFSET $2
+`
+
+type QueryUniverseDivergencesParams struct {
+	ServerHost sql.NullString
+	NumOffset  int32
+	NumLimit   int32
+}
+
+func (q *Queries) QueryUniverseDivergences(ctx context.Context, arg QueryUniverseDivergencesParams) ([]UniverseDivergence, error) {
+	rows, err := q.db.QueryContext(ctx, queryUniverseDivergences, arg.ServerHost, arg.NumOffset, arg.NumLimit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []UniverseDivergence
+	for rows.Next() {
+		var i UniverseDivergence
+		if err := rows.Scan(
+			&i.ID,
+			&i.ServerHost,
+			&i.AssetID,
+			&i.GroupKey,
+			&i.ProofType,
+			&i.LocalRootHash,
+			&i.LocalRootSum,
+			&i.RemoteRootHash,
+			&i.RemoteRootSum,
+			&i.DetectedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const queryUniverseLeafEvents = `-- name: QueryUniverseLeafEvents :many
+SELECT events.seq, leaves.minting_point, leaves.script_key_bytes,
+       nodes.value AS leaf_proof, nodes.sum AS leaf_sum,
+       roots.group_key, roots.proof_type
+FROM universe_leaf_events events
+JOIN universe_leaves leaves
+    ON events.leaf_id = leaves.id
+JOIN mssmt_nodes nodes
+    ON leaves.leaf_node_key = nodes.key AND
+        leaves.leaf_node_namespace = nodes.namespace
+JOIN universe_roots roots
+    ON leaves.universe_root_id = roots.id
+JOIN genesis_assets gen
+    ON leaves.asset_genesis_id = gen.gen_asset_id
+WHERE events.seq > $1 AND
+    (gen.asset_id = $2 OR
+        $2 IS NULL) AND
+    (roots.group_key = $3 OR
+        $3 IS NULL) AND
+    (roots.proof_type = $4 OR
+        $4 IS NULL)
+ORDER BY events.seq ASC
+LIMIT $5
+`
+
+type QueryUniverseLeafEventsParams struct {
+	AfterSeq  int64
+	AssetID   []byte
+	GroupKey  []byte
+	ProofType sql.NullString
+	NumLimit  int32
+}
+
+type QueryUniverseLeafEventsRow struct {
+	Seq            int64
+	MintingPoint   []byte
+	ScriptKeyBytes []byte
+	LeafProof      []byte
+	LeafSum        int64
+	GroupKey       []byte
+	ProofType      string
+}
+
+func (q *Queries) QueryUniverseLeafEvents(ctx context.Context, arg QueryUniverseLeafEventsParams) ([]QueryUniverseLeafEventsRow, error) {
+	rows, err := q.db.QueryContext(ctx, queryUniverseLeafEvents,
+		arg.AfterSeq,
+		arg.AssetID,
+		arg.GroupKey,
+		arg.ProofType,
+		arg.NumLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []QueryUniverseLeafEventsRow
+	for rows.Next() {
+		var i QueryUniverseLeafEventsRow
+		if err := rows.Scan(
+			&i.Seq,
+			&i.MintingPoint,
+			&i.ScriptKeyBytes,
+			&i.LeafProof,
+			&i.LeafSum,
+			&i.GroupKey,
+			&i.ProofType,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const queryUniverseLeaves = `-- name: QueryUniverseLeaves :many
 SELECT leaves.script_key_bytes, gen.gen_asset_id, nodes.value genesis_proof, 
        nodes.sum sum_amt, gen.asset_id
@@ -1301,7 +2242,29 @@ func (q *Queries) UpsertMultiverseRoot(ctx context.Context, arg UpsertMultiverse
 	return id, err
 }
 
-const upsertUniverseLeaf = `-- name: UpsertUniverseLeaf :exec
+const upsertUniverseAdmissionLimits = `-- name: UpsertUniverseAdmissionLimits :exec
+INSERT INTO universe_admission_limits (
+    id, max_proof_size, max_inserts_per_minute
+) VALUES (
+    1, $1, $2
+)
+ON CONFLICT (id)
+    DO UPDATE SET
+        max_proof_size = EXCLUDED.max_proof_size,
+        max_inserts_per_minute = EXCLUDED.max_inserts_per_minute
+`
+
+type UpsertUniverseAdmissionLimitsParams struct {
+	MaxProofSize        int64
+	MaxInsertsPerMinute int64
+}
+
+func (q *Queries) UpsertUniverseAdmissionLimits(ctx context.Context, arg UpsertUniverseAdmissionLimitsParams) error {
+	_, err := q.db.ExecContext(ctx, upsertUniverseAdmissionLimits, arg.MaxProofSize, arg.MaxInsertsPerMinute)
+	return err
+}
+
+const upsertUniverseLeaf = `-- name: UpsertUniverseLeaf :one
 INSERT INTO universe_leaves (
     asset_genesis_id, script_key_bytes, universe_root_id, leaf_node_key, 
     leaf_node_namespace, minting_point
@@ -1313,6 +2276,7 @@ INSERT INTO universe_leaves (
     -- that caused the conflict.
     DO UPDATE SET minting_point = EXCLUDED.minting_point,
                   script_key_bytes = EXCLUDED.script_key_bytes
+RETURNING id
 `
 
 type UpsertUniverseLeafParams struct {
@@ -1324,8 +2288,8 @@ type UpsertUniverseLeafParams struct {
 	MintingPoint      []byte
 }
 
-func (q *Queries) UpsertUniverseLeaf(ctx context.Context, arg UpsertUniverseLeafParams) error {
-	_, err := q.db.ExecContext(ctx, upsertUniverseLeaf,
+func (q *Queries) UpsertUniverseLeaf(ctx context.Context, arg UpsertUniverseLeafParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, upsertUniverseLeaf,
 		arg.AssetGenesisID,
 		arg.ScriptKeyBytes,
 		arg.UniverseRootID,
@@ -1333,7 +2297,9 @@ func (q *Queries) UpsertUniverseLeaf(ctx context.Context, arg UpsertUniverseLeaf
 		arg.LeafNodeNamespace,
 		arg.MintingPoint,
 	)
-	return err
+	var id int64
+	err := row.Scan(&id)
+	return id, err
 }
 
 const upsertUniverseRoot = `-- name: UpsertUniverseRoot :one
@@ -1366,3 +2332,26 @@ func (q *Queries) UpsertUniverseRoot(ctx context.Context, arg UpsertUniverseRoot
 	err := row.Scan(&id)
 	return id, err
 }
+
+const upsertUniverseTransferAnchor = `-- name: UpsertUniverseTransferAnchor :exec
+INSERT INTO universe_transfer_anchors (
+    leaf_id, block_height, block_timestamp
+) VALUES (
+    $1, $2, $3
+)
+ON CONFLICT (leaf_id)
+    DO UPDATE SET
+        block_height = EXCLUDED.block_height,
+        block_timestamp = EXCLUDED.block_timestamp
+`
+
+type UpsertUniverseTransferAnchorParams struct {
+	LeafID         int64
+	BlockHeight    int32
+	BlockTimestamp time.Time
+}
+
+func (q *Queries) UpsertUniverseTransferAnchor(ctx context.Context, arg UpsertUniverseTransferAnchorParams) error {
+	_, err := q.db.ExecContext(ctx, upsertUniverseTransferAnchor, arg.LeafID, arg.BlockHeight, arg.BlockTimestamp)
+	return err
+}
@@ -25,6 +25,14 @@ type (
 	// UpsertUniverseLeaf is used to upsert universe leaves.
 	UpsertUniverseLeaf = sqlc.UpsertUniverseLeafParams
 
+	// UniverseLeafEventsQuery is used to query for the universe leaves
+	// that were inserted after a given leaf.
+	UniverseLeafEventsQuery = sqlc.QueryUniverseLeafEventsParams
+
+	// UniverseLeafEvent is a universe leaf along with the universe it
+	// was inserted into.
+	UniverseLeafEvent = sqlc.QueryUniverseLeafEventsRow
+
 	// NewUniverseRoot is used to insert a new universe root.
 	NewUniverseRoot = sqlc.UpsertUniverseRootParams
 
@@ -42,6 +50,13 @@ type (
 	// currently stored for a given namespace.
 	UniverseLeafKeysQuery = sqlc.FetchUniverseKeysParams
 
+	// UniverseLeafKeyQuery is used to look up the leaf key of a universe
+	// leaf by its MS-SMT key.
+	UniverseLeafKeyQuery = sqlc.FetchUniverseLeafKeyParams
+
+	// UniverseLeafKeyRow is the leaf key of a universe leaf.
+	UniverseLeafKeyRow = sqlc.FetchUniverseLeafKeyRow
+
 	// UpsertMultiverseRoot is used to upsert a multiverse root.
 	UpsertMultiverseRoot = sqlc.UpsertMultiverseRootParams
 
@@ -50,6 +65,14 @@ type (
 
 	// DeleteMultiverseLeaf is used to delete a multiverse leaf.
 	DeleteMultiverseLeaf = sqlc.DeleteMultiverseLeafParams
+
+	// UniverseTransferAnchor is used to store the anchor block of a
+	// transfer leaf.
+	UniverseTransferAnchor = sqlc.UpsertUniverseTransferAnchorParams
+
+	// UniverseTransferSpend is used to mark an asset output of a transfer
+	// universe as spent.
+	UniverseTransferSpend = sqlc.InsertUniverseTransferSpendParams
 )
 
 // BaseUniverseStore is the main interface for the Taproot Asset universe store.
@@ -86,8 +109,25 @@ type BaseUniverseStore interface {
 	FetchUniverseRoot(ctx context.Context,
 		namespace string) (UniverseRoot, error)
 
-	// UpsertUniverseLeaf upserts a Universe leaf in the database.
-	UpsertUniverseLeaf(ctx context.Context, arg UpsertUniverseLeaf) error
+	// UpsertUniverseLeaf upserts a Universe leaf in the database,
+	// returning the primary key of the leaf.
+	UpsertUniverseLeaf(ctx context.Context,
+		arg UpsertUniverseLeaf) (int64, error)
+
+	// InsertUniverseLeafEvent records an upsert of the given universe
+	// leaf, returning the sequence number of the event.
+	InsertUniverseLeafEvent(ctx context.Context,
+		leafID sql.NullInt64) (int64, error)
+
+	// QueryUniverseLeafEvents returns the universe leaves that were
+	// upserted after the given event sequence number, across all
+	// universes.
+	QueryUniverseLeafEvents(ctx context.Context,
+		arg UniverseLeafEventsQuery) ([]UniverseLeafEvent, error)
+
+	// FetchLatestUniverseLeafEventSeq returns the sequence number of the
+	// most recent universe leaf event.
+	FetchLatestUniverseLeafEventSeq(ctx context.Context) (int64, error)
 
 	// UpsertUniverseRoot attempts to insert a universe root, returning the
 	// existing primary key of the root if already exists.
@@ -99,6 +139,11 @@ type BaseUniverseStore interface {
 	FetchUniverseKeys(ctx context.Context,
 		arg UniverseLeafKeysQuery) ([]UniverseKeys, error)
 
+	// FetchUniverseLeafKey fetches the leaf key of the universe leaf that
+	// is stored at the given MS-SMT key within a namespace.
+	FetchUniverseLeafKey(ctx context.Context,
+		arg UniverseLeafKeyQuery) (UniverseLeafKeyRow, error)
+
 	// UpsertMultiverseRoot upserts a multiverse root in the database.
 	UpsertMultiverseRoot(ctx context.Context,
 		arg UpsertMultiverseRoot) (int64, error)
@@ -110,6 +155,25 @@ type BaseUniverseStore interface {
 	// DeleteMultiverseLeaf deletes a multiverse leaf from the database.
 	DeleteMultiverseLeaf(ctx context.Context,
 		arg DeleteMultiverseLeaf) error
+
+	// UpsertUniverseTransferAnchor stores the anchor block of a transfer
+	// leaf.
+	UpsertUniverseTransferAnchor(ctx context.Context,
+		arg UniverseTransferAnchor) error
+
+	// InsertUniverseTransferSpend marks an asset output of a transfer
+	// universe as spent by a later transfer.
+	InsertUniverseTransferSpend(ctx context.Context,
+		arg UniverseTransferSpend) error
+
+	// DeleteUniverseTransferSpends deletes all spent asset outputs of a
+	// transfer universe.
+	DeleteUniverseTransferSpends(ctx context.Context,
+		namespace string) error
+
+	// DeleteUniversePrunedLeaves deletes the tombstones of all pruned
+	// leaves of a transfer universe.
+	DeleteUniversePrunedLeaves(ctx context.Context, namespace string) error
 }
 
 // BaseUniverseStoreOptions is the set of options for universe tree queries.
@@ -339,7 +403,7 @@ func (b *BaseUniverseTree) RegisterIssuance(ctx context.Context,
 		issuanceProof *universe.Proof
 	)
 	dbErr := b.db.ExecTx(ctx, &writeTx, func(dbTx BaseUniverseStore) error {
-		issuanceProof, err = universeUpsertProofLeaf(
+		issuanceProof, _, err = universeUpsertProofLeaf(
 			ctx, dbTx, b.id, key, leaf, metaReveal,
 		)
 		return err
@@ -355,13 +419,14 @@ func (b *BaseUniverseTree) RegisterIssuance(ctx context.Context,
 // at the proof leaf key).
 //
 // This function returns the inserted/updated proof leaf and the new universe
-// root.
+// root, along with the sequence number of the leaf event that records the
+// upsert.
 //
 // NOTE: This function accepts a db transaction, as it's used when making
 // broader DB updates.
 func universeUpsertProofLeaf(ctx context.Context, dbTx BaseUniverseStore,
 	id universe.Identifier, key universe.LeafKey, leaf *universe.Leaf,
-	metaReveal *proof.MetaReveal) (*universe.Proof, error) {
+	metaReveal *proof.MetaReveal) (*universe.Proof, int64, error) {
 
 	namespace := id.String()
 
@@ -380,7 +445,7 @@ func universeUpsertProofLeaf(ctx context.Context, dbTx BaseUniverseStore,
 
 	mintingPointBytes, err := encodeOutpoint(key.OutPoint)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
 	var (
@@ -398,7 +463,7 @@ func universeUpsertProofLeaf(ctx context.Context, dbTx BaseUniverseStore,
 	// insert the leaf into the tree based on its SMT key.
 	_, err = universeTree.Insert(ctx, smtKey, leafNode)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
 	// Next, we'll upsert the universe root in the DB, which gives us the
@@ -410,7 +475,7 @@ func universeUpsertProofLeaf(ctx context.Context, dbTx BaseUniverseStore,
 		ProofType:     id.ProofType.String(),
 	})
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
 	// Before we insert the asset genesis, we'll insert the meta first. The
@@ -418,24 +483,24 @@ func universeUpsertProofLeaf(ctx context.Context, dbTx BaseUniverseStore,
 	// meta blob on disk.
 	_, err = maybeUpsertAssetMeta(ctx, dbTx, &leaf.Genesis, metaReveal)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
 	var leafProof proof.Proof
 	err = leafProof.Decode(bytes.NewReader(leaf.RawProof))
 	if err != nil {
-		return nil, fmt.Errorf("unable to decode proof: %w", err)
+		return nil, 0, fmt.Errorf("unable to decode proof: %w", err)
 	}
 
 	assetGenID, err := upsertAssetGen(
 		ctx, dbTx, leaf.Genesis, leaf.GroupKey, &leafProof,
 	)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
 	scriptKeyBytes := schnorr.SerializePubKey(key.ScriptKey.PubKey)
-	err = dbTx.UpsertUniverseLeaf(ctx, UpsertUniverseLeaf{
+	leafID, err := dbTx.UpsertUniverseLeaf(ctx, UpsertUniverseLeaf{
 		AssetGenesisID:    assetGenID,
 		ScriptKeyBytes:    scriptKeyBytes,
 		UniverseRootID:    universeRootID,
@@ -444,28 +509,49 @@ func universeUpsertProofLeaf(ctx context.Context, dbTx BaseUniverseStore,
 		MintingPoint:      mintingPointBytes,
 	})
 	if err != nil {
-		return nil, err
+		return nil, 0, err
+	}
+
+	// For transfer leaves, we also track the anchor block and the asset
+	// outputs that are spent, so superseded leaves can be pruned later.
+	if id.ProofType == universe.ProofTypeTransfer {
+		err = indexTransferLeaf(
+			ctx, dbTx, leafID, namespace, &leafProof,
+		)
+		if err != nil {
+			return nil, 0, err
+		}
+	}
+
+	// We record the upsert as a leaf event within the same transaction.
+	// The upsert above returns the ID of an existing leaf, and IDs aren't
+	// assigned in commit order anyway, so subscribers use the sequence
+	// number of the event as their cursor instead.
+	eventSeq, err := dbTx.InsertUniverseLeafEvent(ctx, sqlInt64(leafID))
+	if err != nil {
+		return nil, 0, fmt.Errorf("unable to insert leaf event: %w",
+			err)
 	}
 
 	// Finally, we'll obtain the merkle proof from the tree for the leaf we
 	// just inserted.
 	leafInclusionProof, err = universeTree.MerkleProof(ctx, smtKey)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
 	// With the insertion complete, we'll now fetch the root of the tree as
 	// it stands and return it to the caller.
 	universeRoot, err = universeTree.Root(ctx)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
 	// The next step is to insert the multiverse leaf, which is a leaf in
 	// the multiverse tree that points to the universe leaf we just created.
 	multiverseNS, err := namespaceForProof(id.ProofType)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
 	// Retrieve a handle to the multiverse tree so that we can update the
@@ -492,7 +578,7 @@ func universeUpsertProofLeaf(ctx context.Context, dbTx BaseUniverseStore,
 
 	_, err = multiverseTree.Insert(ctx, uniLeafNodeKey, uniLeafNode)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
 	// Now that we've inserted the leaf into the multiverse tree, we'll also
@@ -504,7 +590,7 @@ func universeUpsertProofLeaf(ctx context.Context, dbTx BaseUniverseStore,
 		},
 	)
 	if err != nil {
-		return nil, fmt.Errorf("unable to upsert multiverse root: %w",
+		return nil, 0, fmt.Errorf("unable to upsert multiverse root: %w",
 			err)
 	}
 
@@ -521,7 +607,7 @@ func universeUpsertProofLeaf(ctx context.Context, dbTx BaseUniverseStore,
 		LeafNodeNamespace: multiverseNS,
 	})
 	if err != nil {
-		return nil, fmt.Errorf("unable to upsert multiverse leaf: %w",
+		return nil, 0, fmt.Errorf("unable to upsert multiverse leaf: %w",
 			err)
 	}
 
@@ -529,14 +615,14 @@ func universeUpsertProofLeaf(ctx context.Context, dbTx BaseUniverseStore,
 	// the leaf node.
 	multiverseRoot, err := multiverseTree.Root(ctx)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
 	multiverseInclusionProof, err := multiverseTree.MerkleProof(
 		ctx, uniLeafNodeKey,
 	)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
 	return &universe.Proof{
@@ -546,7 +632,68 @@ func universeUpsertProofLeaf(ctx context.Context, dbTx BaseUniverseStore,
 		MultiverseRoot:           multiverseRoot,
 		MultiverseInclusionProof: multiverseInclusionProof,
 		Leaf:                     leaf,
-	}, nil
+	}, eventSeq, nil
+}
+
+// transferPrevIDs returns the IDs of the previous asset outputs that are spent
+// by the given transfer asset. For split outputs, these are the inputs of the
+// split root asset.
+func transferPrevIDs(transferAsset *asset.Asset) []asset.PrevID {
+	witnesses := transferAsset.PrevWitnesses
+	if transferAsset.HasSplitCommitmentWitness() {
+		splitCommitment := witnesses[0].SplitCommitment
+		witnesses = splitCommitment.RootAsset.PrevWitnesses
+	}
+
+	prevIDs := make([]asset.PrevID, 0, len(witnesses))
+	for _, witness := range witnesses {
+		if witness.PrevID == nil || *witness.PrevID == asset.ZeroPrevID {
+			continue
+		}
+
+		prevIDs = append(prevIDs, *witness.PrevID)
+	}
+
+	return prevIDs
+}
+
+// indexTransferLeaf stores the anchor block of the given transfer leaf and
+// marks the asset outputs spent by its asset. An output that is spent by a
+// later transfer in the same universe makes the leaf of that output
+// superseded.
+func indexTransferLeaf(ctx context.Context, dbTx BaseUniverseStore,
+	leafID int64, namespace string, leafProof *proof.Proof) error {
+
+	err := dbTx.UpsertUniverseTransferAnchor(ctx, UniverseTransferAnchor{
+		LeafID:         leafID,
+		BlockHeight:    int32(leafProof.BlockHeight),
+		BlockTimestamp: leafProof.BlockHeader.Timestamp.UTC(),
+	})
+	if err != nil {
+		return fmt.Errorf("unable to store transfer anchor: %w", err)
+	}
+
+	for _, prevID := range transferPrevIDs(&leafProof.Asset) {
+		mintingPoint, err := encodeOutpoint(prevID.OutPoint)
+		if err != nil {
+			return err
+		}
+
+		err = dbTx.InsertUniverseTransferSpend(
+			ctx, UniverseTransferSpend{
+				LeafNodeNamespace: namespace,
+				MintingPoint:      mintingPoint,
+				ScriptKeyBytes: prevID.ScriptKey.
+					SchnorrSerialized(),
+			},
+		)
+		if err != nil {
+			return fmt.Errorf("unable to store transfer spend: %w",
+				err)
+		}
+	}
+
+	return nil
 }
 
 // FetchIssuanceProof returns an issuance proof for the target key. If the key
@@ -736,27 +883,14 @@ func mintingKeys(ctx context.Context, dbTx BaseUniverseStore,
 
 	var leafKeys []universe.LeafKey
 	err = fn.ForEachErr(universeKeys, func(key UniverseKeys) error {
-		scriptKeyPub, err := schnorr.ParsePubKey(
-			key.ScriptKeyBytes,
-		)
-		if err != nil {
-			return err
-		}
-		scriptKey := asset.NewScriptKey(scriptKeyPub)
-
-		var genPoint wire.OutPoint
-		err = readOutPoint(
-			bytes.NewReader(key.MintingPoint), 0, 0,
-			&genPoint,
+		leafKey, err := parseLeafKey(
+			key.MintingPoint, key.ScriptKeyBytes,
 		)
 		if err != nil {
 			return err
 		}
 
-		leafKeys = append(leafKeys, universe.LeafKey{
-			OutPoint:  genPoint,
-			ScriptKey: &scriptKey,
-		})
+		leafKeys = append(leafKeys, leafKey)
 
 		return nil
 	})
@@ -767,6 +901,88 @@ func mintingKeys(ctx context.Context, dbTx BaseUniverseStore,
 	return leafKeys, nil
 }
 
+// parseLeafKey parses a universe leaf key from its minting point and script
+// key as stored in the database.
+func parseLeafKey(mintingPoint, scriptKeyBytes []byte) (universe.LeafKey,
+	error) {
+
+	scriptKeyPub, err := schnorr.ParsePubKey(scriptKeyBytes)
+	if err != nil {
+		return universe.LeafKey{}, err
+	}
+	scriptKey := asset.NewScriptKey(scriptKeyPub)
+
+	var genPoint wire.OutPoint
+	err = readOutPoint(bytes.NewReader(mintingPoint), 0, 0, &genPoint)
+	if err != nil {
+		return universe.LeafKey{}, err
+	}
+
+	return universe.LeafKey{
+		OutPoint:  genPoint,
+		ScriptKey: &scriptKey,
+	}, nil
+}
+
+// universeTreeNodes returns the nodes of the universe tree with the given
+// namespace at the given paths. For subtrees that only contain a single leaf,
+// the universe leaf key of that leaf is looked up as well.
+func universeTreeNodes(ctx context.Context, dbTx BaseUniverseStore,
+	namespace string,
+	paths []universe.TreeNodePath) ([]universe.TreeNode, error) {
+
+	universeTree := mssmt.NewCompactedTree(
+		newTreeStoreWrapperTx(dbTx, namespace),
+	)
+
+	nodes := make([]universe.TreeNode, 0, len(paths))
+	for _, path := range paths {
+		node, err := universeTree.SubtreeRoot(
+			ctx, int(path.Depth), path.Prefix,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		treeNode := universe.TreeNode{
+			Path: path,
+			Node: mssmt.NewComputedNode(
+				node.NodeHash(), node.NodeSum(),
+			),
+		}
+
+		compLeaf, ok := node.(*mssmt.CompactedLeafNode)
+		if !ok {
+			nodes = append(nodes, treeNode)
+			continue
+		}
+
+		smtKey := compLeaf.Key()
+		dbKey, err := dbTx.FetchUniverseLeafKey(
+			ctx, UniverseLeafKeyQuery{
+				Namespace:   namespace,
+				LeafNodeKey: smtKey[:],
+			},
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to fetch leaf key for "+
+				"smt key %x: %w", smtKey[:], err)
+		}
+
+		leafKey, err := parseLeafKey(
+			dbKey.MintingPoint, dbKey.ScriptKeyBytes,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		treeNode.LeafKey = &leafKey
+		nodes = append(nodes, treeNode)
+	}
+
+	return nodes, nil
+}
+
 // MintingKeys returns all the keys inserted in the universe.
 func (b *BaseUniverseTree) MintingKeys(ctx context.Context,
 	q universe.UniverseLeafKeysQuery) ([]universe.LeafKey, error) {
@@ -892,6 +1108,21 @@ func deleteUniverseTree(ctx context.Context,
 			"tree root: %w", err)
 	}
 
+	// Delete the spent asset outputs tracked for a transfer universe.
+	err = db.DeleteUniverseTransferSpends(ctx, namespace)
+	if err != nil {
+		return fmt.Errorf("failed to delete universe transfer "+
+			"spends: %w", err)
+	}
+
+	// Delete the tombstones of the leaves that were pruned from a transfer
+	// universe.
+	err = db.DeleteUniversePrunedLeaves(ctx, namespace)
+	if err != nil {
+		return fmt.Errorf("failed to delete universe pruned "+
+			"leaves: %w", err)
+	}
+
 	// Delete any events related to this universe.
 	err = db.DeleteUniverseEvents(ctx, namespace)
 	if err != nil {
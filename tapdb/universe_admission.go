@@ -0,0 +1,325 @@
+package tapdb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/tapdb/sqlc"
+	"github.com/lightninglabs/taproot-assets/universe"
+)
+
+type (
+	// NewAdmissionRule is used to insert a new admission rule.
+	NewAdmissionRule = sqlc.InsertUniverseAdmissionRuleParams
+
+	// AdmissionRule is an admission rule returned from a query.
+	AdmissionRule = sqlc.QueryUniverseAdmissionRulesRow
+
+	// NewAdmissionLimits is used to set the admission limits.
+	NewAdmissionLimits = sqlc.UpsertUniverseAdmissionLimitsParams
+
+	// AdmissionLimits are the admission limits returned from a query.
+	AdmissionLimits = sqlc.FetchUniverseAdmissionLimitsRow
+
+	// NewRejectedLeaf is used to record a leaf that was rejected by the
+	// admission policy.
+	NewRejectedLeaf = sqlc.InsertUniverseRejectedLeafParams
+
+	// RejectedLeafQuery is used to look up a rejected leaf.
+	RejectedLeafQuery = sqlc.FetchUniverseRejectedLeafParams
+)
+
+// AdmissionPolicyStore is the database interface used to persist the
+// admission policy of the universe.
+type AdmissionPolicyStore interface {
+	// DeleteUniverseAdmissionRules removes all admission rules.
+	DeleteUniverseAdmissionRules(ctx context.Context) error
+
+	// InsertUniverseAdmissionRule inserts a new admission rule.
+	InsertUniverseAdmissionRule(ctx context.Context,
+		arg NewAdmissionRule) error
+
+	// QueryUniverseAdmissionRules returns all admission rules.
+	QueryUniverseAdmissionRules(ctx context.Context) ([]AdmissionRule,
+		error)
+
+	// UpsertUniverseAdmissionLimits sets the admission limits.
+	UpsertUniverseAdmissionLimits(ctx context.Context,
+		arg NewAdmissionLimits) error
+
+	// FetchUniverseAdmissionLimits returns the admission limits.
+	FetchUniverseAdmissionLimits(ctx context.Context) (AdmissionLimits,
+		error)
+
+	// InsertUniverseRejectedLeaf records a leaf that was rejected by the
+	// admission policy.
+	InsertUniverseRejectedLeaf(ctx context.Context,
+		arg NewRejectedLeaf) error
+
+	// FetchUniverseRejectedLeaf returns the ID of the record of a
+	// rejected leaf.
+	FetchUniverseRejectedLeaf(ctx context.Context,
+		arg RejectedLeafQuery) (int64, error)
+
+	// DeleteUniverseRejectedLeaves removes the records of all rejected
+	// leaves.
+	DeleteUniverseRejectedLeaves(ctx context.Context) error
+}
+
+// AdmissionPolicyTxOptions defines the set of db txn options the
+// AdmissionPolicyStore understands.
+type AdmissionPolicyTxOptions struct {
+	// readOnly governs if a read only transaction is needed or not.
+	readOnly bool
+}
+
+// ReadOnly returns true if the transaction should be read only.
+//
+// NOTE: This implements the TxOptions interface.
+func (a *AdmissionPolicyTxOptions) ReadOnly() bool {
+	return a.readOnly
+}
+
+// NewAdmissionPolicyReadTx creates a new read transaction option set.
+func NewAdmissionPolicyReadTx() AdmissionPolicyTxOptions {
+	return AdmissionPolicyTxOptions{
+		readOnly: true,
+	}
+}
+
+// BatchedAdmissionPolicyStore supports performing the admission policy
+// queries in a single database transaction.
+type BatchedAdmissionPolicyStore interface {
+	AdmissionPolicyStore
+
+	BatchedTx[AdmissionPolicyStore]
+}
+
+// UniverseAdmissionDB is a persistent store for the admission policy of the
+// universe.
+type UniverseAdmissionDB struct {
+	db BatchedAdmissionPolicyStore
+}
+
+// NewUniverseAdmissionDB creates a new admission policy store from the given
+// database.
+func NewUniverseAdmissionDB(
+	db BatchedAdmissionPolicyStore) *UniverseAdmissionDB {
+
+	return &UniverseAdmissionDB{
+		db: db,
+	}
+}
+
+// FetchAdmissionPolicy returns the current admission policy. An empty policy
+// is returned if no policy was set yet.
+func (u *UniverseAdmissionDB) FetchAdmissionPolicy(
+	ctx context.Context) (*universe.AdmissionPolicy, error) {
+
+	var (
+		readTx = NewAdmissionPolicyReadTx()
+		policy universe.AdmissionPolicy
+	)
+	err := u.db.ExecTx(ctx, &readTx, func(db AdmissionPolicyStore) error {
+		limits, err := db.FetchUniverseAdmissionLimits(ctx)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+
+		case err != nil:
+			return fmt.Errorf("unable to fetch admission limits: "+
+				"%w", err)
+
+		default:
+			policy.MaxProofSize = uint64(limits.MaxProofSize)
+			policy.MaxInsertsPerMinute = uint32(
+				limits.MaxInsertsPerMinute,
+			)
+		}
+
+		dbRules, err := db.QueryUniverseAdmissionRules(ctx)
+		if err != nil {
+			return fmt.Errorf("unable to query admission rules: "+
+				"%w", err)
+		}
+
+		for _, dbRule := range dbRules {
+			rule, err := parseAdmissionRule(dbRule)
+			if err != nil {
+				return err
+			}
+
+			policy.Rules = append(policy.Rules, *rule)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &policy, nil
+}
+
+// parseAdmissionRule parses an admission rule from a DB row.
+func parseAdmissionRule(dbRule AdmissionRule) (*universe.AdmissionRule,
+	error) {
+
+	ruleType, err := universe.ParseStrAdmissionRuleType(dbRule.RuleType)
+	if err != nil {
+		return nil, err
+	}
+
+	rule := &universe.AdmissionRule{
+		Type: ruleType,
+	}
+	if dbRule.GroupKey != nil {
+		rule.GroupKey, err = btcec.ParsePubKey(dbRule.GroupKey)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse group key: %w",
+				err)
+		}
+
+		return rule, nil
+	}
+
+	var assetID asset.ID
+	copy(assetID[:], dbRule.AssetID)
+	rule.AssetID = &assetID
+
+	return rule, nil
+}
+
+// SetAdmissionPolicy replaces the current admission policy. The leaves that
+// were rejected under the previous policy are forgotten.
+func (u *UniverseAdmissionDB) SetAdmissionPolicy(ctx context.Context,
+	policy *universe.AdmissionPolicy) error {
+
+	var writeTx AdmissionPolicyTxOptions
+	return u.db.ExecTx(ctx, &writeTx, func(db AdmissionPolicyStore) error {
+		err := db.UpsertUniverseAdmissionLimits(ctx, NewAdmissionLimits{
+			MaxProofSize: int64(policy.MaxProofSize),
+			MaxInsertsPerMinute: int64(
+				policy.MaxInsertsPerMinute,
+			),
+		})
+		if err != nil {
+			return fmt.Errorf("unable to set admission limits: %w",
+				err)
+		}
+
+		err = db.DeleteUniverseAdmissionRules(ctx)
+		if err != nil {
+			return fmt.Errorf("unable to delete admission rules: "+
+				"%w", err)
+		}
+
+		for _, rule := range policy.Rules {
+			newRule := NewAdmissionRule{
+				RuleType: rule.Type.String(),
+			}
+			if rule.GroupKey != nil {
+				newRule.GroupKey =
+					rule.GroupKey.SerializeCompressed()
+			} else {
+				newRule.AssetID = rule.AssetID[:]
+			}
+
+			err := db.InsertUniverseAdmissionRule(ctx, newRule)
+			if err != nil {
+				return fmt.Errorf("unable to insert admission "+
+					"rule: %w", err)
+			}
+		}
+
+		err = db.DeleteUniverseRejectedLeaves(ctx)
+		if err != nil {
+			return fmt.Errorf("unable to delete rejected leaves: "+
+				"%w", err)
+		}
+
+		return nil
+	})
+}
+
+// RejectLeaves records that the synced leaves with the given keys were
+// rejected by the current policy.
+func (u *UniverseAdmissionDB) RejectLeaves(ctx context.Context,
+	id universe.Identifier, leafKeys []universe.LeafKey) error {
+
+	var (
+		writeTx   AdmissionPolicyTxOptions
+		namespace = id.String()
+	)
+	return u.db.ExecTx(ctx, &writeTx, func(db AdmissionPolicyStore) error {
+		for _, key := range leafKeys {
+			smtKey := key.UniverseKey()
+			err := db.InsertUniverseRejectedLeaf(
+				ctx, NewRejectedLeaf{
+					LeafNodeNamespace: namespace,
+					LeafNodeKey:       smtKey[:],
+				},
+			)
+			if err != nil {
+				return fmt.Errorf("unable to insert rejected "+
+					"leaf: %w", err)
+			}
+		}
+
+		return nil
+	})
+}
+
+// RejectedLeafKeys returns the subset of the given leaf keys whose leaves were
+// rejected from the given universe by the current policy.
+func (u *UniverseAdmissionDB) RejectedLeafKeys(ctx context.Context,
+	id universe.Identifier,
+	leafKeys []universe.LeafKey) ([]universe.LeafKey, error) {
+
+	if len(leafKeys) == 0 {
+		return nil, nil
+	}
+
+	var (
+		readTx       = NewAdmissionPolicyReadTx()
+		namespace    = id.String()
+		rejectedKeys []universe.LeafKey
+	)
+	err := u.db.ExecTx(ctx, &readTx, func(db AdmissionPolicyStore) error {
+		rejectedKeys = nil
+
+		for _, key := range leafKeys {
+			smtKey := key.UniverseKey()
+			_, err := db.FetchUniverseRejectedLeaf(
+				ctx, RejectedLeafQuery{
+					LeafNodeNamespace: namespace,
+					LeafNodeKey:       smtKey[:],
+				},
+			)
+			switch {
+			case errors.Is(err, sql.ErrNoRows):
+				continue
+
+			case err != nil:
+				return fmt.Errorf("unable to fetch rejected "+
+					"leaf: %w", err)
+			}
+
+			rejectedKeys = append(rejectedKeys, key)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return rejectedKeys, nil
+}
+
+// A compile-time assertion to ensure that UniverseAdmissionDB implements the
+// universe.AdmissionPolicyStore interface.
+var _ universe.AdmissionPolicyStore = (*UniverseAdmissionDB)(nil)
@@ -0,0 +1,118 @@
+package tapdb
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/universe"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestAdmissionDB(t *testing.T) *UniverseAdmissionDB {
+	db := NewTestDB(t)
+
+	dbTxer := NewTransactionExecutor(db,
+		func(tx *sql.Tx) AdmissionPolicyStore {
+			return db.WithTx(tx)
+		},
+	)
+
+	return NewUniverseAdmissionDB(dbTxer)
+}
+
+// TestUniverseAdmissionPolicy tests that we can store, fetch and replace the
+// admission policy of the universe.
+func TestUniverseAdmissionPolicy(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	admissionDB := newTestAdmissionDB(t)
+
+	// Before a policy is set, we get an empty policy back.
+	policy, err := admissionDB.FetchAdmissionPolicy(ctx)
+	require.NoError(t, err)
+	require.Equal(t, &universe.AdmissionPolicy{}, policy)
+
+	assetID := asset.RandID(t)
+	newPolicy := &universe.AdmissionPolicy{
+		Rules: []universe.AdmissionRule{{
+			Type:    universe.AdmissionRuleAllow,
+			AssetID: &assetID,
+		}, {
+			Type:     universe.AdmissionRuleDeny,
+			GroupKey: test.RandPubKey(t),
+		}},
+		MaxProofSize:        1_000_000,
+		MaxInsertsPerMinute: 10,
+	}
+	require.NoError(t, admissionDB.SetAdmissionPolicy(ctx, newPolicy))
+
+	policy, err = admissionDB.FetchAdmissionPolicy(ctx)
+	require.NoError(t, err)
+	require.Equal(t, newPolicy, policy)
+
+	// Setting a new policy replaces all rules and limits of the old one.
+	newPolicy = &universe.AdmissionPolicy{
+		Rules: []universe.AdmissionRule{{
+			Type:     universe.AdmissionRuleAllow,
+			GroupKey: test.RandPubKey(t),
+		}},
+	}
+	require.NoError(t, admissionDB.SetAdmissionPolicy(ctx, newPolicy))
+
+	policy, err = admissionDB.FetchAdmissionPolicy(ctx)
+	require.NoError(t, err)
+	require.Equal(t, newPolicy, policy)
+}
+
+// TestUniverseRejectedLeaves tests that the leaves rejected by the admission
+// policy are recorded per universe, and that they're forgotten once the policy
+// is replaced.
+func TestUniverseRejectedLeaves(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	admissionDB := newTestAdmissionDB(t)
+
+	id := randUniverseID(t, false)
+	otherID := randUniverseID(t, false)
+
+	leafKeys := make([]universe.LeafKey, 4)
+	for i := range leafKeys {
+		leafKeys[i] = randLeafKey(t)
+	}
+
+	// No leaves are rejected at first.
+	rejectedKeys, err := admissionDB.RejectedLeafKeys(ctx, id, leafKeys)
+	require.NoError(t, err)
+	require.Empty(t, rejectedKeys)
+
+	// We reject two of the leaves, one of them twice.
+	err = admissionDB.RejectLeaves(ctx, id, leafKeys[:2])
+	require.NoError(t, err)
+	err = admissionDB.RejectLeaves(ctx, id, leafKeys[1:2])
+	require.NoError(t, err)
+
+	rejectedKeys, err = admissionDB.RejectedLeafKeys(ctx, id, leafKeys)
+	require.NoError(t, err)
+	require.Equal(t, leafKeys[:2], rejectedKeys)
+
+	// The leaves are only rejected from the universe they were synced
+	// into.
+	rejectedKeys, err = admissionDB.RejectedLeafKeys(
+		ctx, otherID, leafKeys,
+	)
+	require.NoError(t, err)
+	require.Empty(t, rejectedKeys)
+
+	// Once the policy is replaced, the rejected leaves are forgotten.
+	err = admissionDB.SetAdmissionPolicy(ctx, &universe.AdmissionPolicy{})
+	require.NoError(t, err)
+
+	rejectedKeys, err = admissionDB.RejectedLeafKeys(ctx, id, leafKeys)
+	require.NoError(t, err)
+	require.Empty(t, rejectedKeys)
+}
@@ -0,0 +1,613 @@
+package tapdb
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/mssmt"
+	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/lightninglabs/taproot-assets/tapdb/sqlc"
+	"github.com/lightninglabs/taproot-assets/universe"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/keychain"
+)
+
+type (
+	// NewUniverseCommitment is used to insert a new universe commitment.
+	NewUniverseCommitment = sqlc.InsertUniverseCommitmentParams
+
+	// NewUniverseCommitmentLeaf is used to insert a multiverse leaf of a
+	// universe commitment.
+	NewUniverseCommitmentLeaf = sqlc.InsertUniverseCommitmentLeafParams
+
+	// UniverseCommitmentQuery is used to query universe commitments.
+	UniverseCommitmentQuery = sqlc.QueryUniverseCommitmentsParams
+
+	// UniverseCommitment is a universe commitment returned from a query.
+	UniverseCommitment = sqlc.QueryUniverseCommitmentsRow
+
+	// LeafCommitmentQuery is used to look up the latest commitment that
+	// includes a multiverse leaf.
+	LeafCommitmentQuery = sqlc.FetchLeafCommitmentIDParams
+
+	// UniverseCommitmentLeaf is a multiverse leaf of a universe
+	// commitment.
+	UniverseCommitmentLeaf = sqlc.FetchUniverseCommitmentLeavesRow
+
+	// NewPendingUniverseCommitment is used to insert a universe commitment
+	// whose transaction hasn't confirmed yet.
+	NewPendingUniverseCommitment = sqlc.InsertUniversePendingCommitmentParams
+
+	// PendingUniverseCommitment is a universe commitment whose transaction
+	// hasn't confirmed yet.
+	PendingUniverseCommitment = sqlc.FetchUniversePendingCommitmentsRow
+)
+
+// UniverseCommitStore is the database interface used to persist the chain
+// commitments of the multiverse root.
+type UniverseCommitStore interface {
+	// UpsertInternalKey inserts a new or updates an existing internal key
+	// into the database and returns the primary key.
+	UpsertInternalKey(ctx context.Context, arg InternalKey) (int64, error)
+
+	// InsertUniverseCommitment inserts a new universe commitment and
+	// returns its primary key.
+	InsertUniverseCommitment(ctx context.Context,
+		arg NewUniverseCommitment) (int64, error)
+
+	// InsertUniverseCommitmentLeaf inserts a multiverse leaf of a
+	// universe commitment.
+	InsertUniverseCommitmentLeaf(ctx context.Context,
+		arg NewUniverseCommitmentLeaf) error
+
+	// QueryUniverseCommitments returns the universe commitments that match
+	// the query, most recent first.
+	QueryUniverseCommitments(ctx context.Context,
+		arg UniverseCommitmentQuery) ([]UniverseCommitment, error)
+
+	// FetchLeafCommitmentID returns the ID of the most recent universe
+	// commitment that includes the given multiverse leaf.
+	FetchLeafCommitmentID(ctx context.Context,
+		arg LeafCommitmentQuery) (int64, error)
+
+	// FetchUniverseCommitmentLeaves returns the multiverse leaves of the
+	// universe commitment with the given ID.
+	FetchUniverseCommitmentLeaves(ctx context.Context,
+		commitmentID int64) ([]UniverseCommitmentLeaf, error)
+
+	// DeleteStaleUniverseCommitmentLeaves removes the multiverse leaves
+	// of all universe commitments except the one with the given ID.
+	DeleteStaleUniverseCommitmentLeaves(ctx context.Context,
+		commitmentID int64) error
+
+	// InsertUniversePendingCommitment inserts a universe commitment whose
+	// transaction hasn't confirmed yet.
+	InsertUniversePendingCommitment(ctx context.Context,
+		arg NewPendingUniverseCommitment) error
+
+	// FetchUniversePendingCommitments returns all universe commitments
+	// whose transactions haven't confirmed yet, oldest first.
+	FetchUniversePendingCommitments(
+		ctx context.Context) ([]PendingUniverseCommitment, error)
+
+	// DeleteUniversePendingCommitment removes the pending universe
+	// commitment of the transaction with the given ID.
+	DeleteUniversePendingCommitment(ctx context.Context,
+		anchorTxid []byte) error
+}
+
+// UniverseCommitmentTxOptions defines the set of db txn options the
+// UniverseCommitStore understands.
+type UniverseCommitmentTxOptions struct {
+	// readOnly governs if a read only transaction is needed or not.
+	readOnly bool
+}
+
+// ReadOnly returns true if the transaction should be read only.
+//
+// NOTE: This implements the TxOptions interface.
+func (u *UniverseCommitmentTxOptions) ReadOnly() bool {
+	return u.readOnly
+}
+
+// NewUniverseCommitmentReadTx creates a new read transaction option set.
+func NewUniverseCommitmentReadTx() UniverseCommitmentTxOptions {
+	return UniverseCommitmentTxOptions{
+		readOnly: true,
+	}
+}
+
+// BatchedUniverseCommitStore supports performing the universe commitment
+// queries in a single database transaction.
+type BatchedUniverseCommitStore interface {
+	UniverseCommitStore
+
+	BatchedTx[UniverseCommitStore]
+}
+
+// UniverseCommitmentDB is a persistent store for the chain commitments of the
+// multiverse root and the multiverse leaves that each commitment is made of.
+type UniverseCommitmentDB struct {
+	db BatchedUniverseCommitStore
+
+	clock clock.Clock
+}
+
+// NewUniverseCommitmentDB creates a new universe commitment store from the
+// given database.
+func NewUniverseCommitmentDB(db BatchedUniverseCommitStore,
+	clock clock.Clock) *UniverseCommitmentDB {
+
+	return &UniverseCommitmentDB{
+		db:    db,
+		clock: clock,
+	}
+}
+
+// InsertPendingCommitment stores a commitment whose transaction is about to
+// be published.
+//
+// NOTE: This is part of the universe.CommitmentStore interface.
+func (u *UniverseCommitmentDB) InsertPendingCommitment(ctx context.Context,
+	pending *universe.PendingCommitment) error {
+
+	var txBuf bytes.Buffer
+	if err := pending.Tx.Serialize(&txBuf); err != nil {
+		return fmt.Errorf("unable to encode commitment tx: %w", err)
+	}
+
+	var (
+		rootHash    = pending.UniverseRoot.NodeHash()
+		rootSum     = pending.UniverseRoot.NodeSum()
+		txHash      = pending.Tx.TxHash()
+		internalKey = pending.InternalKey
+	)
+
+	var writeTx UniverseCommitmentTxOptions
+	return u.db.ExecTx(ctx, &writeTx, func(q UniverseCommitStore) error {
+		internalKeyID, err := q.UpsertInternalKey(ctx, InternalKey{
+			RawKey:    internalKey.PubKey.SerializeCompressed(),
+			KeyFamily: int32(internalKey.Family),
+			KeyIndex:  int32(internalKey.Index),
+		})
+		if err != nil {
+			return fmt.Errorf("unable to insert internal key: %w",
+				err)
+		}
+
+		return q.InsertUniversePendingCommitment(
+			ctx, NewPendingUniverseCommitment{
+				RootHash:      rootHash[:],
+				RootSum:       int64(rootSum),
+				AnchorTxid:    txHash[:],
+				AnchorTx:      txBuf.Bytes(),
+				OutputIndex:   int32(pending.OutputIndex),
+				InternalKeyID: internalKeyID,
+				HeightHint:    int32(pending.HeightHint),
+				CreatedAt:     u.clock.Now().UTC(),
+			},
+		)
+	})
+}
+
+// FetchPendingCommitments returns the commitments whose transactions were
+// published, but haven't confirmed yet, oldest first.
+//
+// NOTE: This is part of the universe.CommitmentStore interface.
+func (u *UniverseCommitmentDB) FetchPendingCommitments(
+	ctx context.Context) ([]*universe.PendingCommitment, error) {
+
+	var pendings []*universe.PendingCommitment
+
+	readTx := NewUniverseCommitmentReadTx()
+	dbErr := u.db.ExecTx(ctx, &readTx, func(q UniverseCommitStore) error {
+		pendings = nil
+
+		dbPendings, err := q.FetchUniversePendingCommitments(ctx)
+		if err != nil {
+			return err
+		}
+
+		for _, dbPending := range dbPendings {
+			pending, err := parsePendingUniverseCommitment(
+				dbPending,
+			)
+			if err != nil {
+				return err
+			}
+
+			pendings = append(pendings, pending)
+		}
+
+		return nil
+	})
+	if dbErr != nil {
+		return nil, dbErr
+	}
+
+	return pendings, nil
+}
+
+// InsertCommitment stores a confirmed chain commitment along with the
+// multiverse leaves that its universe root commits to, and removes the pending
+// commitment of its transaction. If leaves are given, the leaves stored for
+// all earlier commitments are removed, as only the leaves of the latest
+// commitment are needed to create inclusion proofs for the current universe
+// roots.
+//
+// NOTE: This is part of the universe.CommitmentStore interface.
+func (u *UniverseCommitmentDB) InsertCommitment(ctx context.Context,
+	commitment *universe.Commitment,
+	leaves []universe.MultiverseLeaf) error {
+
+	var txBuf, headerBuf, proofBuf bytes.Buffer
+	if err := commitment.Tx.Serialize(&txBuf); err != nil {
+		return fmt.Errorf("unable to encode commitment tx: %w", err)
+	}
+	if err := commitment.BlockHeader.Serialize(&headerBuf); err != nil {
+		return fmt.Errorf("unable to encode block header: %w", err)
+	}
+	if err := commitment.MerkleProof.Encode(&proofBuf); err != nil {
+		return fmt.Errorf("unable to encode merkle proof: %w", err)
+	}
+
+	var (
+		rootHash    = commitment.UniverseRoot.NodeHash()
+		rootSum     = commitment.UniverseRoot.NodeSum()
+		txHash      = commitment.Tx.TxHash()
+		internalKey = commitment.InternalKey
+	)
+
+	var writeTx UniverseCommitmentTxOptions
+	return u.db.ExecTx(ctx, &writeTx, func(q UniverseCommitStore) error {
+		internalKeyID, err := q.UpsertInternalKey(ctx, InternalKey{
+			RawKey:    internalKey.PubKey.SerializeCompressed(),
+			KeyFamily: int32(internalKey.Family),
+			KeyIndex:  int32(internalKey.Index),
+		})
+		if err != nil {
+			return fmt.Errorf("unable to insert internal key: %w",
+				err)
+		}
+
+		commitmentID, err := q.InsertUniverseCommitment(
+			ctx, NewUniverseCommitment{
+				RootHash:      rootHash[:],
+				RootSum:       int64(rootSum),
+				AnchorTxid:    txHash[:],
+				AnchorTx:      txBuf.Bytes(),
+				OutputIndex:   int32(commitment.OutputIndex),
+				InternalKeyID: internalKeyID,
+				BlockHeight:   int32(commitment.BlockHeight),
+				BlockHeader:   headerBuf.Bytes(),
+				MerkleProof:   proofBuf.Bytes(),
+				CreatedAt:     u.clock.Now().UTC(),
+			},
+		)
+		if err != nil {
+			return fmt.Errorf("unable to insert commitment: %w",
+				err)
+		}
+
+		for _, leaf := range leaves {
+			leafNodeKey := leaf.ID.Bytes()
+			dbLeaf := NewUniverseCommitmentLeaf{
+				CommitmentID: commitmentID,
+				LeafNodeKey:  leafNodeKey[:],
+				LeafValue:    leaf.Value,
+				LeafSum:      int64(leaf.NodeSum()),
+			}
+
+			if leaf.ID.GroupKey != nil {
+				dbLeaf.GroupKey = schnorr.SerializePubKey(
+					leaf.ID.GroupKey,
+				)
+			} else {
+				dbLeaf.AssetID = leaf.ID.AssetID[:]
+			}
+
+			err := q.InsertUniverseCommitmentLeaf(ctx, dbLeaf)
+			if err != nil {
+				return fmt.Errorf("unable to insert "+
+					"commitment leaf: %w", err)
+			}
+		}
+
+		if len(leaves) > 0 {
+			err = q.DeleteStaleUniverseCommitmentLeaves(
+				ctx, commitmentID,
+			)
+			if err != nil {
+				return fmt.Errorf("unable to delete stale "+
+					"commitment leaves: %w", err)
+			}
+		}
+
+		return q.DeleteUniversePendingCommitment(ctx, txHash[:])
+	})
+}
+
+// LatestCommitment returns the most recent chain commitment. If there is none,
+// universe.ErrNoCommitment is returned.
+//
+// NOTE: This is part of the universe.CommitmentStore interface.
+func (u *UniverseCommitmentDB) LatestCommitment(
+	ctx context.Context) (*universe.Commitment, error) {
+
+	var commitment *universe.Commitment
+
+	readTx := NewUniverseCommitmentReadTx()
+	dbErr := u.db.ExecTx(ctx, &readTx, func(q UniverseCommitStore) error {
+		dbCommitments, err := q.QueryUniverseCommitments(
+			ctx, UniverseCommitmentQuery{
+				NumLimit: 1,
+			},
+		)
+		if err != nil {
+			return err
+		}
+		if len(dbCommitments) == 0 {
+			return universe.ErrNoCommitment
+		}
+
+		commitment, err = parseUniverseCommitment(dbCommitments[0])
+		return err
+	})
+	if dbErr != nil {
+		return nil, dbErr
+	}
+
+	return commitment, nil
+}
+
+// QueryCommitments returns all chain commitments, most recent first.
+//
+// NOTE: This is part of the universe.CommitmentStore interface.
+func (u *UniverseCommitmentDB) QueryCommitments(
+	ctx context.Context) ([]*universe.Commitment, error) {
+
+	var commitments []*universe.Commitment
+
+	readTx := NewUniverseCommitmentReadTx()
+	dbErr := u.db.ExecTx(ctx, &readTx, func(q UniverseCommitStore) error {
+		commitments = nil
+
+		// Neither SQLite nor Postgres accept an unbounded limit, so
+		// we use the maximum value that works for both.
+		dbCommitments, err := q.QueryUniverseCommitments(
+			ctx, UniverseCommitmentQuery{
+				NumLimit: math.MaxInt32,
+			},
+		)
+		if err != nil {
+			return err
+		}
+
+		for _, dbCommitment := range dbCommitments {
+			commitment, err := parseUniverseCommitment(dbCommitment)
+			if err != nil {
+				return err
+			}
+
+			commitments = append(commitments, commitment)
+		}
+
+		return nil
+	})
+	if dbErr != nil {
+		return nil, dbErr
+	}
+
+	return commitments, nil
+}
+
+// FetchLeafCommitment returns the most recent chain commitment whose universe
+// root includes the given multiverse leaf, along with all the multiverse
+// leaves of that commitment. If there is none, universe.ErrNoCommitment is
+// returned.
+//
+// NOTE: This is part of the universe.CommitmentStore interface.
+func (u *UniverseCommitmentDB) FetchLeafCommitment(ctx context.Context,
+	leaf universe.MultiverseLeaf) (*universe.Commitment,
+	[]universe.MultiverseLeaf, error) {
+
+	var (
+		commitment *universe.Commitment
+		leaves     []universe.MultiverseLeaf
+	)
+
+	leafNodeKey := leaf.ID.Bytes()
+
+	readTx := NewUniverseCommitmentReadTx()
+	dbErr := u.db.ExecTx(ctx, &readTx, func(q UniverseCommitStore) error {
+		leaves = nil
+
+		commitmentID, err := q.FetchLeafCommitmentID(
+			ctx, LeafCommitmentQuery{
+				LeafNodeKey: leafNodeKey[:],
+				LeafValue:   leaf.Value,
+				LeafSum:     int64(leaf.NodeSum()),
+			},
+		)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return universe.ErrNoCommitment
+
+		case err != nil:
+			return err
+		}
+
+		dbCommitments, err := q.QueryUniverseCommitments(
+			ctx, UniverseCommitmentQuery{
+				CommitmentID: sqlInt64(commitmentID),
+				NumLimit:     1,
+			},
+		)
+		if err != nil {
+			return err
+		}
+		if len(dbCommitments) == 0 {
+			return universe.ErrNoCommitment
+		}
+
+		commitment, err = parseUniverseCommitment(dbCommitments[0])
+		if err != nil {
+			return err
+		}
+
+		dbLeaves, err := q.FetchUniverseCommitmentLeaves(
+			ctx, commitmentID,
+		)
+		if err != nil {
+			return err
+		}
+
+		for _, dbLeaf := range dbLeaves {
+			id := universe.Identifier{
+				ProofType: leaf.ID.ProofType,
+			}
+			if len(dbLeaf.AssetID) > 0 {
+				copy(id.AssetID[:], dbLeaf.AssetID)
+			}
+			if len(dbLeaf.GroupKey) > 0 {
+				id.GroupKey, err = schnorr.ParsePubKey(
+					dbLeaf.GroupKey,
+				)
+				if err != nil {
+					return err
+				}
+			}
+
+			leaves = append(leaves, universe.MultiverseLeaf{
+				ID: id,
+				LeafNode: mssmt.NewLeafNode(
+					dbLeaf.LeafValue,
+					uint64(dbLeaf.LeafSum),
+				),
+			})
+		}
+
+		return nil
+	})
+	if dbErr != nil {
+		return nil, nil, dbErr
+	}
+
+	return commitment, leaves, nil
+}
+
+// parseUniverseCommitment maps a universe commitment from the database to a
+// universe.Commitment.
+func parseUniverseCommitment(
+	dbCommitment UniverseCommitment) (*universe.Commitment, error) {
+
+	tx := wire.NewMsgTx(2)
+	err := tx.Deserialize(bytes.NewReader(dbCommitment.AnchorTx))
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode commitment tx: %w",
+			err)
+	}
+
+	var header wire.BlockHeader
+	err = header.Deserialize(bytes.NewReader(dbCommitment.BlockHeader))
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode block header: %w", err)
+	}
+
+	var merkleProof proof.TxMerkleProof
+	err = merkleProof.Decode(bytes.NewReader(dbCommitment.MerkleProof))
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode merkle proof: %w", err)
+	}
+
+	internalKey, err := parseCommitmentInternalKey(
+		dbCommitment.InternalKey, dbCommitment.KeyFamily,
+		dbCommitment.KeyIndex,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &universe.Commitment{
+		BlockHeight: uint32(dbCommitment.BlockHeight),
+		BlockHeader: header,
+		MerkleProof: &merkleProof,
+		Tx:          tx,
+		OutputIndex: uint32(dbCommitment.OutputIndex),
+		InternalKey: internalKey,
+		UniverseRoot: parseCommitmentRoot(
+			dbCommitment.RootHash, dbCommitment.RootSum,
+		),
+	}, nil
+}
+
+// parsePendingUniverseCommitment maps a pending universe commitment from the
+// database to a universe.PendingCommitment.
+func parsePendingUniverseCommitment(
+	dbPending PendingUniverseCommitment) (*universe.PendingCommitment,
+	error) {
+
+	tx := wire.NewMsgTx(2)
+	err := tx.Deserialize(bytes.NewReader(dbPending.AnchorTx))
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode commitment tx: %w",
+			err)
+	}
+
+	internalKey, err := parseCommitmentInternalKey(
+		dbPending.InternalKey, dbPending.KeyFamily, dbPending.KeyIndex,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &universe.PendingCommitment{
+		Tx:          tx,
+		OutputIndex: uint32(dbPending.OutputIndex),
+		InternalKey: internalKey,
+		UniverseRoot: parseCommitmentRoot(
+			dbPending.RootHash, dbPending.RootSum,
+		),
+		HeightHint: uint32(dbPending.HeightHint),
+	}, nil
+}
+
+// parseCommitmentInternalKey parses the internal key of a commitment anchor
+// output.
+func parseCommitmentInternalKey(rawKey []byte, keyFamily,
+	keyIndex int32) (keychain.KeyDescriptor, error) {
+
+	internalKey, err := btcec.ParsePubKey(rawKey)
+	if err != nil {
+		return keychain.KeyDescriptor{}, fmt.Errorf("unable to parse "+
+			"internal key: %w", err)
+	}
+
+	return keychain.KeyDescriptor{
+		KeyLocator: keychain.KeyLocator{
+			Family: keychain.KeyFamily(keyFamily),
+			Index:  uint32(keyIndex),
+		},
+		PubKey: internalKey,
+	}, nil
+}
+
+// parseCommitmentRoot returns the committed universe root with the given hash
+// and sum.
+func parseCommitmentRoot(rootHashBytes []byte, rootSum int64) mssmt.Node {
+	var rootHash mssmt.NodeHash
+	copy(rootHash[:], rootHashBytes)
+
+	return mssmt.NewComputedBranch(rootHash, uint64(rootSum))
+}
+
+// A compile-time assertion to ensure that UniverseCommitmentDB meets the
+// universe.CommitmentStore interface.
+var _ universe.CommitmentStore = (*UniverseCommitmentDB)(nil)
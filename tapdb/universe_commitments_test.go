@@ -0,0 +1,379 @@
+package tapdb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/lightninglabs/taproot-assets/universe"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/stretchr/testify/require"
+)
+
+// mockChainCommitter is a chain committer that "confirms" each commitment
+// transaction in a new block that only contains a coinbase and the commitment
+// transaction.
+type mockChainCommitter struct {
+	t *testing.T
+
+	// numCommits is the number of commitments created so far.
+	numCommits int
+
+	// publishErr is returned when publishing a commitment, if set.
+	publishErr error
+}
+
+// CreateCommitment creates a commitment transaction for the given root that
+// spends the anchor output of the previous commitment, if there is one.
+func (m *mockChainCommitter) CreateCommitment(_ context.Context,
+	root universe.MultiverseRoot,
+	prev *universe.Commitment) (*universe.PendingCommitment, error) {
+
+	m.numCommits++
+
+	internalKey := test.PubToKeyDesc(test.RandPubKey(m.t))
+	pkScript, err := universe.NewCommitmentOutputScript(
+		internalKey.PubKey, root.Node,
+	)
+	require.NoError(m.t, err)
+
+	prevOut := test.RandOp(m.t)
+	if prev != nil {
+		prevOut = prev.OutPoint()
+	}
+
+	commitmentTx := wire.NewMsgTx(2)
+	commitmentTx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: prevOut,
+	})
+	commitmentTx.AddTxOut(&wire.TxOut{
+		Value:    1_000,
+		PkScript: test.RandBytes(34),
+	})
+	commitmentTx.AddTxOut(&wire.TxOut{
+		Value:    1_000,
+		PkScript: pkScript,
+	})
+
+	return &universe.PendingCommitment{
+		Tx:           commitmentTx,
+		OutputIndex:  1,
+		InternalKey:  internalKey,
+		UniverseRoot: root.Node,
+		HeightHint:   uint32(100 + m.numCommits),
+	}, nil
+}
+
+// PublishCommitment confirms the commitment transaction in a new block.
+func (m *mockChainCommitter) PublishCommitment(_ context.Context,
+	pending *universe.PendingCommitment) (*universe.Commitment, error) {
+
+	if m.publishErr != nil {
+		return nil, m.publishErr
+	}
+
+	coinbaseTx := wire.NewMsgTx(2)
+	coinbaseTx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: wire.OutPoint{Index: wire.MaxPrevOutIndex},
+		SignatureScript:  test.RandBytes(8),
+	})
+	coinbaseTx.AddTxOut(&wire.TxOut{Value: 50})
+
+	txs := []*wire.MsgTx{coinbaseTx, pending.Tx}
+	block := btcutil.NewBlock(&wire.MsgBlock{Transactions: txs})
+	merkleRoot := blockchain.CalcMerkleRoot(block.Transactions(), false)
+
+	merkleProof, err := proof.NewTxMerkleProof(txs, 1)
+	require.NoError(m.t, err)
+
+	return &universe.Commitment{
+		BlockHeight: pending.HeightHint + 1,
+		BlockHeader: wire.BlockHeader{
+			MerkleRoot: merkleRoot,
+		},
+		MerkleProof:  merkleProof,
+		Tx:           pending.Tx,
+		OutputIndex:  pending.OutputIndex,
+		InternalKey:  pending.InternalKey,
+		UniverseRoot: pending.UniverseRoot,
+	}, nil
+}
+
+// AbandonCommitment is a no-op, as the mock doesn't lock any wallet inputs.
+func (m *mockChainCommitter) AbandonCommitment(_ context.Context,
+	_ *universe.PendingCommitment) error {
+
+	return nil
+}
+
+// newTestCanonicalUniverse creates a canonical universe backed by a fresh test
+// database, along with the multiverse that it commits to.
+func newTestCanonicalUniverse(t *testing.T) (*universe.CanonicalUniverse,
+	*MultiverseStore, *UniverseCommitmentDB) {
+
+	db := NewTestDB(t)
+
+	multiverse, _ := newTestMultiverseWithDb(db.BaseDB)
+
+	commitDB := NewTransactionExecutor(
+		db, func(tx *sql.Tx) UniverseCommitStore {
+			return db.WithTx(tx)
+		},
+	)
+	commitStore := NewUniverseCommitmentDB(
+		commitDB, clock.NewDefaultClock(),
+	)
+
+	canonical := universe.NewCanonicalUniverse(universe.CanonicalConfig{
+		Multiverse:      multiverse,
+		CommitmentStore: commitStore,
+	})
+
+	return canonical, multiverse, commitStore
+}
+
+// TestUniverseCommitments tests that the multiverse root can be committed to
+// the chain, and that issuance proofs can be tied to the latest commitment
+// that includes their universe root.
+func TestUniverseCommitments(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	canonical, multiverse, commitStore := newTestCanonicalUniverse(t)
+	committer := &mockChainCommitter{t: t}
+
+	// insertLeaf inserts a new random issuance leaf into the given
+	// universe.
+	insertLeaf := func(id universe.Identifier) universe.LeafKey {
+		assetGen := asset.RandGenesis(t, asset.Normal)
+		leaf := randMintingLeaf(t, assetGen, id.GroupKey)
+		leafKey := randLeafKey(t)
+
+		_, err := multiverse.UpsertProofLeaf(
+			ctx, id, leafKey, &leaf, nil,
+		)
+		require.NoError(t, err)
+
+		return leafKey
+	}
+
+	// Without any commitment, there's nothing to return.
+	_, err := canonical.LatestCommitment(ctx)
+	require.ErrorIs(t, err, universe.ErrNoCommitment)
+
+	idA := randUniverseID(t, false)
+	idB := randUniverseID(t, true)
+	keyA := insertLeaf(idA)
+	keyB := insertLeaf(idB)
+
+	_, err = canonical.Query(ctx, idA, keyA)
+	require.ErrorIs(t, err, universe.ErrNoCommitment)
+
+	// We now commit to the multiverse root, which should match the root
+	// of the multiverse store.
+	firstCommitment, err := canonical.UpdateChainCommitment(
+		ctx, committer,
+	)
+	require.NoError(t, err)
+	require.NoError(t, firstCommitment.Verify())
+	require.Equal(t, 1, committer.numCommits)
+
+	multiverseRoot, err := multiverse.MultiverseRootNode(
+		ctx, universe.ProofTypeIssuance,
+	)
+	require.NoError(t, err)
+	require.Equal(
+		t, multiverseRoot.UnwrapToPtr().NodeHash(),
+		firstCommitment.UniverseRoot.NodeHash(),
+	)
+
+	// The stored commitment should still be valid after a round trip
+	// through the database.
+	latest, err := canonical.LatestCommitment(ctx)
+	require.NoError(t, err)
+	require.NoError(t, latest.Verify())
+	require.Equal(t, firstCommitment.Tx.TxHash(), latest.Tx.TxHash())
+	require.Equal(t, firstCommitment.InternalKey, latest.InternalKey)
+
+	// As long as the multiverse doesn't change, no new commitment is
+	// created.
+	_, err = canonical.UpdateChainCommitment(ctx, committer)
+	require.NoError(t, err)
+	require.Equal(t, 1, committer.numCommits)
+
+	// Both issuance proofs can be verified against the commitment.
+	for id, key := range map[universe.Identifier]universe.LeafKey{
+		idA: keyA,
+		idB: keyB,
+	} {
+		committedProof, err := canonical.Query(ctx, id, key)
+		require.NoError(t, err)
+		require.NoError(t, committedProof.Verify(id))
+		require.Equal(
+			t, firstCommitment.Tx.TxHash(),
+			committedProof.ChainProof.Tx.TxHash(),
+		)
+	}
+
+	// We now add a leaf to the first universe and create a new universe.
+	// Until we commit again, neither of them is tied to a commitment,
+	// while the unchanged universe can still be proven against the first
+	// commitment, even though the multiverse root has changed since.
+	keyA2 := insertLeaf(idA)
+	idC := randUniverseID(t, false)
+	keyC := insertLeaf(idC)
+
+	_, err = canonical.Query(ctx, idA, keyA2)
+	require.ErrorIs(t, err, universe.ErrNoCommitment)
+	_, err = canonical.Query(ctx, idC, keyC)
+	require.ErrorIs(t, err, universe.ErrNoCommitment)
+
+	committedProof, err := canonical.Query(ctx, idB, keyB)
+	require.NoError(t, err)
+	require.NoError(t, committedProof.Verify(idB))
+	require.Equal(
+		t, firstCommitment.Tx.TxHash(),
+		committedProof.ChainProof.Tx.TxHash(),
+	)
+
+	// After a second commitment, all leaves are tied to it. The second
+	// commitment spends the anchor output of the first one.
+	secondCommitment, err := canonical.UpdateChainCommitment(
+		ctx, committer,
+	)
+	require.NoError(t, err)
+	require.Equal(t, 2, committer.numCommits)
+	require.Equal(
+		t, firstCommitment.OutPoint(),
+		secondCommitment.Tx.TxIn[0].PreviousOutPoint,
+	)
+
+	for id, key := range map[universe.Identifier]universe.LeafKey{
+		idA: keyA2,
+		idB: keyB,
+		idC: keyC,
+	} {
+		committedProof, err := canonical.Query(ctx, id, key)
+		require.NoError(t, err)
+		require.NoError(t, committedProof.Verify(id))
+		require.Equal(
+			t, secondCommitment.Tx.TxHash(),
+			committedProof.ChainProof.Tx.TxHash(),
+		)
+	}
+
+	// A proof doesn't verify for a different universe.
+	committedProof, err = canonical.Query(ctx, idA, keyA2)
+	require.NoError(t, err)
+	require.Error(t, committedProof.Verify(idC))
+
+	// The commitment history is returned most recent first.
+	commitments, err := commitStore.QueryCommitments(ctx)
+	require.NoError(t, err)
+	require.Len(t, commitments, 2)
+	require.Equal(
+		t, secondCommitment.Tx.TxHash(), commitments[0].Tx.TxHash(),
+	)
+	require.Equal(
+		t, firstCommitment.Tx.TxHash(), commitments[1].Tx.TxHash(),
+	)
+}
+
+// TestUniverseCommitmentVerify tests that a commitment doesn't verify if the
+// anchor output doesn't commit to the universe root.
+func TestUniverseCommitmentVerify(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	canonical, multiverse, _ := newTestCanonicalUniverse(t)
+
+	id := randUniverseID(t, false)
+	leaf := randMintingLeaf(t, asset.RandGenesis(t, asset.Normal), nil)
+	_, err := multiverse.UpsertProofLeaf(
+		ctx, id, randLeafKey(t), &leaf, nil,
+	)
+	require.NoError(t, err)
+
+	commitment, err := canonical.UpdateChainCommitment(
+		ctx, &mockChainCommitter{t: t},
+	)
+	require.NoError(t, err)
+	require.NoError(t, commitment.Verify())
+
+	// A commitment to a different root doesn't verify.
+	otherCommitment := *commitment
+	otherCommitment.UniverseRoot = leaf.SmtLeafNode()
+	require.ErrorContains(
+		t, otherCommitment.Verify(), "does not commit to universe root",
+	)
+
+	// Neither does a commitment to a different output.
+	otherCommitment = *commitment
+	otherCommitment.OutputIndex = 0
+	require.Error(t, otherCommitment.Verify())
+
+	// Or a commitment in a block that doesn't contain the transaction.
+	otherCommitment = *commitment
+	otherCommitment.BlockHeader.MerkleRoot[0] ^= 1
+	require.ErrorContains(
+		t, otherCommitment.Verify(), "invalid commitment transaction",
+	)
+}
+
+// TestUniverseCommitmentResume tests that a commitment transaction is stored
+// before it is published, and that a commitment that didn't confirm before a
+// restart is resumed instead of creating a new one.
+func TestUniverseCommitmentResume(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	canonical, multiverse, commitStore := newTestCanonicalUniverse(t)
+
+	id := randUniverseID(t, false)
+	leaf := randMintingLeaf(
+		t, asset.RandGenesis(t, asset.Normal), id.GroupKey,
+	)
+	leafKey := randLeafKey(t)
+	_, err := multiverse.UpsertProofLeaf(ctx, id, leafKey, &leaf, nil)
+	require.NoError(t, err)
+
+	// Publishing the commitment fails, but the transaction is stored.
+	committer := &mockChainCommitter{
+		t:          t,
+		publishErr: errors.New("publish failed"),
+	}
+	_, err = canonical.UpdateChainCommitment(ctx, committer)
+	require.ErrorContains(t, err, "publish failed")
+	require.Equal(t, 1, committer.numCommits)
+
+	pendings, err := commitStore.FetchPendingCommitments(ctx)
+	require.NoError(t, err)
+	require.Len(t, pendings, 1)
+
+	_, err = canonical.LatestCommitment(ctx)
+	require.ErrorIs(t, err, universe.ErrNoCommitment)
+
+	// Once publishing works again, the stored transaction is published
+	// instead of creating a new commitment, as the root hasn't changed.
+	committer.publishErr = nil
+	commitment, err := canonical.UpdateChainCommitment(ctx, committer)
+	require.NoError(t, err)
+	require.Equal(t, 1, committer.numCommits)
+	require.Equal(t, pendings[0].Tx.TxHash(), commitment.Tx.TxHash())
+	require.NoError(t, commitment.Verify())
+
+	pendings, err = commitStore.FetchPendingCommitments(ctx)
+	require.NoError(t, err)
+	require.Empty(t, pendings)
+
+	committedProof, err := canonical.Query(ctx, id, leafKey)
+	require.NoError(t, err)
+	require.NoError(t, committedProof.Verify(id))
+}
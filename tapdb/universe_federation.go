@@ -15,6 +15,7 @@ import (
 	"github.com/btcsuite/btcd/wire"
 	"github.com/lightninglabs/taproot-assets/asset"
 	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/mssmt"
 	"github.com/lightninglabs/taproot-assets/proof"
 	"github.com/lightninglabs/taproot-assets/tapdb/sqlc"
 	"github.com/lightninglabs/taproot-assets/universe"
@@ -62,6 +63,23 @@ type (
 
 	// QueryUniServersParams is used to query for universe servers.
 	QueryUniServersParams = sqlc.QueryUniverseServersParams
+
+	// NewUniverseDivergence is used to insert a new universe divergence.
+	NewUniverseDivergence = sqlc.InsertUniverseDivergenceParams
+
+	// NewUniverseDivergenceLeaf is used to insert the key of a leaf that
+	// caused a universe divergence.
+	NewUniverseDivergenceLeaf = sqlc.InsertUniverseDivergenceLeafParams
+
+	// QueryUniDivergencesParams is used to query for universe divergences.
+	QueryUniDivergencesParams = sqlc.QueryUniverseDivergencesParams
+
+	// UniverseDivergence is a universe divergence returned from a query.
+	UniverseDivergence = sqlc.UniverseDivergence
+
+	// UniverseDivergenceLeaf is the key of a leaf that caused a universe
+	// divergence.
+	UniverseDivergenceLeaf = sqlc.FetchUniverseDivergenceLeavesRow
 )
 
 var (
@@ -125,11 +143,36 @@ type FederationSyncConfigStore interface {
 		error)
 }
 
+// FederationDivergenceStore is used to record the divergences that were
+// detected after syncing with a universe server.
+type FederationDivergenceStore interface {
+	// InsertUniverseDivergence inserts a new universe divergence and
+	// returns its primary key.
+	InsertUniverseDivergence(ctx context.Context,
+		arg NewUniverseDivergence) (int64, error)
+
+	// InsertUniverseDivergenceLeaf inserts the key of a leaf that caused
+	// a universe divergence.
+	InsertUniverseDivergenceLeaf(ctx context.Context,
+		arg NewUniverseDivergenceLeaf) error
+
+	// QueryUniverseDivergences returns a page of universe divergences,
+	// starting with the most recent one.
+	QueryUniverseDivergences(ctx context.Context,
+		arg QueryUniDivergencesParams) ([]UniverseDivergence, error)
+
+	// FetchUniverseDivergenceLeaves returns the keys of the leaves that
+	// caused the given universe divergence.
+	FetchUniverseDivergenceLeaves(ctx context.Context,
+		divergenceID int64) ([]UniverseDivergenceLeaf, error)
+}
+
 // UniverseServerStore is used to manage the set of Universe servers as part
 // of a federation.
 type UniverseServerStore interface {
 	FederationSyncConfigStore
 	FederationProofSyncLogStore
+	FederationDivergenceStore
 
 	// InsertUniverseServer inserts a new universe server in to the DB.
 	InsertUniverseServer(ctx context.Context, arg NewUniverseServer) error
@@ -429,6 +472,29 @@ func (u *UniverseFederationDB) FetchPendingProofsSyncLog(ctx context.Context,
 	syncDirection *universe.SyncDirection) ([]*universe.ProofSyncLogEntry,
 	error) {
 
+	return u.fetchProofsSyncLogByStatus(
+		ctx, syncDirection, universe.ProofSyncStatusPending,
+	)
+}
+
+// FetchFailedProofsSyncLog queries the federation proof sync log and returns
+// all log entries with sync status failed.
+func (u *UniverseFederationDB) FetchFailedProofsSyncLog(ctx context.Context,
+	syncDirection *universe.SyncDirection) ([]*universe.ProofSyncLogEntry,
+	error) {
+
+	return u.fetchProofsSyncLogByStatus(
+		ctx, syncDirection, universe.ProofSyncStatusFailed,
+	)
+}
+
+// fetchProofsSyncLogByStatus queries the federation proof sync log and returns
+// all log entries with the given sync status.
+func (u *UniverseFederationDB) fetchProofsSyncLogByStatus(
+	ctx context.Context, syncDirection *universe.SyncDirection,
+	syncStatus universe.ProofSyncStatus) ([]*universe.ProofSyncLogEntry,
+	error) {
+
 	var (
 		readTx        = NewUniverseFederationReadTx()
 		proofSyncLogs []*universe.ProofSyncLogEntry
@@ -436,15 +502,13 @@ func (u *UniverseFederationDB) FetchPendingProofsSyncLog(ctx context.Context,
 
 	err := u.db.ExecTx(ctx, &readTx, func(db UniverseServerStore) error {
 		// If the sync direction is not set, then we'll query for all
-		// pending proof sync log entries.
+		// proof sync log entries with the given status.
 		var sqlSyncDirection sql.NullString
 		if syncDirection != nil {
 			sqlSyncDirection = sqlStr(string(*syncDirection))
 		}
 
-		sqlProofSyncStatus := sqlStr(
-			string(universe.ProofSyncStatusPending),
-		)
+		sqlProofSyncStatus := sqlStr(string(syncStatus))
 
 		params := QueryFedProofSyncLogParams{
 			SyncDirection: sqlSyncDirection,
@@ -603,6 +667,180 @@ func (u *UniverseFederationDB) DeleteProofsSyncLogEntries(ctx context.Context,
 	return nil
 }
 
+// LogDivergence records a new universe divergence along with the keys of the
+// leaves that caused it.
+func (u *UniverseFederationDB) LogDivergence(ctx context.Context,
+	divergence *universe.Divergence) error {
+
+	var (
+		uniID        = divergence.ID
+		groupPubKey  []byte
+		assetIDBytes []byte
+	)
+	if uniID.GroupKey != nil {
+		groupPubKey = uniID.GroupKey.SerializeCompressed()
+	} else {
+		assetIDBytes = uniID.AssetID[:]
+	}
+
+	var (
+		localHash  = divergence.LocalRoot.NodeHash()
+		localSum   = int64(divergence.LocalRoot.NodeSum())
+		remoteHash = divergence.RemoteRoot.NodeHash()
+		remoteSum  = int64(divergence.RemoteRoot.NodeSum())
+	)
+
+	var writeTx UniverseFederationOptions
+	return u.db.ExecTx(ctx, &writeTx, func(db UniverseServerStore) error {
+		divergenceID, err := db.InsertUniverseDivergence(
+			ctx, NewUniverseDivergence{
+				ServerHost:     divergence.ServerHost,
+				AssetID:        assetIDBytes,
+				GroupKey:       groupPubKey,
+				ProofType:      uniID.ProofType.String(),
+				LocalRootHash:  localHash[:],
+				LocalRootSum:   localSum,
+				RemoteRootHash: remoteHash[:],
+				RemoteRootSum:  remoteSum,
+				DetectedAt:     divergence.DetectedAt.UTC(),
+			},
+		)
+		if err != nil {
+			return fmt.Errorf("unable to insert divergence: %w",
+				err)
+		}
+
+		for _, leafKey := range divergence.ConflictingKeys {
+			mintingPoint, err := encodeOutpoint(leafKey.OutPoint)
+			if err != nil {
+				return err
+			}
+
+			err = db.InsertUniverseDivergenceLeaf(
+				ctx, NewUniverseDivergenceLeaf{
+					DivergenceID: divergenceID,
+					MintingPoint: mintingPoint,
+					ScriptKeyBytes: schnorr.SerializePubKey(
+						leafKey.ScriptKey.PubKey,
+					),
+				},
+			)
+			if err != nil {
+				return fmt.Errorf("unable to insert "+
+					"divergence leaf: %w", err)
+			}
+		}
+
+		return nil
+	})
+}
+
+// QueryDivergences returns the recorded universe divergences that match the
+// given query, starting with the most recent one.
+func (u *UniverseFederationDB) QueryDivergences(ctx context.Context,
+	q universe.DivergenceQuery) ([]*universe.Divergence, error) {
+
+	var (
+		readTx      = NewUniverseFederationReadTx()
+		divergences []*universe.Divergence
+	)
+	err := u.db.ExecTx(ctx, &readTx, func(db UniverseServerStore) error {
+		dbDivergences, err := db.QueryUniverseDivergences(
+			ctx, QueryUniDivergencesParams{
+				ServerHost: sqlStr(q.ServerHost),
+				NumOffset:  q.Offset,
+				NumLimit:   q.Limit,
+			},
+		)
+		if err != nil {
+			return fmt.Errorf("unable to query divergences: %w",
+				err)
+		}
+
+		divergences = make(
+			[]*universe.Divergence, 0, len(dbDivergences),
+		)
+		for _, dbDivergence := range dbDivergences {
+			divergence, err := fetchDivergence(
+				ctx, dbDivergence, db,
+			)
+			if err != nil {
+				return err
+			}
+
+			divergences = append(divergences, divergence)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return divergences, nil
+}
+
+// fetchDivergence returns a universe divergence given a DB row, along with
+// the keys of the leaves that caused it.
+func fetchDivergence(ctx context.Context, dbDivergence UniverseDivergence,
+	dbTx UniverseServerStore) (*universe.Divergence, error) {
+
+	uniID, err := universe.NewUniIDFromRawArgs(
+		dbDivergence.AssetID, dbDivergence.GroupKey,
+		dbDivergence.ProofType,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	dbLeaves, err := dbTx.FetchUniverseDivergenceLeaves(
+		ctx, dbDivergence.ID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch divergence leaves: %w",
+			err)
+	}
+
+	leafKeys := make([]universe.LeafKey, 0, len(dbLeaves))
+	for _, dbLeaf := range dbLeaves {
+		scriptKeyPub, err := schnorr.ParsePubKey(dbLeaf.ScriptKeyBytes)
+		if err != nil {
+			return nil, err
+		}
+		scriptKey := asset.NewScriptKey(scriptKeyPub)
+
+		var outPoint wire.OutPoint
+		err = readOutPoint(
+			bytes.NewReader(dbLeaf.MintingPoint), 0, 0, &outPoint,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		leafKeys = append(leafKeys, universe.LeafKey{
+			OutPoint:  outPoint,
+			ScriptKey: &scriptKey,
+		})
+	}
+
+	var localHash, remoteHash mssmt.NodeHash
+	copy(localHash[:], dbDivergence.LocalRootHash)
+	copy(remoteHash[:], dbDivergence.RemoteRootHash)
+
+	return &universe.Divergence{
+		ID:         uniID,
+		ServerHost: dbDivergence.ServerHost,
+		LocalRoot: mssmt.NewComputedNode(
+			localHash, uint64(dbDivergence.LocalRootSum),
+		),
+		RemoteRoot: mssmt.NewComputedNode(
+			remoteHash, uint64(dbDivergence.RemoteRootSum),
+		),
+		ConflictingKeys: leafKeys,
+		DetectedAt:      dbDivergence.DetectedAt.UTC(),
+	}, nil
+}
+
 // UpsertFederationSyncConfig upserts both the global and universe specific
 // federation sync configs.
 func (u *UniverseFederationDB) UpsertFederationSyncConfig(
@@ -830,4 +1068,5 @@ func (u *UniverseFederationDB) QueryFederationSyncConfigs(
 var (
 	_ universe.FederationLog          = (*UniverseFederationDB)(nil)
 	_ universe.FederationSyncConfigDB = (*UniverseFederationDB)(nil)
+	_ universe.FederationDB           = (*UniverseFederationDB)(nil)
 )
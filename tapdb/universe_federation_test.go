@@ -6,8 +6,10 @@ import (
 	"testing"
 	"time"
 
+	"github.com/lightninglabs/taproot-assets/asset"
 	"github.com/lightninglabs/taproot-assets/fn"
 	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/mssmt"
 	"github.com/lightninglabs/taproot-assets/tapdb/sqlc"
 	"github.com/lightninglabs/taproot-assets/universe"
 	"github.com/lightningnetwork/lnd/clock"
@@ -322,6 +324,32 @@ func TestFederationProofSyncLogCRUD(t *testing.T) {
 	// Check that the remaining log entry is as expected.
 	pendingEntry := pendingLogEntries[0]
 	require.Equal(t, servers[2], pendingEntry.ServerAddr)
+
+	// Once a push is given up, it's marked as failed and no longer shows
+	// up as pending.
+	_, err = fedStore.UpsertFederationProofSyncLog(
+		ctx, uniId, uniProof.LeafKey, servers[2],
+		universe.SyncDirectionPush, universe.ProofSyncStatusFailed,
+		false,
+	)
+	require.NoError(t, err)
+
+	pendingLogEntries, err = fedStore.FetchPendingProofsSyncLog(
+		ctx, &syncDirectionPush,
+	)
+	require.NoError(t, err)
+	require.Empty(t, pendingLogEntries)
+
+	failedLogEntries, err := fedStore.FetchFailedProofsSyncLog(
+		ctx, &syncDirectionPush,
+	)
+	require.NoError(t, err)
+	require.Len(t, failedLogEntries, 1)
+	require.Equal(t, servers[2], failedLogEntries[0].ServerAddr)
+	require.Equal(
+		t, universe.ProofSyncStatusFailed,
+		failedLogEntries[0].SyncStatus,
+	)
 }
 
 // assertProofSyncLogLeafKey asserts that a leaf key derived from a proof sync
@@ -513,3 +541,127 @@ func TestFederationConfigCRUD(t *testing.T) {
 	localCfg = fn.MakeSlice(groupNewCfg, assetCfg)
 	require.Equal(t, localCfg, dbLocalCfg)
 }
+
+// TestFederationDivergenceLog tests that we're able to record universe
+// divergences and query them by server.
+func TestFederationDivergenceLog(t *testing.T) {
+	t.Parallel()
+
+	testClock := clock.NewTestClock(time.Now())
+	fedDB, _ := newTestFederationDb(t, testClock)
+
+	ctx := context.Background()
+
+	randLeafKey := func() universe.LeafKey {
+		scriptKey := asset.NewScriptKey(test.RandPubKey(t))
+		return universe.LeafKey{
+			OutPoint:  test.RandOp(t),
+			ScriptKey: &scriptKey,
+		}
+	}
+	randNode := func() mssmt.Node {
+		return mssmt.NewComputedNode(
+			mssmt.NodeHash(test.RandHash()),
+			uint64(test.RandInt[uint32]()),
+		)
+	}
+
+	// We'll record a divergence for an asset ID and one for a group key
+	// universe, each for a different server. A divergence without any
+	// known conflicting keys is valid as well.
+	detectedAt := time.Unix(time.Now().Unix(), 0).UTC()
+	assetDivergence := &universe.Divergence{
+		ID: universe.Identifier{
+			AssetID:   asset.RandID(t),
+			ProofType: universe.ProofTypeIssuance,
+		},
+		ServerHost: "server1.example.com:10029",
+		LocalRoot:  randNode(),
+		RemoteRoot: randNode(),
+		ConflictingKeys: []universe.LeafKey{
+			randLeafKey(), randLeafKey(),
+		},
+		DetectedAt: detectedAt,
+	}
+	groupDivergence := &universe.Divergence{
+		ID: universe.Identifier{
+			GroupKey:  test.RandPubKey(t),
+			ProofType: universe.ProofTypeTransfer,
+		},
+		ServerHost: "server2.example.com:10029",
+		LocalRoot:  randNode(),
+		RemoteRoot: randNode(),
+		DetectedAt: detectedAt.Add(time.Minute),
+	}
+
+	require.NoError(t, fedDB.LogDivergence(ctx, assetDivergence))
+	require.NoError(t, fedDB.LogDivergence(ctx, groupDivergence))
+
+	assertDivergence := func(expected, actual *universe.Divergence) {
+		require.Equal(t, expected.ID.String(), actual.ID.String())
+		require.Equal(t, expected.ServerHost, actual.ServerHost)
+		require.True(t, mssmt.IsEqualNode(
+			expected.LocalRoot, actual.LocalRoot,
+		))
+		require.True(t, mssmt.IsEqualNode(
+			expected.RemoteRoot, actual.RemoteRoot,
+		))
+		require.True(t, expected.DetectedAt.Equal(actual.DetectedAt))
+
+		require.Len(
+			t, actual.ConflictingKeys,
+			len(expected.ConflictingKeys),
+		)
+		for i, key := range expected.ConflictingKeys {
+			require.Equal(
+				t, key.UniverseKey(),
+				actual.ConflictingKeys[i].UniverseKey(),
+			)
+		}
+	}
+
+	// Without a server filter, both divergences should be returned, most
+	// recent first.
+	divergences, err := fedDB.QueryDivergences(
+		ctx, universe.DivergenceQuery{
+			Limit: universe.MaxPageSize,
+		},
+	)
+	require.NoError(t, err)
+	require.Len(t, divergences, 2)
+	assertDivergence(groupDivergence, divergences[0])
+	assertDivergence(assetDivergence, divergences[1])
+
+	// The offset should be applied as well.
+	divergences, err = fedDB.QueryDivergences(
+		ctx, universe.DivergenceQuery{
+			Offset: 1,
+			Limit:  universe.MaxPageSize,
+		},
+	)
+	require.NoError(t, err)
+	require.Len(t, divergences, 1)
+	assertDivergence(assetDivergence, divergences[0])
+
+	// Filtering by server should only return the divergence of that
+	// server.
+	divergences, err = fedDB.QueryDivergences(
+		ctx, universe.DivergenceQuery{
+			ServerHost: assetDivergence.ServerHost,
+			Limit:      universe.MaxPageSize,
+		},
+	)
+	require.NoError(t, err)
+	require.Len(t, divergences, 1)
+	assertDivergence(assetDivergence, divergences[0])
+
+	// An unknown server shouldn't have any divergences.
+	divergences, err = fedDB.QueryDivergences(
+		ctx, universe.DivergenceQuery{
+			ServerHost: "unknown.example.com:10029",
+			Limit:      universe.MaxPageSize,
+		},
+	)
+	require.NoError(t, err)
+	require.Empty(t, divergences)
+}
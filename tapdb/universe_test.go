@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/lightninglabs/taproot-assets/asset"
 	"github.com/lightninglabs/taproot-assets/fn"
@@ -1073,3 +1074,561 @@ func TestMultiverseRootSum(t *testing.T) {
 		})
 	}
 }
+
+// TestUniverseTreeNodes tests that the nodes of a universe tree can be queried
+// by their path, and that single leaf subtrees are returned with the universe
+// key of their leaf.
+func TestUniverseTreeNodes(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	multiverse, _ := newTestMultiverse(t)
+
+	id := randUniverseID(t, false)
+	assetGen := asset.RandGenesis(t, asset.Normal)
+
+	const numLeaves = 10
+	leafKeys := make([]universe.LeafKey, numLeaves)
+	leafNodes := make([]mssmt.Node, numLeaves)
+	for i := 0; i < numLeaves; i++ {
+		leafKeys[i] = randLeafKey(t)
+		leaf := randMintingLeaf(t, assetGen, id.GroupKey)
+		leafNodes[i] = leaf.SmtLeafNode()
+
+		_, err := multiverse.UpsertProofLeaf(
+			ctx, id, leafKeys[i], &leaf, nil,
+		)
+		require.NoError(t, err)
+	}
+
+	// The node at depth zero is the root of the universe, and its children
+	// add up to it.
+	root, err := multiverse.UniverseRootNode(ctx, id)
+	require.NoError(t, err)
+
+	rootPath := universe.TreeNodePath{}
+	leftPath, rightPath := rootPath.Children()
+	nodes, err := multiverse.UniverseTreeNodes(
+		ctx, id, []universe.TreeNodePath{rootPath, leftPath, rightPath},
+	)
+	require.NoError(t, err)
+	require.Len(t, nodes, 3)
+	require.True(t, mssmt.IsEqualNode(root.Node, nodes[0].Node))
+	require.True(t, mssmt.IsEqualNode(
+		root.Node, mssmt.NewBranch(nodes[1].Node, nodes[2].Node),
+	))
+	require.Nil(t, nodes[0].LeafKey)
+
+	// At the leaf level, each node is the leaf itself, which also carries
+	// its universe key. A path without a leaf is empty.
+	emptyPath := universe.TreeNodePath{
+		Depth:  mssmt.MaxTreeLevels,
+		Prefix: test.RandHash(),
+	}
+	paths := []universe.TreeNodePath{emptyPath}
+	for _, leafKey := range leafKeys {
+		paths = append(paths, universe.TreeNodePath{
+			Depth:  mssmt.MaxTreeLevels,
+			Prefix: leafKey.UniverseKey(),
+		})
+	}
+
+	nodes, err = multiverse.UniverseTreeNodes(ctx, id, paths)
+	require.NoError(t, err)
+	require.Len(t, nodes, len(paths))
+
+	require.True(t, mssmt.IsEqualNode(
+		mssmt.EmptyTree[mssmt.MaxTreeLevels], nodes[0].Node,
+	))
+	require.Nil(t, nodes[0].LeafKey)
+
+	for i, node := range nodes[1:] {
+		require.Equal(t, paths[i+1], node.Path)
+		require.True(t, mssmt.IsEqualNode(leafNodes[i], node.Node))
+
+		require.NotNil(t, node.LeafKey)
+		require.Equal(t, leafKeys[i].OutPoint, node.LeafKey.OutPoint)
+		require.Equal(
+			t, asset.ToSerialized(leafKeys[i].ScriptKey.PubKey),
+			asset.ToSerialized(node.LeafKey.ScriptKey.PubKey),
+		)
+	}
+}
+
+// TestMultiverseProofLeaves tests that a multi-proof can be fetched for a set
+// of leaves, including keys that aren't in the universe.
+func TestMultiverseProofLeaves(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	multiverse, _ := newTestMultiverse(t)
+
+	id := randUniverseID(t, true)
+	assetGen := asset.RandGenesis(t, asset.Normal)
+
+	const numLeaves = 10
+	leafKeys := make([]universe.LeafKey, numLeaves)
+	leaves := make([]universe.Leaf, numLeaves)
+	for i := 0; i < numLeaves; i++ {
+		leafKeys[i] = randLeafKey(t)
+		leaves[i] = randMintingLeaf(t, assetGen, id.GroupKey)
+
+		_, err := multiverse.UpsertProofLeaf(
+			ctx, id, leafKeys[i], &leaves[i], nil,
+		)
+		require.NoError(t, err)
+	}
+
+	root, err := multiverse.UniverseRootNode(ctx, id)
+	require.NoError(t, err)
+
+	// We query for half of the leaves, and a key that isn't in the
+	// universe.
+	missingKey := randLeafKey(t)
+	queryKeys := append([]universe.LeafKey{missingKey}, leafKeys[:5]...)
+
+	multiProof, err := multiverse.FetchProofLeaves(ctx, id, queryKeys)
+	require.NoError(t, err)
+	require.True(t, mssmt.IsEqualNode(root.Node, multiProof.UniverseRoot))
+	require.True(t, multiProof.VerifyRoot(root.Node))
+	require.NotNil(t, multiProof.MultiverseRoot)
+	require.NotNil(t, multiProof.MultiverseInclusionProof)
+
+	require.Len(t, multiProof.Leaves, len(queryKeys))
+	require.Nil(t, multiProof.Leaves[0])
+	for i, leaf := range multiProof.Leaves[1:] {
+		require.NotNil(t, leaf)
+		require.Equal(t, leaves[i].RawProof, leaf.RawProof)
+		require.Equal(t, leaves[i].Amt, leaf.Amt)
+	}
+
+	// Claiming that the missing key has a leaf invalidates the proof.
+	multiProof.Leaves[0] = &leaves[5]
+	require.False(t, multiProof.VerifyRoot(root.Node))
+
+	// Keys must be fully specified.
+	_, err = multiverse.FetchProofLeaves(
+		ctx, id, []universe.LeafKey{{
+			OutPoint: leafKeys[0].OutPoint,
+		}},
+	)
+	require.ErrorContains(t, err, "missing script key")
+}
+
+// TestUniverseLeafEvents tests that the leaves inserted into the multiverse
+// can be queried in insertion order, and that subscribers are notified about
+// them.
+func TestUniverseLeafEvents(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	multiverse, _ := newTestMultiverse(t)
+
+	latest, err := multiverse.LatestLeafCursor(ctx)
+	require.NoError(t, err)
+	require.Zero(t, latest)
+
+	receiver := fn.NewEventReceiver[*universe.LeafEvent](
+		fn.DefaultQueueSize,
+	)
+	multiverse.RegisterLeafSubscriber(receiver)
+	defer func() {
+		require.NoError(t, multiverse.RemoveLeafSubscriber(receiver))
+	}()
+
+	// We'll insert a few leaves into a universe one by one, and a batch of
+	// leaves into a universe of an asset group.
+	const numAssetLeaves, numGroupLeaves = 3, 2
+
+	assetID := randUniverseID(t, false)
+	assetID.GroupKey = nil
+	assetGen := asset.RandGenesis(t, asset.Normal)
+
+	var inserted []leafWithKey
+	for i := 0; i < numAssetLeaves; i++ {
+		leaf := leafWithKey{
+			LeafKey: randLeafKey(t),
+			Leaf:    randMintingLeaf(t, assetGen, nil),
+		}
+		_, err := multiverse.UpsertProofLeaf(
+			ctx, assetID, leaf.LeafKey, &leaf.Leaf, nil,
+		)
+		require.NoError(t, err)
+
+		inserted = append(inserted, leaf)
+	}
+
+	groupID := randUniverseID(t, true)
+	groupGen := asset.RandGenesis(t, asset.Normal)
+	items := make([]*universe.Item, numGroupLeaves)
+	for i := range items {
+		leaf := leafWithKey{
+			LeafKey: randLeafKey(t),
+			Leaf:    randMintingLeaf(t, groupGen, groupID.GroupKey),
+		}
+		items[i] = &universe.Item{
+			ID:   groupID,
+			Key:  leaf.LeafKey,
+			Leaf: &leaf.Leaf,
+		}
+
+		inserted = append(inserted, leaf)
+	}
+	require.NoError(t, multiverse.UpsertProofLeafBatch(ctx, items))
+
+	// All leaves should be returned in insertion order, with strictly
+	// increasing cursors.
+	events, err := multiverse.FetchLeafEvents(ctx, universe.LeafEventQuery{})
+	require.NoError(t, err)
+	require.Len(t, events, len(inserted))
+	for i, event := range events {
+		leaf := inserted[i]
+		require.Equal(t, leaf.LeafKey.OutPoint, event.Key.OutPoint)
+		require.Equal(t, inserted[i].Leaf.RawProof, event.Leaf.RawProof)
+		require.Equal(t, inserted[i].Leaf.Amt, event.Leaf.Amt)
+		require.Equal(t, inserted[i].Leaf.ID(), event.Leaf.ID())
+
+		if i > 0 {
+			require.Greater(t, event.Cursor, events[i-1].Cursor)
+		}
+	}
+	for _, event := range events[numAssetLeaves:] {
+		require.Equal(
+			t, schnorr.SerializePubKey(groupID.GroupKey),
+			schnorr.SerializePubKey(event.ID.GroupKey),
+		)
+	}
+
+	latest, err = multiverse.LatestLeafCursor(ctx)
+	require.NoError(t, err)
+	require.Equal(t, events[len(events)-1].Cursor, latest)
+
+	// The subscriber should have been notified about the same leaves.
+	for _, event := range events {
+		select {
+		case ntfn := <-receiver.NewItemCreated.ChanOut():
+			require.Equal(t, event.Cursor, ntfn.Cursor)
+			require.Equal(t, event.Key.OutPoint, ntfn.Key.OutPoint)
+
+		case <-time.After(5 * time.Second):
+			t.Fatalf("no leaf event received")
+		}
+	}
+
+	// Finally, we'll make sure the filters and the cursor are applied.
+	assetGenID := assetGen.ID()
+	groupGenID := groupGen.ID()
+	testCases := []struct {
+		name     string
+		query    universe.LeafEventQuery
+		expected []*universe.LeafEvent
+	}{{
+		name: "asset ID",
+		query: universe.LeafEventQuery{
+			AssetID: &assetGenID,
+		},
+		expected: events[:numAssetLeaves],
+	}, {
+		name: "grouped asset ID",
+		query: universe.LeafEventQuery{
+			AssetID: &groupGenID,
+		},
+		expected: events[numAssetLeaves:],
+	}, {
+		name: "group key",
+		query: universe.LeafEventQuery{
+			GroupKey: groupID.GroupKey,
+		},
+		expected: events[numAssetLeaves:],
+	}, {
+		name: "proof type",
+		query: universe.LeafEventQuery{
+			ProofType: universe.ProofTypeTransfer,
+		},
+	}, {
+		name: "cursor",
+		query: universe.LeafEventQuery{
+			AfterCursor: events[1].Cursor,
+		},
+		expected: events[2:],
+	}, {
+		name: "cursor and limit",
+		query: universe.LeafEventQuery{
+			AfterCursor: events[1].Cursor,
+			Limit:       1,
+		},
+		expected: events[2:3],
+	}}
+	for _, tc := range testCases {
+		result, err := multiverse.FetchLeafEvents(ctx, tc.query)
+		require.NoError(t, err, tc.name)
+
+		cursors := fn.Map(result, func(e *universe.LeafEvent) uint64 {
+			return e.Cursor
+		})
+		expectedCursors := fn.Map(
+			tc.expected, func(e *universe.LeafEvent) uint64 {
+				return e.Cursor
+			},
+		)
+		require.Equal(t, expectedCursors, cursors, tc.name)
+
+		// The in-memory filter should agree with the database, apart
+		// from the limit which it doesn't apply.
+		if tc.query.Limit != 0 {
+			continue
+		}
+		for _, event := range events {
+			require.Equal(
+				t, fn.Any(tc.expected, func(
+					e *universe.LeafEvent) bool {
+
+					return e.Cursor == event.Cursor
+				}), tc.query.Matches(event), tc.name,
+			)
+		}
+	}
+
+	// Upserting an existing leaf again records a new event after all the
+	// previous ones, so subscribers that resume from the latest cursor
+	// don't miss it.
+	_, err = multiverse.UpsertProofLeaf(
+		ctx, assetID, inserted[0].LeafKey, &inserted[0].Leaf, nil,
+	)
+	require.NoError(t, err)
+
+	newEvents, err := multiverse.FetchLeafEvents(
+		ctx, universe.LeafEventQuery{
+			AfterCursor: latest,
+		},
+	)
+	require.NoError(t, err)
+	require.Len(t, newEvents, 1)
+	require.Greater(t, newEvents[0].Cursor, latest)
+	require.Equal(
+		t, inserted[0].LeafKey.OutPoint, newEvents[0].Key.OutPoint,
+	)
+
+	select {
+	case ntfn := <-receiver.NewItemCreated.ChanOut():
+		require.Equal(t, newEvents[0].Cursor, ntfn.Cursor)
+
+	case <-time.After(5 * time.Second):
+		t.Fatalf("no leaf event received")
+	}
+}
+
+// randTransferLeaf creates a random transfer leaf of the given asset genesis
+// that is anchored in a block with the given height and timestamp, and spends
+// the asset outputs of the given leaf keys.
+func randTransferLeaf(t *testing.T, assetGen asset.Genesis, blockHeight uint32,
+	blockTime time.Time, prevKeys ...universe.LeafKey) leafWithKey {
+
+	leafKey := randLeafKey(t)
+
+	randProof := randProof(t, nil)
+	randProof.BlockHeight = blockHeight
+	randProof.BlockHeader.Timestamp = blockTime
+	randProof.Asset.Genesis = assetGen
+	randProof.Asset.GroupKey = nil
+	randProof.Asset.ScriptKey = *leafKey.ScriptKey
+
+	randProof.Asset.PrevWitnesses = nil
+	for _, prevKey := range prevKeys {
+		randProof.Asset.PrevWitnesses = append(
+			randProof.Asset.PrevWitnesses, asset.Witness{
+				PrevID: &asset.PrevID{
+					OutPoint: prevKey.OutPoint,
+					ID:       assetGen.ID(),
+					ScriptKey: asset.ToSerialized(
+						prevKey.ScriptKey.PubKey,
+					),
+				},
+				TxWitness: [][]byte{test.RandBytes(64)},
+			},
+		)
+	}
+
+	var proofBuf bytes.Buffer
+	require.NoError(t, randProof.Encode(&proofBuf))
+
+	return leafWithKey{
+		LeafKey: leafKey,
+		Leaf: universe.Leaf{
+			GenesisWithGroup: universe.GenesisWithGroup{
+				Genesis: assetGen,
+			},
+			Asset:    &randProof.Asset,
+			Amt:      randProof.Asset.Amount,
+			RawProof: proofBuf.Bytes(),
+		},
+	}
+}
+
+// TestMultiversePruneTransferLeaves tests that only superseded transfer leaves
+// that match the prune cutoff are removed, and that the universe and
+// multiverse trees are updated accordingly.
+func TestMultiversePruneTransferLeaves(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	multiverse, db := newTestMultiverse(t)
+
+	assetGen := asset.RandGenesis(t, asset.Normal)
+	transferID := universe.Identifier{
+		AssetID:   assetGen.ID(),
+		ProofType: universe.ProofTypeTransfer,
+	}
+	issuanceID := transferID
+	issuanceID.ProofType = universe.ProofTypeIssuance
+
+	// The issuance leaf is never pruned, no matter how old it is.
+	issuanceLeaf := leafWithKey{
+		LeafKey: randLeafKey(t),
+		Leaf:    randMintingLeaf(t, assetGen, nil),
+	}
+	_, err := multiverse.UpsertProofLeaf(
+		ctx, issuanceID, issuanceLeaf.LeafKey, &issuanceLeaf.Leaf, nil,
+	)
+	require.NoError(t, err)
+
+	// We create a chain of three transfers, where each transfer spends
+	// the output of the previous one, and an unrelated transfer that is
+	// never spent.
+	oldTime := time.Unix(1_600_000_000, 0)
+	newTime := oldTime.Add(30 * 24 * time.Hour)
+
+	first := randTransferLeaf(t, assetGen, 100, oldTime)
+	second := randTransferLeaf(t, assetGen, 110, oldTime, first.LeafKey)
+	third := randTransferLeaf(t, assetGen, 120, newTime, second.LeafKey)
+	unspent := randTransferLeaf(t, assetGen, 100, oldTime)
+
+	// We insert the spending transfers first, to make sure the order in
+	// which leaves are synced doesn't matter.
+	transfers := []leafWithKey{third, second, unspent, first}
+	for _, leaf := range transfers {
+		_, err := multiverse.UpsertProofLeaf(
+			ctx, transferID, leaf.LeafKey, &leaf.Leaf, nil,
+		)
+		require.NoError(t, err)
+	}
+
+	// We also add a node that isn't referenced by the tree, which should
+	// be removed when the tree is pruned.
+	err = db.InsertLeaf(ctx, sqlc.InsertLeafParams{
+		HashKey:   test.RandBytes(32),
+		Value:     test.RandBytes(32),
+		Sum:       1,
+		Namespace: transferID.String(),
+	})
+	require.NoError(t, err)
+
+	assertTransferLeaves := func(expected ...leafWithKey) {
+		t.Helper()
+
+		leafKeys, err := multiverse.UniverseLeafKeys(
+			ctx, universe.UniverseLeafKeysQuery{
+				Id:    transferID,
+				Limit: 100,
+			},
+		)
+		require.NoError(t, err)
+		require.Len(t, leafKeys, len(expected))
+
+		// The universe root must match the root of a tree that only
+		// contains the expected leaves.
+		expectedTree := mssmt.NewCompactedTree(mssmt.NewDefaultStore())
+		for _, leaf := range expected {
+			_, err := expectedTree.Insert(
+				ctx, leaf.LeafKey.UniverseKey(),
+				leaf.Leaf.SmtLeafNode(),
+			)
+			require.NoError(t, err)
+		}
+		expectedRoot, err := expectedTree.Root(ctx)
+		require.NoError(t, err)
+
+		uniRoot, err := multiverse.UniverseRootNode(ctx, transferID)
+		require.NoError(t, err)
+		require.True(t, mssmt.IsEqualNode(expectedRoot, uniRoot.Node))
+
+		// The multiverse leaf of the universe must commit to the new
+		// universe root.
+		multiverseLeaves, err := multiverse.FetchLeaves(
+			ctx, nil, universe.ProofTypeTransfer,
+		)
+		require.NoError(t, err)
+		require.Len(t, multiverseLeaves, 1)
+		require.True(t, mssmt.IsEqualNode(
+			universe.NewMultiverseLeafNode(transferID, expectedRoot),
+			multiverseLeaves[0].LeafNode,
+		))
+	}
+	assertTransferLeaves(first, second, third, unspent)
+
+	// Pruning by height only removes the first transfer. The second
+	// transfer is superseded too, but anchored above the cutoff, and the
+	// unspent transfer isn't superseded.
+	result, err := multiverse.PruneTransferLeaves(
+		ctx, universe.TransferPruneCutoff{
+			MaxBlockHeight: fn.Some(uint32(110 - 1)),
+		},
+	)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, result.LeavesPruned)
+	require.NotZero(t, result.NodesRemoved)
+	assertTransferLeaves(second, third, unspent)
+
+	// The first transfer is gone, but it still counts as spent. So once
+	// the second transfer is old enough, it is pruned as well. The latest
+	// transfer is always kept.
+	result, err = multiverse.PruneTransferLeaves(
+		ctx, universe.TransferPruneCutoff{
+			MinBlockTime: fn.Some(newTime.Add(time.Hour)),
+		},
+	)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, result.LeavesPruned)
+	assertTransferLeaves(third, unspent)
+
+	// Pruning again doesn't remove anything, and there are no unreferenced
+	// nodes left.
+	result, err = multiverse.PruneTransferLeaves(
+		ctx, universe.TransferPruneCutoff{
+			MaxBlockHeight: fn.Some(uint32(1_000)),
+			MinBlockTime:   fn.Some(newTime.Add(time.Hour)),
+		},
+	)
+	require.NoError(t, err)
+	require.Zero(t, result.LeavesPruned)
+
+	numRemoved, err := db.DeleteUnreachableNodes(ctx, transferID.String())
+	require.NoError(t, err)
+	require.Zero(t, numRemoved)
+
+	// A tombstone was left for each pruned leaf, so they aren't synced
+	// again.
+	allKeys := fn.Map(
+		[]leafWithKey{first, second, third, unspent},
+		func(leaf leafWithKey) universe.LeafKey {
+			return leaf.LeafKey
+		},
+	)
+	prunedKeys, err := multiverse.PrunedLeafKeys(ctx, transferID, allKeys)
+	require.NoError(t, err)
+	require.Equal(
+		t, []universe.LeafKey{first.LeafKey, second.LeafKey},
+		prunedKeys,
+	)
+
+	prunedKeys, err = multiverse.PrunedLeafKeys(ctx, issuanceID, allKeys)
+	require.NoError(t, err)
+	require.Empty(t, prunedKeys)
+
+	// The issuance leaf is still there.
+	issuanceProofs, err := multiverse.FetchProofLeaf(
+		ctx, issuanceID, issuanceLeaf.LeafKey,
+	)
+	require.NoError(t, err)
+	require.Len(t, issuanceProofs, 1)
+}
@@ -7,7 +7,6 @@ import (
 
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcutil"
-	"github.com/btcsuite/btcd/btcutil/psbt"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/lightninglabs/taproot-assets/asset"
@@ -349,13 +348,7 @@ type ExportLog interface {
 type ChainBridge = tapgarden.ChainBridge
 
 // WalletAnchor aliases into the WalletAnchor of the taparden package.
-type WalletAnchor interface {
-	tapgarden.WalletAnchor
-
-	// SignPsbt signs all the inputs it can in the passed-in PSBT packet,
-	// returning a new one with updated signature/witness data.
-	SignPsbt(ctx context.Context, packet *psbt.Packet) (*psbt.Packet, error)
-}
+type WalletAnchor = tapgarden.WalletAnchor
 
 // KeyRing aliases into the KeyRing of the tapgarden package.
 type KeyRing = tapgarden.KeyRing
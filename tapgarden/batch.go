@@ -2,8 +2,12 @@ package tapgarden
 
 import (
 	"fmt"
+	"sort"
+	"strings"
 	"sync/atomic"
 	"time"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
@@ -17,6 +21,38 @@ import (
 	"github.com/lightningnetwork/lnd/keychain"
 )
 
+// MaxBatchNameLength is the maximum length of the name of a minting batch, in
+// bytes.
+const MaxBatchNameLength = 64
+
+// validateBatchName checks that the given name can be used as the name of a
+// minting batch. An empty name is valid, and refers to the default pending
+// batch.
+func validateBatchName(name string) error {
+	if len(name) > MaxBatchNameLength {
+		return fmt.Errorf("batch name cannot exceed %d bytes",
+			MaxBatchNameLength)
+	}
+
+	if !utf8.ValidString(name) {
+		return fmt.Errorf("batch name is not a valid UTF-8 string")
+	}
+
+	for _, char := range name {
+		if !unicode.IsPrint(char) {
+			return fmt.Errorf("batch name cannot contain "+
+				"unprintable character: \\x%X", char)
+		}
+	}
+
+	if strings.TrimSpace(name) != name {
+		return fmt.Errorf("batch name cannot start or end with " +
+			"whitespace")
+	}
+
+	return nil
+}
+
 // AssetMetas maps the serialized script key of an asset to the meta reveal for
 // that asset, if it has one.
 type AssetMetas map[asset.SerializedKey]*proof.MetaReveal
@@ -40,6 +76,11 @@ type MintingBatch struct {
 	// BatchKey is the unique identifier for a batch.
 	BatchKey keychain.KeyDescriptor
 
+	// Name is an optional human-readable name for the batch. The name of a
+	// pending batch is unique among all pending batches. The default
+	// pending batch has an empty name.
+	Name string
+
 	// Seedlings is the set of seedlings for this batch. This maps an
 	// asset's name to the seedling itself.
 	//
@@ -84,6 +125,7 @@ func (m *MintingBatch) Copy() *MintingBatch {
 		// The following values are expected to not change once they are
 		// set, so a shallow copy is sufficient.
 		BatchKey:            m.BatchKey,
+		Name:                m.Name,
 		RootAssetCommitment: m.RootAssetCommitment,
 		mintingPubKey:       m.mintingPubKey,
 		tapSibling:          m.tapSibling,
@@ -136,6 +178,28 @@ func (m *MintingBatch) validateGroupAnchor(s *Seedling) error {
 	return nil
 }
 
+// validateNoGroupMembers checks that no seedling in the batch uses the
+// seedling with the given name as its group anchor.
+func (m *MintingBatch) validateNoGroupMembers(anchorName string) error {
+	var members []string
+	for name, seedling := range m.Seedlings {
+		if seedling.GroupAnchor != nil &&
+			*seedling.GroupAnchor == anchorName {
+
+			members = append(members, name)
+		}
+	}
+
+	if len(members) == 0 {
+		return nil
+	}
+
+	sort.Strings(members)
+
+	return fmt.Errorf("seedling %v is the group anchor of %v", anchorName,
+		strings.Join(members, ", "))
+}
+
 // MintingOutputKey derives the output key that once mined, will commit to the
 // Taproot asset root, thereby creating the set of included assets.
 func (m *MintingBatch) MintingOutputKey(sibling *commitment.TapscriptPreimage) (
@@ -186,6 +250,17 @@ func (m *MintingBatch) genesisScript(sibling *commitment.TapscriptPreimage) (
 	return tapscript.PayToTaprootScript(mintingOutputKey)
 }
 
+// anchorOutputIndex returns the index of the anchor output in the genesis TX
+// of a funded batch. The wallet either adds the change output after the anchor
+// output, or places it first.
+func (m *MintingBatch) anchorOutputIndex() uint32 {
+	if m.GenesisPacket.ChangeOutputIndex == 0 {
+		return 1
+	}
+
+	return 0
+}
+
 // State returns the private state of the batch.
 func (m *MintingBatch) State() BatchState {
 	currentBatchState := m.batchState.Load()
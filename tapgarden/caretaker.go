@@ -24,6 +24,7 @@ import (
 	"github.com/lightninglabs/taproot-assets/tapsend"
 	"github.com/lightninglabs/taproot-assets/universe"
 	"github.com/lightningnetwork/lnd/chainntnfs"
+	"github.com/lightningnetwork/lnd/lnwallet"
 	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 	"golang.org/x/exp/maps"
 	"golang.org/x/sync/errgroup"
@@ -38,6 +39,17 @@ var (
 	// key attached, and the asset is not the anchor asset for the group.
 	// This is true for any asset created via reissuance.
 	ErrGenesisNotGroupAnchor = errors.New("genesis not group anchor")
+
+	// ErrFeeBumpTooLow is an error returned if the fee rate of a fee bump
+	// isn't high enough for the new genesis transaction to replace the
+	// one that was already broadcast.
+	ErrFeeBumpTooLow = errors.New("fee rate too low to replace genesis " +
+		"transaction")
+
+	// ErrBatchNotBroadcast is an error returned if the fee of a batch is
+	// bumped that isn't waiting for its genesis transaction to confirm.
+	ErrBatchNotBroadcast = errors.New("batch genesis transaction not " +
+		"awaiting confirmation")
 )
 
 const (
@@ -126,6 +138,20 @@ type BatchCaretaker struct {
 	// the Taproot Asset commitment.
 	anchorOutputIndex uint32
 
+	// bumpFeeReqs is used to deliver requests to replace the broadcast
+	// genesis transaction with one that pays a higher fee.
+	bumpFeeReqs chan *bumpFeeReq
+
+	// genesisPkts holds every version of the genesis transaction that was
+	// broadcast since the caretaker was started, keyed by txid. A fee bump
+	// doesn't guarantee that the replaced version won't confirm, so we
+	// watch all of them and adopt whichever confirms.
+	genesisPkts map[chainhash.Hash]*tapsend.FundedPsbt
+
+	// confCancels cancels the confirmation notifications of all the
+	// versions of the genesis transaction that are being watched.
+	confCancels []func()
+
 	// ContextGuard provides a wait group and main quit channel that can be
 	// used to create guarded contexts.
 	*fn.ContextGuard
@@ -137,9 +163,11 @@ type BatchCaretaker struct {
 // TODO(roasbeef): rename to Cultivator?
 func NewBatchCaretaker(cfg *BatchCaretakerConfig) *BatchCaretaker {
 	return &BatchCaretaker{
-		batchKey:  asset.ToSerialized(cfg.Batch.BatchKey.PubKey),
-		cfg:       cfg,
-		confEvent: make(chan *chainntnfs.TxConfirmation, 1),
+		batchKey:    asset.ToSerialized(cfg.Batch.BatchKey.PubKey),
+		cfg:         cfg,
+		confEvent:   make(chan *chainntnfs.TxConfirmation, 1),
+		bumpFeeReqs: make(chan *bumpFeeReq),
+		genesisPkts: make(map[chainhash.Hash]*tapsend.FundedPsbt),
 		ContextGuard: &fn.ContextGuard{
 			DefaultTimeout: DefaultTimeout,
 			Quit:           make(chan struct{}),
@@ -366,9 +394,8 @@ func (b *BatchCaretaker) assetCultivator() {
 
 	// At this point, we've advanced all the way to broadcasting the
 	// minting transaction, so we'll wait until we need to exit, or we get
-	// the confirmation notification.
-	//
-	// TODO(roasbeef): eventually should attempt to RBF if needed?
+	// the confirmation notification. Until then, the fee of the minting
+	// transaction can be bumped.
 	for {
 		select {
 		// We've received the confirmation notification, so we can
@@ -378,6 +405,15 @@ func (b *BatchCaretaker) assetCultivator() {
 				"hash=%v, height=%v)", b.batchKey[:],
 				confInfo.BlockHash, confInfo.BlockHeight)
 
+			// The confirmed transaction may be a version of the
+			// genesis transaction that was replaced by a fee bump,
+			// in which case we'll adopt it before we continue.
+			err := b.adoptConfirmedGenesisTx(confInfo.Tx)
+			if err != nil {
+				log.Error(err)
+				return
+			}
+
 			b.confInfo = confInfo
 			b.cfg.Batch.UpdateState(BatchStateConfirmed)
 			currentBatchState = b.cfg.Batch.State()
@@ -398,6 +434,11 @@ func (b *BatchCaretaker) assetCultivator() {
 			b.cfg.SignalCompletion()
 			return
 
+		// We've been asked to replace the minting transaction with one
+		// that pays a higher fee.
+		case req := <-b.bumpFeeReqs:
+			req.resp <- b.bumpFee(req.feeRate)
+
 		case <-b.cfg.CancelReqChan:
 			cancelErr := b.Cancel()
 			if cancelErr == nil {
@@ -418,27 +459,28 @@ func extractGenesisOutpoint(tx *wire.MsgTx) wire.OutPoint {
 	return tx.TxIn[0].PreviousOutPoint
 }
 
-// seedlingsToAssetSprouts maps a set of seedlings in the internal batch into a
+// seedlingsToAssetSprouts maps a set of seedlings in the given batch into a
 // set of sprouts: Assets that aren't yet fully linked to broadcast genesis
 // transaction.
-func (b *BatchCaretaker) seedlingsToAssetSprouts(ctx context.Context,
-	genesisPoint wire.OutPoint,
+func seedlingsToAssetSprouts(ctx context.Context, batchStore MintingStore,
+	batch *MintingBatch, genesisPoint wire.OutPoint,
 	assetOutputIndex uint32) (*commitment.TapCommitment, error) {
 
-	log.Infof("BatchCaretaker(%x): mapping %v seedlings to asset sprouts, "+
-		"with genesis_point=%v", b.batchKey[:],
-		len(b.cfg.Batch.Seedlings), genesisPoint)
+	log.Infof("MintingBatch(%x): mapping %v seedlings to asset sprouts, "+
+		"with genesis_point=%v",
+		batch.BatchKey.PubKey.SerializeCompressed(),
+		len(batch.Seedlings), genesisPoint)
 
-	newAssets := make([]*asset.Asset, 0, len(b.cfg.Batch.Seedlings))
+	newAssets := make([]*asset.Asset, 0, len(batch.Seedlings))
 
 	// separate grouped assets from ungrouped
 	groupedSeedlings, ungroupedSeedlings := filterSeedlingsWithGroup(
-		b.cfg.Batch.Seedlings,
+		batch.Seedlings,
 	)
 	groupedSeedlingCount := len(groupedSeedlings)
 
 	// load seedling asset groups and check for correct group count
-	seedlingGroups, err := b.cfg.Log.FetchSeedlingGroups(
+	seedlingGroups, err := batchStore.FetchSeedlingGroups(
 		ctx, genesisPoint, assetOutputIndex,
 		maps.Values(groupedSeedlings),
 	)
@@ -539,6 +581,24 @@ func (b *BatchCaretaker) seedlingsToAssetSprouts(ctx context.Context,
 	return commitment.FromAssets(newAssets...)
 }
 
+// loadBatchSibling loads the optional tapscript sibling of the given batch
+// from the tree store, and converts it to a TapscriptPreimage.
+func loadBatchSibling(ctx context.Context,
+	treeStore asset.TapscriptTreeManager,
+	batch *MintingBatch) (*commitment.TapscriptPreimage, error) {
+
+	if batch.tapSibling == nil {
+		return nil, nil
+	}
+
+	tapSibling, err := treeStore.LoadTapscriptTree(ctx, *batch.tapSibling)
+	if err != nil {
+		return nil, err
+	}
+
+	return commitment.NewPreimageFromTapscriptTreeNodes(*tapSibling)
+}
+
 // stateStep attempts to transition the state machine from one state to
 // another. Two states are terminal: the broadcast state, and the finalized
 // state.
@@ -599,8 +659,9 @@ func (b *BatchCaretaker) stateStep(currentState BatchState) (BatchState, error)
 		genesisPoint := extractGenesisOutpoint(genesisTxPkt.UnsignedTx)
 
 		// First, we'll turn all the seedlings into actual taproot assets.
-		tapCommitment, err := b.seedlingsToAssetSprouts(
-			ctx, genesisPoint, b.anchorOutputIndex,
+		tapCommitment, err := seedlingsToAssetSprouts(
+			ctx, b.cfg.Log, b.cfg.Batch, genesisPoint,
+			b.anchorOutputIndex,
 		)
 		if err != nil {
 			return 0, fmt.Errorf("unable to map seedlings to "+
@@ -611,20 +672,11 @@ func (b *BatchCaretaker) stateStep(currentState BatchState) (BatchState, error)
 
 		// Fetch the optional Tapscript sibling for this batch, and
 		// convert it to a TapscriptPreimage.
-		var batchSibling *commitment.TapscriptPreimage
-		if b.cfg.Batch.tapSibling != nil {
-			tapSibling, err := b.cfg.TreeStore.LoadTapscriptTree(
-				ctx, *b.cfg.Batch.tapSibling,
-			)
-			if err != nil {
-				return 0, err
-			}
-
-			batchSibling, err = commitment.
-				NewPreimageFromTapscriptTreeNodes(*tapSibling)
-			if err != nil {
-				return 0, err
-			}
+		batchSibling, err := loadBatchSibling(
+			ctx, b.cfg.TreeStore, b.cfg.Batch,
+		)
+		if err != nil {
+			return 0, err
 		}
 
 		// With the commitment Taproot Asset root SMT constructed, we'll
@@ -729,55 +781,15 @@ func (b *BatchCaretaker) stateStep(currentState BatchState) (BatchState, error)
 
 		// At this point we have a fully signed PSBT packet which'll
 		// create our set of assets once mined. We'll write this to
-		// disk, then import the public key into the wallet. The sibling
-		// here can always be nil as we'll fetch the output key computed
-		// previously in BatchStateFrozen.
+		// disk, then import the public key into the wallet.
 		//
 		// TODO(roasbeef): re-run during the broadcast phase to ensure
 		// it's fully imported?
-		mintingOutputKey, merkleRoot, err := b.cfg.Batch.
-			MintingOutputKey(nil)
-		if err != nil {
-			return 0, err
-		}
-
-		// To spend this output in the future, we must also commit the
-		// Taproot Asset commitment root and batch tapscript sibling.
-		tapCommitmentRoot := b.cfg.Batch.RootAssetCommitment.
-			TapscriptRoot(nil)
-
-		// Fetch the optional Tapscript sibling for this batch, and
-		// encode it to bytes.
-		var siblingBytes []byte
-		if b.cfg.Batch.tapSibling != nil {
-			tapSibling, err := b.cfg.TreeStore.LoadTapscriptTree(
-				ctx, *b.cfg.Batch.tapSibling,
-			)
-			if err != nil {
-				return 0, err
-			}
-
-			batchSibling, err := commitment.
-				NewPreimageFromTapscriptTreeNodes(*tapSibling)
-			if err != nil {
-				return 0, err
-			}
-
-			siblingBytes, _, err = commitment.
-				MaybeEncodeTapscriptPreimage(batchSibling)
-			if err != nil {
-				return 0, err
-			}
-		}
-
-		err = b.cfg.Log.CommitSignedGenesisTx(
-			ctx, b.cfg.Batch.BatchKey.PubKey,
-			b.cfg.Batch.GenesisPacket, b.anchorOutputIndex,
-			merkleRoot, tapCommitmentRoot[:], siblingBytes,
+		mintingOutputKey, err := b.commitSignedGenesisTx(
+			ctx, b.cfg.Batch.GenesisPacket,
 		)
 		if err != nil {
-			return 0, fmt.Errorf("unable to commit genesis "+
-				"tx: %w", err)
+			return 0, err
 		}
 
 		// With the genesis transaction committed to disk, we'll also
@@ -836,115 +848,12 @@ func (b *BatchCaretaker) stateStep(currentState BatchState) (BatchState, error)
 		}
 
 		// Now we'll wait for a confirmation as we reach our terminal
-		// state that requires an on-chain event to shift from. We make
-		// sure to request that the block is included as well, since we
-		// need this to construct the proof files for each of the
-		// assets later.
-		//
-		// TODO(roasbeef): eventually want to be able to RBF the bump
-		heightHint := b.cfg.Batch.HeightHint
-		txHash := signedTx.TxHash()
-		confCtx, confCancel := b.WithCtxQuitNoTimeout()
-		confNtfn, errChan, err := b.cfg.ChainBridge.RegisterConfirmationsNtfn(
-			confCtx, &txHash, signedTx.TxOut[0].PkScript, 1,
-			heightHint, true, nil,
-		)
+		// state that requires an on-chain event to shift from.
+		err = b.watchGenesisConf(b.cfg.Batch.GenesisPacket, signedTx)
 		if err != nil {
-			return 0, fmt.Errorf("unable to register for "+
-				"minting tx conf: %w", err)
+			return 0, err
 		}
 
-		// Launch a goroutine that'll notify us when the transaction
-		// confirms.
-		//
-		// TODO(roasbeef): make blocking here?
-		b.Wg.Add(1)
-		go func() {
-			defer confCancel()
-			defer b.Wg.Done()
-
-			var (
-				confEvent *chainntnfs.TxConfirmation
-				confRecv  bool
-			)
-
-			for !confRecv {
-				select {
-				case confEvent = <-confNtfn.Confirmed:
-					confRecv = true
-
-				case err := <-errChan:
-					confErr := fmt.Errorf("error getting "+
-						"confirmation: %w", err)
-					log.Info(confErr)
-					b.cfg.ErrChan <- confErr
-
-					return
-
-				case <-confCtx.Done():
-					log.Debugf("Skipping TX confirmation, " +
-						"context done")
-					confRecv = true
-
-				case <-b.cfg.CancelReqChan:
-					cancelErr := b.Cancel()
-					if cancelErr == nil {
-						return
-					}
-
-					// Cancellation failed, continue to wait
-					// for transaction confirmation.
-					log.Info(cancelErr)
-
-				case <-b.Quit:
-					log.Debugf("Skipping TX confirmation, " +
-						"exiting")
-					return
-				}
-			}
-
-			if confEvent == nil {
-				confErr := fmt.Errorf("got empty " +
-					"confirmation event in batch")
-				log.Info(confErr)
-				b.cfg.ErrChan <- confErr
-
-				return
-			}
-
-			if confEvent.Tx != nil {
-				log.Debugf("Got chain confirmation: %v",
-					confEvent.Tx.TxHash())
-			}
-
-			for {
-				select {
-				case b.confEvent <- confEvent:
-					return
-
-				case <-confCtx.Done():
-					log.Debugf("Skipping TX confirmation, " +
-						"context done")
-					return
-
-				case <-b.cfg.CancelReqChan:
-					cancelErr := b.Cancel()
-					if cancelErr == nil {
-						return
-					}
-
-					// Cancellation failed, continue to try
-					// and send the confirmation event.
-					log.Info(cancelErr)
-
-				case <-b.Quit:
-					log.Debugf("Skipping TX confirmation, " +
-						"exiting")
-					return
-				}
-			}
-		}()
-
 		log.Infof("BatchCaretaker(%x): transition states: %v -> %v",
 			b.batchKey, BatchStateBroadcast, BatchStateBroadcast)
 
@@ -1161,6 +1070,411 @@ func (b *BatchCaretaker) stateStep(currentState BatchState) (BatchState, error)
 	}
 }
 
+// watchGenesisConf registers for a confirmation notification of the given
+// version of the genesis transaction, and launches a goroutine that delivers
+// the notification to the main caretaker goroutine. Versions of the genesis
+// transaction that were watched before are still watched, as a replaced
+// version may confirm instead.
+func (b *BatchCaretaker) watchGenesisConf(genesisPkt *tapsend.FundedPsbt,
+	signedTx *wire.MsgTx) error {
+
+	txHash := signedTx.TxHash()
+	if _, ok := b.genesisPkts[txHash]; ok {
+		return nil
+	}
+
+	// We make sure to request that the block is included as well, since
+	// we need this to construct the proof files for each of the assets
+	// later.
+	heightHint := b.cfg.Batch.HeightHint
+	confCtx, confCancel := b.WithCtxQuitNoTimeout()
+	confNtfn, errChan, err := b.cfg.ChainBridge.RegisterConfirmationsNtfn(
+		confCtx, &txHash, signedTx.TxOut[0].PkScript, 1,
+		heightHint, true, nil,
+	)
+	if err != nil {
+		confCancel()
+		return fmt.Errorf("unable to register for minting tx "+
+			"conf: %w", err)
+	}
+
+	// Launch a goroutine that'll notify us when the transaction
+	// confirms.
+	//
+	// TODO(roasbeef): make blocking here?
+	b.Wg.Add(1)
+	go func() {
+		defer confCancel()
+		defer b.Wg.Done()
+
+		var (
+			confEvent *chainntnfs.TxConfirmation
+			confRecv  bool
+		)
+
+		for !confRecv {
+			select {
+			case confEvent = <-confNtfn.Confirmed:
+				confRecv = true
+
+			case err := <-errChan:
+				// If the notification was cancelled, then
+				// there's no need to report an error.
+				if confCtx.Err() != nil {
+					return
+				}
+
+				confErr := fmt.Errorf("error getting "+
+					"confirmation: %w", err)
+				log.Info(confErr)
+				b.cfg.ErrChan <- confErr
+
+				return
+
+			// The context is also cancelled once another
+			// version of the genesis transaction confirmed.
+			case <-confCtx.Done():
+				log.Debugf("Skipping TX confirmation, " +
+					"context done")
+				return
+
+			case <-b.cfg.CancelReqChan:
+				cancelErr := b.Cancel()
+				if cancelErr == nil {
+					return
+				}
+
+				// Cancellation failed, continue to wait
+				// for transaction confirmation.
+				log.Info(cancelErr)
+
+			case <-b.Quit:
+				log.Debugf("Skipping TX confirmation, " +
+					"exiting")
+				return
+			}
+		}
+
+		if confEvent == nil {
+			confErr := fmt.Errorf("got empty " +
+				"confirmation event in batch")
+			log.Info(confErr)
+			b.cfg.ErrChan <- confErr
+
+			return
+		}
+
+		if confEvent.Tx != nil {
+			log.Debugf("Got chain confirmation: %v",
+				confEvent.Tx.TxHash())
+		}
+
+		for {
+			select {
+			case b.confEvent <- confEvent:
+				return
+
+			case <-confCtx.Done():
+				log.Debugf("Skipping TX confirmation, " +
+					"context done")
+				return
+
+			case <-b.cfg.CancelReqChan:
+				cancelErr := b.Cancel()
+				if cancelErr == nil {
+					return
+				}
+
+				// Cancellation failed, continue to try
+				// and send the confirmation event.
+				log.Info(cancelErr)
+
+			case <-b.Quit:
+				log.Debugf("Skipping TX confirmation, " +
+					"exiting")
+				return
+			}
+		}
+	}()
+
+	b.genesisPkts[txHash] = genesisPkt
+	b.confCancels = append(b.confCancels, confCancel)
+
+	return nil
+}
+
+// adoptConfirmedGenesisTx makes the version of the genesis transaction that
+// confirmed the genesis transaction of the batch, and writes it to disk if it
+// isn't the version that was broadcast last. The confirmation notifications
+// for all other versions are cancelled.
+func (b *BatchCaretaker) adoptConfirmedGenesisTx(confTx *wire.MsgTx) error {
+	for _, confCancel := range b.confCancels {
+		confCancel()
+	}
+	b.confCancels = nil
+
+	if confTx == nil {
+		return fmt.Errorf("confirmation event is missing the genesis " +
+			"tx")
+	}
+
+	txHash := confTx.TxHash()
+	genesisPkt, ok := b.genesisPkts[txHash]
+	if !ok {
+		return fmt.Errorf("confirmed tx %v is not a known genesis tx",
+			txHash)
+	}
+
+	if genesisPkt == b.cfg.Batch.GenesisPacket {
+		return nil
+	}
+
+	log.Infof("BatchCaretaker(%x): replaced genesis tx %v confirmed, "+
+		"adopting it", b.batchKey[:], txHash)
+
+	ctx, cancel := b.WithCtxQuit()
+	defer cancel()
+
+	_, err := b.commitSignedGenesisTx(ctx, genesisPkt)
+	if err != nil {
+		return err
+	}
+
+	b.cfg.Batch.GenesisPacket = genesisPkt
+
+	return nil
+}
+
+// commitSignedGenesisTx writes the given signed genesis packet to disk, along
+// with the anchor output that commits to the assets of the batch. The Taproot
+// output key of the anchor output is returned.
+func (b *BatchCaretaker) commitSignedGenesisTx(ctx context.Context,
+	genesisPkt *tapsend.FundedPsbt) (*btcec.PublicKey, error) {
+
+	// The sibling here can always be nil as we'll fetch the output key
+	// computed previously in BatchStateFrozen.
+	mintingOutputKey, merkleRoot, err := b.cfg.Batch.MintingOutputKey(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// To spend this output in the future, we must also commit the Taproot
+	// Asset commitment root and batch tapscript sibling.
+	tapCommitmentRoot := b.cfg.Batch.RootAssetCommitment.TapscriptRoot(nil)
+
+	// Fetch the optional Tapscript sibling for this batch, and encode it
+	// to bytes.
+	var siblingBytes []byte
+	if b.cfg.Batch.tapSibling != nil {
+		tapSibling, err := b.cfg.TreeStore.LoadTapscriptTree(
+			ctx, *b.cfg.Batch.tapSibling,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		batchSibling, err := commitment.
+			NewPreimageFromTapscriptTreeNodes(*tapSibling)
+		if err != nil {
+			return nil, err
+		}
+
+		siblingBytes, _, err = commitment.
+			MaybeEncodeTapscriptPreimage(batchSibling)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = b.cfg.Log.CommitSignedGenesisTx(
+		ctx, b.cfg.Batch.BatchKey.PubKey, genesisPkt,
+		b.anchorOutputIndex, merkleRoot, tapCommitmentRoot[:],
+		siblingBytes,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to commit genesis tx: %w", err)
+	}
+
+	return mintingOutputKey, nil
+}
+
+// bumpFeeReq is a request to replace the broadcast genesis transaction of a
+// batch with one that pays a higher fee rate.
+type bumpFeeReq struct {
+	// feeRate is the fee rate the new genesis transaction should pay.
+	feeRate chainfee.SatPerKWeight
+
+	// resp is used to deliver the result of the fee bump.
+	resp chan error
+}
+
+// BumpFee replaces the broadcast genesis transaction of the batch with one
+// that pays the given fee rate. The genesis transaction keeps spending the
+// same inputs, so the genesis point and therefore the IDs of the assets in the
+// batch don't change. The additional fee is paid from the change output.
+func (b *BatchCaretaker) BumpFee(ctx context.Context,
+	feeRate chainfee.SatPerKWeight) error {
+
+	if b.cfg.Batch.State() != BatchStateBroadcast {
+		return fmt.Errorf("%w: batch state %v", ErrBatchNotBroadcast,
+			b.cfg.Batch.State())
+	}
+
+	req := &bumpFeeReq{
+		feeRate: feeRate,
+		resp:    make(chan error, 1),
+	}
+
+	select {
+	case b.bumpFeeReqs <- req:
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-b.Quit:
+		return fmt.Errorf("BatchCaretaker(%x), shutting down",
+			b.batchKey[:])
+	}
+
+	select {
+	case err := <-req.resp:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-b.Quit:
+		return fmt.Errorf("BatchCaretaker(%x), shutting down",
+			b.batchKey[:])
+	}
+}
+
+// bumpFee replaces the broadcast genesis transaction with one that pays the
+// given fee rate, and waits for either of them to confirm.
+func (b *BatchCaretaker) bumpFee(feeRate chainfee.SatPerKWeight) error {
+	ctx, cancel := b.WithCtxQuit()
+	defer cancel()
+
+	oldPkt := b.cfg.Batch.GenesisPacket
+	changeIndex := oldPkt.ChangeOutputIndex
+	if changeIndex < 0 {
+		return fmt.Errorf("genesis tx has no change output to pay " +
+			"the fee bump")
+	}
+
+	// We'll use the weight of the broadcast transaction to compute the
+	// fee of the replacement, as both spend the same inputs and create
+	// the same outputs.
+	oldTx, err := psbt.Extract(oldPkt.Pkt)
+	if err != nil {
+		return fmt.Errorf("unable to extract genesis tx: %w", err)
+	}
+	oldFee, err := oldPkt.Pkt.GetTxFee()
+	if err != nil {
+		return fmt.Errorf("unable to get genesis tx fee: %w", err)
+	}
+
+	weight := blockchain.GetTransactionWeight(btcutil.NewTx(oldTx))
+	newFee := feeRate.FeeForWeight(weight)
+
+	// The replacement must pay at least the fee of the old transaction,
+	// plus the relay fee for its own size.
+	minFee := oldFee + chainfee.FeePerKwFloor.FeeForWeight(weight)
+	if newFee < minFee {
+		return fmt.Errorf("%w: fee_rate=%v, old_fee_rate=%v",
+			ErrFeeBumpTooLow, feeRate,
+			chainfee.NewSatPerKWeight(oldFee, uint64(weight)))
+	}
+
+	// Make a copy of the genesis PSBT, which we'll strip of its
+	// signatures and then pay the additional fee from the change output.
+	var psbtBuf bytes.Buffer
+	if err := oldPkt.Pkt.Serialize(&psbtBuf); err != nil {
+		return fmt.Errorf("unable to serialize genesis PSBT: %w", err)
+	}
+	newPkt, err := psbt.NewFromRawBytes(&psbtBuf, false)
+	if err != nil {
+		return fmt.Errorf("unable to deserialize genesis PSBT: %w", err)
+	}
+
+	for idx := range newPkt.Inputs {
+		newPkt.Inputs[idx].FinalScriptSig = nil
+		newPkt.Inputs[idx].FinalScriptWitness = nil
+	}
+
+	changeOutput := newPkt.UnsignedTx.TxOut[changeIndex]
+	changeOutput.Value -= int64(newFee - oldFee)
+	dustLimit := lnwallet.DustLimitUnknownWitness()
+	if changeOutput.Value < int64(dustLimit) {
+		return fmt.Errorf("change output too small to pay the fee "+
+			"bump: change=%v, fee=%v", btcutil.Amount(
+			changeOutput.Value+int64(newFee-oldFee)), newFee)
+	}
+
+	signedPkt, err := b.cfg.Wallet.SignAndFinalizePsbt(ctx, newPkt)
+	if err != nil {
+		return fmt.Errorf("unable to sign psbt: %w", err)
+	}
+
+	signedTx, err := psbt.Extract(signedPkt)
+	if err != nil {
+		return fmt.Errorf("unable to extract psbt: %w", err)
+	}
+
+	err = blockchain.CheckTransactionSanity(btcutil.NewTx(signedTx))
+	if err != nil {
+		return fmt.Errorf("genesis TX failed final checks: %w", err)
+	}
+
+	// The genesis point is the first input of the genesis transaction,
+	// so the assets of the batch would change if the wallet reordered the
+	// inputs.
+	oldGenesisPoint := extractGenesisOutpoint(oldTx)
+	if extractGenesisOutpoint(signedTx) != oldGenesisPoint {
+		return fmt.Errorf("genesis point changed while signing")
+	}
+
+	chainFees, err := signedPkt.GetTxFee()
+	if err != nil {
+		return fmt.Errorf("unable to get on-chain fees for psbt: %w",
+			err)
+	}
+
+	newGenesisPkt := &tapsend.FundedPsbt{
+		Pkt:               signedPkt,
+		ChangeOutputIndex: changeIndex,
+		ChainFees:         int64(chainFees),
+		LockedUTXOs:       oldPkt.LockedUTXOs,
+	}
+
+	log.Infof("BatchCaretaker(%x): replacing genesis tx %v with %v "+
+		"(absolute_fee_sats: %d, fee_rate: %v)", b.batchKey[:],
+		oldTx.TxHash(), signedTx.TxHash(), chainFees, feeRate)
+
+	// We'll write the new genesis transaction to disk before we publish
+	// it, so we'll never lose track of the transaction that mints the
+	// assets.
+	_, err = b.commitSignedGenesisTx(ctx, newGenesisPkt)
+	if err != nil {
+		return err
+	}
+
+	err = b.cfg.ChainBridge.PublishTransaction(ctx, signedTx)
+	if err != nil {
+		// The new transaction didn't replace the old one, so we'll
+		// restore the old one on disk.
+		_, restoreErr := b.commitSignedGenesisTx(ctx, oldPkt)
+		if restoreErr != nil {
+			log.Errorf("BatchCaretaker(%x): unable to restore "+
+				"genesis tx: %v", b.batchKey[:], restoreErr)
+		}
+
+		return fmt.Errorf("unable to publish transaction: %w", err)
+	}
+
+	b.cfg.Batch.GenesisPacket = newGenesisPkt
+
+	// The old genesis transaction may still confirm if the replacement
+	// doesn't propagate, so we'll wait for either of them to confirm.
+	return b.watchGenesisConf(newGenesisPkt, signedTx)
+}
+
 // storeMintingProof stores the minting proof for a new asset in the proof
 // store. If a universe is configured, it also returns the issuance item that
 // can be used to register the asset with the universe.
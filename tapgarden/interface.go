@@ -34,27 +34,40 @@ type Planter interface {
 	// details of a specific batch.
 	ListBatches(batchKey *btcec.PublicKey) ([]*MintingBatch, error)
 
-	// CancelSeedling attempts to cancel the creation of a new asset
-	// identified by its name. If the seedling has already progressed to a
-	// point where the genesis PSBT has been broadcasted, an error is
-	// returned.
-	CancelSeedling() error
-
-	// FundBatch attempts to provide a genesis point for the current batch,
-	// or create a new funded batch.
+	// RemoveSeedling removes a seedling, identified by its name, from the
+	// target pending batch. The batch must not be sealed yet.
+	RemoveSeedling(params RemoveSeedlingParams) (*MintingBatch, error)
+
+	// UpdateSeedling changes the amount, metadata or group settings of a
+	// seedling, identified by its name, in the target pending batch. The
+	// batch must not be sealed yet.
+	UpdateSeedling(params UpdateSeedlingParams) (*MintingBatch, error)
+
+	// FundBatch attempts to provide a genesis point for the target pending
+	// batch, or create a new funded batch.
 	FundBatch(params FundParams) (*MintingBatch, error)
 
-	// SealBatch attempts to seal the current batch, by providing or
+	// SealBatch attempts to seal the target pending batch, by providing or
 	// deriving all witnesses necessary to create the final genesis TX.
 	SealBatch(params SealParams) (*MintingBatch, error)
 
+	// ListGroupVirtualTxs returns the grouped seedlings of the funded
+	// target pending batch, along with the group virtual TXs that must be
+	// signed to seal the batch.
+	ListGroupVirtualTxs(target BatchTarget) ([]*UnsealedSeedling, error)
+
 	// FinalizeBatch signals that the asset minter should finalize
-	// the current batch, if one exists.
+	// the target pending batch, if one exists.
 	FinalizeBatch(params FinalizeParams) (*MintingBatch, error)
 
 	// CancelBatch signals that the asset minter should cancel the
-	// current batch, if one exists.
-	CancelBatch() (*btcec.PublicKey, error)
+	// target batch, if one exists. If no batch is targeted, the only
+	// existing batch is cancelled.
+	CancelBatch(target BatchTarget) (*btcec.PublicKey, error)
+
+	// BumpBatchFee replaces the genesis transaction of a broadcast but
+	// unconfirmed batch with one that pays a higher fee rate.
+	BumpBatchFee(params BumpFeeParams) (*MintingBatch, error)
 
 	// Start signals that the asset minter should being operations.
 	Start() error
@@ -182,7 +195,8 @@ type MintingStore interface {
 	asset.TapscriptTreeManager
 
 	// CommitMintingBatch commits a new minting batch to disk, identified
-	// by its batch key.
+	// by its batch key. The name of a pending batch must be unique among
+	// all pending batches.
 	CommitMintingBatch(ctx context.Context, newBatch *MintingBatch) error
 
 	// UpdateBatchState updates the batch state on disk identified by the
@@ -192,11 +206,22 @@ type MintingStore interface {
 
 	// AddSeedlingsToBatch adds a new seedling to an existing batch. Once
 	// added this batch should remain in the BatchStatePending state.
-	//
-	// TODO(roasbeef): assumption that only one pending batch at a time?
 	AddSeedlingsToBatch(ctx context.Context, batchKey *btcec.PublicKey,
 		seedlings ...*Seedling) error
 
+	// RemoveSeedlingFromBatch removes the seedling with the given name from
+	// an existing batch. The batch should remain in the BatchStatePending
+	// state.
+	RemoveSeedlingFromBatch(ctx context.Context,
+		batchKey *btcec.PublicKey, assetName string) error
+
+	// UpdateSeedlingInBatch replaces the amount, metadata and group
+	// settings of an existing seedling in a batch, which is identified by
+	// the seedling name. The batch should remain in the BatchStatePending
+	// state.
+	UpdateSeedlingInBatch(ctx context.Context, batchKey *btcec.PublicKey,
+		seedling *Seedling) error
+
 	// FetchAllBatches fetches all the batches on disk.
 	FetchAllBatches(ctx context.Context) ([]*MintingBatch, error)
 
@@ -235,6 +260,9 @@ type MintingStore interface {
 	// left/right sibling for the Taproot Asset tapscript commitment in the
 	// transaction.
 	//
+	// If the batch was already broadcast, the given transaction replaces
+	// the previously committed genesis transaction.
+	//
 	// NOTE: The BatchState should transition to the BatchStateBroadcast
 	// state upon a successful call.
 	CommitSignedGenesisTx(ctx context.Context, batchKey *btcec.PublicKey,
@@ -326,6 +354,10 @@ type WalletAnchor interface {
 		feeRate chainfee.SatPerKWeight,
 		changeIdx int32) (*tapsend.FundedPsbt, error)
 
+	// SignPsbt signs all the inputs it can in the passed-in PSBT packet,
+	// returning a new one with updated signature/witness data.
+	SignPsbt(ctx context.Context, packet *psbt.Packet) (*psbt.Packet, error)
+
 	// SignAndFinalizePsbt fully signs and finalizes the target PSBT
 	// packet.
 	SignAndFinalizePsbt(context.Context, *psbt.Packet) (*psbt.Packet, error)
@@ -26,6 +26,7 @@ import (
 	"github.com/lightninglabs/taproot-assets/tapsend"
 	"github.com/lightningnetwork/lnd/chainntnfs"
 	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lnutils"
 	"github.com/lightningnetwork/lnd/lnwallet"
 	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 	"github.com/stretchr/testify/require"
@@ -83,6 +84,11 @@ type MockWalletAnchor struct {
 
 	Transactions  []lndclient.Transaction
 	ImportedUtxos []*lnwallet.Utxo
+
+	// walletInputs are the outpoints of the inputs the mock wallet added
+	// when funding a PSBT. Like the real wallet, the mock only signs these
+	// inputs.
+	walletInputs lnutils.SyncMap[wire.OutPoint, struct{}]
 }
 
 func NewMockWalletAnchor() *MockWalletAnchor {
@@ -155,10 +161,12 @@ func (m *MockWalletAnchor) FundPsbt(_ context.Context, packet *psbt.Packet,
 
 	// Take the PSBT packet and add an additional input and output to
 	// simulate the wallet funding the transaction.
+	walletInput := wire.OutPoint{
+		Index: rand.Uint32(),
+	}
+	m.walletInputs.Store(walletInput, struct{}{})
 	packet.UnsignedTx.AddTxIn(&wire.TxIn{
-		PreviousOutPoint: wire.OutPoint{
-			Index: rand.Uint32(),
-		},
+		PreviousOutPoint: walletInput,
 	})
 
 	// Use a P2TR input by default.
@@ -181,6 +189,12 @@ func (m *MockWalletAnchor) FundPsbt(_ context.Context, packet *psbt.Packet,
 	packet.UnsignedTx.AddTxOut(&changeOutput)
 	packet.Outputs = append(packet.Outputs, psbt.POutput{})
 
+	// Like the real wallet, we report the index of the change output we
+	// added if the caller didn't specify an existing one.
+	if changeIdx < 0 {
+		changeIdx = int32(len(packet.UnsignedTx.TxOut) - 1)
+	}
+
 	// We always have the change output be the second output, so this means
 	// the Taproot Asset commitment will live in the first output.
 	pkt := &tapsend.FundedPsbt{
@@ -193,6 +207,32 @@ func (m *MockWalletAnchor) FundPsbt(_ context.Context, packet *psbt.Packet,
 	return pkt, nil
 }
 
+// isWalletInput returns true if the input at the given index of the PSBT was
+// added by the mock wallet, or spends an output with a key derived by the
+// wallet.
+func (m *MockWalletAnchor) isWalletInput(pkt *psbt.Packet, idx int) bool {
+	prevOut := pkt.UnsignedTx.TxIn[idx].PreviousOutPoint
+	if _, ok := m.walletInputs.Load(prevOut); ok {
+		return true
+	}
+
+	return len(pkt.Inputs[idx].TaprootBip32Derivation) != 0
+}
+
+// SignPsbt "signs" the wallet inputs of the PSBT by attaching a dummy key spend
+// signature to each of them.
+func (m *MockWalletAnchor) SignPsbt(_ context.Context,
+	pkt *psbt.Packet) (*psbt.Packet, error) {
+
+	for idx := range pkt.Inputs {
+		if m.isWalletInput(pkt, idx) {
+			pkt.Inputs[idx].TaprootKeySpendSig = make([]byte, 64)
+		}
+	}
+
+	return pkt, nil
+}
+
 func (m *MockWalletAnchor) SignAndFinalizePsbt(ctx context.Context,
 	pkt *psbt.Packet) (*psbt.Packet, error) {
 
@@ -202,9 +242,21 @@ func (m *MockWalletAnchor) SignAndFinalizePsbt(ctx context.Context,
 	default:
 	}
 
-	// We'll modify the packet by attaching a "signature" so the PSBT
-	// appears to actually be finalized.
-	pkt.Inputs[0].FinalScriptSig = []byte{}
+	// We'll modify the packet by attaching a "signature" to each wallet
+	// input so the PSBT appears to actually be finalized. Like the real
+	// wallet, we can't finalize inputs that we don't own, so those must
+	// already be finalized.
+	for idx := range pkt.Inputs {
+		pIn := &pkt.Inputs[idx]
+		switch {
+		case m.isWalletInput(pkt, idx):
+			pIn.FinalScriptSig = []byte{}
+
+		case pIn.FinalScriptSig == nil && pIn.FinalScriptWitness == nil:
+			return nil, fmt.Errorf("input %d is not owned by the "+
+				"wallet and not finalized", idx)
+		}
+	}
 
 	select {
 	case <-ctx.Done():
@@ -615,8 +667,17 @@ func (m *MockKeyRing) DeriveKey(ctx context.Context,
 	return keychain.KeyDescriptor{}, nil
 }
 
-func (m *MockKeyRing) IsLocalKey(context.Context, keychain.KeyDescriptor) bool {
-	return true
+// IsLocalKey returns true if the private key of the given key descriptor is
+// held by the key ring.
+func (m *MockKeyRing) IsLocalKey(_ context.Context,
+	desc keychain.KeyDescriptor) bool {
+
+	priv, ok := m.Keys[desc.KeyLocator]
+	if !ok {
+		return false
+	}
+
+	return desc.PubKey == nil || priv.PubKey().IsEqual(desc.PubKey)
 }
 
 type MockGenSigner struct {
@@ -18,6 +18,7 @@ import (
 	"github.com/lightninglabs/taproot-assets/tapscript"
 	"github.com/lightninglabs/taproot-assets/tapsend"
 	"github.com/lightninglabs/taproot-assets/universe"
+	"github.com/lightningnetwork/lnd/keychain"
 	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 	"golang.org/x/exp/maps"
 )
@@ -128,27 +129,87 @@ type stateParamReq[T, S any] struct {
 // FinalizeParams are the options available to change how a batch is finalized,
 // and how the genesis TX is constructed.
 type FinalizeParams struct {
+	Batch          BatchTarget
 	FeeRate        fn.Option[chainfee.SatPerKWeight]
 	SiblingTapTree fn.Option[asset.TapscriptTreeNodes]
+
+	// SignedGenesisPacket is an optional copy of the funded genesis PSBT of
+	// the batch, in which the caller finalized the inputs it contributed
+	// with the genesis template. The wallet then only signs its own inputs.
+	SignedGenesisPacket fn.Option[psbt.Packet]
 }
 
 // FundParams are the options available to change how a batch is funded, and how
 // the genesis TX is constructed.
 type FundParams struct {
+	Batch          BatchTarget
 	FeeRate        fn.Option[chainfee.SatPerKWeight]
 	SiblingTapTree fn.Option[asset.TapscriptTreeNodes]
-	// TODO(jhb): follow-up PR: accept a PSBT here
+
+	// GenesisTemplate is an optional PSBT that the genesis TX is built
+	// on top of. Its inputs are spent by the genesis TX before any inputs
+	// selected by the wallet, and its outputs are created after the anchor
+	// output. The wallet doesn't sign the inputs of the template, so they
+	// must be signed by the caller when finalizing the batch.
+	GenesisTemplate fn.Option[psbt.Packet]
+}
+
+// BumpFeeParams are the options used to replace the genesis TX of a broadcast
+// batch with one that pays a higher fee.
+type BumpFeeParams struct {
+	BatchKey *btcec.PublicKey
+	FeeRate  chainfee.SatPerKWeight
+}
+
+// BatchTarget selects a pending batch either by its name or by its batch key.
+// The zero value selects the default pending batch, which has no name.
+type BatchTarget struct {
+	// Name is the name of the pending batch. Requests that add to a batch
+	// create a new batch with this name if no such batch exists yet.
+	Name string
+
+	// Key is the batch key of an existing pending batch. If a name is
+	// also given, it must match the name of that batch.
+	Key *btcec.PublicKey
+}
+
+// RemoveSeedlingParams select a seedling to remove from a pending batch.
+type RemoveSeedlingParams struct {
+	Batch     BatchTarget
+	AssetName string
+}
+
+// UpdateSeedlingParams are the changes to make to a seedling of a pending
+// batch. Only the fields that are set are changed.
+type UpdateSeedlingParams struct {
+	Batch     BatchTarget
+	AssetName string
+	Amount    fn.Option[uint64]
+	Meta      fn.Option[*proof.MetaReveal]
+	Group     fn.Option[SeedlingGroupParams]
+}
+
+// SeedlingGroupParams are the settings that determine the asset group of a
+// seedling. When a seedling is updated, all of these settings are replaced
+// together.
+type SeedlingGroupParams struct {
+	EnableEmission     bool
+	GroupInfo          *asset.AssetGroup
+	GroupAnchor        *string
+	GroupInternalKey   *keychain.KeyDescriptor
+	GroupTapscriptRoot []byte
 }
 
-// groupSeal specifies the group witness for a seedling in a funded batch.
-type groupSeal struct {
+// GroupSeal specifies the group witness for a seedling in a funded batch.
+type GroupSeal struct {
 	GroupMember  asset.ID
-	GroupWitness []wire.TxWitness
+	GroupWitness wire.TxWitness
 }
 
 // SealParams change how asset groups in a minting batch are created.
 type SealParams struct {
-	GroupWitnesses []groupSeal
+	Batch          BatchTarget
+	GroupWitnesses []GroupSeal
 	// TODO(jhb): follow-up PR: accept a witness for the genesis point here
 	// to enable script-path spends
 }
@@ -205,6 +266,10 @@ const (
 	reqTypeCancelBatch
 	reqTypeFundBatch
 	reqTypeSealBatch
+	reqTypeBumpBatchFee
+	reqTypeListGroupVirtualTxs
+	reqTypeRemoveSeedling
+	reqTypeUpdateSeedling
 )
 
 // ChainPlanter is responsible for accepting new incoming requests to create
@@ -221,9 +286,9 @@ type ChainPlanter struct {
 	// seedlingReqs is used to accept new asset issuance requests.
 	seedlingReqs chan *Seedling
 
-	// pendingBatch is the current pending, non-frozen batch. Only one of
-	// these will exist at any given time.
-	pendingBatch *MintingBatch
+	// pendingBatches is the set of current pending, non-frozen batches,
+	// keyed by their name. The default pending batch has an empty name.
+	pendingBatches map[string]*MintingBatch
 
 	// caretakers maps a batch key (which is used as the internal key for
 	// the transaction that mints the assets) to the caretaker that will
@@ -255,6 +320,7 @@ type ChainPlanter struct {
 func NewChainPlanter(cfg PlanterConfig) *ChainPlanter {
 	return &ChainPlanter{
 		cfg:               cfg,
+		pendingBatches:    make(map[string]*MintingBatch),
 		caretakers:        make(map[BatchKey]*BatchCaretaker),
 		completionSignals: make(chan BatchKey),
 		seedlingReqs:      make(chan *Seedling),
@@ -401,9 +467,14 @@ func (c *ChainPlanter) stopCaretakers() {
 	}
 }
 
-// newBatch creates a new minting batch, which includes deriving a new internal
-// key. The batch is not written to disk nor set as the pending batch.
-func (c *ChainPlanter) newBatch() (*MintingBatch, error) {
+// newBatch creates a new minting batch with the given name, which includes
+// deriving a new internal key. The batch is not written to disk nor added to
+// the pending batches.
+func (c *ChainPlanter) newBatch(name string) (*MintingBatch, error) {
+	if err := validateBatchName(name); err != nil {
+		return nil, err
+	}
+
 	ctx, cancel := c.WithCtxQuit()
 	defer cancel()
 
@@ -428,6 +499,7 @@ func (c *ChainPlanter) newBatch() (*MintingBatch, error) {
 		CreationTime: time.Now(),
 		HeightHint:   currentHeight,
 		BatchKey:     newInternalKey,
+		Name:         name,
 		Seedlings:    make(map[string]*Seedling),
 		AssetMetas:   make(AssetMetas),
 	}
@@ -435,25 +507,77 @@ func (c *ChainPlanter) newBatch() (*MintingBatch, error) {
 	return newBatch, nil
 }
 
+// newGenesisPsbt creates the skeleton PSBT for a genesis TX, which has the
+// anchor output with a dummy script as its first output. If a template PSBT is
+// given, its inputs and outputs are added to the skeleton as well.
+func newGenesisPsbt(template *psbt.Packet) (*psbt.Packet, error) {
+	txTemplate := wire.NewMsgTx(2)
+	txTemplate.AddTxOut(tapsend.CreateDummyOutput())
+	genesisPkt, err := psbt.NewFromUnsignedTx(txTemplate)
+	if err != nil {
+		return nil, fmt.Errorf("unable to make psbt packet: %w", err)
+	}
+
+	if template == nil {
+		return genesisPkt, nil
+	}
+
+	if err := template.SanityCheck(); err != nil {
+		return nil, fmt.Errorf("invalid genesis template: %w", err)
+	}
+
+	// The wallet needs to know the value of the inputs it didn't select
+	// itself to fund the genesis TX.
+	for idx := range template.Inputs {
+		if template.Inputs[idx].WitnessUtxo == nil {
+			return nil, fmt.Errorf("genesis template input %d is "+
+				"missing its witness utxo", idx)
+		}
+	}
+
+	// The dummy script identifies the anchor output until the batch is
+	// finalized, so it can't be used by any other output.
+	for idx, txOut := range template.UnsignedTx.TxOut {
+		if bytes.Equal(txOut.PkScript, tapsend.GenesisDummyScript) {
+			return nil, fmt.Errorf("genesis template output %d "+
+				"uses the genesis dummy script", idx)
+		}
+	}
+
+	// The anchor output stays the first output, so the wallet can append
+	// the change output without affecting the anchor output index.
+	genesisPkt.UnsignedTx.Version = template.UnsignedTx.Version
+	genesisPkt.UnsignedTx.LockTime = template.UnsignedTx.LockTime
+	genesisPkt.UnsignedTx.TxIn = append(
+		genesisPkt.UnsignedTx.TxIn, template.UnsignedTx.TxIn...,
+	)
+	genesisPkt.Inputs = append(genesisPkt.Inputs, template.Inputs...)
+	genesisPkt.UnsignedTx.TxOut = append(
+		genesisPkt.UnsignedTx.TxOut, template.UnsignedTx.TxOut...,
+	)
+	genesisPkt.Outputs = append(genesisPkt.Outputs, template.Outputs...)
+
+	return genesisPkt, nil
+}
+
 // fundGenesisPsbt generates a PSBT packet we'll use to create an asset.  In
 // order to be able to create an asset, we need an initial genesis outpoint. To
 // obtain this we'll ask the wallet to fund a PSBT template for GenesisAmtSats
 // (all outputs need to hold some BTC to not be dust), and with a dummy script.
 // We need to use a dummy script as we can't know the actual script key since
-// that's dependent on the genesis outpoint.
+// that's dependent on the genesis outpoint. If a caller provided template is
+// given, the first input of the template becomes the genesis outpoint.
 func (c *ChainPlanter) fundGenesisPsbt(ctx context.Context,
-	batchKey asset.SerializedKey,
-	manualFeeRate *chainfee.SatPerKWeight) (*tapsend.FundedPsbt, error) {
+	batchKey asset.SerializedKey, manualFeeRate *chainfee.SatPerKWeight,
+	template *psbt.Packet) (*tapsend.FundedPsbt, error) {
 
 	log.Infof("Attempting to fund batch: %x", batchKey)
 
-	// Construct a 1-output TX as a template for our genesis TX, which the
-	// backing wallet will fund.
-	txTemplate := wire.NewMsgTx(2)
-	txTemplate.AddTxOut(tapsend.CreateDummyOutput())
-	genesisPkt, err := psbt.NewFromUnsignedTx(txTemplate)
+	// Construct a TX with the anchor output as a template for our genesis
+	// TX, which the backing wallet will fund.
+	genesisPkt, err := newGenesisPsbt(template)
 	if err != nil {
-		return nil, fmt.Errorf("unable to make psbt packet: %w", err)
+		return nil, err
 	}
 
 	log.Infof("creating skeleton PSBT for batch: %x", batchKey)
@@ -692,27 +816,112 @@ func listBatches(ctx context.Context, batchStore MintingStore,
 	return []*MintingBatch{batch}, nil
 }
 
-// canCancelBatch returns a batch key if the planter is in a state where a batch
-// can be cancelled. This does not account for the state of a caretaker that
-// may be managing a batch.
-func (c *ChainPlanter) canCancelBatch() (*btcec.PublicKey, error) {
-	caretakerCount := len(c.caretakers)
+// noPendingBatchErr returns the error for a request that targets a pending
+// batch that doesn't exist.
+func noPendingBatchErr(target BatchTarget) error {
+	switch {
+	case target.Key != nil:
+		return fmt.Errorf("no pending batch with key %x",
+			target.Key.SerializeCompressed())
+
+	case target.Name != "":
+		return fmt.Errorf("no pending batch with name %q", target.Name)
 
-	switch caretakerCount {
-	case 0:
-		// If there are no caretakers, the only batch we could cancel
-		// would be the current pending batch.
-		if c.pendingBatch == nil {
-			return nil, fmt.Errorf("no pending batch")
+	default:
+		return fmt.Errorf("no pending batch")
+	}
+}
+
+// findPendingBatch returns the pending batch selected by the target, or nil if
+// there is no such batch. If the target specifies a batch key, it must match
+// an existing pending batch.
+func (c *ChainPlanter) findPendingBatch(
+	target BatchTarget) (*MintingBatch, error) {
+
+	if target.Key == nil {
+		return c.pendingBatches[target.Name], nil
+	}
+
+	for _, batch := range c.pendingBatches {
+		if !batch.BatchKey.PubKey.IsEqual(target.Key) {
+			continue
+		}
+
+		if target.Name != "" && target.Name != batch.Name {
+			return nil, fmt.Errorf("pending batch with key %x is "+
+				"named %q, not %q",
+				target.Key.SerializeCompressed(), batch.Name,
+				target.Name)
+		}
+
+		return batch, nil
+	}
+
+	return nil, noPendingBatchErr(target)
+}
+
+// targetPendingBatch returns the existing pending batch selected by the
+// target.
+func (c *ChainPlanter) targetPendingBatch(
+	target BatchTarget) (*MintingBatch, error) {
+
+	batch, err := c.findPendingBatch(target)
+	if err != nil {
+		return nil, err
+	}
+
+	if batch == nil {
+		return nil, noPendingBatchErr(target)
+	}
+
+	return batch, nil
+}
+
+// removePendingBatch removes the pending batch with the given batch key, if
+// there is one.
+func (c *ChainPlanter) removePendingBatch(batchKey *btcec.PublicKey) {
+	for name, batch := range c.pendingBatches {
+		if batch.BatchKey.PubKey.IsEqual(batchKey) {
+			delete(c.pendingBatches, name)
+			return
+		}
+	}
+}
+
+// canCancelBatch returns a batch key if the planter is in a state where the
+// targeted batch can be cancelled. If no batch is targeted, the batch to
+// cancel must be unambiguous. This does not account for the state of a
+// caretaker that may be managing a batch.
+func (c *ChainPlanter) canCancelBatch(
+	target BatchTarget) (*btcec.PublicKey, error) {
+
+	if target.Name != "" || target.Key != nil {
+		// A batch key can also refer to a batch that is already
+		// managed by a caretaker.
+		if target.Key != nil && target.Name == "" {
+			batchKey := asset.ToSerialized(target.Key)
+			if _, ok := c.caretakers[batchKey]; ok {
+				return target.Key, nil
+			}
+		}
+
+		batch, err := c.targetPendingBatch(target)
+		if err != nil {
+			return nil, err
 		}
 
-		return c.pendingBatch.BatchKey.PubKey, nil
+		return batch.BatchKey.PubKey, nil
+	}
+
+	switch len(c.caretakers) + len(c.pendingBatches) {
+	case 0:
+		return nil, fmt.Errorf("no pending batch")
+
 	case 1:
-		// TODO(jhb): Update once we support multiple batches.
-		// If there is exactly one caretaker, our pending batch should
-		// be empty. Otherwise, the batch to cancel is ambiguous.
-		if c.pendingBatch != nil {
-			return nil, fmt.Errorf("multiple batches not supported")
+		// There is exactly one batch, which is either pending or
+		// managed by a caretaker.
+		for _, batch := range c.pendingBatches {
+			return batch.BatchKey.PubKey, nil
 		}
 
 		batchKeys := maps.Keys(c.caretakers)
@@ -722,11 +931,11 @@ func (c *ChainPlanter) canCancelBatch() (*btcec.PublicKey, error) {
 		}
 
 		return batchKey, nil
+
 	default:
+		return nil, fmt.Errorf("multiple batches exist, batch name " +
+			"or key required")
 	}
-
-	// TODO(jhb): Update once we support multiple batches.
-	return nil, fmt.Errorf("multiple caretakers not supported")
 }
 
 // cancelMintingBatch attempts to cancel a target minting batch. This can fail
@@ -764,12 +973,17 @@ func (c *ChainPlanter) cancelMintingBatch(ctx context.Context,
 		}
 	}
 
+	batch, err := c.targetPendingBatch(BatchTarget{Key: batchKey})
+	if err != nil {
+		return err
+	}
+
 	log.Infof("Cancelling MintingBatch(key=%x, num_assets=%v)",
-		batchKeySerialized, len(c.pendingBatch.Seedlings))
+		batchKeySerialized, len(batch.Seedlings))
 
 	// If the target batch was not assigned a caretaker, we only need to
 	// update the batch state on disk to cancel it.
-	err := c.cfg.Log.UpdateBatchState(
+	err = c.cfg.Log.UpdateBatchState(
 		ctx, batchKey, BatchStateSeedlingCancelled,
 	)
 	if err != nil {
@@ -802,7 +1016,7 @@ func (c *ChainPlanter) gardener() {
 			// seedling (soon to be a sprout) by committing it to
 			// disk as part of the latest batch.
 			ctx, cancel := c.WithCtxQuit()
-			err := c.prepAssetSeedling(ctx, req)
+			batch, err := c.prepAssetSeedling(ctx, req)
 			cancel()
 			if err != nil {
 				// Something went wrong, so then an error
@@ -822,7 +1036,7 @@ func (c *ChainPlanter) gardener() {
 			// TODO(roasbeef): extend the ticker by a certain
 			// portion?
 			req.updates <- SeedlingUpdate{
-				PendingBatch: c.pendingBatch,
+				PendingBatch: batch,
 				NewState:     MintingStateSeed,
 			}
 
@@ -853,7 +1067,20 @@ func (c *ChainPlanter) gardener() {
 		case req := <-c.stateReqs:
 			switch req.Type() {
 			case reqTypePendingBatch:
-				req.Resolve(c.pendingBatch)
+				target, err := typedParam[BatchTarget](req)
+				if err != nil {
+					req.Error(fmt.Errorf("bad batch "+
+						"target: %w", err))
+					break
+				}
+
+				batch, err := c.findPendingBatch(*target)
+				if err != nil {
+					req.Error(err)
+					break
+				}
+
+				req.Resolve(batch)
 
 			case reqTypeNumActiveBatches:
 				req.Resolve(len(c.caretakers))
@@ -879,47 +1106,122 @@ func (c *ChainPlanter) gardener() {
 				req.Resolve(batches)
 
 			case reqTypeFundBatch:
-				if c.pendingBatch != nil &&
-					c.pendingBatch.IsFunded() {
+				fundReqParams, err :=
+					typedParam[FundParams](req)
+				if err != nil {
+					req.Error(fmt.Errorf("bad fund "+
+						"params: %w", err))
+					break
+				}
+
+				batch, err := c.findPendingBatch(
+					fundReqParams.Batch,
+				)
+				if err != nil {
+					req.Error(err)
+					break
+				}
 
+				if batch != nil && batch.IsFunded() {
 					req.Error(fmt.Errorf("batch already " +
 						"funded"))
 					break
 				}
 
-				fundReqParams, err :=
-					typedParam[FundParams](req)
+				ctx, cancel := c.WithCtxQuit()
+				batch, err = c.fundBatch(ctx, *fundReqParams)
+				cancel()
 				if err != nil {
-					req.Error(fmt.Errorf("bad fund "+
+					req.Error(fmt.Errorf("unable to fund "+
+						"minting batch: %w", err))
+					break
+				}
+
+				req.Resolve(batch)
+
+			case reqTypeSealBatch:
+				sealReqParams, err :=
+					typedParam[SealParams](req)
+				if err != nil {
+					req.Error(fmt.Errorf("bad seal "+
 						"params: %w", err))
 					break
 				}
 
 				ctx, cancel := c.WithCtxQuit()
-				err = c.fundBatch(ctx, *fundReqParams)
+				batch, err := c.sealPendingBatch(
+					ctx, *sealReqParams,
+				)
 				cancel()
 				if err != nil {
-					req.Error(fmt.Errorf("unable to fund "+
+					req.Error(fmt.Errorf("unable to seal "+
 						"minting batch: %w", err))
 					break
 				}
 
-				req.Resolve(c.pendingBatch)
+				req.Resolve(batch)
 
-			// TODO(jhb): follow-up PR: Implement SealBatch command
-			case reqTypeSealBatch:
-				req.Error(fmt.Errorf("not yet implemented"))
+			case reqTypeListGroupVirtualTxs:
+				target, err := typedParam[BatchTarget](req)
+				if err != nil {
+					req.Error(fmt.Errorf("bad batch "+
+						"target: %w", err))
+					break
+				}
 
-			case reqTypeFinalizeBatch:
-				if c.pendingBatch == nil {
-					req.Error(fmt.Errorf("no pending batch"))
+				unsealed, err := c.unsealedSeedlings(*target)
+				if err != nil {
+					req.Error(err)
 					break
 				}
 
-				batchKey := c.pendingBatch.BatchKey.PubKey
-				batchKeySerial := asset.ToSerialized(batchKey)
-				log.Infof("Finalizing batch %x", batchKeySerial)
+				req.Resolve(unsealed)
+
+			case reqTypeRemoveSeedling:
+				removeReqParams, err :=
+					typedParam[RemoveSeedlingParams](req)
+				if err != nil {
+					req.Error(fmt.Errorf("bad remove "+
+						"seedling params: %w", err))
+					break
+				}
+
+				ctx, cancel := c.WithCtxQuit()
+				batch, err := c.removeSeedling(
+					ctx, *removeReqParams,
+				)
+				cancel()
+				if err != nil {
+					req.Error(fmt.Errorf("unable to "+
+						"remove seedling: %w", err))
+					break
+				}
+
+				req.Resolve(batch)
+
+			case reqTypeUpdateSeedling:
+				updateReqParams, err :=
+					typedParam[UpdateSeedlingParams](req)
+				if err != nil {
+					req.Error(fmt.Errorf("bad update "+
+						"seedling params: %w", err))
+					break
+				}
+
+				ctx, cancel := c.WithCtxQuit()
+				batch, err := c.updateSeedling(
+					ctx, *updateReqParams,
+				)
+				cancel()
+				if err != nil {
+					req.Error(fmt.Errorf("unable to "+
+						"update seedling: %w", err))
+					break
+				}
 
+				req.Resolve(batch)
+
+			case reqTypeFinalizeBatch:
 				finalizeReqParams, err :=
 					typedParam[FinalizeParams](req)
 				if err != nil {
@@ -928,8 +1230,39 @@ func (c *ChainPlanter) gardener() {
 					break
 				}
 
+				batch, err := c.targetPendingBatch(
+					finalizeReqParams.Batch,
+				)
+				if err != nil {
+					req.Error(err)
+					break
+				}
+
+				// A signed genesis PSBT is checked before the
+				// batch is frozen, so an invalid one is only
+				// reported back to the caller.
+				ctx, cancel := c.WithCtxQuit()
+				err = fn.MapOptionZ(
+					finalizeReqParams.SignedGenesisPacket,
+					func(signedPkt psbt.Packet) error {
+						return c.addSignedGenesisPacket(
+							ctx, batch, &signedPkt,
+						)
+					},
+				)
+				cancel()
+				if err != nil {
+					req.Error(fmt.Errorf("invalid signed "+
+						"genesis PSBT: %w", err))
+					break
+				}
+
+				batchKey := batch.BatchKey.PubKey
+				batchKeySerial := asset.ToSerialized(batchKey)
+				log.Infof("Finalizing batch %x", batchKeySerial)
+
 				caretaker, err := c.finalizeBatch(
-					*finalizeReqParams,
+					batch, *finalizeReqParams,
 				)
 				if err != nil {
 					freezeErr := fmt.Errorf("unable to "+
@@ -966,21 +1299,71 @@ func (c *ChainPlanter) gardener() {
 				// Now that we have a caretaker launched for
 				// this batch and broadcast its minting
 				// transaction, we can remove the pending batch.
-				c.pendingBatch = nil
+				delete(c.pendingBatches, batch.Name)
+
+			case reqTypeBumpBatchFee:
+				bumpReqParams, err :=
+					typedParam[BumpFeeParams](req)
+				if err != nil {
+					req.Error(fmt.Errorf("bad bump fee "+
+						"params: %w", err))
+					break
+				}
+
+				batchKey := asset.ToSerialized(
+					bumpReqParams.BatchKey,
+				)
+				caretaker, ok := c.caretakers[batchKey]
+				if !ok {
+					req.Error(fmt.Errorf("no active batch "+
+						"with key %x", batchKey[:]))
+					break
+				}
+
+				// The caretaker signs and publishes the
+				// replacement transaction, so we don't block
+				// the main loop while waiting for it.
+				c.Wg.Add(1)
+				go func() {
+					defer c.Wg.Done()
+
+					ctx, cancel := c.WithCtxQuit()
+					defer cancel()
+
+					err := caretaker.BumpFee(
+						ctx, bumpReqParams.FeeRate,
+					)
+					if err != nil {
+						req.Error(fmt.Errorf("unable "+
+							"to bump batch fee: %w",
+							err))
+						return
+					}
+
+					req.Resolve(caretaker.cfg.Batch)
+				}()
 
 			case reqTypeCancelBatch:
-				batchKey, err := c.canCancelBatch()
+				target, err := typedParam[BatchTarget](req)
+				if err != nil {
+					req.Error(fmt.Errorf("bad batch "+
+						"target: %w", err))
+					break
+				}
+
+				batchKey, err := c.canCancelBatch(*target)
 				if err != nil {
 					req.Error(err)
 					break
 				}
 
-				// Attempt to cancel the current batch, and then
-				// clear the pending batch in the planter.
+				// Attempt to cancel the target batch, and then
+				// remove it from the pending batches in the
+				// planter.
 				ctx, cancel := c.WithCtxQuit()
 				err = c.cancelMintingBatch(ctx, batchKey)
 				cancel()
-				c.pendingBatch = nil
+				c.removePendingBatch(batchKey)
 
 				// Always return the key of the batch we tried
 				// to cancel.
@@ -996,10 +1379,12 @@ func (c *ChainPlanter) gardener() {
 // fundBatch attempts to fund a minting batch and create a funded genesis PSBT.
 // This PSBT is a template that the caretaker will modify when finalizing the
 // batch. If a feerate or tapscript sibling are provided, those will be used
-// when funding the batch. If no pending batch exists, a batch will be created
-// with the funded genesis PSBT. After funding, the pending batch will be
-// saved to disk and updated in memory.
-func (c *ChainPlanter) fundBatch(ctx context.Context, params FundParams) error {
+// when funding the batch. If the target pending batch doesn't exist, a batch
+// will be created with the funded genesis PSBT. After funding, the pending
+// batch will be saved to disk and updated in memory.
+func (c *ChainPlanter) fundBatch(ctx context.Context,
+	params FundParams) (*MintingBatch, error) {
+
 	var (
 		feeRate  *chainfee.SatPerKWeight
 		rootHash *chainhash.Hash
@@ -1010,13 +1395,19 @@ func (c *ChainPlanter) fundBatch(ctx context.Context, params FundParams) error {
 	// disk. The caretaker we start for this batch will use it when deriving
 	// the final Taproot output key.
 	feeRate = params.FeeRate.UnwrapToPtr()
+	template := params.GenesisTemplate.UnwrapToPtr()
 	params.SiblingTapTree.WhenSome(func(tn asset.TapscriptTreeNodes) {
 		rootHash, err = c.cfg.TreeStore.StoreTapscriptTree(ctx, tn)
 	})
 
 	if err != nil {
-		return fmt.Errorf("unable to store tapscript tree for minting "+
-			"batch: %w", err)
+		return nil, fmt.Errorf("unable to store tapscript tree for "+
+			"minting batch: %w", err)
+	}
+
+	batch, err := c.findPendingBatch(params.Batch)
+	if err != nil {
+		return nil, err
 	}
 
 	// Update the batch by adding the sibling root hash and genesis TX.
@@ -1028,7 +1419,9 @@ func (c *ChainPlanter) fundBatch(ctx context.Context, params FundParams) error {
 
 		// Fund the batch with the specified fee rate.
 		batchKey := asset.ToSerialized(batch.BatchKey.PubKey)
-		batchTX, err := c.fundGenesisPsbt(ctx, batchKey, feeRate)
+		batchTX, err := c.fundGenesisPsbt(
+			ctx, batchKey, feeRate, template,
+		)
 		if err != nil {
 			return fmt.Errorf("unable to fund minting PSBT for "+
 				"batch: %x %w", batchKey[:], err)
@@ -1042,114 +1435,407 @@ func (c *ChainPlanter) fundBatch(ctx context.Context, params FundParams) error {
 	switch {
 	// If we don't have a batch, we'll create an empty batch before funding
 	// and writing to disk.
-	case c.pendingBatch == nil:
-		newBatch, err := c.newBatch()
+	case batch == nil:
+		newBatch, err := c.newBatch(params.Batch.Name)
 		if err != nil {
-			return fmt.Errorf("unable to create new batch: %w", err)
+			return nil, fmt.Errorf("unable to create new batch: %w",
+				err)
 		}
 
 		err = updateBatch(newBatch)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 		// Now that we're done populating parts of the batch, write it
 		// to disk.
 		err = c.cfg.Log.CommitMintingBatch(ctx, newBatch)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
-		c.pendingBatch = newBatch
+		c.pendingBatches[newBatch.Name] = newBatch
+		batch = newBatch
 
 	// If we already have a batch, we need to attach the optional sibling
 	// root hash and fund the batch.
-	case c.pendingBatch != nil:
-		err = updateBatch(c.pendingBatch)
+	default:
+		err = updateBatch(batch)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 		// Write the associated sibling root hash and TX to disk.
-		if c.pendingBatch.tapSibling != nil {
+		if batch.tapSibling != nil {
 			err = c.cfg.Log.CommitBatchTapSibling(
-				ctx, c.pendingBatch.BatchKey.PubKey, rootHash,
+				ctx, batch.BatchKey.PubKey, rootHash,
 			)
 			if err != nil {
-				return fmt.Errorf("unable to commit tapscript "+
-					"sibling for minting batch %w", err)
+				return nil, fmt.Errorf("unable to commit "+
+					"tapscript sibling for minting batch "+
+					"%w", err)
 			}
 		}
 
 		err = c.cfg.Log.CommitBatchTx(
-			ctx, c.pendingBatch.BatchKey.PubKey,
-			c.pendingBatch.GenesisPacket,
+			ctx, batch.BatchKey.PubKey, batch.GenesisPacket,
 		)
 		if err != nil {
-			return err
+			return nil, err
 		}
 	}
 
-	return nil
+	// If the seedlings of the batch are already known, the funded genesis
+	// PSBT we return should have the final anchor output script.
+	if err := c.updateGenesisScript(ctx, batch); err != nil {
+		return nil, err
+	}
+
+	return batch, nil
 }
 
-// sealBatch will verify that each grouped asset in the pending batch has an
-// asset group witness, and will attempt to create asset group witnesses when
-// possible if they are not provided. After all asset group witnesses have been
-// validated, they are saved to disk to be used by the caretaker during batch
-// finalization.
-func (c *ChainPlanter) sealBatch(ctx context.Context, _ SealParams) error {
-	// A batch should exist with 1+ seedlings and be funded before being
-	// sealed.
-	if c.pendingBatch == nil {
-		return fmt.Errorf("no pending batch")
-	}
+// updateGenesisScript sets the final script of the anchor output in the
+// genesis PSBT of a funded batch, so the genesis TX can be signed by the
+// caller before the batch is finalized. The anchor output keeps the dummy
+// script while the batch has no seedlings, or while any of its grouped
+// seedlings are missing their asset group witness. The updated genesis PSBT
+// is written to disk.
+func (c *ChainPlanter) updateGenesisScript(ctx context.Context,
+	batch *MintingBatch) error {
 
-	if len(c.pendingBatch.Seedlings) == 0 {
-		return fmt.Errorf("no seedlings in batch")
+	if !batch.IsFunded() {
+		return nil
 	}
 
-	if !c.pendingBatch.IsFunded() {
-		return fmt.Errorf("batch is not funded")
+	var (
+		genesisScript = bytes.Clone(tapsend.GenesisDummyScript)
+		err           error
+	)
+	groupSeedlings, _ := filterSeedlingsWithGroup(batch.Seedlings)
+	haveAssets := len(batch.Seedlings) != 0 &&
+		(len(groupSeedlings) == 0 || c.isBatchSealed(ctx, batch))
+	if haveAssets {
+		genesisScript, err = c.batchGenesisScript(ctx, batch)
+		if err != nil {
+			return fmt.Errorf("unable to create genesis script: %w",
+				err)
+		}
 	}
 
-	// Filter the batch seedlings to only consider those that will become
-	// grouped assets. If there are no such seedlings, then there is nothing
-	// to seal and no action is needed.
-	groupSeedlings, _ := filterSeedlingsWithGroup(c.pendingBatch.Seedlings)
-	if len(groupSeedlings) == 0 {
+	genesisTx := batch.GenesisPacket.Pkt.UnsignedTx
+	anchorOutput := genesisTx.TxOut[batch.anchorOutputIndex()]
+	if bytes.Equal(anchorOutput.PkScript, genesisScript) {
 		return nil
 	}
 
-	// Before we can build the group key requests for each seedling, we must
-	// fetch the genesis point and anchor index for the batch.
-	anchorOutputIndex := uint32(0)
-	if c.pendingBatch.GenesisPacket.ChangeOutputIndex == 0 {
-		anchorOutputIndex = 1
-	}
+	anchorOutput.PkScript = genesisScript
 
-	genesisPoint := extractGenesisOutpoint(
-		c.pendingBatch.GenesisPacket.Pkt.UnsignedTx,
+	return c.cfg.Log.CommitBatchTx(
+		ctx, batch.BatchKey.PubKey, batch.GenesisPacket,
 	)
+}
 
-	// Construct the group key requests and group virtual TXs for each
-	// seedling. With these we can verify provided asset group witnesses,
-	// or attempt to derive asset group witnesses if needed.
-	groupReqs, genTXs, err := c.buildGroupReqs(
-		genesisPoint, anchorOutputIndex, groupSeedlings,
+// batchGenesisScript derives the script of the anchor output of a funded batch
+// from its current seedlings and asset groups.
+func (c *ChainPlanter) batchGenesisScript(ctx context.Context,
+	batch *MintingBatch) ([]byte, error) {
+
+	genesisPoint := extractGenesisOutpoint(
+		batch.GenesisPacket.Pkt.UnsignedTx,
+	)
+	tapCommitment, err := seedlingsToAssetSprouts(
+		ctx, c.cfg.Log, batch, genesisPoint, batch.anchorOutputIndex(),
 	)
 	if err != nil {
-		return fmt.Errorf("unable to build group requests: %w", err)
+		return nil, err
 	}
 
-	assetGroups := make([]*asset.AssetGroup, 0, len(groupReqs))
-	for i := 0; i < len(groupReqs); i++ {
-		// Derive the asset group witness.
-		groupKey, err := asset.DeriveGroupKey(
-			c.cfg.GenSigner, genTXs[i], groupReqs[i], nil,
-		)
-		if err != nil {
-			return err
+	batchSibling, err := loadBatchSibling(ctx, c.cfg.TreeStore, batch)
+	if err != nil {
+		return nil, err
+	}
+
+	// The batch caches its minting output key once it's derived, so we
+	// use a copy to not pin the key while the seedlings can still change.
+	batchCopy := &MintingBatch{
+		BatchKey:            batch.BatchKey,
+		RootAssetCommitment: tapCommitment,
+	}
+
+	return batchCopy.genesisScript(batchSibling)
+}
+
+// addGenesisSignatures checks that the signed genesis PSBT provided by the
+// caller matches the funded genesis PSBT of the batch, and copies the final
+// scripts and witnesses of its finalized inputs to the funded genesis PSBT.
+// The genesis point and the outputs, including the final anchor output, must
+// be unchanged, as the assets of the batch and the signatures of the wallet
+// depend on them.
+func addGenesisSignatures(fundedPkt, signedPkt *psbt.Packet) error {
+	fundedTx := fundedPkt.UnsignedTx
+	signedTx := signedPkt.UnsignedTx
+
+	if len(signedTx.TxIn) == 0 ||
+		extractGenesisOutpoint(signedTx) !=
+			extractGenesisOutpoint(fundedTx) {
+
+		return fmt.Errorf("signed genesis PSBT doesn't spend the " +
+			"genesis point of the batch")
+	}
+
+	if len(signedTx.TxOut) != len(fundedTx.TxOut) {
+		return fmt.Errorf("signed genesis PSBT has %d outputs, "+
+			"expected %d", len(signedTx.TxOut), len(fundedTx.TxOut))
+	}
+	for idx, fundedOut := range fundedTx.TxOut {
+		signedOut := signedTx.TxOut[idx]
+		if signedOut.Value != fundedOut.Value ||
+			!bytes.Equal(signedOut.PkScript, fundedOut.PkScript) {
+
+			return fmt.Errorf("signed genesis PSBT output %d "+
+				"doesn't match the funded genesis PSBT", idx)
+		}
+	}
+
+	// Any other change to the genesis TX, like different wallet inputs,
+	// would also invalidate the signatures.
+	if signedTx.TxHash() != fundedTx.TxHash() ||
+		len(signedPkt.Inputs) != len(fundedPkt.Inputs) {
+
+		return fmt.Errorf("signed genesis PSBT doesn't match the " +
+			"funded genesis PSBT")
+	}
+
+	for idx := range signedPkt.Inputs {
+		signedIn := &signedPkt.Inputs[idx]
+		if signedIn.FinalScriptSig == nil &&
+			signedIn.FinalScriptWitness == nil {
+
+			continue
+		}
+
+		fundedPkt.Inputs[idx].FinalScriptSig = signedIn.FinalScriptSig
+		fundedPkt.Inputs[idx].FinalScriptWitness =
+			signedIn.FinalScriptWitness
+	}
+
+	return nil
+}
+
+// batchGroupReqs builds the group key requests and group virtual TXs for the
+// given grouped seedlings of a funded batch.
+func (c *ChainPlanter) batchGroupReqs(batch *MintingBatch,
+	groupSeedlings map[string]*Seedling) (wire.OutPoint,
+	[]asset.GroupKeyRequest, []asset.GroupVirtualTx, error) {
+
+	// Before we can build the group key requests for each seedling, we must
+	// fetch the genesis point and anchor index for the batch.
+	anchorOutputIndex := batch.anchorOutputIndex()
+	genesisPoint := extractGenesisOutpoint(
+		batch.GenesisPacket.Pkt.UnsignedTx,
+	)
+
+	// Construct the group key requests and group virtual TXs for each
+	// seedling. With these we can verify provided asset group witnesses,
+	// or attempt to derive asset group witnesses if needed.
+	groupReqs, genTXs, err := c.buildGroupReqs(
+		genesisPoint, anchorOutputIndex, groupSeedlings,
+	)
+	if err != nil {
+		return wire.OutPoint{}, nil, nil, fmt.Errorf("unable to build "+
+			"group requests: %w", err)
+	}
+
+	return genesisPoint, groupReqs, genTXs, nil
+}
+
+// isBatchSealed returns true if asset group witnesses for all grouped
+// seedlings of the funded batch are already stored on disk.
+func (c *ChainPlanter) isBatchSealed(ctx context.Context,
+	batch *MintingBatch) bool {
+
+	if !batch.IsFunded() {
+		return false
+	}
+
+	groupSeedlings, _ := filterSeedlingsWithGroup(batch.Seedlings)
+	if len(groupSeedlings) == 0 {
+		return false
+	}
+
+	anchorOutputIndex := batch.anchorOutputIndex()
+	genesisPoint := extractGenesisOutpoint(
+		batch.GenesisPacket.Pkt.UnsignedTx,
+	)
+
+	// If any of the seedling groups can't be found, the batch hasn't been
+	// sealed yet.
+	seedlingGroups, err := c.cfg.Log.FetchSeedlingGroups(
+		ctx, genesisPoint, anchorOutputIndex,
+		maps.Values(groupSeedlings),
+	)
+	if err != nil || len(seedlingGroups) != len(groupSeedlings) {
+		return false
+	}
+
+	return fn.All(seedlingGroups, func(group *asset.AssetGroup) bool {
+		return len(group.GroupKey.Witness) != 0
+	})
+}
+
+// unsealedSeedlings returns the grouped seedlings of the funded target pending
+// batch, along with the group virtual TXs that must be signed to produce their
+// asset group witnesses.
+func (c *ChainPlanter) unsealedSeedlings(
+	target BatchTarget) ([]*UnsealedSeedling, error) {
+
+	batch, err := c.targetPendingBatch(target)
+	if err != nil {
+		return nil, err
+	}
+
+	if !batch.IsFunded() {
+		return nil, fmt.Errorf("batch is not funded")
+	}
+
+	groupSeedlings, _ := filterSeedlingsWithGroup(batch.Seedlings)
+	if len(groupSeedlings) == 0 {
+		return nil, nil
+	}
+
+	_, groupReqs, genTXs, err := c.batchGroupReqs(batch, groupSeedlings)
+	if err != nil {
+		return nil, err
+	}
+
+	unsealed := make([]*UnsealedSeedling, 0, len(groupReqs))
+	for i := range groupReqs {
+		seedlingName := groupReqs[i].NewAsset.Genesis.Tag
+		unsealed = append(unsealed, &UnsealedSeedling{
+			Seedling:       groupSeedlings[seedlingName],
+			GroupReq:       groupReqs[i],
+			GroupVirtualTx: genTXs[i],
+		})
+	}
+
+	return unsealed, nil
+}
+
+// sealPendingBatch seals the target pending batch with the given parameters.
+// A batch can only be sealed once.
+func (c *ChainPlanter) sealPendingBatch(ctx context.Context,
+	params SealParams) (*MintingBatch, error) {
+
+	batch, err := c.targetPendingBatch(params.Batch)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.isBatchSealed(ctx, batch) {
+		return nil, fmt.Errorf("batch already sealed")
+	}
+
+	if err := c.sealBatch(ctx, batch, params); err != nil {
+		return nil, err
+	}
+
+	// Now that the batch is sealed, the anchor output script is final.
+	if err := c.updateGenesisScript(ctx, batch); err != nil {
+		return nil, err
+	}
+
+	return batch, nil
+}
+
+// sealBatch will verify that each grouped asset in the pending batch has an
+// asset group witness, and will attempt to create asset group witnesses when
+// possible if they are not provided. After all asset group witnesses have been
+// validated, they are saved to disk to be used by the caretaker during batch
+// finalization.
+func (c *ChainPlanter) sealBatch(ctx context.Context, batch *MintingBatch,
+	params SealParams) error {
+
+	// A batch should have 1+ seedlings and be funded before being sealed.
+	if len(batch.Seedlings) == 0 {
+		return fmt.Errorf("no seedlings in batch")
+	}
+
+	if !batch.IsFunded() {
+		return fmt.Errorf("batch is not funded")
+	}
+
+	// Filter the batch seedlings to only consider those that will become
+	// grouped assets. If there are no such seedlings, then there is nothing
+	// to seal and no action is needed.
+	groupSeedlings, _ := filterSeedlingsWithGroup(batch.Seedlings)
+	if len(groupSeedlings) == 0 {
+		if len(params.GroupWitnesses) != 0 {
+			return fmt.Errorf("batch has no grouped seedlings")
+		}
+
+		return nil
+	}
+
+	genesisPoint, groupReqs, genTXs, err := c.batchGroupReqs(
+		batch, groupSeedlings,
+	)
+	if err != nil {
+		return err
+	}
+
+	// Any provided asset group witness must belong to a grouped asset of
+	// this batch.
+	groupWitnesses := make(map[asset.ID]wire.TxWitness)
+	for _, seal := range params.GroupWitnesses {
+		if _, ok := groupWitnesses[seal.GroupMember]; ok {
+			return fmt.Errorf("duplicate group witness for asset "+
+				"%v", seal.GroupMember)
+		}
+
+		groupWitnesses[seal.GroupMember] = seal.GroupWitness
+	}
+
+	batchAssetIDs := fn.NewSet(fn.Map(
+		groupReqs, func(req asset.GroupKeyRequest) asset.ID {
+			return req.NewAsset.ID()
+		},
+	)...)
+	for assetID := range groupWitnesses {
+		if !batchAssetIDs.Contains(assetID) {
+			return fmt.Errorf("group witness for unknown asset %v",
+				assetID)
+		}
+	}
+
+	assetGroups := make([]*asset.AssetGroup, 0, len(groupReqs))
+	for i := 0; i < len(groupReqs); i++ {
+		var groupKey *asset.GroupKey
+
+		// Use the asset group witness if one was provided, otherwise
+		// derive it ourselves.
+		witness, ok := groupWitnesses[groupReqs[i].NewAsset.ID()]
+		switch {
+		case ok:
+			groupKey = &asset.GroupKey{
+				RawKey:        groupReqs[i].RawKey,
+				GroupPubKey:   genTXs[i].TweakedKey,
+				TapscriptRoot: groupReqs[i].TapscriptRoot,
+				Witness:       witness,
+			}
+
+		// We can only derive the witness if we hold the group internal
+		// key.
+		case !c.cfg.KeyRing.IsLocalKey(ctx, groupReqs[i].RawKey):
+			return fmt.Errorf("missing group witness for asset %v",
+				groupReqs[i].NewAsset.ID())
+
+		default:
+			groupKey, err = asset.DeriveGroupKey(
+				c.cfg.GenSigner, genTXs[i], groupReqs[i], nil,
+			)
+			if err != nil {
+				return err
+			}
 		}
 
 		// Recreate the asset with the populated group key and validate
@@ -1189,9 +1875,10 @@ func (c *ChainPlanter) sealBatch(ctx context.Context, _ SealParams) error {
 	return nil
 }
 
-// finalizeBatch creates a new caretaker for the batch and starts it.
-func (c *ChainPlanter) finalizeBatch(params FinalizeParams) (*BatchCaretaker,
-	error) {
+// finalizeBatch creates a new caretaker for the given pending batch and starts
+// it.
+func (c *ChainPlanter) finalizeBatch(batch *MintingBatch,
+	params FinalizeParams) (*BatchCaretaker, error) {
 
 	var (
 		feeRate *chainfee.SatPerKWeight
@@ -1202,7 +1889,7 @@ func (c *ChainPlanter) finalizeBatch(params FinalizeParams) (*BatchCaretaker,
 	// funded. If so, reject any provided parameters, as they would conflict
 	// with those previously used for batch funding.
 	haveParams := params.FeeRate.IsSome() || params.SiblingTapTree.IsSome()
-	if haveParams && c.pendingBatch.IsFunded() {
+	if haveParams && batch.IsFunded() {
 		return nil, fmt.Errorf("cannot provide finalize parameters " +
 			"if batch already funded")
 	}
@@ -1221,38 +1908,48 @@ func (c *ChainPlanter) finalizeBatch(params FinalizeParams) (*BatchCaretaker,
 		return nil, fmt.Errorf("unable to store tapscript tree for "+
 			"minting batch: %w", err)
 	}
+
 	// At this point, we have a non-empty batch, so we'll first finalize it
 	// on disk. This means no further seedlings can be added to this batch.
-	err = freezeMintingBatch(ctx, c.cfg.Log, c.pendingBatch)
+	err = freezeMintingBatch(ctx, c.cfg.Log, batch)
 	if err != nil {
 		return nil, err
 	}
 
 	// If the batch already has a funded TX, we can skip funding the batch.
-	if !c.pendingBatch.IsFunded() {
+	if !batch.IsFunded() {
 		// Fund the batch before starting the caretaker. If funding
 		// fails, we can't start a caretaker for the batch, so we'll
-		// clear the pending batch. The batch will exist on disk for
-		// the user to recreate it if necessary.
+		// remove it from the pending batches. The batch will exist on
+		// disk for the user to recreate it if necessary.
 		// TODO(jhb): Don't clear pending batch here
-		err = c.fundBatch(ctx, FundParams(params))
+		_, err = c.fundBatch(ctx, FundParams{
+			Batch: BatchTarget{
+				Key: batch.BatchKey.PubKey,
+			},
+			FeeRate:        params.FeeRate,
+			SiblingTapTree: params.SiblingTapTree,
+		})
 		if err != nil {
-			c.pendingBatch = nil
+			delete(c.pendingBatches, batch.Name)
 			return nil, err
 		}
 	}
 
-	// TODO(jhb): follow-up PR: detect batches that were already sealed
-	err = c.sealBatch(ctx, SealParams{})
-	if err != nil {
-		return nil, err
+	// If the batch wasn't sealed with caller provided asset group
+	// witnesses, we'll derive them now.
+	if !c.isBatchSealed(ctx, batch) {
+		err = c.sealBatch(ctx, batch, SealParams{})
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	// Now that the batch has been frozen on disk, we can update the batch
 	// state to frozen before launching a new caretaker state machine for
 	// the batch that'll drive all the seedlings do adulthood.
-	c.pendingBatch.UpdateState(BatchStateFrozen)
-	caretaker := c.newCaretakerForBatch(c.pendingBatch, feeRate)
+	batch.UpdateState(BatchStateFrozen)
+	caretaker := c.newCaretakerForBatch(batch, feeRate)
 	if err := caretaker.Start(); err != nil {
 		return nil, fmt.Errorf("unable to start new caretaker: %w", err)
 	}
@@ -1260,16 +1957,47 @@ func (c *ChainPlanter) finalizeBatch(params FinalizeParams) (*BatchCaretaker,
 	return caretaker, nil
 }
 
-// PendingBatch returns the current pending batch. If there's no pending batch,
-// then an error is returned.
-func (c *ChainPlanter) PendingBatch() (*MintingBatch, error) {
-	req := newStateReq[*MintingBatch](reqTypePendingBatch)
+// addSignedGenesisPacket adds the signatures of a genesis PSBT signed by the
+// caller to the funded genesis PSBT of the batch, and writes it to disk.
+func (c *ChainPlanter) addSignedGenesisPacket(ctx context.Context,
+	batch *MintingBatch, signedPkt *psbt.Packet) error {
+
+	if !batch.IsFunded() {
+		return fmt.Errorf("batch must be funded before providing a " +
+			"signed genesis PSBT")
+	}
+
+	if err := c.updateGenesisScript(ctx, batch); err != nil {
+		return err
+	}
+
+	genesisTx := batch.GenesisPacket.Pkt.UnsignedTx
+	anchorOutput := genesisTx.TxOut[batch.anchorOutputIndex()]
+	if bytes.Equal(anchorOutput.PkScript, tapsend.GenesisDummyScript) {
+		return fmt.Errorf("batch must be sealed before providing a " +
+			"signed genesis PSBT")
+	}
+
+	err := addGenesisSignatures(batch.GenesisPacket.Pkt, signedPkt)
+	if err != nil {
+		return err
+	}
+
+	return c.cfg.Log.CommitBatchTx(
+		ctx, batch.BatchKey.PubKey, batch.GenesisPacket,
+	)
+}
+
+// PendingBatch returns the pending batch selected by the target. If there's no
+// such pending batch, then nil is returned.
+func (c *ChainPlanter) PendingBatch(target BatchTarget) (*MintingBatch, error) {
+	req := newStateParamReq[*MintingBatch](reqTypePendingBatch, target)
 
 	if !fn.SendOrQuit[stateRequest](c.stateReqs, req, c.Quit) {
 		return nil, fmt.Errorf("chain planter shutting down")
 	}
 
-	return <-req.resp, nil
+	return <-req.resp, <-req.err
 }
 
 // NumActiveBatches returns the total number of active batches that have an
@@ -1298,8 +2026,8 @@ func (c *ChainPlanter) ListBatches(batchKey *btcec.PublicKey) ([]*MintingBatch,
 	return <-req.resp, <-req.err
 }
 
-// FundBatch sends a signal to the planter to fund the current batch, or create
-// a funded batch.
+// FundBatch sends a signal to the planter to fund the target pending batch, or
+// create a funded batch.
 func (c *ChainPlanter) FundBatch(params FundParams) (*MintingBatch, error) {
 	req := newStateParamReq[*MintingBatch](reqTypeFundBatch, params)
 
@@ -1310,8 +2038,8 @@ func (c *ChainPlanter) FundBatch(params FundParams) (*MintingBatch, error) {
 	return <-req.resp, <-req.err
 }
 
-// SealBatch attempts to seal the current batch, by providing or deriving all
-// witnesses necessary to create the final genesis TX.
+// SealBatch attempts to seal the target pending batch, by providing or deriving
+// all witnesses necessary to create the final genesis TX.
 func (c *ChainPlanter) SealBatch(params SealParams) (*MintingBatch, error) {
 	req := newStateParamReq[*MintingBatch](reqTypeSealBatch, params)
 
@@ -1322,7 +2050,25 @@ func (c *ChainPlanter) SealBatch(params SealParams) (*MintingBatch, error) {
 	return <-req.resp, <-req.err
 }
 
-// FinalizeBatch sends a signal to the planter to finalize the current batch.
+// ListGroupVirtualTxs returns the grouped seedlings of the funded target
+// pending batch, along with the group virtual TXs that must be signed to seal
+// the batch.
+func (c *ChainPlanter) ListGroupVirtualTxs(
+	target BatchTarget) ([]*UnsealedSeedling, error) {
+
+	req := newStateParamReq[[]*UnsealedSeedling](
+		reqTypeListGroupVirtualTxs, target,
+	)
+
+	if !fn.SendOrQuit[stateRequest](c.stateReqs, req, c.Quit) {
+		return nil, fmt.Errorf("chain planter shutting down")
+	}
+
+	return <-req.resp, <-req.err
+}
+
+// FinalizeBatch sends a signal to the planter to finalize the target pending
+// batch.
 func (c *ChainPlanter) FinalizeBatch(params FinalizeParams) (*MintingBatch,
 	error) {
 
@@ -1335,9 +2081,26 @@ func (c *ChainPlanter) FinalizeBatch(params FinalizeParams) (*MintingBatch,
 	return <-req.resp, <-req.err
 }
 
-// CancelBatch sends a signal to the planter to cancel the current batch.
-func (c *ChainPlanter) CancelBatch() (*btcec.PublicKey, error) {
-	req := newStateReq[*btcec.PublicKey](reqTypeCancelBatch)
+// BumpBatchFee sends a signal to the planter to replace the genesis TX of a
+// broadcast batch with one that pays a higher fee.
+func (c *ChainPlanter) BumpBatchFee(params BumpFeeParams) (*MintingBatch,
+	error) {
+
+	req := newStateParamReq[*MintingBatch](reqTypeBumpBatchFee, params)
+
+	if !fn.SendOrQuit[stateRequest](c.stateReqs, req, c.Quit) {
+		return nil, fmt.Errorf("chain planter shutting down")
+	}
+
+	return <-req.resp, <-req.err
+}
+
+// CancelBatch sends a signal to the planter to cancel the target batch. If no
+// batch is targeted, the only existing batch is cancelled.
+func (c *ChainPlanter) CancelBatch(target BatchTarget) (*btcec.PublicKey,
+	error) {
+
+	req := newStateParamReq[*btcec.PublicKey](reqTypeCancelBatch, target)
 
 	if !fn.SendOrQuit[stateRequest](c.stateReqs, req, c.Quit) {
 		return nil, fmt.Errorf("chain planter shutting down")
@@ -1347,24 +2110,108 @@ func (c *ChainPlanter) CancelBatch() (*btcec.PublicKey, error) {
 }
 
 // prepAssetSeedling performs some basic validation for the Seedling, then
-// either adds it to an existing pending batch or creates a new batch for it. A
-// bool indicating if a new batch should immediately be created is returned.
+// either adds it to the target pending batch or creates a new batch for it.
+// The batch the seedling was added to is returned.
 func (c *ChainPlanter) prepAssetSeedling(ctx context.Context,
-	req *Seedling) error {
+	req *Seedling) (*MintingBatch, error) {
 
 	// First, we'll perform some basic validation for the seedling.
 	if err := req.validateFields(); err != nil {
-		return err
+		return nil, err
+	}
+
+	batch, err := c.findPendingBatch(req.Batch)
+	if err != nil {
+		return nil, err
 	}
 
 	// The seedling name must be unique within the pending batch.
-	if c.pendingBatch != nil {
-		if _, ok := c.pendingBatch.Seedlings[req.AssetName]; ok {
-			return fmt.Errorf("asset with name %v already in batch",
-				req.AssetName)
+	if batch != nil {
+		if _, ok := batch.Seedlings[req.AssetName]; ok {
+			return nil, fmt.Errorf("asset with name %v already in "+
+				"batch", req.AssetName)
 		}
 	}
 
+	if err := c.prepSeedlingGroup(ctx, batch, req); err != nil {
+		return nil, err
+	}
+
+	// Now that we've validated the seedling, we can derive a script key to
+	// be used for this asset, if an external script key was not provided.
+	if req.ScriptKey.PubKey == nil {
+		scriptKey, err := c.cfg.KeyRing.DeriveNextKey(
+			ctx, asset.TaprootAssetsKeyFamily,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to obtain script key "+
+				"for seedling: %s %w", req.AssetName, err)
+		}
+
+		// Default to BIP86 for the script key tweaking method.
+		req.ScriptKey = asset.NewScriptKeyBip86(scriptKey)
+	}
+
+	// Now that we know the seedling is valid, we'll check to see if a batch
+	// already exists.
+	switch {
+	// No batch, so we'll create a new one with only this seedling as part
+	// of the batch.
+	case batch == nil:
+		newBatch, err := c.newBatch(req.Batch.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		log.Infof("Adding %v to new MintingBatch", req)
+
+		newBatch.Seedlings[req.AssetName] = req
+
+		ctx, cancel := c.WithCtxQuit()
+		defer cancel()
+		err = c.cfg.Log.CommitMintingBatch(ctx, newBatch)
+		if err != nil {
+			return nil, err
+		}
+
+		c.pendingBatches[newBatch.Name] = newBatch
+		batch = newBatch
+
+	// A batch already exists, so we'll add this seedling to the batch,
+	// committing it to disk fully before we move on.
+	default:
+		log.Infof("Adding %v to existing MintingBatch", req)
+
+		batch.Seedlings[req.AssetName] = req
+
+		// Now that we know the seedling is ok, we'll write it to disk.
+		ctx, cancel := c.WithCtxQuit()
+		defer cancel()
+		err := c.cfg.Log.AddSeedlingsToBatch(
+			ctx, batch.BatchKey.PubKey, req,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// If the batch was already funded, its anchor output script changes
+	// with the new seedling.
+	if err := c.updateGenesisScript(ctx, batch); err != nil {
+		return nil, err
+	}
+
+	// Now that we have the batch committed to disk, we'll return back to
+	// the caller the batch the seedling was added to.
+	return batch, nil
+}
+
+// prepSeedlingGroup validates the group settings of a seedling that is added
+// to the given pending batch, which may be nil, and derives a group internal
+// key if the seedling anchors a new asset group without one.
+func (c *ChainPlanter) prepSeedlingGroup(ctx context.Context,
+	batch *MintingBatch, req *Seedling) error {
+
 	// If emission is enabled and a group key is specified, we need to
 	// make sure the asset types match and that we can sign with that key.
 	if req.HasGroupKey() {
@@ -1375,8 +2222,7 @@ func (c *ChainPlanter) prepAssetSeedling(ctx context.Context,
 			groupKeyBytes := req.GroupInfo.GroupPubKey.
 				SerializeCompressed()
 			return fmt.Errorf("group key %x not found: %w",
-				groupKeyBytes, err,
-			)
+				groupKeyBytes, err)
 		}
 
 		if err := req.validateGroupKey(*groupInfo); err != nil {
@@ -1389,13 +2235,12 @@ func (c *ChainPlanter) prepAssetSeedling(ctx context.Context,
 	// If a group anchor is specified, we need to ensure that the anchor
 	// seedling is already in the batch and has emission enabled.
 	if req.GroupAnchor != nil {
-		if c.pendingBatch == nil {
+		if batch == nil {
 			return fmt.Errorf("batch empty, group anchor %v "+
 				"invalid", *req.GroupAnchor)
 		}
 
-		err := c.pendingBatch.validateGroupAnchor(req)
-		if err != nil {
+		if err := batch.validateGroupAnchor(req); err != nil {
 			return err
 		}
 	}
@@ -1404,13 +2249,13 @@ func (c *ChainPlanter) prepAssetSeedling(ctx context.Context,
 	// also be enabled.
 	if !req.EnableEmission {
 		if req.GroupInternalKey != nil {
-			return fmt.Errorf("cannot specify group internal key " +
-				"without enabling emission")
+			return fmt.Errorf("cannot specify group internal " +
+				"key without enabling emission")
 		}
 
 		if req.GroupTapscriptRoot != nil {
-			return fmt.Errorf("cannot specify group tapscript " +
-				"root without enabling emission")
+			return fmt.Errorf("cannot specify group " +
+				"tapscript root without enabling emission")
 		}
 	}
 
@@ -1421,75 +2266,157 @@ func (c *ChainPlanter) prepAssetSeedling(ctx context.Context,
 			ctx, asset.TaprootAssetsKeyFamily,
 		)
 		if err != nil {
-			return fmt.Errorf("unable to obtain internal key for "+
-				"group key for seedling: %s %w", req.AssetName,
-				err)
+			return fmt.Errorf("unable to obtain internal "+
+				"key for group key for seedling: %s %w",
+				req.AssetName, err)
 		}
 
 		req.GroupInternalKey = &groupInternalKey
 	}
 
-	// Now that we've validated the seedling, we can derive a script key to
-	// be used for this asset, if an external script key was not provided.
-	if req.ScriptKey.PubKey == nil {
-		scriptKey, err := c.cfg.KeyRing.DeriveNextKey(
-			ctx, asset.TaprootAssetsKeyFamily,
-		)
-		if err != nil {
-			return fmt.Errorf("unable to obtain script key for "+
-				"seedling: %s %w", req.AssetName, err)
-		}
+	return nil
+}
 
-		// Default to BIP86 for the script key tweaking method.
-		req.ScriptKey = asset.NewScriptKeyBip86(scriptKey)
+// editablePendingBatch returns the target pending batch, if its seedlings can
+// still be changed. Once a batch is sealed, the asset group witnesses commit
+// to the seedlings of the batch.
+func (c *ChainPlanter) editablePendingBatch(ctx context.Context,
+	target BatchTarget) (*MintingBatch, error) {
+
+	batch, err := c.targetPendingBatch(target)
+	if err != nil {
+		return nil, err
 	}
 
-	// Now that we know the seedling is valid, we'll check to see if a batch
-	// already exists.
-	switch {
-	// No batch, so we'll create a new one with only this seedling as part
-	// of the batch.
-	case c.pendingBatch == nil:
-		newBatch, err := c.newBatch()
-		if err != nil {
-			return err
-		}
+	if c.isBatchSealed(ctx, batch) {
+		return nil, fmt.Errorf("batch already sealed")
+	}
 
-		log.Infof("Adding %v to new MintingBatch", req)
+	return batch, nil
+}
 
-		newBatch.Seedlings[req.AssetName] = req
+// removeSeedling removes a seedling from the target pending batch. A seedling
+// that is the group anchor of other seedlings in the batch can't be removed.
+func (c *ChainPlanter) removeSeedling(ctx context.Context,
+	params RemoveSeedlingParams) (*MintingBatch, error) {
 
-		ctx, cancel := c.WithCtxQuit()
-		defer cancel()
-		err = c.cfg.Log.CommitMintingBatch(ctx, newBatch)
-		if err != nil {
-			return err
+	batch, err := c.editablePendingBatch(ctx, params.Batch)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := batch.Seedlings[params.AssetName]; !ok {
+		return nil, fmt.Errorf("asset with name %v not in batch",
+			params.AssetName)
+	}
+
+	if err := batch.validateNoGroupMembers(params.AssetName); err != nil {
+		return nil, err
+	}
+
+	err = c.cfg.Log.RemoveSeedlingFromBatch(
+		ctx, batch.BatchKey.PubKey, params.AssetName,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Infof("Removed seedling %v from MintingBatch(key=%x)",
+		params.AssetName, batch.BatchKey.PubKey.SerializeCompressed())
+
+	delete(batch.Seedlings, params.AssetName)
+
+	if err := c.updateGenesisScript(ctx, batch); err != nil {
+		return nil, err
+	}
+
+	return batch, nil
+}
+
+// updateSeedling changes the amount, metadata or group settings of a seedling
+// in the target pending batch. The updated seedling is validated in the same
+// way as a new seedling, and it must not orphan any seedlings that use it as
+// their group anchor.
+func (c *ChainPlanter) updateSeedling(ctx context.Context,
+	params UpdateSeedlingParams) (*MintingBatch, error) {
+
+	batch, err := c.editablePendingBatch(ctx, params.Batch)
+	if err != nil {
+		return nil, err
+	}
+
+	oldSeedling, ok := batch.Seedlings[params.AssetName]
+	if !ok {
+		return nil, fmt.Errorf("asset with name %v not in batch",
+			params.AssetName)
+	}
+
+	// We apply the changes to a copy of the seedling, so the batch is only
+	// modified once the new seedling is valid and written to disk.
+	seedling := *oldSeedling
+	params.Amount.WhenSome(func(amt uint64) {
+		seedling.Amount = amt
+	})
+	params.Meta.WhenSome(func(meta *proof.MetaReveal) {
+		seedling.Meta = meta
+	})
+	params.Group.WhenSome(func(group SeedlingGroupParams) {
+		seedling.EnableEmission = group.EnableEmission
+		seedling.GroupInfo = group.GroupInfo
+		seedling.GroupAnchor = group.GroupAnchor
+		seedling.GroupInternalKey = group.GroupInternalKey
+		seedling.GroupTapscriptRoot = group.GroupTapscriptRoot
+
+		// If the seedling keeps anchoring a new asset group, we can
+		// re-use its existing group internal key.
+		keepGroupKey := seedling.EnableEmission &&
+			oldSeedling.EnableEmission
+		if keepGroupKey && seedling.GroupInternalKey == nil {
+			seedling.GroupInternalKey = oldSeedling.GroupInternalKey
 		}
+	})
 
-		c.pendingBatch = newBatch
+	if err := seedling.validateFields(); err != nil {
+		return nil, err
+	}
 
-	// A batch already exists, so we'll add this seedling to the batch,
-	// committing it to disk fully before we move on.
-	case c.pendingBatch != nil:
-		log.Infof("Adding %v to existing MintingBatch", req)
+	if seedling.GroupAnchor != nil &&
+		*seedling.GroupAnchor == seedling.AssetName {
 
-		c.pendingBatch.Seedlings[req.AssetName] = req
+		return nil, fmt.Errorf("seedling %v cannot be its own group "+
+			"anchor", seedling.AssetName)
+	}
 
-		// Now that we know the seedling is ok, we'll write it to disk.
-		ctx, cancel := c.WithCtxQuit()
-		defer cancel()
-		err := c.cfg.Log.AddSeedlingsToBatch(
-			ctx, c.pendingBatch.BatchKey.PubKey, req,
-		)
+	// Other seedlings in the batch can only keep using this seedling as
+	// their group anchor if it still anchors a new asset group.
+	if !seedling.EnableEmission {
+		err := batch.validateNoGroupMembers(seedling.AssetName)
 		if err != nil {
-			return err
+			return nil, err
 		}
 	}
 
-	// Now that we have the batch committed to disk, we'll return back to
-	// the caller if we should finalize the batch immediately or not based
-	// on its preference.
-	return nil
+	if err := c.prepSeedlingGroup(ctx, batch, &seedling); err != nil {
+		return nil, err
+	}
+
+	err = c.cfg.Log.UpdateSeedlingInBatch(
+		ctx, batch.BatchKey.PubKey, &seedling,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Infof("Updated %v in MintingBatch(key=%x)", seedling,
+		batch.BatchKey.PubKey.SerializeCompressed())
+
+	batch.Seedlings[seedling.AssetName] = &seedling
+
+	if err := c.updateGenesisScript(ctx, batch); err != nil {
+		return nil, err
+	}
+
+	return batch, nil
 }
 
 // updateMintingProofs is called by the re-org watcher when it detects a re-org
@@ -1588,14 +2515,37 @@ func (c *ChainPlanter) QueueNewSeedling(req *Seedling) (SeedlingUpdates, error)
 	return req.updates, nil
 }
 
-// CancelSeedling attempts to cancel the creation of a new asset identified by
-// its name. If the seedling has already progressed to a point where the
-// genesis PSBT has been broadcasted, an error is returned.
+// RemoveSeedling removes a seedling, identified by its name, from the target
+// pending batch. The batch must not be sealed yet.
 //
 // NOTE: This is part of the Planter interface.
-func (c *ChainPlanter) CancelSeedling() error {
-	// TODO(roasbeef): actually needed?
-	return nil
+func (c *ChainPlanter) RemoveSeedling(
+	params RemoveSeedlingParams) (*MintingBatch, error) {
+
+	req := newStateParamReq[*MintingBatch](reqTypeRemoveSeedling, params)
+
+	if !fn.SendOrQuit[stateRequest](c.stateReqs, req, c.Quit) {
+		return nil, fmt.Errorf("chain planter shutting down")
+	}
+
+	return <-req.resp, <-req.err
+}
+
+// UpdateSeedling changes the amount, metadata or group settings of a
+// seedling, identified by its name, in the target pending batch. The batch
+// must not be sealed yet.
+//
+// NOTE: This is part of the Planter interface.
+func (c *ChainPlanter) UpdateSeedling(
+	params UpdateSeedlingParams) (*MintingBatch, error) {
+
+	req := newStateParamReq[*MintingBatch](reqTypeUpdateSeedling, params)
+
+	if !fn.SendOrQuit[stateRequest](c.stateReqs, req, c.Quit) {
+		return nil, fmt.Errorf("chain planter shutting down")
+	}
+
+	return <-req.resp, <-req.err
 }
 
 // RegisterSubscriber adds a new subscriber to the set of subscribers that will
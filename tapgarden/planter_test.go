@@ -37,6 +37,7 @@ import (
 	"github.com/lightningnetwork/lnd/lntest/wait"
 	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/exp/maps"
 )
 
 // Default to a large interval so the planter never actually ticks and only
@@ -250,7 +251,7 @@ func (t *mintingTestHarness) queueSeedlingsInBatch(isFunded bool,
 func (t *mintingTestHarness) assertPendingBatchExists(numSeedlings int) {
 	t.Helper()
 
-	batch, err := t.planter.PendingBatch()
+	batch, err := t.planter.PendingBatch(tapgarden.BatchTarget{})
 	require.NoError(t, err)
 	require.NotNil(t, batch)
 	require.Len(t, batch.Seedlings, numSeedlings)
@@ -403,8 +404,13 @@ func (t *mintingTestHarness) progressCaretaker(isFunded bool,
 	tx := t.assertTxPublished()
 
 	// With the transaction published, we should now receive a confirmation
-	// request. To ensure the file proof is constructed properly, we'll
-	// also make a "fake" block that includes our transaction.
+	// request.
+	return t.assertConfReqSent(tx, newConfBlock(tx))
+}
+
+// newConfBlock creates a "fake" block that includes the given transaction, to
+// ensure the file proof of a confirmed batch is constructed properly.
+func newConfBlock(tx *wire.MsgTx) *wire.MsgBlock {
 	merkleTree := blockchain.BuildMerkleTreeStore(
 		[]*btcutil.Tx{btcutil.NewTx(tx)}, false,
 	)
@@ -412,12 +418,11 @@ func (t *mintingTestHarness) progressCaretaker(isFunded bool,
 	blockHeader := wire.NewBlockHeader(
 		0, chaincfg.MainNetParams.GenesisHash, merkleRoot, 0, 0,
 	)
-	block := &wire.MsgBlock{
+
+	return &wire.MsgBlock{
 		Header:       *blockHeader,
 		Transactions: []*wire.MsgTx{tx},
 	}
-
-	return t.assertConfReqSent(tx, block)
 }
 
 // finalizeBatchAssertFrozen fires the ticker that forces the planter to create
@@ -507,7 +512,7 @@ func (t *mintingTestHarness) assertNewBatchFrozen(
 func (t *mintingTestHarness) cancelMintingBatch(noBatch bool) *btcec.PublicKey {
 	t.Helper()
 
-	batchKey, err := t.planter.CancelBatch()
+	batchKey, err := t.planter.CancelBatch(tapgarden.BatchTarget{})
 	if noBatch {
 		require.ErrorContains(t, err, "no pending batch")
 		require.Nil(t, batchKey)
@@ -893,17 +898,18 @@ func (t *mintingTestHarness) assertGenesisPsbtFinalized(
 	)
 	require.NoError(t, err, "psbt sign req not sent")
 
-	// TODO(jhb): fix for multibatch?
-	// Next fetch the minting key of the batch.
+	// Next fetch the minting key of the batch. Other batches may still be
+	// pending, so we only look at batches that have been frozen.
 	pendingBatches, err := t.store.FetchNonFinalBatches(
 		context.Background(),
 	)
 	require.NoError(t, err)
 
-	isNotCancelledBatch := func(batch *tapgarden.MintingBatch) bool {
-		return !isCancelledBatch(batch)
+	isFrozenBatch := func(batch *tapgarden.MintingBatch) bool {
+		return !isCancelledBatch(batch) &&
+			batch.State() != tapgarden.BatchStatePending
 	}
-	pendingBatch, err := fn.Last(pendingBatches, isNotCancelledBatch)
+	pendingBatch, err := fn.Last(pendingBatches, isFrozenBatch)
 	require.NoError(t, err)
 
 	// The minting key of the batch should match the public key
@@ -1675,6 +1681,796 @@ func testFundBeforeFinalize(t *mintingTestHarness) {
 	t.assertMintOutputKey(mintedBatch, &defaultTapHash)
 }
 
+// testSealBatchWithExternalWitness tests that the asset group witnesses of a
+// funded batch can be created externally, and that the batch is then minted
+// with those witnesses.
+func testSealBatchWithExternalWitness(t *mintingTestHarness) {
+	// First, create a new chain planter instance using the supplied test
+	// harness.
+	t.refreshChainPlanter()
+
+	var (
+		wg               sync.WaitGroup
+		respChan         = make(chan *FundBatchResp, 1)
+		finalizeRespChan = make(chan *FinalizeBatchResp, 1)
+	)
+
+	// Sealing a batch or listing its group virtual TXs requires a funded
+	// pending batch.
+	_, err := t.planter.SealBatch(tapgarden.SealParams{})
+	require.ErrorContains(t, err, "no pending batch")
+
+	_, err = t.planter.ListGroupVirtualTxs(
+		tapgarden.BatchTarget{},
+	)
+	require.ErrorContains(t, err, "no pending batch")
+
+	manualFee := chainfee.FeePerKwFloor * 2
+	fundReq := tapgarden.FundParams{
+		FeeRate: fn.Some(manualFee),
+	}
+	t.fundBatch(&wg, respChan, &fundReq)
+
+	t.assertKeyDerived()
+	t.assertGenesisTxFunded(&manualFee)
+	t.assertFundBatch(&wg, respChan, "")
+
+	// The group internal key of the first seedling is held externally, so
+	// it isn't known to the key ring of the planter. The second seedling
+	// is a member of that new asset group, and the third seedling is
+	// ungrouped.
+	groupKeyDesc, groupKeyPriv := test.RandKeyDesc(t)
+
+	seedlings := t.newRandSeedlings(3)
+	seedlings[0].EnableEmission = true
+	seedlings[0].GroupInternalKey = &groupKeyDesc
+
+	seedlings[1].EnableEmission = false
+	seedlings[1].GroupAnchor = &seedlings[0].AssetName
+	seedlings[1].AssetType = seedlings[0].AssetType
+	seedlings[1].Amount = 1
+
+	seedlings[2].EnableEmission = false
+
+	t.queueSeedlingsInBatch(true, seedlings...)
+	t.assertPendingBatchExists(len(seedlings))
+
+	// There should be a group virtual TX for each grouped seedling, which
+	// must be signed with the external group internal key.
+	unsealedSeedlings, err := t.planter.ListGroupVirtualTxs(
+		tapgarden.BatchTarget{},
+	)
+	require.NoError(t, err)
+	require.Len(t, unsealedSeedlings, 2)
+
+	// The planter can't seal the batch by itself.
+	_, err = t.planter.SealBatch(tapgarden.SealParams{})
+	require.ErrorContains(t, err, "missing group witness")
+
+	// We'll now sign the group virtual TXs with the external key.
+	externalSigner := asset.NewMockGenesisSigner(groupKeyPriv)
+	groupWitnesses := make(
+		[]tapgarden.GroupSeal, 0, len(unsealedSeedlings),
+	)
+	for _, unsealed := range unsealedSeedlings {
+		require.True(t, unsealed.GroupReq.RawKey.PubKey.IsEqual(
+			groupKeyDesc.PubKey,
+		))
+
+		groupKey, err := asset.DeriveGroupKey(
+			externalSigner, unsealed.GroupVirtualTx,
+			unsealed.GroupReq, nil,
+		)
+		require.NoError(t, err)
+
+		groupWitnesses = append(groupWitnesses, tapgarden.GroupSeal{
+			GroupMember:  unsealed.GroupReq.NewAsset.ID(),
+			GroupWitness: groupKey.Witness,
+		})
+	}
+
+	// Witnesses for assets that aren't part of the batch, or witnesses
+	// that don't belong to the asset they're provided for, are rejected.
+	_, err = t.planter.SealBatch(tapgarden.SealParams{
+		GroupWitnesses: []tapgarden.GroupSeal{{
+			GroupMember:  asset.RandID(t),
+			GroupWitness: groupWitnesses[0].GroupWitness,
+		}},
+	})
+	require.ErrorContains(t, err, "group witness for unknown asset")
+
+	_, err = t.planter.SealBatch(tapgarden.SealParams{
+		GroupWitnesses: []tapgarden.GroupSeal{{
+			GroupMember:  groupWitnesses[0].GroupMember,
+			GroupWitness: groupWitnesses[1].GroupWitness,
+		}, {
+			GroupMember:  groupWitnesses[1].GroupMember,
+			GroupWitness: groupWitnesses[0].GroupWitness,
+		}},
+	})
+	require.ErrorContains(t, err, "unable to verify asset group witness")
+
+	// With the correct witnesses, the batch can be sealed, but only once.
+	_, err = t.planter.SealBatch(tapgarden.SealParams{
+		GroupWitnesses: groupWitnesses,
+	})
+	require.NoError(t, err)
+
+	_, err = t.planter.SealBatch(tapgarden.SealParams{
+		GroupWitnesses: groupWitnesses,
+	})
+	require.ErrorContains(t, err, "batch already sealed")
+
+	// Finalizing the batch should use the stored group witnesses instead
+	// of trying to derive them.
+	t.finalizeBatch(&wg, finalizeRespChan, nil)
+	t.assertBatchProgressing()
+	t.assertNoPendingBatch()
+
+	sendConfNtfn := t.progressCaretaker(true, nil, &manualFee)
+	t.assertFinalizeBatch(&wg, finalizeRespChan, "")
+	t.assertSeedlingsMatchSprouts(seedlings)
+
+	sendConfNtfn()
+
+	t.assertNoError()
+	t.assertNumCaretakersActive(0)
+	t.assertLastBatchState(1, tapgarden.BatchStateFinalized)
+}
+
+// testFundBatchWithTemplate tests that a batch can be funded on top of a
+// caller provided PSBT template, and that the inputs and outputs of the
+// template end up in the minting transaction.
+func testFundBatchWithTemplate(t *mintingTestHarness) {
+	// First, create a new chain planter instance using the supplied test
+	// harness.
+	t.refreshChainPlanter()
+
+	var (
+		wg               sync.WaitGroup
+		respChan         = make(chan *FundBatchResp, 1)
+		finalizeRespChan = make(chan *FinalizeBatchResp, 1)
+	)
+
+	// The template spends a specific UTXO and pays a partner output, which
+	// uses a non-P2TR script to avoid generating exclusion proofs.
+	templateInput := wire.OutPoint{
+		Hash:  test.RandHash(),
+		Index: test.RandInt[uint32](),
+	}
+	partnerOutput := &wire.TxOut{
+		Value:    5_000,
+		PkScript: bytes.Clone(tapsend.GenesisDummyScript),
+	}
+	partnerOutput.PkScript[0] = txscript.OP_0
+
+	newTemplate := func() *psbt.Packet {
+		templateTx := wire.NewMsgTx(2)
+		templateTx.AddTxIn(&wire.TxIn{
+			PreviousOutPoint: templateInput,
+		})
+		templateTx.AddTxOut(partnerOutput)
+
+		template, err := psbt.NewFromUnsignedTx(templateTx)
+		require.NoError(t, err)
+
+		template.Inputs[0].WitnessUtxo = &wire.TxOut{
+			Value:    10_000,
+			PkScript: bytes.Clone(tapsend.GenesisDummyScript),
+		}
+
+		return template
+	}
+
+	// A template must not contain an output with the genesis dummy
+	// script, as that identifies the anchor output.
+	invalidTemplate := newTemplate()
+	invalidTemplate.UnsignedTx.AddTxOut(tapsend.CreateDummyOutput())
+	invalidTemplate.Outputs = append(
+		invalidTemplate.Outputs, psbt.POutput{},
+	)
+
+	manualFee := chainfee.FeePerKwFloor * 2
+	fundReq := tapgarden.FundParams{
+		FeeRate:         fn.Some(manualFee),
+		GenesisTemplate: fn.Some(*invalidTemplate),
+	}
+	t.fundBatch(&wg, respChan, &fundReq)
+
+	t.assertKeyDerived()
+	t.assertFundBatch(&wg, respChan, "genesis dummy script")
+	t.assertNoPendingBatch()
+
+	// Each template input must also have its witness UTXO set.
+	invalidTemplate = newTemplate()
+	invalidTemplate.Inputs[0].WitnessUtxo = nil
+
+	fundReq.GenesisTemplate = fn.Some(*invalidTemplate)
+	t.fundBatch(&wg, respChan, &fundReq)
+
+	t.assertKeyDerived()
+	t.assertFundBatch(&wg, respChan, "missing its witness utxo")
+	t.assertNoPendingBatch()
+
+	// With a valid template, the anchor output should be the first output,
+	// followed by the template output and the change output added by the
+	// wallet. The template input is the genesis point of the batch.
+	fundReq.GenesisTemplate = fn.Some(*newTemplate())
+	t.fundBatch(&wg, respChan, &fundReq)
+
+	t.assertKeyDerived()
+	t.assertGenesisTxFunded(&manualFee)
+	fundedBatch := t.assertFundBatch(&wg, respChan, "")
+
+	fundedPkt := fundedBatch.GenesisPacket
+	require.NotNil(t, fundedPkt)
+
+	fundedTx := fundedPkt.Pkt.UnsignedTx
+	require.Len(t, fundedTx.TxIn, 2)
+	require.Len(t, fundedTx.TxOut, 3)
+	require.Equal(t, templateInput, fundedTx.TxIn[0].PreviousOutPoint)
+	require.Equal(
+		t, tapsend.GenesisDummyScript[:], fundedTx.TxOut[0].PkScript,
+	)
+	require.Equal(t, partnerOutput, fundedTx.TxOut[1])
+	require.EqualValues(t, 2, fundedPkt.ChangeOutputIndex)
+
+	// Once seedlings are added to the funded batch, the anchor output
+	// gets its final script, so the genesis TX can be signed.
+	seedlings := t.newRandSeedlings(3)
+	for _, seedling := range seedlings {
+		seedling.EnableEmission = false
+	}
+	t.queueSeedlingsInBatch(true, seedlings...)
+	t.assertPendingBatchExists(len(seedlings))
+
+	pendingBatch, err := t.planter.PendingBatch(tapgarden.BatchTarget{})
+	require.NoError(t, err)
+
+	genesisPkt := pendingBatch.GenesisPacket.Pkt
+	require.NotEqual(
+		t, tapsend.GenesisDummyScript[:],
+		genesisPkt.UnsignedTx.TxOut[0].PkScript,
+	)
+
+	// The caller signs the template input of a copy of the genesis PSBT.
+	newSignedPkt := func() *psbt.Packet {
+		var buf bytes.Buffer
+		require.NoError(t, genesisPkt.Serialize(&buf))
+
+		signedPkt, err := psbt.NewFromRawBytes(&buf, false)
+		require.NoError(t, err)
+
+		var witness bytes.Buffer
+		err = psbt.WriteTxWitness(
+			&witness, wire.TxWitness{test.RandBytes(64)},
+		)
+		require.NoError(t, err)
+		signedPkt.Inputs[0].FinalScriptWitness = witness.Bytes()
+
+		return signedPkt
+	}
+
+	// A signed PSBT that changes an output or the genesis point is
+	// rejected, and the batch stays pending.
+	invalidPkt := newSignedPkt()
+	invalidPkt.UnsignedTx.TxOut[1].Value--
+	t.finalizeBatch(&wg, finalizeRespChan, &tapgarden.FinalizeParams{
+		SignedGenesisPacket: fn.Some(*invalidPkt),
+	})
+	t.assertFinalizeBatch(
+		&wg, finalizeRespChan, "output 1 doesn't match the funded "+
+			"genesis PSBT",
+	)
+
+	invalidPkt = newSignedPkt()
+	invalidPkt.UnsignedTx.TxIn[0].PreviousOutPoint.Index++
+	t.finalizeBatch(&wg, finalizeRespChan, &tapgarden.FinalizeParams{
+		SignedGenesisPacket: fn.Some(*invalidPkt),
+	})
+	t.assertFinalizeBatch(
+		&wg, finalizeRespChan, "doesn't spend the genesis point",
+	)
+	t.assertPendingBatchExists(len(seedlings))
+
+	// With the signed template input, the wallet only needs to sign its
+	// own input, and the batch is minted. The minting transaction should
+	// still pay the partner output.
+	signedPkt := newSignedPkt()
+	t.finalizeBatch(&wg, finalizeRespChan, &tapgarden.FinalizeParams{
+		SignedGenesisPacket: fn.Some(*signedPkt),
+	})
+	t.assertBatchProgressing()
+	t.assertNoPendingBatch()
+
+	sendConfNtfn := t.progressCaretaker(true, nil, &manualFee)
+	mintedBatch := t.assertFinalizeBatch(&wg, finalizeRespChan, "")
+	t.assertSeedlingsMatchSprouts(seedlings)
+
+	mintTx, err := psbt.Extract(mintedBatch.GenesisPacket.Pkt)
+	require.NoError(t, err)
+	require.Equal(t, templateInput, mintTx.TxIn[0].PreviousOutPoint)
+	require.Equal(
+		t, signedPkt.Inputs[0].FinalScriptWitness,
+		mintedBatch.GenesisPacket.Pkt.Inputs[0].FinalScriptWitness,
+	)
+	require.Equal(
+		t, genesisPkt.UnsignedTx.TxOut[0].PkScript,
+		mintTx.TxOut[0].PkScript,
+	)
+	require.Equal(t, partnerOutput, mintTx.TxOut[1])
+
+	sendConfNtfn()
+
+	t.assertNoError()
+	t.assertNumCaretakersActive(0)
+	t.assertLastBatchState(1, tapgarden.BatchStateFinalized)
+}
+
+// testBumpBatchFee tests that the minting transaction of a broadcast batch can
+// be replaced with one that pays a higher fee, and that the batch is then
+// finalized with the replacement transaction.
+func testBumpBatchFee(t *mintingTestHarness) {
+	// First, create a new chain planter instance using the supplied test
+	// harness.
+	t.refreshChainPlanter()
+
+	var (
+		wg       sync.WaitGroup
+		respChan = make(chan *FinalizeBatchResp, 1)
+	)
+
+	// Queue and finalize a batch, which will publish its minting
+	// transaction.
+	const numSeedlings = 5
+	seedlings := t.queueInitialBatch(numSeedlings)
+
+	t.finalizeBatch(&wg, respChan, nil)
+	_ = t.progressCaretaker(false, nil, nil)
+	batch := t.assertFinalizeBatch(&wg, respChan, "")
+	batchKey := batch.BatchKey.PubKey
+
+	oldPkt := batch.GenesisPacket
+	oldTx, err := psbt.Extract(oldPkt.Pkt)
+	require.NoError(t, err)
+	oldFee, err := oldPkt.Pkt.GetTxFee()
+	require.NoError(t, err)
+
+	weight := blockchain.GetTransactionWeight(btcutil.NewTx(oldTx))
+	oldFeeRate := chainfee.NewSatPerKWeight(oldFee, uint64(weight))
+
+	// Bumping the fee of an unknown batch should fail.
+	_, err = t.planter.BumpBatchFee(tapgarden.BumpFeeParams{
+		BatchKey: test.RandPubKey(t),
+		FeeRate:  oldFeeRate * 2,
+	})
+	require.ErrorContains(t, err, "no active batch")
+
+	// The replacement transaction must pay a higher fee than the broadcast
+	// one.
+	_, err = t.planter.BumpBatchFee(tapgarden.BumpFeeParams{
+		BatchKey: batchKey,
+		FeeRate:  oldFeeRate,
+	})
+	require.ErrorIs(t, err, tapgarden.ErrFeeBumpTooLow)
+
+	// Now we'll bump the fee for real. The caretaker should sign and
+	// publish a new transaction, and then wait for it to confirm.
+	newFeeRate := chainfee.NewSatPerKWeight(
+		oldFee+10_000, uint64(weight),
+	)
+	bumpRespChan := make(chan *FinalizeBatchResp, 1)
+	go func() {
+		bumpedBatch, err := t.planter.BumpBatchFee(
+			tapgarden.BumpFeeParams{
+				BatchKey: batchKey,
+				FeeRate:  newFeeRate,
+			},
+		)
+		bumpRespChan <- &FinalizeBatchResp{
+			Batch: bumpedBatch,
+			Err:   err,
+		}
+	}()
+
+	_, err = fn.RecvOrTimeout(t.wallet.SignPsbtSignal, defaultTimeout)
+	require.NoError(t, err)
+
+	newTx := t.assertTxPublished()
+	sendConfNtfn := t.assertConfReqSent(newTx, newConfBlock(newTx))
+
+	bumpResp, err := fn.RecvOrTimeout(bumpRespChan, defaultTimeout)
+	require.NoError(t, err)
+	require.NoError(t, (*bumpResp).Err)
+
+	// The replacement transaction spends the same inputs, so the genesis
+	// point of the batch stays the same. The additional fee is paid from
+	// the change output.
+	require.NotEqual(t, oldTx.TxHash(), newTx.TxHash())
+	require.Equal(t, oldTx.TxIn[0].PreviousOutPoint,
+		newTx.TxIn[0].PreviousOutPoint)
+	require.Equal(t, oldTx.TxOut[0], newTx.TxOut[0])
+
+	changeIdx := oldPkt.ChangeOutputIndex
+	require.Greater(
+		t, oldTx.TxOut[changeIdx].Value, newTx.TxOut[changeIdx].Value,
+	)
+
+	newPkt := (*bumpResp).Batch.GenesisPacket
+	newFee, err := newPkt.Pkt.GetTxFee()
+	require.NoError(t, err)
+	require.Greater(t, newFee, oldFee)
+	require.EqualValues(t, newFee, newPkt.ChainFees)
+
+	// The replacement transaction should also have been written to disk.
+	dbBatch := t.fetchSingleBatch(batchKey)
+	dbTx, err := psbt.Extract(dbBatch.GenesisPacket.Pkt)
+	require.NoError(t, err)
+	require.Equal(t, newTx.TxHash(), dbTx.TxHash())
+	t.assertSeedlingsMatchSprouts(seedlings)
+
+	// Once the replacement confirms, the batch should be finalized.
+	sendConfNtfn()
+
+	t.assertNoError()
+	t.assertNumCaretakersActive(0)
+	t.assertLastBatchState(1, tapgarden.BatchStateFinalized)
+
+	// The batch is no longer broadcast, so its fee can't be bumped again.
+	_, err = t.planter.BumpBatchFee(tapgarden.BumpFeeParams{
+		BatchKey: batchKey,
+		FeeRate:  newFeeRate * 2,
+	})
+	require.ErrorContains(t, err, "no active batch")
+}
+
+// testBumpBatchFeeReplacedTxConfirms tests that the caretaker keeps watching
+// the minting transaction of a batch after its fee was bumped, and adopts it
+// if it confirms instead of the replacement.
+func testBumpBatchFeeReplacedTxConfirms(t *mintingTestHarness) {
+	// First, create a new chain planter instance using the supplied test
+	// harness.
+	t.refreshChainPlanter()
+
+	var (
+		wg       sync.WaitGroup
+		respChan = make(chan *FinalizeBatchResp, 1)
+	)
+
+	// Queue and finalize a batch, which will publish its minting
+	// transaction.
+	const numSeedlings = 5
+	seedlings := t.queueInitialBatch(numSeedlings)
+
+	t.finalizeBatch(&wg, respChan, nil)
+	sendOldConfNtfn := t.progressCaretaker(false, nil, nil)
+	batch := t.assertFinalizeBatch(&wg, respChan, "")
+	batchKey := batch.BatchKey.PubKey
+
+	oldPkt := batch.GenesisPacket
+	oldTx, err := psbt.Extract(oldPkt.Pkt)
+	require.NoError(t, err)
+	oldFee, err := oldPkt.Pkt.GetTxFee()
+	require.NoError(t, err)
+
+	// We'll now bump the fee of the batch, which replaces the minting
+	// transaction on disk.
+	weight := blockchain.GetTransactionWeight(btcutil.NewTx(oldTx))
+	bumpRespChan := make(chan error, 1)
+	go func() {
+		_, err := t.planter.BumpBatchFee(tapgarden.BumpFeeParams{
+			BatchKey: batchKey,
+			FeeRate: chainfee.NewSatPerKWeight(
+				oldFee+10_000, uint64(weight),
+			),
+		})
+		bumpRespChan <- err
+	}()
+
+	_, err = fn.RecvOrTimeout(t.wallet.SignPsbtSignal, defaultTimeout)
+	require.NoError(t, err)
+
+	newTx := t.assertTxPublished()
+	_ = t.assertConfReqSent(newTx, newConfBlock(newTx))
+
+	bumpErr, err := fn.RecvOrTimeout(bumpRespChan, defaultTimeout)
+	require.NoError(t, err)
+	require.NoError(t, *bumpErr)
+
+	dbBatch := t.fetchSingleBatch(batchKey)
+	dbTx, err := psbt.Extract(dbBatch.GenesisPacket.Pkt)
+	require.NoError(t, err)
+	require.Equal(t, newTx.TxHash(), dbTx.TxHash())
+	t.assertSeedlingsMatchSprouts(seedlings)
+
+	// The replacement never made it into a block, and the original
+	// minting transaction confirms instead. The batch should still be
+	// finalized, with the original transaction written back to disk.
+	sendOldConfNtfn()
+
+	t.assertNoError()
+	t.assertNumCaretakersActive(0)
+	t.assertLastBatchState(1, tapgarden.BatchStateFinalized)
+
+	dbBatch = t.fetchSingleBatch(batchKey)
+	dbTx, err = psbt.Extract(dbBatch.GenesisPacket.Pkt)
+	require.NoError(t, err)
+	require.Equal(t, oldTx.TxHash(), dbTx.TxHash())
+}
+
+// testNamedPendingBatches tests that seedlings can be added to several named
+// pending batches at once, and that each of those batches can be targeted by
+// name or key to be funded, finalized or cancelled on its own.
+func testNamedPendingBatches(t *mintingTestHarness) {
+	// First, create a new chain planter instance using the supplied test
+	// harness.
+	t.refreshChainPlanter()
+
+	var (
+		wg               sync.WaitGroup
+		fundRespChan     = make(chan *FundBatchResp, 1)
+		finalizeRespChan = make(chan *FinalizeBatchResp, 1)
+		targetA          = tapgarden.BatchTarget{Name: "batch-a"}
+		targetB          = tapgarden.BatchTarget{Name: "batch-b"}
+	)
+
+	// A seedling that targets a batch with an invalid name should be
+	// rejected.
+	invalidSeedling := t.newRandSeedlings(1)[0]
+	invalidSeedling.Batch = tapgarden.BatchTarget{Name: " batch-a"}
+	updates, err := t.planter.QueueNewSeedling(invalidSeedling)
+	require.NoError(t, err)
+
+	update, err := fn.RecvOrTimeout(updates, defaultTimeout)
+	require.NoError(t, err)
+	require.ErrorContains(t, update.Error, "whitespace")
+
+	// Queue seedlings into two named batches and the default batch. Asset
+	// names only need to be unique within a batch.
+	seedlingsA := t.newRandSeedlings(2)
+	seedlingsB := t.newRandSeedlings(2)
+	seedlingsB[0].AssetName = seedlingsA[0].AssetName
+	for idx := range seedlingsA {
+		seedlingsA[idx].Batch = targetA
+		seedlingsB[idx].Batch = targetB
+	}
+
+	t.queueSeedlingsInBatch(false, seedlingsA...)
+	t.queueSeedlingsInBatch(false, seedlingsB...)
+	t.queueSeedlingsInBatch(false, t.newRandSeedlings(1)...)
+
+	batchA, err := t.planter.PendingBatch(targetA)
+	require.NoError(t, err)
+	require.Equal(t, targetA.Name, batchA.Name)
+	require.Len(t, batchA.Seedlings, 2)
+
+	batchB, err := t.planter.PendingBatch(targetB)
+	require.NoError(t, err)
+	require.Equal(t, targetB.Name, batchB.Name)
+	require.Len(t, batchB.Seedlings, 2)
+
+	t.assertPendingBatchExists(1)
+
+	// The batch names should also have been written to disk.
+	keyA := batchA.BatchKey.PubKey
+	keyB := batchB.BatchKey.PubKey
+	require.Equal(t, targetA.Name, t.fetchSingleBatch(keyA).Name)
+	require.Equal(t, targetB.Name, t.fetchSingleBatch(keyB).Name)
+
+	// A batch can also be targeted by its key. If a name is given as well,
+	// it must match the name of the batch.
+	batch, err := t.planter.PendingBatch(tapgarden.BatchTarget{Key: keyA})
+	require.NoError(t, err)
+	require.Equal(t, targetA.Name, batch.Name)
+
+	_, err = t.planter.PendingBatch(tapgarden.BatchTarget{
+		Name: targetB.Name,
+		Key:  keyA,
+	})
+	require.ErrorContains(t, err, "is named")
+
+	_, err = t.planter.PendingBatch(tapgarden.BatchTarget{
+		Key: test.RandPubKey(t),
+	})
+	require.ErrorContains(t, err, "no pending batch with key")
+
+	// Seedlings that target a batch by key are added to that batch.
+	extraSeedling := t.newRandSeedlings(1)[0]
+	extraSeedling.Batch = tapgarden.BatchTarget{Key: keyA}
+	t.queueSeedlingsInBatch(true, extraSeedling)
+	seedlingsA = append(seedlingsA, extraSeedling)
+
+	batchA, err = t.planter.PendingBatch(targetA)
+	require.NoError(t, err)
+	require.Len(t, batchA.Seedlings, 3)
+
+	// With several pending batches, cancelling a batch requires a target.
+	_, err = t.planter.CancelBatch(tapgarden.BatchTarget{})
+	require.ErrorContains(t, err, "multiple batches")
+
+	cancelledKey, err := t.planter.CancelBatch(targetB)
+	require.NoError(t, err)
+	require.True(t, keyB.IsEqual(cancelledKey))
+	t.assertBatchState(keyB, tapgarden.BatchStateSeedlingCancelled)
+
+	batch, err = t.planter.PendingBatch(targetB)
+	require.NoError(t, err)
+	require.Nil(t, batch)
+
+	// Now we'll fund and finalize the first named batch. The default batch
+	// should not be affected.
+	manualFee := chainfee.FeePerKwFloor * 2
+	t.fundBatch(&wg, fundRespChan, &tapgarden.FundParams{
+		Batch:   targetA,
+		FeeRate: fn.Some(manualFee),
+	})
+	t.assertGenesisTxFunded(&manualFee)
+	fundedBatch := t.assertFundBatch(&wg, fundRespChan, "")
+	require.True(t, keyA.IsEqual(fundedBatch.BatchKey.PubKey))
+
+	t.finalizeBatch(&wg, finalizeRespChan, &tapgarden.FinalizeParams{
+		Batch: targetA,
+	})
+	sendConfNtfn := t.progressCaretaker(true, nil, &manualFee)
+	mintedBatch := t.assertFinalizeBatch(&wg, finalizeRespChan, "")
+	require.True(t, keyA.IsEqual(mintedBatch.BatchKey.PubKey))
+
+	t.assertSeedlingsMatchSprouts(seedlingsA)
+
+	sendConfNtfn()
+
+	t.assertNoError()
+	t.assertNumCaretakersActive(0)
+	t.assertBatchState(keyA, tapgarden.BatchStateFinalized)
+	t.assertPendingBatchExists(1)
+
+	// The name of the finalized batch can be used for a new batch.
+	t.queueSeedlingsInBatch(false, &tapgarden.Seedling{
+		AssetVersion: asset.V0,
+		AssetType:    asset.Normal,
+		AssetName:    seedlingsA[0].AssetName,
+		Amount:       1000,
+		Batch:        targetA,
+	})
+
+	batch, err = t.planter.PendingBatch(targetA)
+	require.NoError(t, err)
+	require.False(t, keyA.IsEqual(batch.BatchKey.PubKey))
+}
+
+// testUpdateAndRemoveSeedlings tests that single seedlings of a pending batch
+// can be changed or removed, and that seedlings can't be left without their
+// group anchor.
+func testUpdateAndRemoveSeedlings(t *mintingTestHarness) {
+	// First, create a new chain planter instance using the supplied test
+	// harness.
+	t.refreshChainPlanter()
+
+	var (
+		wg       sync.WaitGroup
+		respChan = make(chan *FinalizeBatchResp, 1)
+	)
+
+	// Queue a batch with a group anchor, a seedling that is a member of
+	// its group, and an ungrouped seedling.
+	seedlings := t.newRandSeedlings(3)
+	for _, seedling := range seedlings {
+		seedling.AssetType = asset.Normal
+		seedling.EnableEmission = false
+	}
+	seedlings[0].EnableEmission = true
+	seedlings[1].GroupAnchor = &seedlings[0].AssetName
+
+	anchorName := seedlings[0].AssetName
+	memberName := seedlings[1].AssetName
+	plainName := seedlings[2].AssetName
+
+	t.queueSeedlingsInBatch(false, seedlings...)
+	t.assertPendingBatchExists(3)
+
+	// Seedlings that aren't part of the batch can't be changed.
+	_, err := t.planter.RemoveSeedling(tapgarden.RemoveSeedlingParams{
+		AssetName: "unknown",
+	})
+	require.ErrorContains(t, err, "not in batch")
+
+	_, err = t.planter.UpdateSeedling(tapgarden.UpdateSeedlingParams{
+		AssetName: "unknown",
+		Amount:    fn.Some[uint64](1),
+	})
+	require.ErrorContains(t, err, "not in batch")
+
+	// The group anchor can't be removed, or stop anchoring a new group,
+	// while another seedling is a member of its group.
+	_, err = t.planter.RemoveSeedling(tapgarden.RemoveSeedlingParams{
+		AssetName: anchorName,
+	})
+	require.ErrorContains(t, err, "is the group anchor of "+memberName)
+
+	_, err = t.planter.UpdateSeedling(tapgarden.UpdateSeedlingParams{
+		AssetName: anchorName,
+		Group:     fn.Some(tapgarden.SeedlingGroupParams{}),
+	})
+	require.ErrorContains(t, err, "is the group anchor of "+memberName)
+
+	// Updated seedlings are validated just like new seedlings.
+	_, err = t.planter.UpdateSeedling(tapgarden.UpdateSeedlingParams{
+		AssetName: plainName,
+		Amount:    fn.Some[uint64](0),
+	})
+	require.ErrorIs(t, err, tapgarden.ErrInvalidAssetAmt)
+
+	_, err = t.planter.UpdateSeedling(tapgarden.UpdateSeedlingParams{
+		AssetName: plainName,
+		Group: fn.Some(tapgarden.SeedlingGroupParams{
+			GroupAnchor: &plainName,
+		}),
+	})
+	require.ErrorContains(t, err, "its own group anchor")
+
+	_, err = t.planter.UpdateSeedling(tapgarden.UpdateSeedlingParams{
+		AssetName: plainName,
+		Group: fn.Some(tapgarden.SeedlingGroupParams{
+			GroupAnchor: &memberName,
+		}),
+	})
+	require.ErrorContains(t, err, "emission disabled")
+
+	// Now we'll change the amount and metadata of the ungrouped seedling,
+	// and move it into the group of the anchor.
+	newMeta := &proof.MetaReveal{
+		Data: []byte("updated meta"),
+	}
+	batch, err := t.planter.UpdateSeedling(tapgarden.UpdateSeedlingParams{
+		AssetName: plainName,
+		Amount:    fn.Some[uint64](1337),
+		Meta:      fn.Some(newMeta),
+	})
+	require.NoError(t, err)
+	require.EqualValues(t, 1337, batch.Seedlings[plainName].Amount)
+	require.Equal(t, newMeta, batch.Seedlings[plainName].Meta)
+
+	batch, err = t.planter.UpdateSeedling(tapgarden.UpdateSeedlingParams{
+		AssetName: plainName,
+		Group: fn.Some(tapgarden.SeedlingGroupParams{
+			GroupAnchor: &anchorName,
+		}),
+	})
+	require.NoError(t, err)
+	require.Equal(t, &anchorName, batch.Seedlings[plainName].GroupAnchor)
+	require.EqualValues(t, 1337, batch.Seedlings[plainName].Amount)
+
+	// Members of a group can be removed without affecting the anchor.
+	batch, err = t.planter.RemoveSeedling(tapgarden.RemoveSeedlingParams{
+		AssetName: memberName,
+	})
+	require.NoError(t, err)
+	require.NotContains(t, batch.Seedlings, memberName)
+
+	// The changes should also have been written to disk.
+	updatedSeedlings := maps.Values(batch.Seedlings)
+	t.assertSeedlingsExist(updatedSeedlings, batch.BatchKey.PubKey)
+
+	// Finalizing the batch should mint the updated seedlings.
+	t.finalizeBatch(&wg, respChan, nil)
+	sendConfNtfn := t.progressCaretaker(false, nil, nil)
+	t.assertFinalizeBatch(&wg, respChan, "")
+
+	t.assertSeedlingsMatchSprouts(updatedSeedlings)
+
+	sendConfNtfn()
+
+	t.assertNoError()
+	t.assertNumCaretakersActive(0)
+	t.assertLastBatchState(1, tapgarden.BatchStateFinalized)
+
+	// Once the batch is no longer pending, its seedlings can't be changed.
+	_, err = t.planter.RemoveSeedling(tapgarden.RemoveSeedlingParams{
+		AssetName: anchorName,
+	})
+	require.ErrorContains(t, err, "no pending batch")
+}
+
 // mintingStoreTestCase is used to programmatically run a series of test cases
 // that are parametrized based on a fresh minting store.
 type mintingStoreTestCase struct {
@@ -1708,6 +2504,30 @@ var testCases = []mintingStoreTestCase{
 		name:     "fund_before_finalize",
 		testFunc: testFundBeforeFinalize,
 	},
+	{
+		name:     "seal_batch_with_external_witness",
+		testFunc: testSealBatchWithExternalWitness,
+	},
+	{
+		name:     "fund_batch_with_template",
+		testFunc: testFundBatchWithTemplate,
+	},
+	{
+		name:     "bump_batch_fee",
+		testFunc: testBumpBatchFee,
+	},
+	{
+		name:     "bump_batch_fee_replaced_tx_confirms",
+		testFunc: testBumpBatchFeeReplacedTxConfirms,
+	},
+	{
+		name:     "named_pending_batches",
+		testFunc: testNamedPendingBatches,
+	},
+	{
+		name:     "update_and_remove_seedlings",
+		testFunc: testUpdateAndRemoveSeedlings,
+	},
 }
 
 // TestBatchedAssetIssuance runs a test of tests to ensure that the set of
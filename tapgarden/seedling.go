@@ -113,6 +113,11 @@ type Seedling struct {
 	// a Schnorr signature for reissuing assets. A group key with an empty
 	// Tapscript root can only authorize reissuance with a signature.
 	GroupTapscriptRoot []byte
+
+	// Batch selects the pending batch the seedling is added to. If no
+	// pending batch with the given name exists, a new batch with that name
+	// is created.
+	Batch BatchTarget
 }
 
 // validateFields attempts to validate the set of input fields for the passed
@@ -147,7 +152,7 @@ func (c Seedling) validateFields() error {
 			sha256.Size)
 	}
 
-	return nil
+	return validateBatchName(c.Batch.Name)
 }
 
 // validateGroupKey attempts to validate that the non-zero group key provided
@@ -173,6 +178,21 @@ func (c Seedling) HasGroupKey() bool {
 	return c.GroupInfo != nil && c.GroupInfo.GroupKey != nil
 }
 
+// UnsealedSeedling is a grouped seedling of a funded batch, along with the
+// group virtual transaction that must be signed to produce the asset group
+// witness for the seedling.
+type UnsealedSeedling struct {
+	*Seedling
+
+	// GroupReq is the request for the asset of the seedling to become a
+	// member of its asset group.
+	GroupReq asset.GroupKeyRequest
+
+	// GroupVirtualTx is the virtual transaction that must be signed with
+	// the group internal key to produce the asset group witness.
+	GroupVirtualTx asset.GroupVirtualTx
+}
+
 // String returns a human-readable representation for the AssetSeedling.
 func (c Seedling) String() string {
 	return fmt.Sprintf("AssetSeedling(name=%v, type=%v, amt=%v, "+
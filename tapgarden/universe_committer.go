@@ -0,0 +1,331 @@
+package tapgarden
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/address"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/lightninglabs/taproot-assets/tappsbt"
+	"github.com/lightninglabs/taproot-assets/universe"
+)
+
+const (
+	// UniverseCommitmentAmtSats is the amount of sats we'll use for the
+	// output that anchors a universe root commitment.
+	UniverseCommitmentAmtSats = btcutil.Amount(1_000)
+)
+
+// UniverseCommitterConfig is the config for the universe chain committer.
+type UniverseCommitterConfig struct {
+	// Wallet is used to fund and sign the commitment transactions.
+	Wallet WalletAnchor
+
+	// ChainBridge is used to publish the commitment transactions and to
+	// wait for their confirmation.
+	ChainBridge ChainBridge
+
+	// KeyRing is used to derive the internal keys of the commitment
+	// outputs.
+	KeyRing KeyRing
+
+	// ChainParams are the Taproot Asset specific chain parameters. They
+	// are used to derive the BIP-0032 path of the internal keys, so that
+	// the wallet can sign for the previous commitment output.
+	ChainParams *address.ChainParams
+}
+
+// UniverseCommitter is a universe.ChainCommitter that anchors universe roots
+// in a P2TR output of a transaction funded by the backing wallet. The output
+// key commits to a tapscript leaf that contains the universe root.
+type UniverseCommitter struct {
+	cfg UniverseCommitterConfig
+}
+
+// NewUniverseCommitter creates a new universe chain committer.
+func NewUniverseCommitter(cfg UniverseCommitterConfig) *UniverseCommitter {
+	return &UniverseCommitter{
+		cfg: cfg,
+	}
+}
+
+// CreateCommitment funds and signs a new transaction that anchors the given
+// multiverse root, without publishing it. If a previous commitment is given,
+// the new transaction spends its anchor output.
+//
+// NOTE: This is part of the universe.ChainCommitter interface.
+func (u *UniverseCommitter) CreateCommitment(ctx context.Context,
+	root universe.MultiverseRoot,
+	prev *universe.Commitment) (*universe.PendingCommitment, error) {
+
+	internalKey, err := u.cfg.KeyRing.DeriveNextKey(
+		ctx, asset.TaprootAssetsKeyFamily,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to derive internal key: %w", err)
+	}
+
+	pkScript, err := universe.NewCommitmentOutputScript(
+		internalKey.PubKey, root.Node,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create commitment output "+
+			"script: %w", err)
+	}
+
+	heightHint, err := u.cfg.ChainBridge.CurrentHeight(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get current height: %w", err)
+	}
+
+	signedTx, lockedInputs, err := u.signCommitmentTx(ctx, pkScript, prev)
+	if err != nil {
+		return nil, err
+	}
+
+	outputIndex := -1
+	for idx, txOut := range signedTx.TxOut {
+		if bytes.Equal(txOut.PkScript, pkScript) {
+			outputIndex = idx
+			break
+		}
+	}
+	if outputIndex == -1 {
+		u.unlockInputs(ctx, lockedInputs)
+		return nil, fmt.Errorf("commitment output not found in tx %v",
+			signedTx.TxHash())
+	}
+
+	return &universe.PendingCommitment{
+		Tx:           signedTx,
+		OutputIndex:  uint32(outputIndex),
+		InternalKey:  internalKey,
+		UniverseRoot: root.Node,
+		HeightHint:   heightHint,
+		LockedInputs: lockedInputs,
+	}, nil
+}
+
+// PublishCommitment publishes the transaction of the given pending commitment
+// and returns the commitment once the transaction has confirmed.
+//
+// NOTE: This is part of the universe.ChainCommitter interface.
+func (u *UniverseCommitter) PublishCommitment(ctx context.Context,
+	pending *universe.PendingCommitment) (*universe.Commitment, error) {
+
+	err := u.cfg.ChainBridge.PublishTransaction(ctx, pending.Tx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to publish tx: %w", err)
+	}
+
+	log.Infof("Published universe commitment tx %v, waiting for "+
+		"confirmation", pending.Tx.TxHash())
+
+	pkScript := pending.Tx.TxOut[pending.OutputIndex].PkScript
+	commitment, err := u.waitForConfirmation(
+		ctx, pending.Tx, pkScript, pending.HeightHint,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	commitment.OutputIndex = pending.OutputIndex
+	commitment.InternalKey = pending.InternalKey
+	commitment.UniverseRoot = pending.UniverseRoot
+
+	return commitment, nil
+}
+
+// AbandonCommitment releases the wallet inputs that were locked to fund the
+// transaction of the given pending commitment.
+//
+// NOTE: This is part of the universe.ChainCommitter interface.
+func (u *UniverseCommitter) AbandonCommitment(ctx context.Context,
+	pending *universe.PendingCommitment) error {
+
+	log.Infof("Abandoning universe commitment tx %v", pending.Tx.TxHash())
+
+	u.unlockInputs(ctx, pending.LockedInputs)
+
+	return nil
+}
+
+// unlockInputs releases the given wallet inputs that were locked when a
+// commitment transaction was funded.
+func (u *UniverseCommitter) unlockInputs(ctx context.Context,
+	inputs []wire.OutPoint) {
+
+	for _, outpoint := range inputs {
+		err := u.cfg.Wallet.UnlockInput(ctx, outpoint)
+		if err != nil {
+			log.Errorf("Unable to unlock input %v: %v", outpoint,
+				err)
+		}
+	}
+}
+
+// signCommitmentTx funds and signs a transaction that contains an output with
+// the given pkScript. If a previous commitment is given, its anchor output is
+// spent by the transaction, so that the output doesn't remain unspent forever.
+// The wallet inputs that were locked to fund the transaction are returned
+// along with it.
+func (u *UniverseCommitter) signCommitmentTx(ctx context.Context,
+	pkScript []byte, prev *universe.Commitment) (*wire.MsgTx,
+	[]wire.OutPoint, error) {
+
+	txTemplate := wire.NewMsgTx(2)
+	txTemplate.AddTxOut(&wire.TxOut{
+		Value:    int64(UniverseCommitmentAmtSats),
+		PkScript: pkScript,
+	})
+	pkt, err := psbt.NewFromUnsignedTx(txTemplate)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to make psbt packet: %w",
+			err)
+	}
+
+	if prev != nil {
+		err := u.addPrevCommitmentInput(pkt, prev)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	feeRate, err := u.cfg.ChainBridge.EstimateFee(ctx, GenesisConfTarget)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to estimate fee: %w", err)
+	}
+
+	fundedPkt, err := u.cfg.Wallet.FundPsbt(ctx, pkt, 1, feeRate, -1)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to fund psbt: %w", err)
+	}
+
+	// If anything goes wrong while signing, we release the inputs that the
+	// wallet locked for us.
+	lockedInputs := fundedPkt.LockedUTXOs
+
+	// The wallet signs its own inputs, as well as the previous commitment
+	// output for which we provided the key derivation.
+	signedPkt, err := u.cfg.Wallet.SignPsbt(ctx, fundedPkt.Pkt)
+	if err != nil {
+		u.unlockInputs(ctx, lockedInputs)
+		return nil, nil, fmt.Errorf("unable to sign psbt: %w", err)
+	}
+
+	err = psbt.MaybeFinalizeAll(signedPkt)
+	if err != nil {
+		u.unlockInputs(ctx, lockedInputs)
+		return nil, nil, fmt.Errorf("unable to finalize psbt: %w",
+			err)
+	}
+
+	signedTx, err := psbt.Extract(signedPkt)
+	if err != nil {
+		u.unlockInputs(ctx, lockedInputs)
+		return nil, nil, fmt.Errorf("unable to extract tx: %w", err)
+	}
+
+	return signedTx, lockedInputs, nil
+}
+
+// addPrevCommitmentInput adds the anchor output of the given commitment as an
+// input to the packet. The output is spent through the key path of its
+// internal key, which is derived by the wallet.
+func (u *UniverseCommitter) addPrevCommitmentInput(pkt *psbt.Packet,
+	prev *universe.Commitment) error {
+
+	if int(prev.OutputIndex) >= len(prev.Tx.TxOut) {
+		return fmt.Errorf("previous commitment output index %d out "+
+			"of range", prev.OutputIndex)
+	}
+
+	tapRoot, err := universe.NewCommitmentTapscriptRoot(
+		prev.UniverseRoot,
+	)
+	if err != nil {
+		return err
+	}
+
+	bip32Derivation, trBip32Derivation :=
+		tappsbt.Bip32DerivationFromKeyDesc(
+			prev.InternalKey, u.cfg.ChainParams.HDCoinType,
+		)
+
+	pkt.UnsignedTx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: prev.OutPoint(),
+	})
+	pkt.Inputs = append(pkt.Inputs, psbt.PInput{
+		WitnessUtxo: prev.Tx.TxOut[prev.OutputIndex],
+		SighashType: txscript.SigHashDefault,
+		Bip32Derivation: []*psbt.Bip32Derivation{
+			bip32Derivation,
+		},
+		TaprootBip32Derivation: []*psbt.TaprootBip32Derivation{
+			trBip32Derivation,
+		},
+		TaprootInternalKey: schnorr.SerializePubKey(
+			prev.InternalKey.PubKey,
+		),
+		TaprootMerkleRoot: tapRoot[:],
+	})
+
+	return nil
+}
+
+// waitForConfirmation waits for the given transaction to confirm and returns a
+// commitment with the confirmation details.
+func (u *UniverseCommitter) waitForConfirmation(ctx context.Context,
+	tx *wire.MsgTx, pkScript []byte,
+	heightHint uint32) (*universe.Commitment, error) {
+
+	txHash := tx.TxHash()
+	confNtfn, errChan, err := u.cfg.ChainBridge.RegisterConfirmationsNtfn(
+		ctx, &txHash, pkScript, 1, heightHint, true, nil,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to register for commitment tx "+
+			"conf: %w", err)
+	}
+
+	select {
+	case conf := <-confNtfn.Confirmed:
+		if conf == nil || conf.Block == nil {
+			return nil, fmt.Errorf("got empty confirmation event " +
+				"for commitment tx")
+		}
+
+		merkleProof, err := proof.NewTxMerkleProof(
+			conf.Block.Transactions, int(conf.TxIndex),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to create merkle "+
+				"proof: %w", err)
+		}
+
+		return &universe.Commitment{
+			BlockHeight: conf.BlockHeight,
+			BlockHeader: conf.Block.Header,
+			MerkleProof: merkleProof,
+			Tx:          tx,
+		}, nil
+
+	case err := <-errChan:
+		return nil, fmt.Errorf("error getting confirmation: %w", err)
+
+	case <-ctx.Done():
+		return nil, fmt.Errorf("commitment tx %v not confirmed: %w",
+			txHash, ctx.Err())
+	}
+}
+
+// A compile-time assertion to ensure that UniverseCommitter meets the
+// universe.ChainCommitter interface.
+var _ universe.ChainCommitter = (*UniverseCommitter)(nil)
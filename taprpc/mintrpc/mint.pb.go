@@ -339,6 +339,14 @@ type MintAssetRequest struct {
 	// response. This is mainly to avoid a lot of data being transmitted and
 	// possibly printed on the command line in the case of a very large batch.
 	ShortResponse bool `protobuf:"varint,2,opt,name=short_response,json=shortResponse,proto3" json:"short_response,omitempty"`
+	// The optional name of the pending batch to add the asset to. If no pending
+	// batch with this name exists, a new batch with this name is created. If
+	// neither a batch name nor a batch key is set, the asset is added to the
+	// default pending batch.
+	BatchName string `protobuf:"bytes,3,opt,name=batch_name,json=batchName,proto3" json:"batch_name,omitempty"`
+	// The optional batch key of an existing pending batch to add the asset to.
+	// If a batch name is set as well, it must match the name of the batch.
+	BatchKey []byte `protobuf:"bytes,4,opt,name=batch_key,json=batchKey,proto3" json:"batch_key,omitempty"`
 }
 
 func (x *MintAssetRequest) Reset() {
@@ -387,6 +395,20 @@ func (x *MintAssetRequest) GetShortResponse() bool {
 	return false
 }
 
+func (x *MintAssetRequest) GetBatchName() string {
+	if x != nil {
+		return x.BatchName
+	}
+	return ""
+}
+
+func (x *MintAssetRequest) GetBatchKey() []byte {
+	if x != nil {
+		return x.BatchKey
+	}
+	return nil
+}
+
 type MintAssetResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -458,6 +480,8 @@ type MintingBatch struct {
 	// The genesis transaction as a PSBT packet. Only populated if the batch has
 	// been committed.
 	BatchPsbt []byte `protobuf:"bytes,7,opt,name=batch_psbt,json=batchPsbt,proto3" json:"batch_psbt,omitempty"`
+	// The name of the batch. The default pending batch has no name.
+	BatchName string `protobuf:"bytes,8,opt,name=batch_name,json=batchName,proto3" json:"batch_name,omitempty"`
 }
 
 func (x *MintingBatch) Reset() {
@@ -541,7 +565,14 @@ func (x *MintingBatch) GetBatchPsbt() []byte {
 	return nil
 }
 
-type FinalizeBatchRequest struct {
+func (x *MintingBatch) GetBatchName() string {
+	if x != nil {
+		return x.BatchName
+	}
+	return ""
+}
+
+type FundBatchRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -558,13 +589,30 @@ type FinalizeBatchRequest struct {
 	//
 	// Types that are assignable to BatchSibling:
 	//
-	//	*FinalizeBatchRequest_FullTree
-	//	*FinalizeBatchRequest_Branch
-	BatchSibling isFinalizeBatchRequest_BatchSibling `protobuf_oneof:"batch_sibling"`
-}
-
-func (x *FinalizeBatchRequest) Reset() {
-	*x = FinalizeBatchRequest{}
+	//	*FundBatchRequest_FullTree
+	//	*FundBatchRequest_Branch
+	BatchSibling isFundBatchRequest_BatchSibling `protobuf_oneof:"batch_sibling"`
+	// An optional serialized PSBT that the genesis transaction is built on top
+	// of. All inputs of the template must have their witness UTXO set, and the
+	// first input becomes the genesis outpoint of the batch. The outputs of the
+	// template are added after the anchor output, and the wallet adds any
+	// further inputs and a change output as needed. The funded PSBT is returned
+	// in the batch_psbt field of the response. Once the batch has seedlings and
+	// is sealed, the anchor output of the batch PSBT has its final script, and
+	// the inputs of the template can be signed and passed to FinalizeBatch as
+	// signed_batch_psbt.
+	PsbtTemplate []byte `protobuf:"bytes,5,opt,name=psbt_template,json=psbtTemplate,proto3" json:"psbt_template,omitempty"`
+	// The optional name of the pending batch to fund. If no pending batch with
+	// this name exists, a new funded batch with this name is created. If neither
+	// a batch name nor a batch key is set, the default pending batch is used.
+	BatchName string `protobuf:"bytes,6,opt,name=batch_name,json=batchName,proto3" json:"batch_name,omitempty"`
+	// The optional batch key of an existing pending batch to fund. If a batch
+	// name is set as well, it must match the name of the batch.
+	BatchKey []byte `protobuf:"bytes,7,opt,name=batch_key,json=batchKey,proto3" json:"batch_key,omitempty"`
+}
+
+func (x *FundBatchRequest) Reset() {
+	*x = FundBatchRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mintrpc_mint_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -572,13 +620,13 @@ func (x *FinalizeBatchRequest) Reset() {
 	}
 }
 
-func (x *FinalizeBatchRequest) String() string {
+func (x *FundBatchRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FinalizeBatchRequest) ProtoMessage() {}
+func (*FundBatchRequest) ProtoMessage() {}
 
-func (x *FinalizeBatchRequest) ProtoReflect() protoreflect.Message {
+func (x *FundBatchRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_mintrpc_mint_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -590,76 +638,97 @@ func (x *FinalizeBatchRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FinalizeBatchRequest.ProtoReflect.Descriptor instead.
-func (*FinalizeBatchRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use FundBatchRequest.ProtoReflect.Descriptor instead.
+func (*FundBatchRequest) Descriptor() ([]byte, []int) {
 	return file_mintrpc_mint_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *FinalizeBatchRequest) GetShortResponse() bool {
+func (x *FundBatchRequest) GetShortResponse() bool {
 	if x != nil {
 		return x.ShortResponse
 	}
 	return false
 }
 
-func (x *FinalizeBatchRequest) GetFeeRate() uint32 {
+func (x *FundBatchRequest) GetFeeRate() uint32 {
 	if x != nil {
 		return x.FeeRate
 	}
 	return 0
 }
 
-func (m *FinalizeBatchRequest) GetBatchSibling() isFinalizeBatchRequest_BatchSibling {
+func (m *FundBatchRequest) GetBatchSibling() isFundBatchRequest_BatchSibling {
 	if m != nil {
 		return m.BatchSibling
 	}
 	return nil
 }
 
-func (x *FinalizeBatchRequest) GetFullTree() *taprpc.TapscriptFullTree {
-	if x, ok := x.GetBatchSibling().(*FinalizeBatchRequest_FullTree); ok {
+func (x *FundBatchRequest) GetFullTree() *taprpc.TapscriptFullTree {
+	if x, ok := x.GetBatchSibling().(*FundBatchRequest_FullTree); ok {
 		return x.FullTree
 	}
 	return nil
 }
 
-func (x *FinalizeBatchRequest) GetBranch() *taprpc.TapBranch {
-	if x, ok := x.GetBatchSibling().(*FinalizeBatchRequest_Branch); ok {
+func (x *FundBatchRequest) GetBranch() *taprpc.TapBranch {
+	if x, ok := x.GetBatchSibling().(*FundBatchRequest_Branch); ok {
 		return x.Branch
 	}
 	return nil
 }
 
-type isFinalizeBatchRequest_BatchSibling interface {
-	isFinalizeBatchRequest_BatchSibling()
+func (x *FundBatchRequest) GetPsbtTemplate() []byte {
+	if x != nil {
+		return x.PsbtTemplate
+	}
+	return nil
 }
 
-type FinalizeBatchRequest_FullTree struct {
+func (x *FundBatchRequest) GetBatchName() string {
+	if x != nil {
+		return x.BatchName
+	}
+	return ""
+}
+
+func (x *FundBatchRequest) GetBatchKey() []byte {
+	if x != nil {
+		return x.BatchKey
+	}
+	return nil
+}
+
+type isFundBatchRequest_BatchSibling interface {
+	isFundBatchRequest_BatchSibling()
+}
+
+type FundBatchRequest_FullTree struct {
 	// An ordered list of TapLeafs, which will be used to construct a
 	// Tapscript tree.
 	FullTree *taprpc.TapscriptFullTree `protobuf:"bytes,3,opt,name=full_tree,json=fullTree,proto3,oneof"`
 }
 
-type FinalizeBatchRequest_Branch struct {
+type FundBatchRequest_Branch struct {
 	// A TapBranch that represents a Tapscript tree managed externally.
 	Branch *taprpc.TapBranch `protobuf:"bytes,4,opt,name=branch,proto3,oneof"`
 }
 
-func (*FinalizeBatchRequest_FullTree) isFinalizeBatchRequest_BatchSibling() {}
+func (*FundBatchRequest_FullTree) isFundBatchRequest_BatchSibling() {}
 
-func (*FinalizeBatchRequest_Branch) isFinalizeBatchRequest_BatchSibling() {}
+func (*FundBatchRequest_Branch) isFundBatchRequest_BatchSibling() {}
 
-type FinalizeBatchResponse struct {
+type FundBatchResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The finalized batch.
+	// The funded batch.
 	Batch *MintingBatch `protobuf:"bytes,1,opt,name=batch,proto3" json:"batch,omitempty"`
 }
 
-func (x *FinalizeBatchResponse) Reset() {
-	*x = FinalizeBatchResponse{}
+func (x *FundBatchResponse) Reset() {
+	*x = FundBatchResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mintrpc_mint_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -667,13 +736,13 @@ func (x *FinalizeBatchResponse) Reset() {
 	}
 }
 
-func (x *FinalizeBatchResponse) String() string {
+func (x *FundBatchResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FinalizeBatchResponse) ProtoMessage() {}
+func (*FundBatchResponse) ProtoMessage() {}
 
-func (x *FinalizeBatchResponse) ProtoReflect() protoreflect.Message {
+func (x *FundBatchResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_mintrpc_mint_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -685,26 +754,32 @@ func (x *FinalizeBatchResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FinalizeBatchResponse.ProtoReflect.Descriptor instead.
-func (*FinalizeBatchResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use FundBatchResponse.ProtoReflect.Descriptor instead.
+func (*FundBatchResponse) Descriptor() ([]byte, []int) {
 	return file_mintrpc_mint_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *FinalizeBatchResponse) GetBatch() *MintingBatch {
+func (x *FundBatchResponse) GetBatch() *MintingBatch {
 	if x != nil {
 		return x.Batch
 	}
 	return nil
 }
 
-type CancelBatchRequest struct {
+type GroupWitness struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// The asset ID of the grouped asset the witness belongs to.
+	AssetId []byte `protobuf:"bytes,1,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	// The witness stack that authorizes the asset to be a member of its asset
+	// group.
+	Witness [][]byte `protobuf:"bytes,2,rep,name=witness,proto3" json:"witness,omitempty"`
 }
 
-func (x *CancelBatchRequest) Reset() {
-	*x = CancelBatchRequest{}
+func (x *GroupWitness) Reset() {
+	*x = GroupWitness{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_mintrpc_mint_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -712,13 +787,13 @@ func (x *CancelBatchRequest) Reset() {
 	}
 }
 
-func (x *CancelBatchRequest) String() string {
+func (x *GroupWitness) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelBatchRequest) ProtoMessage() {}
+func (*GroupWitness) ProtoMessage() {}
 
-func (x *CancelBatchRequest) ProtoReflect() protoreflect.Message {
+func (x *GroupWitness) ProtoReflect() protoreflect.Message {
 	mi := &file_mintrpc_mint_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -730,90 +805,1141 @@ func (x *CancelBatchRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelBatchRequest.ProtoReflect.Descriptor instead.
-func (*CancelBatchRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GroupWitness.ProtoReflect.Descriptor instead.
+func (*GroupWitness) Descriptor() ([]byte, []int) {
 	return file_mintrpc_mint_proto_rawDescGZIP(), []int{7}
 }
 
-type CancelBatchResponse struct {
+func (x *GroupWitness) GetAssetId() []byte {
+	if x != nil {
+		return x.AssetId
+	}
+	return nil
+}
+
+func (x *GroupWitness) GetWitness() [][]byte {
+	if x != nil {
+		return x.Witness
+	}
+	return nil
+}
+
+type SealBatchRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The internal public key of the batch.
-	BatchKey []byte `protobuf:"bytes,1,opt,name=batch_key,json=batchKey,proto3" json:"batch_key,omitempty"`
+	// If true, then the assets currently in the batch won't be returned in the
+	// response. This is mainly to avoid a lot of data being transmitted and
+	// possibly printed on the command line in the case of a very large batch.
+	ShortResponse bool `protobuf:"varint,1,opt,name=short_response,json=shortResponse,proto3" json:"short_response,omitempty"`
+	// The asset group witnesses created externally for assets in the batch.
+	GroupWitnesses []*GroupWitness `protobuf:"bytes,2,rep,name=group_witnesses,json=groupWitnesses,proto3" json:"group_witnesses,omitempty"`
+	// The optional name of the pending batch to seal. If neither a batch name
+	// nor a batch key is set, the default pending batch is used.
+	BatchName string `protobuf:"bytes,3,opt,name=batch_name,json=batchName,proto3" json:"batch_name,omitempty"`
+	// The optional batch key of the pending batch to seal. If a batch name is set
+	// as well, it must match the name of the batch.
+	BatchKey []byte `protobuf:"bytes,4,opt,name=batch_key,json=batchKey,proto3" json:"batch_key,omitempty"`
+}
+
+func (x *SealBatchRequest) Reset() {
+	*x = SealBatchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-func (x *CancelBatchResponse) Reset() {
-	*x = CancelBatchResponse{}
+func (x *SealBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SealBatchRequest) ProtoMessage() {}
+
+func (x *SealBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SealBatchRequest.ProtoReflect.Descriptor instead.
+func (*SealBatchRequest) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SealBatchRequest) GetShortResponse() bool {
+	if x != nil {
+		return x.ShortResponse
+	}
+	return false
+}
+
+func (x *SealBatchRequest) GetGroupWitnesses() []*GroupWitness {
+	if x != nil {
+		return x.GroupWitnesses
+	}
+	return nil
+}
+
+func (x *SealBatchRequest) GetBatchName() string {
+	if x != nil {
+		return x.BatchName
+	}
+	return ""
+}
+
+func (x *SealBatchRequest) GetBatchKey() []byte {
+	if x != nil {
+		return x.BatchKey
+	}
+	return nil
+}
+
+type SealBatchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The sealed batch.
+	Batch *MintingBatch `protobuf:"bytes,1,opt,name=batch,proto3" json:"batch,omitempty"`
+}
+
+func (x *SealBatchResponse) Reset() {
+	*x = SealBatchResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mintrpc_mint_proto_msgTypes[8]
+		mi := &file_mintrpc_mint_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CancelBatchResponse) String() string {
+func (x *SealBatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SealBatchResponse) ProtoMessage() {}
+
+func (x *SealBatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SealBatchResponse.ProtoReflect.Descriptor instead.
+func (*SealBatchResponse) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SealBatchResponse) GetBatch() *MintingBatch {
+	if x != nil {
+		return x.Batch
+	}
+	return nil
+}
+
+type FinalizeBatchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// If true, then the assets currently in the batch won't be returned in the
+	// response. This is mainly to avoid a lot of data being transmitted and
+	// possibly printed on the command line in the case of a very large batch.
+	ShortResponse bool `protobuf:"varint,1,opt,name=short_response,json=shortResponse,proto3" json:"short_response,omitempty"`
+	// The optional fee rate to use for the minting transaction, in sat/kw.
+	FeeRate uint32 `protobuf:"varint,2,opt,name=fee_rate,json=feeRate,proto3" json:"fee_rate,omitempty"`
+	// The optional tapscript sibling that will be used when deriving the genesis
+	// output for the batch. This sibling is a tapscript tree, which allows the
+	// minter to encumber future transfers of assets in the batch with Tapscript.
+	//
+	// Types that are assignable to BatchSibling:
+	//
+	//	*FinalizeBatchRequest_FullTree
+	//	*FinalizeBatchRequest_Branch
+	BatchSibling isFinalizeBatchRequest_BatchSibling `protobuf_oneof:"batch_sibling"`
+	// An optional serialized copy of the batch PSBT of a funded and sealed batch,
+	// in which all inputs that were added with the PSBT template are finalized.
+	// The genesis outpoint and the outputs of the PSBT must be unchanged. The
+	// wallet then only signs the inputs it added when funding the batch.
+	SignedBatchPsbt []byte `protobuf:"bytes,5,opt,name=signed_batch_psbt,json=signedBatchPsbt,proto3" json:"signed_batch_psbt,omitempty"`
+	// The optional name of the pending batch to finalize. If neither a batch
+	// name nor a batch key is set, the default pending batch is used.
+	BatchName string `protobuf:"bytes,6,opt,name=batch_name,json=batchName,proto3" json:"batch_name,omitempty"`
+	// The optional batch key of the pending batch to finalize. If a batch name is
+	// set as well, it must match the name of the batch.
+	BatchKey []byte `protobuf:"bytes,7,opt,name=batch_key,json=batchKey,proto3" json:"batch_key,omitempty"`
+}
+
+func (x *FinalizeBatchRequest) Reset() {
+	*x = FinalizeBatchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FinalizeBatchRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelBatchResponse) ProtoMessage() {}
+func (*FinalizeBatchRequest) ProtoMessage() {}
+
+func (x *FinalizeBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FinalizeBatchRequest.ProtoReflect.Descriptor instead.
+func (*FinalizeBatchRequest) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *FinalizeBatchRequest) GetShortResponse() bool {
+	if x != nil {
+		return x.ShortResponse
+	}
+	return false
+}
+
+func (x *FinalizeBatchRequest) GetFeeRate() uint32 {
+	if x != nil {
+		return x.FeeRate
+	}
+	return 0
+}
+
+func (m *FinalizeBatchRequest) GetBatchSibling() isFinalizeBatchRequest_BatchSibling {
+	if m != nil {
+		return m.BatchSibling
+	}
+	return nil
+}
+
+func (x *FinalizeBatchRequest) GetFullTree() *taprpc.TapscriptFullTree {
+	if x, ok := x.GetBatchSibling().(*FinalizeBatchRequest_FullTree); ok {
+		return x.FullTree
+	}
+	return nil
+}
+
+func (x *FinalizeBatchRequest) GetBranch() *taprpc.TapBranch {
+	if x, ok := x.GetBatchSibling().(*FinalizeBatchRequest_Branch); ok {
+		return x.Branch
+	}
+	return nil
+}
+
+func (x *FinalizeBatchRequest) GetSignedBatchPsbt() []byte {
+	if x != nil {
+		return x.SignedBatchPsbt
+	}
+	return nil
+}
+
+func (x *FinalizeBatchRequest) GetBatchName() string {
+	if x != nil {
+		return x.BatchName
+	}
+	return ""
+}
+
+func (x *FinalizeBatchRequest) GetBatchKey() []byte {
+	if x != nil {
+		return x.BatchKey
+	}
+	return nil
+}
+
+type isFinalizeBatchRequest_BatchSibling interface {
+	isFinalizeBatchRequest_BatchSibling()
+}
+
+type FinalizeBatchRequest_FullTree struct {
+	// An ordered list of TapLeafs, which will be used to construct a
+	// Tapscript tree.
+	FullTree *taprpc.TapscriptFullTree `protobuf:"bytes,3,opt,name=full_tree,json=fullTree,proto3,oneof"`
+}
+
+type FinalizeBatchRequest_Branch struct {
+	// A TapBranch that represents a Tapscript tree managed externally.
+	Branch *taprpc.TapBranch `protobuf:"bytes,4,opt,name=branch,proto3,oneof"`
+}
+
+func (*FinalizeBatchRequest_FullTree) isFinalizeBatchRequest_BatchSibling() {}
+
+func (*FinalizeBatchRequest_Branch) isFinalizeBatchRequest_BatchSibling() {}
+
+type FinalizeBatchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The finalized batch.
+	Batch *MintingBatch `protobuf:"bytes,1,opt,name=batch,proto3" json:"batch,omitempty"`
+}
+
+func (x *FinalizeBatchResponse) Reset() {
+	*x = FinalizeBatchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FinalizeBatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FinalizeBatchResponse) ProtoMessage() {}
+
+func (x *FinalizeBatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FinalizeBatchResponse.ProtoReflect.Descriptor instead.
+func (*FinalizeBatchResponse) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *FinalizeBatchResponse) GetBatch() *MintingBatch {
+	if x != nil {
+		return x.Batch
+	}
+	return nil
+}
+
+type CancelBatchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The optional name of the pending batch to cancel. If neither a batch name
+	// nor a batch key is set, the only existing batch is cancelled.
+	BatchName string `protobuf:"bytes,1,opt,name=batch_name,json=batchName,proto3" json:"batch_name,omitempty"`
+	// The optional batch key of the batch to cancel. This can also be the key of
+	// a batch that was finalized but not yet broadcast. If a batch name is set as
+	// well, it must match the name of the pending batch.
+	BatchKey []byte `protobuf:"bytes,2,opt,name=batch_key,json=batchKey,proto3" json:"batch_key,omitempty"`
+}
+
+func (x *CancelBatchRequest) Reset() {
+	*x = CancelBatchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelBatchRequest) ProtoMessage() {}
+
+func (x *CancelBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelBatchRequest.ProtoReflect.Descriptor instead.
+func (*CancelBatchRequest) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CancelBatchRequest) GetBatchName() string {
+	if x != nil {
+		return x.BatchName
+	}
+	return ""
+}
+
+func (x *CancelBatchRequest) GetBatchKey() []byte {
+	if x != nil {
+		return x.BatchKey
+	}
+	return nil
+}
+
+type CancelBatchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The internal public key of the batch.
+	BatchKey []byte `protobuf:"bytes,1,opt,name=batch_key,json=batchKey,proto3" json:"batch_key,omitempty"`
+}
+
+func (x *CancelBatchResponse) Reset() {
+	*x = CancelBatchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelBatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelBatchResponse) ProtoMessage() {}
+
+func (x *CancelBatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelBatchResponse.ProtoReflect.Descriptor instead.
+func (*CancelBatchResponse) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CancelBatchResponse) GetBatchKey() []byte {
+	if x != nil {
+		return x.BatchKey
+	}
+	return nil
+}
+
+type RemoveSeedlingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the asset to remove from the batch.
+	AssetName string `protobuf:"bytes,1,opt,name=asset_name,json=assetName,proto3" json:"asset_name,omitempty"`
+	// The optional name of the pending batch to remove the asset from. If
+	// neither a batch name nor a batch key is set, the default pending batch is
+	// used.
+	BatchName string `protobuf:"bytes,2,opt,name=batch_name,json=batchName,proto3" json:"batch_name,omitempty"`
+	// The optional batch key of the pending batch to remove the asset from. If a
+	// batch name is set as well, it must match the name of the batch.
+	BatchKey []byte `protobuf:"bytes,3,opt,name=batch_key,json=batchKey,proto3" json:"batch_key,omitempty"`
+}
+
+func (x *RemoveSeedlingRequest) Reset() {
+	*x = RemoveSeedlingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveSeedlingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveSeedlingRequest) ProtoMessage() {}
+
+func (x *RemoveSeedlingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveSeedlingRequest.ProtoReflect.Descriptor instead.
+func (*RemoveSeedlingRequest) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RemoveSeedlingRequest) GetAssetName() string {
+	if x != nil {
+		return x.AssetName
+	}
+	return ""
+}
+
+func (x *RemoveSeedlingRequest) GetBatchName() string {
+	if x != nil {
+		return x.BatchName
+	}
+	return ""
+}
+
+func (x *RemoveSeedlingRequest) GetBatchKey() []byte {
+	if x != nil {
+		return x.BatchKey
+	}
+	return nil
+}
+
+type RemoveSeedlingResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The pending batch the asset was removed from.
+	Batch *MintingBatch `protobuf:"bytes,1,opt,name=batch,proto3" json:"batch,omitempty"`
+}
+
+func (x *RemoveSeedlingResponse) Reset() {
+	*x = RemoveSeedlingResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveSeedlingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveSeedlingResponse) ProtoMessage() {}
+
+func (x *RemoveSeedlingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveSeedlingResponse.ProtoReflect.Descriptor instead.
+func (*RemoveSeedlingResponse) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *RemoveSeedlingResponse) GetBatch() *MintingBatch {
+	if x != nil {
+		return x.Batch
+	}
+	return nil
+}
+
+type UpdateSeedlingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the asset to update.
+	AssetName string `protobuf:"bytes,1,opt,name=asset_name,json=assetName,proto3" json:"asset_name,omitempty"`
+	// The optional name of the pending batch that contains the asset. If neither
+	// a batch name nor a batch key is set, the default pending batch is used.
+	BatchName string `protobuf:"bytes,2,opt,name=batch_name,json=batchName,proto3" json:"batch_name,omitempty"`
+	// The optional batch key of the pending batch that contains the asset. If a
+	// batch name is set as well, it must match the name of the batch.
+	BatchKey []byte `protobuf:"bytes,3,opt,name=batch_key,json=batchKey,proto3" json:"batch_key,omitempty"`
+	// The new amount of the asset. If zero, the amount is not changed.
+	Amount uint64 `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	// The new metadata of the asset. If not set, the metadata is not changed.
+	AssetMeta *taprpc.AssetMeta `protobuf:"bytes,5,opt,name=asset_meta,json=assetMeta,proto3" json:"asset_meta,omitempty"`
+	// If true, the group settings of the asset are replaced with the settings
+	// given by the new_grouped_asset, grouped_asset, group_key and group_anchor
+	// fields. Otherwise, those fields are ignored.
+	UpdateGroup bool `protobuf:"varint,6,opt,name=update_group,json=updateGroup,proto3" json:"update_group,omitempty"`
+	// If true, then the asset will be created with a group key, which allows for
+	// future asset issuance.
+	NewGroupedAsset bool `protobuf:"varint,7,opt,name=new_grouped_asset,json=newGroupedAsset,proto3" json:"new_grouped_asset,omitempty"`
+	// If true, then a group key or group anchor can be set to mint this asset into
+	// an existing asset group.
+	GroupedAsset bool `protobuf:"varint,8,opt,name=grouped_asset,json=groupedAsset,proto3" json:"grouped_asset,omitempty"`
+	// The specific group key this asset should be minted with.
+	GroupKey []byte `protobuf:"bytes,9,opt,name=group_key,json=groupKey,proto3" json:"group_key,omitempty"`
+	// The name of the asset in the batch that will anchor a new asset group.
+	// This asset will be minted with the same group key as the anchor asset.
+	GroupAnchor string `protobuf:"bytes,10,opt,name=group_anchor,json=groupAnchor,proto3" json:"group_anchor,omitempty"`
+}
+
+func (x *UpdateSeedlingRequest) Reset() {
+	*x = UpdateSeedlingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateSeedlingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSeedlingRequest) ProtoMessage() {}
+
+func (x *UpdateSeedlingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSeedlingRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSeedlingRequest) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *UpdateSeedlingRequest) GetAssetName() string {
+	if x != nil {
+		return x.AssetName
+	}
+	return ""
+}
+
+func (x *UpdateSeedlingRequest) GetBatchName() string {
+	if x != nil {
+		return x.BatchName
+	}
+	return ""
+}
+
+func (x *UpdateSeedlingRequest) GetBatchKey() []byte {
+	if x != nil {
+		return x.BatchKey
+	}
+	return nil
+}
+
+func (x *UpdateSeedlingRequest) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *UpdateSeedlingRequest) GetAssetMeta() *taprpc.AssetMeta {
+	if x != nil {
+		return x.AssetMeta
+	}
+	return nil
+}
+
+func (x *UpdateSeedlingRequest) GetUpdateGroup() bool {
+	if x != nil {
+		return x.UpdateGroup
+	}
+	return false
+}
+
+func (x *UpdateSeedlingRequest) GetNewGroupedAsset() bool {
+	if x != nil {
+		return x.NewGroupedAsset
+	}
+	return false
+}
+
+func (x *UpdateSeedlingRequest) GetGroupedAsset() bool {
+	if x != nil {
+		return x.GroupedAsset
+	}
+	return false
+}
+
+func (x *UpdateSeedlingRequest) GetGroupKey() []byte {
+	if x != nil {
+		return x.GroupKey
+	}
+	return nil
+}
+
+func (x *UpdateSeedlingRequest) GetGroupAnchor() string {
+	if x != nil {
+		return x.GroupAnchor
+	}
+	return ""
+}
+
+type UpdateSeedlingResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The pending batch that contains the updated asset.
+	Batch *MintingBatch `protobuf:"bytes,1,opt,name=batch,proto3" json:"batch,omitempty"`
+}
+
+func (x *UpdateSeedlingResponse) Reset() {
+	*x = UpdateSeedlingResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateSeedlingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSeedlingResponse) ProtoMessage() {}
+
+func (x *UpdateSeedlingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSeedlingResponse.ProtoReflect.Descriptor instead.
+func (*UpdateSeedlingResponse) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *UpdateSeedlingResponse) GetBatch() *MintingBatch {
+	if x != nil {
+		return x.Batch
+	}
+	return nil
+}
+
+type BumpBatchFeeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The internal public key of the batch to bump the fee of.
+	BatchKey []byte `protobuf:"bytes,1,opt,name=batch_key,json=batchKey,proto3" json:"batch_key,omitempty"`
+	// The new fee rate to use for the minting transaction, in sat/kw.
+	FeeRate uint32 `protobuf:"varint,2,opt,name=fee_rate,json=feeRate,proto3" json:"fee_rate,omitempty"`
+}
+
+func (x *BumpBatchFeeRequest) Reset() {
+	*x = BumpBatchFeeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BumpBatchFeeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BumpBatchFeeRequest) ProtoMessage() {}
+
+func (x *BumpBatchFeeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BumpBatchFeeRequest.ProtoReflect.Descriptor instead.
+func (*BumpBatchFeeRequest) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *BumpBatchFeeRequest) GetBatchKey() []byte {
+	if x != nil {
+		return x.BatchKey
+	}
+	return nil
+}
+
+func (x *BumpBatchFeeRequest) GetFeeRate() uint32 {
+	if x != nil {
+		return x.FeeRate
+	}
+	return 0
+}
+
+type BumpBatchFeeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The batch with the replaced minting transaction.
+	Batch *MintingBatch `protobuf:"bytes,1,opt,name=batch,proto3" json:"batch,omitempty"`
+}
+
+func (x *BumpBatchFeeResponse) Reset() {
+	*x = BumpBatchFeeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BumpBatchFeeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BumpBatchFeeResponse) ProtoMessage() {}
+
+func (x *BumpBatchFeeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BumpBatchFeeResponse.ProtoReflect.Descriptor instead.
+func (*BumpBatchFeeResponse) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *BumpBatchFeeResponse) GetBatch() *MintingBatch {
+	if x != nil {
+		return x.Batch
+	}
+	return nil
+}
+
+type ListBatchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The optional batch key of the batch to list.
+	//
+	// Types that are assignable to Filter:
+	//
+	//	*ListBatchRequest_BatchKey
+	//	*ListBatchRequest_BatchKeyStr
+	Filter isListBatchRequest_Filter `protobuf_oneof:"filter"`
+}
+
+func (x *ListBatchRequest) Reset() {
+	*x = ListBatchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBatchRequest) ProtoMessage() {}
+
+func (x *ListBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBatchRequest.ProtoReflect.Descriptor instead.
+func (*ListBatchRequest) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{20}
+}
+
+func (m *ListBatchRequest) GetFilter() isListBatchRequest_Filter {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+func (x *ListBatchRequest) GetBatchKey() []byte {
+	if x, ok := x.GetFilter().(*ListBatchRequest_BatchKey); ok {
+		return x.BatchKey
+	}
+	return nil
+}
+
+func (x *ListBatchRequest) GetBatchKeyStr() string {
+	if x, ok := x.GetFilter().(*ListBatchRequest_BatchKeyStr); ok {
+		return x.BatchKeyStr
+	}
+	return ""
+}
+
+type isListBatchRequest_Filter interface {
+	isListBatchRequest_Filter()
+}
+
+type ListBatchRequest_BatchKey struct {
+	// The optional batch key of the batch to list, specified as raw bytes
+	// (gRPC only).
+	BatchKey []byte `protobuf:"bytes,1,opt,name=batch_key,json=batchKey,proto3,oneof"`
+}
+
+type ListBatchRequest_BatchKeyStr struct {
+	// The optional batch key of the batch to list, specified as a hex
+	// encoded string (use this for REST).
+	BatchKeyStr string `protobuf:"bytes,2,opt,name=batch_key_str,json=batchKeyStr,proto3,oneof"`
+}
+
+func (*ListBatchRequest_BatchKey) isListBatchRequest_Filter() {}
+
+func (*ListBatchRequest_BatchKeyStr) isListBatchRequest_Filter() {}
+
+type ListBatchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Batches []*MintingBatch `protobuf:"bytes,1,rep,name=batches,proto3" json:"batches,omitempty"`
+}
+
+func (x *ListBatchResponse) Reset() {
+	*x = ListBatchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBatchResponse) ProtoMessage() {}
+
+func (x *ListBatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBatchResponse.ProtoReflect.Descriptor instead.
+func (*ListBatchResponse) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListBatchResponse) GetBatches() []*MintingBatch {
+	if x != nil {
+		return x.Batches
+	}
+	return nil
+}
+
+type GroupVirtualTx struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the grouped asset in the pending batch.
+	AssetName string `protobuf:"bytes,1,opt,name=asset_name,json=assetName,proto3" json:"asset_name,omitempty"`
+	// The asset ID of the grouped asset.
+	AssetId []byte `protobuf:"bytes,2,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	// The group internal key that must sign the virtual transaction.
+	RawKey *taprpc.KeyDescriptor `protobuf:"bytes,3,opt,name=raw_key,json=rawKey,proto3" json:"raw_key,omitempty"`
+	// The asset ID of the group anchor, which is used as the single tweak for
+	// the group internal key.
+	SingleTweak []byte `protobuf:"bytes,4,opt,name=single_tweak,json=singleTweak,proto3" json:"single_tweak,omitempty"`
+	// The optional tapscript root of the group key, used as the tap tweak.
+	TapscriptRoot []byte `protobuf:"bytes,5,opt,name=tapscript_root,json=tapscriptRoot,proto3" json:"tapscript_root,omitempty"`
+	// The tweaked group key.
+	TweakedKey []byte `protobuf:"bytes,6,opt,name=tweaked_key,json=tweakedKey,proto3" json:"tweaked_key,omitempty"`
+	// The serialized virtual transaction that must be signed.
+	Transaction []byte `protobuf:"bytes,7,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	// The value of the output spent by the virtual transaction.
+	PrevOutValue int64 `protobuf:"varint,8,opt,name=prev_out_value,json=prevOutValue,proto3" json:"prev_out_value,omitempty"`
+	// The pk script of the output spent by the virtual transaction.
+	PrevOutPkScript []byte `protobuf:"bytes,9,opt,name=prev_out_pk_script,json=prevOutPkScript,proto3" json:"prev_out_pk_script,omitempty"`
+}
+
+func (x *GroupVirtualTx) Reset() {
+	*x = GroupVirtualTx{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mintrpc_mint_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GroupVirtualTx) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupVirtualTx) ProtoMessage() {}
+
+func (x *GroupVirtualTx) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupVirtualTx.ProtoReflect.Descriptor instead.
+func (*GroupVirtualTx) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GroupVirtualTx) GetAssetName() string {
+	if x != nil {
+		return x.AssetName
+	}
+	return ""
+}
+
+func (x *GroupVirtualTx) GetAssetId() []byte {
+	if x != nil {
+		return x.AssetId
+	}
+	return nil
+}
+
+func (x *GroupVirtualTx) GetRawKey() *taprpc.KeyDescriptor {
+	if x != nil {
+		return x.RawKey
+	}
+	return nil
+}
+
+func (x *GroupVirtualTx) GetSingleTweak() []byte {
+	if x != nil {
+		return x.SingleTweak
+	}
+	return nil
+}
+
+func (x *GroupVirtualTx) GetTapscriptRoot() []byte {
+	if x != nil {
+		return x.TapscriptRoot
+	}
+	return nil
+}
+
+func (x *GroupVirtualTx) GetTweakedKey() []byte {
+	if x != nil {
+		return x.TweakedKey
+	}
+	return nil
+}
 
-func (x *CancelBatchResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mintrpc_mint_proto_msgTypes[8]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *GroupVirtualTx) GetTransaction() []byte {
+	if x != nil {
+		return x.Transaction
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use CancelBatchResponse.ProtoReflect.Descriptor instead.
-func (*CancelBatchResponse) Descriptor() ([]byte, []int) {
-	return file_mintrpc_mint_proto_rawDescGZIP(), []int{8}
+func (x *GroupVirtualTx) GetPrevOutValue() int64 {
+	if x != nil {
+		return x.PrevOutValue
+	}
+	return 0
 }
 
-func (x *CancelBatchResponse) GetBatchKey() []byte {
+func (x *GroupVirtualTx) GetPrevOutPkScript() []byte {
 	if x != nil {
-		return x.BatchKey
+		return x.PrevOutPkScript
 	}
 	return nil
 }
 
-type ListBatchRequest struct {
+type ListGroupVirtualTxsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The optional batch key of the batch to list.
-	//
-	// Types that are assignable to Filter:
-	//
-	//	*ListBatchRequest_BatchKey
-	//	*ListBatchRequest_BatchKeyStr
-	Filter isListBatchRequest_Filter `protobuf_oneof:"filter"`
+	// The optional name of the pending batch to list the group virtual
+	// transactions for. If neither a batch name nor a batch key is set, the
+	// default pending batch is used.
+	BatchName string `protobuf:"bytes,1,opt,name=batch_name,json=batchName,proto3" json:"batch_name,omitempty"`
+	// The optional batch key of the pending batch to list the group virtual
+	// transactions for. If a batch name is set as well, it must match the name of
+	// the batch.
+	BatchKey []byte `protobuf:"bytes,2,opt,name=batch_key,json=batchKey,proto3" json:"batch_key,omitempty"`
 }
 
-func (x *ListBatchRequest) Reset() {
-	*x = ListBatchRequest{}
+func (x *ListGroupVirtualTxsRequest) Reset() {
+	*x = ListGroupVirtualTxsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mintrpc_mint_proto_msgTypes[9]
+		mi := &file_mintrpc_mint_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListBatchRequest) String() string {
+func (x *ListGroupVirtualTxsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListBatchRequest) ProtoMessage() {}
+func (*ListGroupVirtualTxsRequest) ProtoMessage() {}
 
-func (x *ListBatchRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mintrpc_mint_proto_msgTypes[9]
+func (x *ListGroupVirtualTxsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -824,77 +1950,51 @@ func (x *ListBatchRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListBatchRequest.ProtoReflect.Descriptor instead.
-func (*ListBatchRequest) Descriptor() ([]byte, []int) {
-	return file_mintrpc_mint_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use ListGroupVirtualTxsRequest.ProtoReflect.Descriptor instead.
+func (*ListGroupVirtualTxsRequest) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{23}
 }
 
-func (m *ListBatchRequest) GetFilter() isListBatchRequest_Filter {
-	if m != nil {
-		return m.Filter
+func (x *ListGroupVirtualTxsRequest) GetBatchName() string {
+	if x != nil {
+		return x.BatchName
 	}
-	return nil
+	return ""
 }
 
-func (x *ListBatchRequest) GetBatchKey() []byte {
-	if x, ok := x.GetFilter().(*ListBatchRequest_BatchKey); ok {
+func (x *ListGroupVirtualTxsRequest) GetBatchKey() []byte {
+	if x != nil {
 		return x.BatchKey
 	}
 	return nil
 }
 
-func (x *ListBatchRequest) GetBatchKeyStr() string {
-	if x, ok := x.GetFilter().(*ListBatchRequest_BatchKeyStr); ok {
-		return x.BatchKeyStr
-	}
-	return ""
-}
-
-type isListBatchRequest_Filter interface {
-	isListBatchRequest_Filter()
-}
-
-type ListBatchRequest_BatchKey struct {
-	// The optional batch key of the batch to list, specified as raw bytes
-	// (gRPC only).
-	BatchKey []byte `protobuf:"bytes,1,opt,name=batch_key,json=batchKey,proto3,oneof"`
-}
-
-type ListBatchRequest_BatchKeyStr struct {
-	// The optional batch key of the batch to list, specified as a hex
-	// encoded string (use this for REST).
-	BatchKeyStr string `protobuf:"bytes,2,opt,name=batch_key_str,json=batchKeyStr,proto3,oneof"`
-}
-
-func (*ListBatchRequest_BatchKey) isListBatchRequest_Filter() {}
-
-func (*ListBatchRequest_BatchKeyStr) isListBatchRequest_Filter() {}
-
-type ListBatchResponse struct {
+type ListGroupVirtualTxsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Batches []*MintingBatch `protobuf:"bytes,1,rep,name=batches,proto3" json:"batches,omitempty"`
+	// The group virtual transactions of the funded pending batch.
+	GroupVirtualTxs []*GroupVirtualTx `protobuf:"bytes,1,rep,name=group_virtual_txs,json=groupVirtualTxs,proto3" json:"group_virtual_txs,omitempty"`
 }
 
-func (x *ListBatchResponse) Reset() {
-	*x = ListBatchResponse{}
+func (x *ListGroupVirtualTxsResponse) Reset() {
+	*x = ListGroupVirtualTxsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mintrpc_mint_proto_msgTypes[10]
+		mi := &file_mintrpc_mint_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListBatchResponse) String() string {
+func (x *ListGroupVirtualTxsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListBatchResponse) ProtoMessage() {}
+func (*ListGroupVirtualTxsResponse) ProtoMessage() {}
 
-func (x *ListBatchResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mintrpc_mint_proto_msgTypes[10]
+func (x *ListGroupVirtualTxsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mintrpc_mint_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -905,14 +2005,14 @@ func (x *ListBatchResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListBatchResponse.ProtoReflect.Descriptor instead.
-func (*ListBatchResponse) Descriptor() ([]byte, []int) {
-	return file_mintrpc_mint_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use ListGroupVirtualTxsResponse.ProtoReflect.Descriptor instead.
+func (*ListGroupVirtualTxsResponse) Descriptor() ([]byte, []int) {
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *ListBatchResponse) GetBatches() []*MintingBatch {
+func (x *ListGroupVirtualTxsResponse) GetGroupVirtualTxs() []*GroupVirtualTx {
 	if x != nil {
-		return x.Batches
+		return x.GroupVirtualTxs
 	}
 	return nil
 }
@@ -931,7 +2031,7 @@ type SubscribeMintEventsRequest struct {
 func (x *SubscribeMintEventsRequest) Reset() {
 	*x = SubscribeMintEventsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mintrpc_mint_proto_msgTypes[11]
+		mi := &file_mintrpc_mint_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -944,7 +2044,7 @@ func (x *SubscribeMintEventsRequest) String() string {
 func (*SubscribeMintEventsRequest) ProtoMessage() {}
 
 func (x *SubscribeMintEventsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mintrpc_mint_proto_msgTypes[11]
+	mi := &file_mintrpc_mint_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -957,7 +2057,7 @@ func (x *SubscribeMintEventsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubscribeMintEventsRequest.ProtoReflect.Descriptor instead.
 func (*SubscribeMintEventsRequest) Descriptor() ([]byte, []int) {
-	return file_mintrpc_mint_proto_rawDescGZIP(), []int{11}
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *SubscribeMintEventsRequest) GetShortResponse() bool {
@@ -987,7 +2087,7 @@ type MintEvent struct {
 func (x *MintEvent) Reset() {
 	*x = MintEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mintrpc_mint_proto_msgTypes[12]
+		mi := &file_mintrpc_mint_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1000,7 +2100,7 @@ func (x *MintEvent) String() string {
 func (*MintEvent) ProtoMessage() {}
 
 func (x *MintEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_mintrpc_mint_proto_msgTypes[12]
+	mi := &file_mintrpc_mint_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1013,7 +2113,7 @@ func (x *MintEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MintEvent.ProtoReflect.Descriptor instead.
 func (*MintEvent) Descriptor() ([]byte, []int) {
-	return file_mintrpc_mint_proto_rawDescGZIP(), []int{12}
+	return file_mintrpc_mint_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *MintEvent) GetTimestamp() int64 {
@@ -1093,127 +2193,298 @@ var file_mintrpc_mint_proto_rawDesc = []byte{
 	0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4b, 0x65,
 	0x79, 0x12, 0x21, 0x0a, 0x0c, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x61, 0x6e, 0x63, 0x68, 0x6f,
 	0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x6e,
-	0x63, 0x68, 0x6f, 0x72, 0x22, 0x63, 0x0a, 0x10, 0x4d, 0x69, 0x6e, 0x74, 0x41, 0x73, 0x73, 0x65,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x05, 0x61, 0x73, 0x73, 0x65,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70,
-	0x63, 0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x05, 0x61, 0x73, 0x73,
-	0x65, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x73, 0x68, 0x6f, 0x72,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4f, 0x0a, 0x11, 0x4d, 0x69, 0x6e,
-	0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a,
-	0x0a, 0x0d, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e,
-	0x4d, 0x69, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x0c, 0x70, 0x65,
-	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x61, 0x74, 0x63, 0x68, 0x22, 0x83, 0x02, 0x0a, 0x0c, 0x4d,
-	0x69, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x1b, 0x0a, 0x09, 0x62,
-	0x61, 0x74, 0x63, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08,
-	0x62, 0x61, 0x74, 0x63, 0x68, 0x4b, 0x65, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x74, 0x63,
-	0x68, 0x5f, 0x74, 0x78, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x61,
-	0x74, 0x63, 0x68, 0x54, 0x78, 0x69, 0x64, 0x12, 0x29, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63,
-	0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61,
-	0x74, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x65, 0x6e,
-	0x64, 0x69, 0x6e, 0x67, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x06, 0x61, 0x73, 0x73, 0x65, 0x74,
-	0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
-	0x12, 0x1f, 0x0a, 0x0b, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x68, 0x69, 0x6e, 0x74, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x48, 0x69, 0x6e,
-	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x70, 0x73, 0x62, 0x74, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x62, 0x61, 0x74, 0x63, 0x68, 0x50, 0x73, 0x62, 0x74,
-	0x22, 0xd0, 0x01, 0x0a, 0x14, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x42, 0x61, 0x74,
-	0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x68, 0x6f,
-	0x72, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x0d, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x19, 0x0a, 0x08, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x07, 0x66, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x66,
-	0x75, 0x6c, 0x6c, 0x5f, 0x74, 0x72, 0x65, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
-	0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x54, 0x61, 0x70, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x46, 0x75, 0x6c, 0x6c, 0x54, 0x72, 0x65, 0x65, 0x48, 0x00, 0x52, 0x08, 0x66, 0x75, 0x6c,
-	0x6c, 0x54, 0x72, 0x65, 0x65, 0x12, 0x2b, 0x0a, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x54,
-	0x61, 0x70, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x48, 0x00, 0x52, 0x06, 0x62, 0x72, 0x61, 0x6e,
-	0x63, 0x68, 0x42, 0x0f, 0x0a, 0x0d, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x73, 0x69, 0x62, 0x6c,
-	0x69, 0x6e, 0x67, 0x22, 0x44, 0x0a, 0x15, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x42,
-	0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x05,
+	0x63, 0x68, 0x6f, 0x72, 0x22, 0x9f, 0x01, 0x0a, 0x10, 0x4d, 0x69, 0x6e, 0x74, 0x41, 0x73, 0x73,
+	0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x05, 0x61, 0x73, 0x73,
+	0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72,
+	0x70, 0x63, 0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x05, 0x61, 0x73,
+	0x73, 0x65, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x73, 0x68, 0x6f,
+	0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x61,
+	0x74, 0x63, 0x68, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x62, 0x61, 0x74, 0x63, 0x68, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x61, 0x74,
+	0x63, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x62, 0x61,
+	0x74, 0x63, 0x68, 0x4b, 0x65, 0x79, 0x22, 0x4f, 0x0a, 0x11, 0x4d, 0x69, 0x6e, 0x74, 0x41, 0x73,
+	0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x0d, 0x70,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x69, 0x6e,
+	0x74, 0x69, 0x6e, 0x67, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x0c, 0x70, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x42, 0x61, 0x74, 0x63, 0x68, 0x22, 0xa2, 0x02, 0x0a, 0x0c, 0x4d, 0x69, 0x6e, 0x74,
+	0x69, 0x6e, 0x67, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x61, 0x74, 0x63,
+	0x68, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x62, 0x61, 0x74,
+	0x63, 0x68, 0x4b, 0x65, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x74,
+	0x78, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x61, 0x74, 0x63, 0x68,
+	0x54, 0x78, 0x69, 0x64, 0x12, 0x29, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x2d, 0x0a, 0x06, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x06, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x12, 0x1d,
+	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1f, 0x0a,
+	0x0b, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x68, 0x69, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0a, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x48, 0x69, 0x6e, 0x74, 0x12, 0x1d,
+	0x0a, 0x0a, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x70, 0x73, 0x62, 0x74, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x09, 0x62, 0x61, 0x74, 0x63, 0x68, 0x50, 0x73, 0x62, 0x74, 0x12, 0x1d, 0x0a,
+	0x0a, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x62, 0x61, 0x74, 0x63, 0x68, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xad, 0x02, 0x0a,
+	0x10, 0x46, 0x75, 0x6e, 0x64, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x73, 0x68, 0x6f, 0x72, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x66, 0x65, 0x65, 0x5f,
+	0x72, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x66, 0x65, 0x65, 0x52,
+	0x61, 0x74, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x74, 0x72, 0x65, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e,
+	0x54, 0x61, 0x70, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x46, 0x75, 0x6c, 0x6c, 0x54, 0x72, 0x65,
+	0x65, 0x48, 0x00, 0x52, 0x08, 0x66, 0x75, 0x6c, 0x6c, 0x54, 0x72, 0x65, 0x65, 0x12, 0x2b, 0x0a,
+	0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x54, 0x61, 0x70, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68,
+	0x48, 0x00, 0x52, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x73,
+	0x62, 0x74, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x0c, 0x70, 0x73, 0x62, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x61, 0x74, 0x63, 0x68, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b,
+	0x0a, 0x09, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x08, 0x62, 0x61, 0x74, 0x63, 0x68, 0x4b, 0x65, 0x79, 0x42, 0x0f, 0x0a, 0x0d, 0x62,
+	0x61, 0x74, 0x63, 0x68, 0x5f, 0x73, 0x69, 0x62, 0x6c, 0x69, 0x6e, 0x67, 0x22, 0x40, 0x0a, 0x11,
+	0x46, 0x75, 0x6e, 0x64, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2b, 0x0a, 0x05, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x69,
+	0x6e, 0x67, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x05, 0x62, 0x61, 0x74, 0x63, 0x68, 0x22, 0x43,
+	0x0a, 0x0c, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x57, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x12, 0x19,
+	0x0a, 0x08, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x07, 0x61, 0x73, 0x73, 0x65, 0x74, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x77, 0x69, 0x74,
+	0x6e, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x07, 0x77, 0x69, 0x74, 0x6e,
+	0x65, 0x73, 0x73, 0x22, 0xb5, 0x01, 0x0a, 0x10, 0x53, 0x65, 0x61, 0x6c, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x68, 0x6f, 0x72,
+	0x74, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0d, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x3e, 0x0a, 0x0f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x77, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72,
+	0x70, 0x63, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x57, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x52,
+	0x0e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x57, 0x69, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12,
+	0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x61, 0x74, 0x63, 0x68, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b,
+	0x0a, 0x09, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x08, 0x62, 0x61, 0x74, 0x63, 0x68, 0x4b, 0x65, 0x79, 0x22, 0x40, 0x0a, 0x11, 0x53,
+	0x65, 0x61, 0x6c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x2b, 0x0a, 0x05, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x69, 0x6e,
+	0x67, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x05, 0x62, 0x61, 0x74, 0x63, 0x68, 0x22, 0xb8, 0x02,
+	0x0a, 0x14, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d,
+	0x73, 0x68, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a,
+	0x08, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x07, 0x66, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x66, 0x75, 0x6c, 0x6c,
+	0x5f, 0x74, 0x72, 0x65, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74, 0x61,
+	0x70, 0x72, 0x70, 0x63, 0x2e, 0x54, 0x61, 0x70, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x46, 0x75,
+	0x6c, 0x6c, 0x54, 0x72, 0x65, 0x65, 0x48, 0x00, 0x52, 0x08, 0x66, 0x75, 0x6c, 0x6c, 0x54, 0x72,
+	0x65, 0x65, 0x12, 0x2b, 0x0a, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x54, 0x61, 0x70, 0x42,
+	0x72, 0x61, 0x6e, 0x63, 0x68, 0x48, 0x00, 0x52, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x12,
+	0x2a, 0x0a, 0x11, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f,
+	0x70, 0x73, 0x62, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x73, 0x69, 0x67, 0x6e,
+	0x65, 0x64, 0x42, 0x61, 0x74, 0x63, 0x68, 0x50, 0x73, 0x62, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x62,
+	0x61, 0x74, 0x63, 0x68, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x62, 0x61, 0x74, 0x63, 0x68, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x61,
+	0x74, 0x63, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x62,
+	0x61, 0x74, 0x63, 0x68, 0x4b, 0x65, 0x79, 0x42, 0x0f, 0x0a, 0x0d, 0x62, 0x61, 0x74, 0x63, 0x68,
+	0x5f, 0x73, 0x69, 0x62, 0x6c, 0x69, 0x6e, 0x67, 0x22, 0x44, 0x0a, 0x15, 0x46, 0x69, 0x6e, 0x61,
+	0x6c, 0x69, 0x7a, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2b, 0x0a, 0x05, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x69,
+	0x6e, 0x67, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x05, 0x62, 0x61, 0x74, 0x63, 0x68, 0x22, 0x50,
+	0x0a, 0x12, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x61, 0x74, 0x63, 0x68, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6b, 0x65, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x62, 0x61, 0x74, 0x63, 0x68, 0x4b, 0x65, 0x79,
+	0x22, 0x32, 0x0a, 0x13, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x61, 0x74, 0x63, 0x68,
+	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x62, 0x61, 0x74, 0x63,
+	0x68, 0x4b, 0x65, 0x79, 0x22, 0x72, 0x0a, 0x15, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x65,
+	0x65, 0x64, 0x6c, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a,
+	0x0a, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
+	0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x62, 0x61, 0x74, 0x63, 0x68, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x62,
+	0x61, 0x74, 0x63, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08,
+	0x62, 0x61, 0x74, 0x63, 0x68, 0x4b, 0x65, 0x79, 0x22, 0x45, 0x0a, 0x16, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x53, 0x65, 0x65, 0x64, 0x6c, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2b, 0x0a, 0x05, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x69, 0x6e, 0x74,
+	0x69, 0x6e, 0x67, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x05, 0x62, 0x61, 0x74, 0x63, 0x68, 0x22,
+	0xf0, 0x02, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x65, 0x64, 0x6c, 0x69,
+	0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x73, 0x73,
+	0x65, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61,
+	0x73, 0x73, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x74, 0x63,
+	0x68, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x61,
+	0x74, 0x63, 0x68, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x61, 0x74, 0x63, 0x68,
+	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x62, 0x61, 0x74, 0x63,
+	0x68, 0x4b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x0a,
+	0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4d,
+	0x65, 0x74, 0x61, 0x52, 0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x21,
+	0x0a, 0x0c, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x12, 0x2a, 0x0a, 0x11, 0x6e, 0x65, 0x77, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x64,
+	0x5f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x6e, 0x65,
+	0x77, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x64, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x23, 0x0a,
+	0x0d, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x64, 0x5f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x64, 0x41, 0x73, 0x73,
+	0x65, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6b, 0x65, 0x79, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4b, 0x65, 0x79, 0x12,
+	0x21, 0x0a, 0x0c, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x6e, 0x63, 0x68,
+	0x6f, 0x72, 0x22, 0x45, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x65, 0x64,
+	0x6c, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x05,
 	0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x69,
 	0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x42, 0x61, 0x74,
-	0x63, 0x68, 0x52, 0x05, 0x62, 0x61, 0x74, 0x63, 0x68, 0x22, 0x14, 0x0a, 0x12, 0x43, 0x61, 0x6e,
-	0x63, 0x65, 0x6c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
-	0x32, 0x0a, 0x13, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f,
-	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x62, 0x61, 0x74, 0x63, 0x68,
-	0x4b, 0x65, 0x79, 0x22, 0x61, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x09, 0x62, 0x61, 0x74, 0x63, 0x68,
-	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x08, 0x62, 0x61,
-	0x74, 0x63, 0x68, 0x4b, 0x65, 0x79, 0x12, 0x24, 0x0a, 0x0d, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f,
-	0x6b, 0x65, 0x79, 0x5f, 0x73, 0x74, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
-	0x0b, 0x62, 0x61, 0x74, 0x63, 0x68, 0x4b, 0x65, 0x79, 0x53, 0x74, 0x72, 0x42, 0x08, 0x0a, 0x06,
-	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0x44, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61,
-	0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x07, 0x62,
-	0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d,
-	0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x42, 0x61,
-	0x74, 0x63, 0x68, 0x52, 0x07, 0x62, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x22, 0x43, 0x0a, 0x1a,
-	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4d, 0x69, 0x6e, 0x74, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x68,
-	0x6f, 0x72, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x0d, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0xa2, 0x01, 0x0a, 0x09, 0x4d, 0x69, 0x6e, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12,
-	0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x34, 0x0a,
-	0x0b, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x13, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x74,
-	0x63, 0x68, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x0a, 0x62, 0x61, 0x74, 0x63, 0x68, 0x53, 0x74,
-	0x61, 0x74, 0x65, 0x12, 0x2b, 0x0a, 0x05, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x69, 0x6e,
-	0x74, 0x69, 0x6e, 0x67, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x05, 0x62, 0x61, 0x74, 0x63, 0x68,
-	0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x2a, 0x88, 0x02, 0x0a, 0x0a, 0x42, 0x61, 0x74, 0x63, 0x68,
-	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x17, 0x0a, 0x13, 0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x53,
-	0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x17,
-	0x0a, 0x13, 0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x50, 0x45,
-	0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x42, 0x41, 0x54, 0x43, 0x48,
-	0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x46, 0x52, 0x4f, 0x5a, 0x45, 0x4e, 0x10, 0x02, 0x12,
-	0x19, 0x0a, 0x15, 0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x43,
-	0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x54, 0x45, 0x44, 0x10, 0x03, 0x12, 0x19, 0x0a, 0x15, 0x42, 0x41,
-	0x54, 0x43, 0x48, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x42, 0x52, 0x4f, 0x41, 0x44, 0x43,
-	0x41, 0x53, 0x54, 0x10, 0x04, 0x12, 0x19, 0x0a, 0x15, 0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x53,
-	0x54, 0x41, 0x54, 0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x52, 0x4d, 0x45, 0x44, 0x10, 0x05,
-	0x12, 0x19, 0x0a, 0x15, 0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f,
-	0x46, 0x49, 0x4e, 0x41, 0x4c, 0x49, 0x5a, 0x45, 0x44, 0x10, 0x06, 0x12, 0x22, 0x0a, 0x1e, 0x42,
-	0x41, 0x54, 0x43, 0x48, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x45, 0x45, 0x44, 0x4c,
-	0x49, 0x4e, 0x47, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x07, 0x12,
-	0x20, 0x0a, 0x1c, 0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x53,
-	0x50, 0x52, 0x4f, 0x55, 0x54, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10,
-	0x08, 0x32, 0xfc, 0x02, 0x0a, 0x04, 0x4d, 0x69, 0x6e, 0x74, 0x12, 0x42, 0x0a, 0x09, 0x4d, 0x69,
-	0x6e, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x19, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70,
-	0x63, 0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x69, 0x6e,
-	0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e,
-	0x0a, 0x0d, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12,
-	0x1d, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69,
-	0x7a, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e,
-	0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a,
-	0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48,
-	0x0a, 0x0b, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x1b, 0x2e,
-	0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x42, 0x61,
-	0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6d, 0x69, 0x6e,
+	0x63, 0x68, 0x52, 0x05, 0x62, 0x61, 0x74, 0x63, 0x68, 0x22, 0x4d, 0x0a, 0x13, 0x42, 0x75, 0x6d,
+	0x70, 0x42, 0x61, 0x74, 0x63, 0x68, 0x46, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1b, 0x0a, 0x09, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x08, 0x62, 0x61, 0x74, 0x63, 0x68, 0x4b, 0x65, 0x79, 0x12, 0x19, 0x0a,
+	0x08, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x07, 0x66, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x22, 0x43, 0x0a, 0x14, 0x42, 0x75, 0x6d, 0x70,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x46, 0x65, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x2b, 0x0a, 0x05, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x69, 0x6e,
+	0x67, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x05, 0x62, 0x61, 0x74, 0x63, 0x68, 0x22, 0x61, 0x0a,
+	0x10, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1d, 0x0a, 0x09, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x08, 0x62, 0x61, 0x74, 0x63, 0x68, 0x4b, 0x65, 0x79,
+	0x12, 0x24, 0x0a, 0x0d, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x73, 0x74,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0b, 0x62, 0x61, 0x74, 0x63, 0x68,
+	0x4b, 0x65, 0x79, 0x53, 0x74, 0x72, 0x42, 0x08, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x22, 0x44, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x07, 0x62, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63,
+	0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x07, 0x62,
+	0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x22, 0xda, 0x02, 0x0a, 0x0e, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x54, 0x78, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x73, 0x73,
+	0x65, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61,
+	0x73, 0x73, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x73, 0x73, 0x65,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x73, 0x73, 0x65,
+	0x74, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x07, 0x72, 0x61, 0x77, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4b, 0x65,
+	0x79, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x06, 0x72, 0x61, 0x77,
+	0x4b, 0x65, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x5f, 0x74, 0x77,
+	0x65, 0x61, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x73, 0x69, 0x6e, 0x67, 0x6c,
+	0x65, 0x54, 0x77, 0x65, 0x61, 0x6b, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x61, 0x70, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d,
+	0x74, 0x61, 0x70, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x1f, 0x0a,
+	0x0b, 0x74, 0x77, 0x65, 0x61, 0x6b, 0x65, 0x64, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x0a, 0x74, 0x77, 0x65, 0x61, 0x6b, 0x65, 0x64, 0x4b, 0x65, 0x79, 0x12, 0x20,
+	0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x24, 0x0a, 0x0e, 0x70, 0x72, 0x65, 0x76, 0x5f, 0x6f, 0x75, 0x74, 0x5f, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x70, 0x72, 0x65, 0x76, 0x4f, 0x75,
+	0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x2b, 0x0a, 0x12, 0x70, 0x72, 0x65, 0x76, 0x5f, 0x6f,
+	0x75, 0x74, 0x5f, 0x70, 0x6b, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x0f, 0x70, 0x72, 0x65, 0x76, 0x4f, 0x75, 0x74, 0x50, 0x6b, 0x53, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x22, 0x58, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x54, 0x78, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x61, 0x74, 0x63, 0x68, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x1b, 0x0a, 0x09, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x08, 0x62, 0x61, 0x74, 0x63, 0x68, 0x4b, 0x65, 0x79, 0x22, 0x62, 0x0a,
+	0x1b, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61,
+	0x6c, 0x54, 0x78, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x11,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x5f, 0x74, 0x78,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70,
+	0x63, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x54, 0x78,
+	0x52, 0x0f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x54, 0x78,
+	0x73, 0x22, 0x43, 0x0a, 0x1a, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4d, 0x69,
+	0x6e, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x25, 0x0a, 0x0e, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xa2, 0x01, 0x0a, 0x09, 0x4d, 0x69, 0x6e, 0x74, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x12, 0x34, 0x0a, 0x0b, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70,
+	0x63, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x0a, 0x62, 0x61,
+	0x74, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x2b, 0x0a, 0x05, 0x62, 0x61, 0x74, 0x63,
+	0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70,
+	0x63, 0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x05,
+	0x62, 0x61, 0x74, 0x63, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x2a, 0x88, 0x02, 0x0a, 0x0a,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x17, 0x0a, 0x13, 0x42, 0x41,
+	0x54, 0x43, 0x48, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57,
+	0x4e, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x45, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12,
+	0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x46, 0x52, 0x4f, 0x5a,
+	0x45, 0x4e, 0x10, 0x02, 0x12, 0x19, 0x0a, 0x15, 0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x45, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x49, 0x54, 0x54, 0x45, 0x44, 0x10, 0x03, 0x12,
+	0x19, 0x0a, 0x15, 0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x42,
+	0x52, 0x4f, 0x41, 0x44, 0x43, 0x41, 0x53, 0x54, 0x10, 0x04, 0x12, 0x19, 0x0a, 0x15, 0x42, 0x41,
+	0x54, 0x43, 0x48, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x52,
+	0x4d, 0x45, 0x44, 0x10, 0x05, 0x12, 0x19, 0x0a, 0x15, 0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x45, 0x5f, 0x46, 0x49, 0x4e, 0x41, 0x4c, 0x49, 0x5a, 0x45, 0x44, 0x10, 0x06,
+	0x12, 0x22, 0x0a, 0x1e, 0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f,
+	0x53, 0x45, 0x45, 0x44, 0x4c, 0x49, 0x4e, 0x47, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c,
+	0x45, 0x44, 0x10, 0x07, 0x12, 0x20, 0x0a, 0x1c, 0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x45, 0x5f, 0x53, 0x50, 0x52, 0x4f, 0x55, 0x54, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45,
+	0x4c, 0x4c, 0x45, 0x44, 0x10, 0x08, 0x32, 0xd9, 0x06, 0x0a, 0x04, 0x4d, 0x69, 0x6e, 0x74, 0x12,
+	0x42, 0x0a, 0x09, 0x4d, 0x69, 0x6e, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x19, 0x2e, 0x6d,
+	0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70,
+	0x63, 0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x09, 0x46, 0x75, 0x6e, 0x64, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x12, 0x19, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x75, 0x6e, 0x64, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6d, 0x69,
+	0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x75, 0x6e, 0x64, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x09, 0x53, 0x65, 0x61, 0x6c, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x12, 0x19, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x53,
+	0x65, 0x61, 0x6c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1a, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x61, 0x6c, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x0d, 0x46,
+	0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x1d, 0x2e, 0x6d,
+	0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x6d, 0x69,
+	0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x0b, 0x43,
+	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x1b, 0x2e, 0x6d, 0x69, 0x6e,
 	0x74, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70,
+	0x63, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x0e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53,
+	0x65, 0x65, 0x64, 0x6c, 0x69, 0x6e, 0x67, 0x12, 0x1e, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70,
+	0x63, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x65, 0x65, 0x64, 0x6c, 0x69, 0x6e, 0x67,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70,
+	0x63, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x65, 0x65, 0x64, 0x6c, 0x69, 0x6e, 0x67,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x53, 0x65, 0x65, 0x64, 0x6c, 0x69, 0x6e, 0x67, 0x12, 0x1e, 0x2e, 0x6d, 0x69, 0x6e,
+	0x74, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x65, 0x64, 0x6c,
+	0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6d, 0x69, 0x6e,
+	0x74, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x65, 0x64, 0x6c,
+	0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x0c, 0x42,
+	0x75, 0x6d, 0x70, 0x42, 0x61, 0x74, 0x63, 0x68, 0x46, 0x65, 0x65, 0x12, 0x1c, 0x2e, 0x6d, 0x69,
+	0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x75, 0x6d, 0x70, 0x42, 0x61, 0x74, 0x63, 0x68, 0x46,
+	0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6d, 0x69, 0x6e, 0x74,
+	0x72, 0x70, 0x63, 0x2e, 0x42, 0x75, 0x6d, 0x70, 0x42, 0x61, 0x74, 0x63, 0x68, 0x46, 0x65, 0x65,
 	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74,
 	0x42, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x12, 0x19, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70,
 	0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65,
 	0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73,
-	0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50,
-	0x0a, 0x13, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4d, 0x69, 0x6e, 0x74, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x23, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e,
-	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4d, 0x69, 0x6e, 0x74, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x6d, 0x69, 0x6e,
-	0x74, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01,
-	0x42, 0x38, 0x5a, 0x36, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c,
-	0x69, 0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x74, 0x61, 0x70,
-	0x72, 0x6f, 0x6f, 0x74, 0x2d, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x2f, 0x74, 0x61, 0x70, 0x72,
-	0x70, 0x63, 0x2f, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60,
+	0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x56, 0x69, 0x72, 0x74, 0x75,
+	0x61, 0x6c, 0x54, 0x78, 0x73, 0x12, 0x23, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c,
+	0x54, 0x78, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6d, 0x69, 0x6e,
+	0x74, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x56, 0x69,
+	0x72, 0x74, 0x75, 0x61, 0x6c, 0x54, 0x78, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x50, 0x0a, 0x13, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4d, 0x69, 0x6e,
+	0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x23, 0x2e, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70,
+	0x63, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4d, 0x69, 0x6e, 0x74, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x6d,
+	0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x30, 0x01, 0x42, 0x38, 0x5a, 0x36, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x74,
+	0x61, 0x70, 0x72, 0x6f, 0x6f, 0x74, 0x2d, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x2f, 0x74, 0x61,
+	0x70, 0x72, 0x70, 0x63, 0x2f, 0x6d, 0x69, 0x6e, 0x74, 0x72, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1229,60 +2500,98 @@ func file_mintrpc_mint_proto_rawDescGZIP() []byte {
 }
 
 var file_mintrpc_mint_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_mintrpc_mint_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_mintrpc_mint_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
 var file_mintrpc_mint_proto_goTypes = []interface{}{
-	(BatchState)(0),                    // 0: mintrpc.BatchState
-	(*PendingAsset)(nil),               // 1: mintrpc.PendingAsset
-	(*MintAsset)(nil),                  // 2: mintrpc.MintAsset
-	(*MintAssetRequest)(nil),           // 3: mintrpc.MintAssetRequest
-	(*MintAssetResponse)(nil),          // 4: mintrpc.MintAssetResponse
-	(*MintingBatch)(nil),               // 5: mintrpc.MintingBatch
-	(*FinalizeBatchRequest)(nil),       // 6: mintrpc.FinalizeBatchRequest
-	(*FinalizeBatchResponse)(nil),      // 7: mintrpc.FinalizeBatchResponse
-	(*CancelBatchRequest)(nil),         // 8: mintrpc.CancelBatchRequest
-	(*CancelBatchResponse)(nil),        // 9: mintrpc.CancelBatchResponse
-	(*ListBatchRequest)(nil),           // 10: mintrpc.ListBatchRequest
-	(*ListBatchResponse)(nil),          // 11: mintrpc.ListBatchResponse
-	(*SubscribeMintEventsRequest)(nil), // 12: mintrpc.SubscribeMintEventsRequest
-	(*MintEvent)(nil),                  // 13: mintrpc.MintEvent
-	(taprpc.AssetVersion)(0),           // 14: taprpc.AssetVersion
-	(taprpc.AssetType)(0),              // 15: taprpc.AssetType
-	(*taprpc.AssetMeta)(nil),           // 16: taprpc.AssetMeta
-	(*taprpc.TapscriptFullTree)(nil),   // 17: taprpc.TapscriptFullTree
-	(*taprpc.TapBranch)(nil),           // 18: taprpc.TapBranch
+	(BatchState)(0),                     // 0: mintrpc.BatchState
+	(*PendingAsset)(nil),                // 1: mintrpc.PendingAsset
+	(*MintAsset)(nil),                   // 2: mintrpc.MintAsset
+	(*MintAssetRequest)(nil),            // 3: mintrpc.MintAssetRequest
+	(*MintAssetResponse)(nil),           // 4: mintrpc.MintAssetResponse
+	(*MintingBatch)(nil),                // 5: mintrpc.MintingBatch
+	(*FundBatchRequest)(nil),            // 6: mintrpc.FundBatchRequest
+	(*FundBatchResponse)(nil),           // 7: mintrpc.FundBatchResponse
+	(*GroupWitness)(nil),                // 8: mintrpc.GroupWitness
+	(*SealBatchRequest)(nil),            // 9: mintrpc.SealBatchRequest
+	(*SealBatchResponse)(nil),           // 10: mintrpc.SealBatchResponse
+	(*FinalizeBatchRequest)(nil),        // 11: mintrpc.FinalizeBatchRequest
+	(*FinalizeBatchResponse)(nil),       // 12: mintrpc.FinalizeBatchResponse
+	(*CancelBatchRequest)(nil),          // 13: mintrpc.CancelBatchRequest
+	(*CancelBatchResponse)(nil),         // 14: mintrpc.CancelBatchResponse
+	(*RemoveSeedlingRequest)(nil),       // 15: mintrpc.RemoveSeedlingRequest
+	(*RemoveSeedlingResponse)(nil),      // 16: mintrpc.RemoveSeedlingResponse
+	(*UpdateSeedlingRequest)(nil),       // 17: mintrpc.UpdateSeedlingRequest
+	(*UpdateSeedlingResponse)(nil),      // 18: mintrpc.UpdateSeedlingResponse
+	(*BumpBatchFeeRequest)(nil),         // 19: mintrpc.BumpBatchFeeRequest
+	(*BumpBatchFeeResponse)(nil),        // 20: mintrpc.BumpBatchFeeResponse
+	(*ListBatchRequest)(nil),            // 21: mintrpc.ListBatchRequest
+	(*ListBatchResponse)(nil),           // 22: mintrpc.ListBatchResponse
+	(*GroupVirtualTx)(nil),              // 23: mintrpc.GroupVirtualTx
+	(*ListGroupVirtualTxsRequest)(nil),  // 24: mintrpc.ListGroupVirtualTxsRequest
+	(*ListGroupVirtualTxsResponse)(nil), // 25: mintrpc.ListGroupVirtualTxsResponse
+	(*SubscribeMintEventsRequest)(nil),  // 26: mintrpc.SubscribeMintEventsRequest
+	(*MintEvent)(nil),                   // 27: mintrpc.MintEvent
+	(taprpc.AssetVersion)(0),            // 28: taprpc.AssetVersion
+	(taprpc.AssetType)(0),               // 29: taprpc.AssetType
+	(*taprpc.AssetMeta)(nil),            // 30: taprpc.AssetMeta
+	(*taprpc.TapscriptFullTree)(nil),    // 31: taprpc.TapscriptFullTree
+	(*taprpc.TapBranch)(nil),            // 32: taprpc.TapBranch
+	(*taprpc.KeyDescriptor)(nil),        // 33: taprpc.KeyDescriptor
 }
 var file_mintrpc_mint_proto_depIdxs = []int32{
-	14, // 0: mintrpc.PendingAsset.asset_version:type_name -> taprpc.AssetVersion
-	15, // 1: mintrpc.PendingAsset.asset_type:type_name -> taprpc.AssetType
-	16, // 2: mintrpc.PendingAsset.asset_meta:type_name -> taprpc.AssetMeta
-	14, // 3: mintrpc.MintAsset.asset_version:type_name -> taprpc.AssetVersion
-	15, // 4: mintrpc.MintAsset.asset_type:type_name -> taprpc.AssetType
-	16, // 5: mintrpc.MintAsset.asset_meta:type_name -> taprpc.AssetMeta
+	28, // 0: mintrpc.PendingAsset.asset_version:type_name -> taprpc.AssetVersion
+	29, // 1: mintrpc.PendingAsset.asset_type:type_name -> taprpc.AssetType
+	30, // 2: mintrpc.PendingAsset.asset_meta:type_name -> taprpc.AssetMeta
+	28, // 3: mintrpc.MintAsset.asset_version:type_name -> taprpc.AssetVersion
+	29, // 4: mintrpc.MintAsset.asset_type:type_name -> taprpc.AssetType
+	30, // 5: mintrpc.MintAsset.asset_meta:type_name -> taprpc.AssetMeta
 	2,  // 6: mintrpc.MintAssetRequest.asset:type_name -> mintrpc.MintAsset
 	5,  // 7: mintrpc.MintAssetResponse.pending_batch:type_name -> mintrpc.MintingBatch
 	0,  // 8: mintrpc.MintingBatch.state:type_name -> mintrpc.BatchState
 	1,  // 9: mintrpc.MintingBatch.assets:type_name -> mintrpc.PendingAsset
-	17, // 10: mintrpc.FinalizeBatchRequest.full_tree:type_name -> taprpc.TapscriptFullTree
-	18, // 11: mintrpc.FinalizeBatchRequest.branch:type_name -> taprpc.TapBranch
-	5,  // 12: mintrpc.FinalizeBatchResponse.batch:type_name -> mintrpc.MintingBatch
-	5,  // 13: mintrpc.ListBatchResponse.batches:type_name -> mintrpc.MintingBatch
-	0,  // 14: mintrpc.MintEvent.batch_state:type_name -> mintrpc.BatchState
-	5,  // 15: mintrpc.MintEvent.batch:type_name -> mintrpc.MintingBatch
-	3,  // 16: mintrpc.Mint.MintAsset:input_type -> mintrpc.MintAssetRequest
-	6,  // 17: mintrpc.Mint.FinalizeBatch:input_type -> mintrpc.FinalizeBatchRequest
-	8,  // 18: mintrpc.Mint.CancelBatch:input_type -> mintrpc.CancelBatchRequest
-	10, // 19: mintrpc.Mint.ListBatches:input_type -> mintrpc.ListBatchRequest
-	12, // 20: mintrpc.Mint.SubscribeMintEvents:input_type -> mintrpc.SubscribeMintEventsRequest
-	4,  // 21: mintrpc.Mint.MintAsset:output_type -> mintrpc.MintAssetResponse
-	7,  // 22: mintrpc.Mint.FinalizeBatch:output_type -> mintrpc.FinalizeBatchResponse
-	9,  // 23: mintrpc.Mint.CancelBatch:output_type -> mintrpc.CancelBatchResponse
-	11, // 24: mintrpc.Mint.ListBatches:output_type -> mintrpc.ListBatchResponse
-	13, // 25: mintrpc.Mint.SubscribeMintEvents:output_type -> mintrpc.MintEvent
-	21, // [21:26] is the sub-list for method output_type
-	16, // [16:21] is the sub-list for method input_type
-	16, // [16:16] is the sub-list for extension type_name
-	16, // [16:16] is the sub-list for extension extendee
-	0,  // [0:16] is the sub-list for field type_name
+	31, // 10: mintrpc.FundBatchRequest.full_tree:type_name -> taprpc.TapscriptFullTree
+	32, // 11: mintrpc.FundBatchRequest.branch:type_name -> taprpc.TapBranch
+	5,  // 12: mintrpc.FundBatchResponse.batch:type_name -> mintrpc.MintingBatch
+	8,  // 13: mintrpc.SealBatchRequest.group_witnesses:type_name -> mintrpc.GroupWitness
+	5,  // 14: mintrpc.SealBatchResponse.batch:type_name -> mintrpc.MintingBatch
+	31, // 15: mintrpc.FinalizeBatchRequest.full_tree:type_name -> taprpc.TapscriptFullTree
+	32, // 16: mintrpc.FinalizeBatchRequest.branch:type_name -> taprpc.TapBranch
+	5,  // 17: mintrpc.FinalizeBatchResponse.batch:type_name -> mintrpc.MintingBatch
+	5,  // 18: mintrpc.RemoveSeedlingResponse.batch:type_name -> mintrpc.MintingBatch
+	30, // 19: mintrpc.UpdateSeedlingRequest.asset_meta:type_name -> taprpc.AssetMeta
+	5,  // 20: mintrpc.UpdateSeedlingResponse.batch:type_name -> mintrpc.MintingBatch
+	5,  // 21: mintrpc.BumpBatchFeeResponse.batch:type_name -> mintrpc.MintingBatch
+	5,  // 22: mintrpc.ListBatchResponse.batches:type_name -> mintrpc.MintingBatch
+	33, // 23: mintrpc.GroupVirtualTx.raw_key:type_name -> taprpc.KeyDescriptor
+	23, // 24: mintrpc.ListGroupVirtualTxsResponse.group_virtual_txs:type_name -> mintrpc.GroupVirtualTx
+	0,  // 25: mintrpc.MintEvent.batch_state:type_name -> mintrpc.BatchState
+	5,  // 26: mintrpc.MintEvent.batch:type_name -> mintrpc.MintingBatch
+	3,  // 27: mintrpc.Mint.MintAsset:input_type -> mintrpc.MintAssetRequest
+	6,  // 28: mintrpc.Mint.FundBatch:input_type -> mintrpc.FundBatchRequest
+	9,  // 29: mintrpc.Mint.SealBatch:input_type -> mintrpc.SealBatchRequest
+	11, // 30: mintrpc.Mint.FinalizeBatch:input_type -> mintrpc.FinalizeBatchRequest
+	13, // 31: mintrpc.Mint.CancelBatch:input_type -> mintrpc.CancelBatchRequest
+	15, // 32: mintrpc.Mint.RemoveSeedling:input_type -> mintrpc.RemoveSeedlingRequest
+	17, // 33: mintrpc.Mint.UpdateSeedling:input_type -> mintrpc.UpdateSeedlingRequest
+	19, // 34: mintrpc.Mint.BumpBatchFee:input_type -> mintrpc.BumpBatchFeeRequest
+	21, // 35: mintrpc.Mint.ListBatches:input_type -> mintrpc.ListBatchRequest
+	24, // 36: mintrpc.Mint.ListGroupVirtualTxs:input_type -> mintrpc.ListGroupVirtualTxsRequest
+	26, // 37: mintrpc.Mint.SubscribeMintEvents:input_type -> mintrpc.SubscribeMintEventsRequest
+	4,  // 38: mintrpc.Mint.MintAsset:output_type -> mintrpc.MintAssetResponse
+	7,  // 39: mintrpc.Mint.FundBatch:output_type -> mintrpc.FundBatchResponse
+	10, // 40: mintrpc.Mint.SealBatch:output_type -> mintrpc.SealBatchResponse
+	12, // 41: mintrpc.Mint.FinalizeBatch:output_type -> mintrpc.FinalizeBatchResponse
+	14, // 42: mintrpc.Mint.CancelBatch:output_type -> mintrpc.CancelBatchResponse
+	16, // 43: mintrpc.Mint.RemoveSeedling:output_type -> mintrpc.RemoveSeedlingResponse
+	18, // 44: mintrpc.Mint.UpdateSeedling:output_type -> mintrpc.UpdateSeedlingResponse
+	20, // 45: mintrpc.Mint.BumpBatchFee:output_type -> mintrpc.BumpBatchFeeResponse
+	22, // 46: mintrpc.Mint.ListBatches:output_type -> mintrpc.ListBatchResponse
+	25, // 47: mintrpc.Mint.ListGroupVirtualTxs:output_type -> mintrpc.ListGroupVirtualTxsResponse
+	27, // 48: mintrpc.Mint.SubscribeMintEvents:output_type -> mintrpc.MintEvent
+	38, // [38:49] is the sub-list for method output_type
+	27, // [27:38] is the sub-list for method input_type
+	27, // [27:27] is the sub-list for extension type_name
+	27, // [27:27] is the sub-list for extension extendee
+	0,  // [0:27] is the sub-list for field type_name
 }
 
 func init() { file_mintrpc_mint_proto_init() }
@@ -1352,7 +2661,7 @@ func file_mintrpc_mint_proto_init() {
 			}
 		}
 		file_mintrpc_mint_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FinalizeBatchRequest); i {
+			switch v := v.(*FundBatchRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1364,7 +2673,7 @@ func file_mintrpc_mint_proto_init() {
 			}
 		}
 		file_mintrpc_mint_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FinalizeBatchResponse); i {
+			switch v := v.(*FundBatchResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1376,7 +2685,7 @@ func file_mintrpc_mint_proto_init() {
 			}
 		}
 		file_mintrpc_mint_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CancelBatchRequest); i {
+			switch v := v.(*GroupWitness); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1388,7 +2697,7 @@ func file_mintrpc_mint_proto_init() {
 			}
 		}
 		file_mintrpc_mint_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CancelBatchResponse); i {
+			switch v := v.(*SealBatchRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1400,7 +2709,7 @@ func file_mintrpc_mint_proto_init() {
 			}
 		}
 		file_mintrpc_mint_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListBatchRequest); i {
+			switch v := v.(*SealBatchResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1412,7 +2721,7 @@ func file_mintrpc_mint_proto_init() {
 			}
 		}
 		file_mintrpc_mint_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListBatchResponse); i {
+			switch v := v.(*FinalizeBatchRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1424,7 +2733,7 @@ func file_mintrpc_mint_proto_init() {
 			}
 		}
 		file_mintrpc_mint_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubscribeMintEventsRequest); i {
+			switch v := v.(*FinalizeBatchResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1436,6 +2745,174 @@ func file_mintrpc_mint_proto_init() {
 			}
 		}
 		file_mintrpc_mint_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelBatchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelBatchResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveSeedlingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveSeedlingResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateSeedlingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateSeedlingResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BumpBatchFeeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BumpBatchFeeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBatchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBatchResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupVirtualTx); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListGroupVirtualTxsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListGroupVirtualTxsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeMintEventsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mintrpc_mint_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MintEvent); i {
 			case 0:
 				return &v.state
@@ -1449,10 +2926,14 @@ func file_mintrpc_mint_proto_init() {
 		}
 	}
 	file_mintrpc_mint_proto_msgTypes[5].OneofWrappers = []interface{}{
+		(*FundBatchRequest_FullTree)(nil),
+		(*FundBatchRequest_Branch)(nil),
+	}
+	file_mintrpc_mint_proto_msgTypes[10].OneofWrappers = []interface{}{
 		(*FinalizeBatchRequest_FullTree)(nil),
 		(*FinalizeBatchRequest_Branch)(nil),
 	}
-	file_mintrpc_mint_proto_msgTypes[9].OneofWrappers = []interface{}{
+	file_mintrpc_mint_proto_msgTypes[20].OneofWrappers = []interface{}{
 		(*ListBatchRequest_BatchKey)(nil),
 		(*ListBatchRequest_BatchKeyStr)(nil),
 	}
@@ -1462,7 +2943,7 @@ func file_mintrpc_mint_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_mintrpc_mint_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   13,
+			NumMessages:   27,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -25,16 +25,53 @@ type MintClient interface {
 	// batch. This call will block until the operation succeeds (asset is staged
 	// in the batch) or fails.
 	MintAsset(ctx context.Context, in *MintAssetRequest, opts ...grpc.CallOption) (*MintAssetResponse, error)
+	// tapcli: `assets mint fund`
+	// FundBatch will attempt to fund the current pending batch with a genesis
+	// input, or create a new funded batch if no batch exists yet. This RPC is
+	// only needed if the group witnesses of the batch must be created
+	// externally, otherwise FinalizeBatch can be called directly.
+	FundBatch(ctx context.Context, in *FundBatchRequest, opts ...grpc.CallOption) (*FundBatchResponse, error)
+	// tapcli: `assets mint seal`
+	// SealBatch will attempt to seal the current pending batch by creating and
+	// validating an asset group witness for all assets in the batch. If a
+	// witness is not provided, a signature will be derived to serve as the
+	// witness. This RPC is only needed if the group witnesses of the batch must
+	// be created externally, otherwise FinalizeBatch can be called directly.
+	SealBatch(ctx context.Context, in *SealBatchRequest, opts ...grpc.CallOption) (*SealBatchResponse, error)
 	// tapcli: `assets mint finalize`
 	// FinalizeBatch will attempt to finalize the current pending batch.
 	FinalizeBatch(ctx context.Context, in *FinalizeBatchRequest, opts ...grpc.CallOption) (*FinalizeBatchResponse, error)
 	// tapcli: `assets mint cancel`
 	// CancelBatch will attempt to cancel the current pending batch.
 	CancelBatch(ctx context.Context, in *CancelBatchRequest, opts ...grpc.CallOption) (*CancelBatchResponse, error)
+	// tapcli: `assets mint remove`
+	// RemoveSeedling will attempt to remove a single asset from a pending batch
+	// that isn't sealed yet. An asset that is the group anchor of other assets in
+	// the batch can't be removed.
+	RemoveSeedling(ctx context.Context, in *RemoveSeedlingRequest, opts ...grpc.CallOption) (*RemoveSeedlingResponse, error)
+	// tapcli: `assets mint update`
+	// UpdateSeedling will attempt to change the amount, metadata or group
+	// settings of a single asset in a pending batch that isn't sealed yet. The
+	// group settings of an asset that is the group anchor of other assets in the
+	// batch can't be changed in a way that would leave those assets without a
+	// group anchor.
+	UpdateSeedling(ctx context.Context, in *UpdateSeedlingRequest, opts ...grpc.CallOption) (*UpdateSeedlingResponse, error)
+	// tapcli: `assets mint bumpfee`
+	// BumpBatchFee will attempt to replace the minting transaction of a batch
+	// that was broadcast but isn't confirmed yet with one that pays a higher fee
+	// rate. The new transaction spends the same inputs, so the IDs of the assets
+	// in the batch don't change.
+	BumpBatchFee(ctx context.Context, in *BumpBatchFeeRequest, opts ...grpc.CallOption) (*BumpBatchFeeResponse, error)
 	// tapcli: `assets mint batches`
 	// ListBatches lists the set of batches submitted to the daemon, including
 	// pending and cancelled batches.
 	ListBatches(ctx context.Context, in *ListBatchRequest, opts ...grpc.CallOption) (*ListBatchResponse, error)
+	// tapcli: `assets mint groupvtxs`
+	// ListGroupVirtualTxs lists the group virtual transactions of the grouped
+	// assets in the funded pending batch. Signing these transactions with the
+	// corresponding group internal key produces the group witnesses that can be
+	// passed to SealBatch.
+	ListGroupVirtualTxs(ctx context.Context, in *ListGroupVirtualTxsRequest, opts ...grpc.CallOption) (*ListGroupVirtualTxsResponse, error)
 	// tapcli: `events mint`
 	// SubscribeMintEvents allows a caller to subscribe to mint events for asset
 	// creation batches.
@@ -58,6 +95,24 @@ func (c *mintClient) MintAsset(ctx context.Context, in *MintAssetRequest, opts .
 	return out, nil
 }
 
+func (c *mintClient) FundBatch(ctx context.Context, in *FundBatchRequest, opts ...grpc.CallOption) (*FundBatchResponse, error) {
+	out := new(FundBatchResponse)
+	err := c.cc.Invoke(ctx, "/mintrpc.Mint/FundBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mintClient) SealBatch(ctx context.Context, in *SealBatchRequest, opts ...grpc.CallOption) (*SealBatchResponse, error) {
+	out := new(SealBatchResponse)
+	err := c.cc.Invoke(ctx, "/mintrpc.Mint/SealBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *mintClient) FinalizeBatch(ctx context.Context, in *FinalizeBatchRequest, opts ...grpc.CallOption) (*FinalizeBatchResponse, error) {
 	out := new(FinalizeBatchResponse)
 	err := c.cc.Invoke(ctx, "/mintrpc.Mint/FinalizeBatch", in, out, opts...)
@@ -76,6 +131,33 @@ func (c *mintClient) CancelBatch(ctx context.Context, in *CancelBatchRequest, op
 	return out, nil
 }
 
+func (c *mintClient) RemoveSeedling(ctx context.Context, in *RemoveSeedlingRequest, opts ...grpc.CallOption) (*RemoveSeedlingResponse, error) {
+	out := new(RemoveSeedlingResponse)
+	err := c.cc.Invoke(ctx, "/mintrpc.Mint/RemoveSeedling", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mintClient) UpdateSeedling(ctx context.Context, in *UpdateSeedlingRequest, opts ...grpc.CallOption) (*UpdateSeedlingResponse, error) {
+	out := new(UpdateSeedlingResponse)
+	err := c.cc.Invoke(ctx, "/mintrpc.Mint/UpdateSeedling", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mintClient) BumpBatchFee(ctx context.Context, in *BumpBatchFeeRequest, opts ...grpc.CallOption) (*BumpBatchFeeResponse, error) {
+	out := new(BumpBatchFeeResponse)
+	err := c.cc.Invoke(ctx, "/mintrpc.Mint/BumpBatchFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *mintClient) ListBatches(ctx context.Context, in *ListBatchRequest, opts ...grpc.CallOption) (*ListBatchResponse, error) {
 	out := new(ListBatchResponse)
 	err := c.cc.Invoke(ctx, "/mintrpc.Mint/ListBatches", in, out, opts...)
@@ -85,6 +167,15 @@ func (c *mintClient) ListBatches(ctx context.Context, in *ListBatchRequest, opts
 	return out, nil
 }
 
+func (c *mintClient) ListGroupVirtualTxs(ctx context.Context, in *ListGroupVirtualTxsRequest, opts ...grpc.CallOption) (*ListGroupVirtualTxsResponse, error) {
+	out := new(ListGroupVirtualTxsResponse)
+	err := c.cc.Invoke(ctx, "/mintrpc.Mint/ListGroupVirtualTxs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *mintClient) SubscribeMintEvents(ctx context.Context, in *SubscribeMintEventsRequest, opts ...grpc.CallOption) (Mint_SubscribeMintEventsClient, error) {
 	stream, err := c.cc.NewStream(ctx, &Mint_ServiceDesc.Streams[0], "/mintrpc.Mint/SubscribeMintEvents", opts...)
 	if err != nil {
@@ -128,16 +219,53 @@ type MintServer interface {
 	// batch. This call will block until the operation succeeds (asset is staged
 	// in the batch) or fails.
 	MintAsset(context.Context, *MintAssetRequest) (*MintAssetResponse, error)
+	// tapcli: `assets mint fund`
+	// FundBatch will attempt to fund the current pending batch with a genesis
+	// input, or create a new funded batch if no batch exists yet. This RPC is
+	// only needed if the group witnesses of the batch must be created
+	// externally, otherwise FinalizeBatch can be called directly.
+	FundBatch(context.Context, *FundBatchRequest) (*FundBatchResponse, error)
+	// tapcli: `assets mint seal`
+	// SealBatch will attempt to seal the current pending batch by creating and
+	// validating an asset group witness for all assets in the batch. If a
+	// witness is not provided, a signature will be derived to serve as the
+	// witness. This RPC is only needed if the group witnesses of the batch must
+	// be created externally, otherwise FinalizeBatch can be called directly.
+	SealBatch(context.Context, *SealBatchRequest) (*SealBatchResponse, error)
 	// tapcli: `assets mint finalize`
 	// FinalizeBatch will attempt to finalize the current pending batch.
 	FinalizeBatch(context.Context, *FinalizeBatchRequest) (*FinalizeBatchResponse, error)
 	// tapcli: `assets mint cancel`
 	// CancelBatch will attempt to cancel the current pending batch.
 	CancelBatch(context.Context, *CancelBatchRequest) (*CancelBatchResponse, error)
+	// tapcli: `assets mint remove`
+	// RemoveSeedling will attempt to remove a single asset from a pending batch
+	// that isn't sealed yet. An asset that is the group anchor of other assets in
+	// the batch can't be removed.
+	RemoveSeedling(context.Context, *RemoveSeedlingRequest) (*RemoveSeedlingResponse, error)
+	// tapcli: `assets mint update`
+	// UpdateSeedling will attempt to change the amount, metadata or group
+	// settings of a single asset in a pending batch that isn't sealed yet. The
+	// group settings of an asset that is the group anchor of other assets in the
+	// batch can't be changed in a way that would leave those assets without a
+	// group anchor.
+	UpdateSeedling(context.Context, *UpdateSeedlingRequest) (*UpdateSeedlingResponse, error)
+	// tapcli: `assets mint bumpfee`
+	// BumpBatchFee will attempt to replace the minting transaction of a batch
+	// that was broadcast but isn't confirmed yet with one that pays a higher fee
+	// rate. The new transaction spends the same inputs, so the IDs of the assets
+	// in the batch don't change.
+	BumpBatchFee(context.Context, *BumpBatchFeeRequest) (*BumpBatchFeeResponse, error)
 	// tapcli: `assets mint batches`
 	// ListBatches lists the set of batches submitted to the daemon, including
 	// pending and cancelled batches.
 	ListBatches(context.Context, *ListBatchRequest) (*ListBatchResponse, error)
+	// tapcli: `assets mint groupvtxs`
+	// ListGroupVirtualTxs lists the group virtual transactions of the grouped
+	// assets in the funded pending batch. Signing these transactions with the
+	// corresponding group internal key produces the group witnesses that can be
+	// passed to SealBatch.
+	ListGroupVirtualTxs(context.Context, *ListGroupVirtualTxsRequest) (*ListGroupVirtualTxsResponse, error)
 	// tapcli: `events mint`
 	// SubscribeMintEvents allows a caller to subscribe to mint events for asset
 	// creation batches.
@@ -152,15 +280,33 @@ type UnimplementedMintServer struct {
 func (UnimplementedMintServer) MintAsset(context.Context, *MintAssetRequest) (*MintAssetResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MintAsset not implemented")
 }
+func (UnimplementedMintServer) FundBatch(context.Context, *FundBatchRequest) (*FundBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundBatch not implemented")
+}
+func (UnimplementedMintServer) SealBatch(context.Context, *SealBatchRequest) (*SealBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SealBatch not implemented")
+}
 func (UnimplementedMintServer) FinalizeBatch(context.Context, *FinalizeBatchRequest) (*FinalizeBatchResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FinalizeBatch not implemented")
 }
 func (UnimplementedMintServer) CancelBatch(context.Context, *CancelBatchRequest) (*CancelBatchResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelBatch not implemented")
 }
+func (UnimplementedMintServer) RemoveSeedling(context.Context, *RemoveSeedlingRequest) (*RemoveSeedlingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveSeedling not implemented")
+}
+func (UnimplementedMintServer) UpdateSeedling(context.Context, *UpdateSeedlingRequest) (*UpdateSeedlingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSeedling not implemented")
+}
+func (UnimplementedMintServer) BumpBatchFee(context.Context, *BumpBatchFeeRequest) (*BumpBatchFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BumpBatchFee not implemented")
+}
 func (UnimplementedMintServer) ListBatches(context.Context, *ListBatchRequest) (*ListBatchResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListBatches not implemented")
 }
+func (UnimplementedMintServer) ListGroupVirtualTxs(context.Context, *ListGroupVirtualTxsRequest) (*ListGroupVirtualTxsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGroupVirtualTxs not implemented")
+}
 func (UnimplementedMintServer) SubscribeMintEvents(*SubscribeMintEventsRequest, Mint_SubscribeMintEventsServer) error {
 	return status.Errorf(codes.Unimplemented, "method SubscribeMintEvents not implemented")
 }
@@ -195,6 +341,42 @@ func _Mint_MintAsset_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Mint_FundBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FundBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MintServer).FundBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mintrpc.Mint/FundBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MintServer).FundBatch(ctx, req.(*FundBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Mint_SealBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SealBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MintServer).SealBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mintrpc.Mint/SealBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MintServer).SealBatch(ctx, req.(*SealBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Mint_FinalizeBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(FinalizeBatchRequest)
 	if err := dec(in); err != nil {
@@ -231,6 +413,60 @@ func _Mint_CancelBatch_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Mint_RemoveSeedling_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveSeedlingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MintServer).RemoveSeedling(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mintrpc.Mint/RemoveSeedling",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MintServer).RemoveSeedling(ctx, req.(*RemoveSeedlingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Mint_UpdateSeedling_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSeedlingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MintServer).UpdateSeedling(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mintrpc.Mint/UpdateSeedling",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MintServer).UpdateSeedling(ctx, req.(*UpdateSeedlingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Mint_BumpBatchFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BumpBatchFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MintServer).BumpBatchFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mintrpc.Mint/BumpBatchFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MintServer).BumpBatchFee(ctx, req.(*BumpBatchFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Mint_ListBatches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListBatchRequest)
 	if err := dec(in); err != nil {
@@ -249,6 +485,24 @@ func _Mint_ListBatches_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Mint_ListGroupVirtualTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGroupVirtualTxsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MintServer).ListGroupVirtualTxs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mintrpc.Mint/ListGroupVirtualTxs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MintServer).ListGroupVirtualTxs(ctx, req.(*ListGroupVirtualTxsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Mint_SubscribeMintEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(SubscribeMintEventsRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -281,6 +535,14 @@ var Mint_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "MintAsset",
 			Handler:    _Mint_MintAsset_Handler,
 		},
+		{
+			MethodName: "FundBatch",
+			Handler:    _Mint_FundBatch_Handler,
+		},
+		{
+			MethodName: "SealBatch",
+			Handler:    _Mint_SealBatch_Handler,
+		},
 		{
 			MethodName: "FinalizeBatch",
 			Handler:    _Mint_FinalizeBatch_Handler,
@@ -289,10 +551,26 @@ var Mint_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CancelBatch",
 			Handler:    _Mint_CancelBatch_Handler,
 		},
+		{
+			MethodName: "RemoveSeedling",
+			Handler:    _Mint_RemoveSeedling_Handler,
+		},
+		{
+			MethodName: "UpdateSeedling",
+			Handler:    _Mint_UpdateSeedling_Handler,
+		},
+		{
+			MethodName: "BumpBatchFee",
+			Handler:    _Mint_BumpBatchFee_Handler,
+		},
 		{
 			MethodName: "ListBatches",
 			Handler:    _Mint_ListBatches_Handler,
 		},
+		{
+			MethodName: "ListGroupVirtualTxs",
+			Handler:    _Mint_ListGroupVirtualTxs_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
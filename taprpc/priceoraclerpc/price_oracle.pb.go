@@ -0,0 +1,841 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.33.0
+// 	protoc        v3.21.12
+// source: priceoraclerpc/price_oracle.proto
+
+package priceoraclerpc
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// AssetSpecifier is a union type for specifying an asset by either its asset ID
+// or group key.
+type AssetSpecifier struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Id:
+	//
+	//	*AssetSpecifier_AssetId
+	//	*AssetSpecifier_AssetIdStr
+	//	*AssetSpecifier_GroupKey
+	//	*AssetSpecifier_GroupKeyStr
+	Id isAssetSpecifier_Id `protobuf_oneof:"id"`
+}
+
+func (x *AssetSpecifier) Reset() {
+	*x = AssetSpecifier{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_priceoraclerpc_price_oracle_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AssetSpecifier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssetSpecifier) ProtoMessage() {}
+
+func (x *AssetSpecifier) ProtoReflect() protoreflect.Message {
+	mi := &file_priceoraclerpc_price_oracle_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssetSpecifier.ProtoReflect.Descriptor instead.
+func (*AssetSpecifier) Descriptor() ([]byte, []int) {
+	return file_priceoraclerpc_price_oracle_proto_rawDescGZIP(), []int{0}
+}
+
+func (m *AssetSpecifier) GetId() isAssetSpecifier_Id {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+func (x *AssetSpecifier) GetAssetId() []byte {
+	if x, ok := x.GetId().(*AssetSpecifier_AssetId); ok {
+		return x.AssetId
+	}
+	return nil
+}
+
+func (x *AssetSpecifier) GetAssetIdStr() string {
+	if x, ok := x.GetId().(*AssetSpecifier_AssetIdStr); ok {
+		return x.AssetIdStr
+	}
+	return ""
+}
+
+func (x *AssetSpecifier) GetGroupKey() []byte {
+	if x, ok := x.GetId().(*AssetSpecifier_GroupKey); ok {
+		return x.GroupKey
+	}
+	return nil
+}
+
+func (x *AssetSpecifier) GetGroupKeyStr() string {
+	if x, ok := x.GetId().(*AssetSpecifier_GroupKeyStr); ok {
+		return x.GroupKeyStr
+	}
+	return ""
+}
+
+type isAssetSpecifier_Id interface {
+	isAssetSpecifier_Id()
+}
+
+type AssetSpecifier_AssetId struct {
+	// The 32-byte asset ID specified as raw bytes (gRPC only).
+	AssetId []byte `protobuf:"bytes,1,opt,name=asset_id,json=assetId,proto3,oneof"`
+}
+
+type AssetSpecifier_AssetIdStr struct {
+	// The 32-byte asset ID encoded as a hex string (use this for REST).
+	AssetIdStr string `protobuf:"bytes,2,opt,name=asset_id_str,json=assetIdStr,proto3,oneof"`
+}
+
+type AssetSpecifier_GroupKey struct {
+	// The 33-byte compressed asset group key specified as raw bytes
+	// (gRPC only).
+	GroupKey []byte `protobuf:"bytes,3,opt,name=group_key,json=groupKey,proto3,oneof"`
+}
+
+type AssetSpecifier_GroupKeyStr struct {
+	// The 33-byte compressed asset group key encoded as hex string (use
+	// this for REST).
+	GroupKeyStr string `protobuf:"bytes,4,opt,name=group_key_str,json=groupKeyStr,proto3,oneof"`
+}
+
+func (*AssetSpecifier_AssetId) isAssetSpecifier_Id() {}
+
+func (*AssetSpecifier_AssetIdStr) isAssetSpecifier_Id() {}
+
+func (*AssetSpecifier_GroupKey) isAssetSpecifier_Id() {}
+
+func (*AssetSpecifier_GroupKeyStr) isAssetSpecifier_Id() {}
+
+// OracleError is an error returned by the price oracle service. It is
+// delivered as part of a successful RPC response, so that the caller can
+// distinguish between a transport failure and a deliberate refusal to quote.
+type OracleError struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// code is a code which uniquely identifies the error type.
+	Code uint32 `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	// message is a human-readable error message.
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *OracleError) Reset() {
+	*x = OracleError{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_priceoraclerpc_price_oracle_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OracleError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OracleError) ProtoMessage() {}
+
+func (x *OracleError) ProtoReflect() protoreflect.Message {
+	mi := &file_priceoraclerpc_price_oracle_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OracleError.ProtoReflect.Descriptor instead.
+func (*OracleError) Descriptor() ([]byte, []int) {
+	return file_priceoraclerpc_price_oracle_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *OracleError) GetCode() uint32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *OracleError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// PriceQuote is a price suggested by the oracle together with the lifetime of
+// that price.
+type PriceQuote struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// price is the suggested price for the full asset amount (units:
+	// millisats).
+	Price uint64 `protobuf:"varint,1,opt,name=price,proto3" json:"price,omitempty"`
+	// expiry_timestamp is the unix timestamp in seconds after which the
+	// price is no longer valid.
+	ExpiryTimestamp uint64 `protobuf:"varint,2,opt,name=expiry_timestamp,json=expiryTimestamp,proto3" json:"expiry_timestamp,omitempty"`
+}
+
+func (x *PriceQuote) Reset() {
+	*x = PriceQuote{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_priceoraclerpc_price_oracle_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PriceQuote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceQuote) ProtoMessage() {}
+
+func (x *PriceQuote) ProtoReflect() protoreflect.Message {
+	mi := &file_priceoraclerpc_price_oracle_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceQuote.ProtoReflect.Descriptor instead.
+func (*PriceQuote) Descriptor() ([]byte, []int) {
+	return file_priceoraclerpc_price_oracle_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PriceQuote) GetPrice() uint64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *PriceQuote) GetExpiryTimestamp() uint64 {
+	if x != nil {
+		return x.ExpiryTimestamp
+	}
+	return 0
+}
+
+type QueryAskPriceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// asset_specifier is the subject asset.
+	AssetSpecifier *AssetSpecifier `protobuf:"bytes,1,opt,name=asset_specifier,json=assetSpecifier,proto3" json:"asset_specifier,omitempty"`
+	// asset_amount is the amount of the asset which the ask price applies to.
+	AssetAmount uint64 `protobuf:"varint,2,opt,name=asset_amount,json=assetAmount,proto3" json:"asset_amount,omitempty"`
+	// suggested_bid_price is an optional bid price proposed by the
+	// counterparty for the asset amount (units: millisats). A value of zero
+	// means that no bid price was suggested.
+	SuggestedBidPrice uint64 `protobuf:"varint,3,opt,name=suggested_bid_price,json=suggestedBidPrice,proto3" json:"suggested_bid_price,omitempty"`
+	// suggested_bid_price_expiry is the unix timestamp in seconds after which
+	// the suggested bid price is no longer valid. A value of zero means that
+	// the counterparty did not specify an expiry.
+	SuggestedBidPriceExpiry uint64 `protobuf:"varint,4,opt,name=suggested_bid_price_expiry,json=suggestedBidPriceExpiry,proto3" json:"suggested_bid_price_expiry,omitempty"`
+}
+
+func (x *QueryAskPriceRequest) Reset() {
+	*x = QueryAskPriceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_priceoraclerpc_price_oracle_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryAskPriceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryAskPriceRequest) ProtoMessage() {}
+
+func (x *QueryAskPriceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_priceoraclerpc_price_oracle_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryAskPriceRequest.ProtoReflect.Descriptor instead.
+func (*QueryAskPriceRequest) Descriptor() ([]byte, []int) {
+	return file_priceoraclerpc_price_oracle_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *QueryAskPriceRequest) GetAssetSpecifier() *AssetSpecifier {
+	if x != nil {
+		return x.AssetSpecifier
+	}
+	return nil
+}
+
+func (x *QueryAskPriceRequest) GetAssetAmount() uint64 {
+	if x != nil {
+		return x.AssetAmount
+	}
+	return 0
+}
+
+func (x *QueryAskPriceRequest) GetSuggestedBidPrice() uint64 {
+	if x != nil {
+		return x.SuggestedBidPrice
+	}
+	return 0
+}
+
+func (x *QueryAskPriceRequest) GetSuggestedBidPriceExpiry() uint64 {
+	if x != nil {
+		return x.SuggestedBidPriceExpiry
+	}
+	return 0
+}
+
+type QueryAskPriceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Result:
+	//
+	//	*QueryAskPriceResponse_Success
+	//	*QueryAskPriceResponse_Error
+	Result isQueryAskPriceResponse_Result `protobuf_oneof:"result"`
+}
+
+func (x *QueryAskPriceResponse) Reset() {
+	*x = QueryAskPriceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_priceoraclerpc_price_oracle_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryAskPriceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryAskPriceResponse) ProtoMessage() {}
+
+func (x *QueryAskPriceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_priceoraclerpc_price_oracle_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryAskPriceResponse.ProtoReflect.Descriptor instead.
+func (*QueryAskPriceResponse) Descriptor() ([]byte, []int) {
+	return file_priceoraclerpc_price_oracle_proto_rawDescGZIP(), []int{4}
+}
+
+func (m *QueryAskPriceResponse) GetResult() isQueryAskPriceResponse_Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (x *QueryAskPriceResponse) GetSuccess() *PriceQuote {
+	if x, ok := x.GetResult().(*QueryAskPriceResponse_Success); ok {
+		return x.Success
+	}
+	return nil
+}
+
+func (x *QueryAskPriceResponse) GetError() *OracleError {
+	if x, ok := x.GetResult().(*QueryAskPriceResponse_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isQueryAskPriceResponse_Result interface {
+	isQueryAskPriceResponse_Result()
+}
+
+type QueryAskPriceResponse_Success struct {
+	// success is the ask price quote if the query was successful.
+	Success *PriceQuote `protobuf:"bytes,1,opt,name=success,proto3,oneof"`
+}
+
+type QueryAskPriceResponse_Error struct {
+	// error is the error returned by the oracle if it refused to quote.
+	Error *OracleError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*QueryAskPriceResponse_Success) isQueryAskPriceResponse_Result() {}
+
+func (*QueryAskPriceResponse_Error) isQueryAskPriceResponse_Result() {}
+
+type QueryBidPriceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// asset_specifier is the subject asset.
+	AssetSpecifier *AssetSpecifier `protobuf:"bytes,1,opt,name=asset_specifier,json=assetSpecifier,proto3" json:"asset_specifier,omitempty"`
+	// asset_amount is the amount of the asset which the bid price applies to.
+	AssetAmount uint64 `protobuf:"varint,2,opt,name=asset_amount,json=assetAmount,proto3" json:"asset_amount,omitempty"`
+	// suggested_ask_price is an optional ask price proposed by the
+	// counterparty for the asset amount (units: millisats). A value of zero
+	// means that no ask price was suggested.
+	SuggestedAskPrice uint64 `protobuf:"varint,3,opt,name=suggested_ask_price,json=suggestedAskPrice,proto3" json:"suggested_ask_price,omitempty"`
+	// suggested_ask_price_expiry is the unix timestamp in seconds after which
+	// the suggested ask price is no longer valid. A value of zero means that
+	// the counterparty did not specify an expiry.
+	SuggestedAskPriceExpiry uint64 `protobuf:"varint,4,opt,name=suggested_ask_price_expiry,json=suggestedAskPriceExpiry,proto3" json:"suggested_ask_price_expiry,omitempty"`
+}
+
+func (x *QueryBidPriceRequest) Reset() {
+	*x = QueryBidPriceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_priceoraclerpc_price_oracle_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryBidPriceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryBidPriceRequest) ProtoMessage() {}
+
+func (x *QueryBidPriceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_priceoraclerpc_price_oracle_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryBidPriceRequest.ProtoReflect.Descriptor instead.
+func (*QueryBidPriceRequest) Descriptor() ([]byte, []int) {
+	return file_priceoraclerpc_price_oracle_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *QueryBidPriceRequest) GetAssetSpecifier() *AssetSpecifier {
+	if x != nil {
+		return x.AssetSpecifier
+	}
+	return nil
+}
+
+func (x *QueryBidPriceRequest) GetAssetAmount() uint64 {
+	if x != nil {
+		return x.AssetAmount
+	}
+	return 0
+}
+
+func (x *QueryBidPriceRequest) GetSuggestedAskPrice() uint64 {
+	if x != nil {
+		return x.SuggestedAskPrice
+	}
+	return 0
+}
+
+func (x *QueryBidPriceRequest) GetSuggestedAskPriceExpiry() uint64 {
+	if x != nil {
+		return x.SuggestedAskPriceExpiry
+	}
+	return 0
+}
+
+type QueryBidPriceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Result:
+	//
+	//	*QueryBidPriceResponse_Success
+	//	*QueryBidPriceResponse_Error
+	Result isQueryBidPriceResponse_Result `protobuf_oneof:"result"`
+}
+
+func (x *QueryBidPriceResponse) Reset() {
+	*x = QueryBidPriceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_priceoraclerpc_price_oracle_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryBidPriceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryBidPriceResponse) ProtoMessage() {}
+
+func (x *QueryBidPriceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_priceoraclerpc_price_oracle_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryBidPriceResponse.ProtoReflect.Descriptor instead.
+func (*QueryBidPriceResponse) Descriptor() ([]byte, []int) {
+	return file_priceoraclerpc_price_oracle_proto_rawDescGZIP(), []int{6}
+}
+
+func (m *QueryBidPriceResponse) GetResult() isQueryBidPriceResponse_Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (x *QueryBidPriceResponse) GetSuccess() *PriceQuote {
+	if x, ok := x.GetResult().(*QueryBidPriceResponse_Success); ok {
+		return x.Success
+	}
+	return nil
+}
+
+func (x *QueryBidPriceResponse) GetError() *OracleError {
+	if x, ok := x.GetResult().(*QueryBidPriceResponse_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isQueryBidPriceResponse_Result interface {
+	isQueryBidPriceResponse_Result()
+}
+
+type QueryBidPriceResponse_Success struct {
+	// success is the bid price quote if the query was successful.
+	Success *PriceQuote `protobuf:"bytes,1,opt,name=success,proto3,oneof"`
+}
+
+type QueryBidPriceResponse_Error struct {
+	// error is the error returned by the oracle if it refused to quote.
+	Error *OracleError `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*QueryBidPriceResponse_Success) isQueryBidPriceResponse_Result() {}
+
+func (*QueryBidPriceResponse_Error) isQueryBidPriceResponse_Result() {}
+
+var File_priceoraclerpc_price_oracle_proto protoreflect.FileDescriptor
+
+var file_priceoraclerpc_price_oracle_proto_rawDesc = []byte{
+	0x0a, 0x21, 0x70, 0x72, 0x69, 0x63, 0x65, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x72, 0x70, 0x63,
+	0x2f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x70, 0x72, 0x69, 0x63, 0x65, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65,
+	0x72, 0x70, 0x63, 0x22, 0x9c, 0x01, 0x0a, 0x0e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x53, 0x70, 0x65,
+	0x63, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x08, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x07, 0x61, 0x73, 0x73, 0x65,
+	0x74, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x5f,
+	0x73, 0x74, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0a, 0x61, 0x73, 0x73,
+	0x65, 0x74, 0x49, 0x64, 0x53, 0x74, 0x72, 0x12, 0x1d, 0x0a, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x08, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x4b, 0x65, 0x79, 0x12, 0x24, 0x0a, 0x0d, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f,
+	0x6b, 0x65, 0x79, 0x5f, 0x73, 0x74, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
+	0x0b, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4b, 0x65, 0x79, 0x53, 0x74, 0x72, 0x42, 0x04, 0x0a, 0x02,
+	0x69, 0x64, 0x22, 0x3b, 0x0a, 0x0b, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22,
+	0x4d, 0x0a, 0x0a, 0x50, 0x72, 0x69, 0x63, 0x65, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0xef,
+	0x01, 0x0a, 0x14, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x73, 0x6b, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x47, 0x0a, 0x0f, 0x61, 0x73, 0x73, 0x65, 0x74,
+	0x5f, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1e, 0x2e, 0x70, 0x72, 0x69, 0x63, 0x65, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x72, 0x70,
+	0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x72,
+	0x52, 0x0e, 0x61, 0x73, 0x73, 0x65, 0x74, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x72,
+	0x12, 0x21, 0x0a, 0x0c, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x61, 0x73, 0x73, 0x65, 0x74, 0x41, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x65, 0x64,
+	0x5f, 0x62, 0x69, 0x64, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x11, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x65, 0x64, 0x42, 0x69, 0x64, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x12, 0x3b, 0x0a, 0x1a, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x65, 0x64,
+	0x5f, 0x62, 0x69, 0x64, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x17, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74,
+	0x65, 0x64, 0x42, 0x69, 0x64, 0x50, 0x72, 0x69, 0x63, 0x65, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79,
+	0x22, 0x8e, 0x01, 0x0a, 0x15, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x73, 0x6b, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x07, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x48, 0x00, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x12, 0x33, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x72, 0x69, 0x63, 0x65, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x72,
+	0x70, 0x63, 0x2e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00,
+	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x08, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x22, 0xef, 0x01, 0x0a, 0x14, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x69, 0x64, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x47, 0x0a, 0x0f, 0x61, 0x73,
+	0x73, 0x65, 0x74, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x72, 0x69, 0x63, 0x65, 0x6f, 0x72, 0x61, 0x63, 0x6c,
+	0x65, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66,
+	0x69, 0x65, 0x72, 0x52, 0x0e, 0x61, 0x73, 0x73, 0x65, 0x74, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66,
+	0x69, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x61, 0x73, 0x73, 0x65, 0x74,
+	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x73, 0x6b, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x11, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x65, 0x64, 0x41, 0x73,
+	0x6b, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x3b, 0x0a, 0x1a, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x73, 0x6b, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x17, 0x73, 0x75, 0x67, 0x67,
+	0x65, 0x73, 0x74, 0x65, 0x64, 0x41, 0x73, 0x6b, 0x50, 0x72, 0x69, 0x63, 0x65, 0x45, 0x78, 0x70,
+	0x69, 0x72, 0x79, 0x22, 0x8e, 0x01, 0x0a, 0x15, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x69, 0x64,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a,
+	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x70, 0x72, 0x69, 0x63, 0x65, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x72, 0x70, 0x63, 0x2e,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x48, 0x00, 0x52, 0x07, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x33, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x72, 0x69, 0x63, 0x65, 0x6f, 0x72, 0x61, 0x63,
+	0x6c, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x4f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x08, 0x0a, 0x06, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x32, 0xc9, 0x01, 0x0a, 0x0b, 0x50, 0x72, 0x69, 0x63, 0x65, 0x4f, 0x72,
+	0x61, 0x63, 0x6c, 0x65, 0x12, 0x5c, 0x0a, 0x0d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x73, 0x6b,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x24, 0x2e, 0x70, 0x72, 0x69, 0x63, 0x65, 0x6f, 0x72, 0x61,
+	0x63, 0x6c, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x73, 0x6b, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x41, 0x73, 0x6b, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x5c, 0x0a, 0x0d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x69, 0x64, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x12, 0x24, 0x2e, 0x70, 0x72, 0x69, 0x63, 0x65, 0x6f, 0x72, 0x61, 0x63, 0x6c,
+	0x65, 0x72, 0x70, 0x63, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x69, 0x64, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x72, 0x70, 0x63, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x42, 0x69, 0x64, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x42, 0x3f, 0x5a, 0x3d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c,
+	0x69, 0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x74, 0x61, 0x70,
+	0x72, 0x6f, 0x6f, 0x74, 0x2d, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x2f, 0x74, 0x61, 0x70, 0x72,
+	0x70, 0x63, 0x2f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x6f, 0x72, 0x61, 0x63, 0x6c, 0x65, 0x72, 0x70,
+	0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_priceoraclerpc_price_oracle_proto_rawDescOnce sync.Once
+	file_priceoraclerpc_price_oracle_proto_rawDescData = file_priceoraclerpc_price_oracle_proto_rawDesc
+)
+
+func file_priceoraclerpc_price_oracle_proto_rawDescGZIP() []byte {
+	file_priceoraclerpc_price_oracle_proto_rawDescOnce.Do(func() {
+		file_priceoraclerpc_price_oracle_proto_rawDescData = protoimpl.X.CompressGZIP(file_priceoraclerpc_price_oracle_proto_rawDescData)
+	})
+	return file_priceoraclerpc_price_oracle_proto_rawDescData
+}
+
+var file_priceoraclerpc_price_oracle_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_priceoraclerpc_price_oracle_proto_goTypes = []interface{}{
+	(*AssetSpecifier)(nil),        // 0: priceoraclerpc.AssetSpecifier
+	(*OracleError)(nil),           // 1: priceoraclerpc.OracleError
+	(*PriceQuote)(nil),            // 2: priceoraclerpc.PriceQuote
+	(*QueryAskPriceRequest)(nil),  // 3: priceoraclerpc.QueryAskPriceRequest
+	(*QueryAskPriceResponse)(nil), // 4: priceoraclerpc.QueryAskPriceResponse
+	(*QueryBidPriceRequest)(nil),  // 5: priceoraclerpc.QueryBidPriceRequest
+	(*QueryBidPriceResponse)(nil), // 6: priceoraclerpc.QueryBidPriceResponse
+}
+var file_priceoraclerpc_price_oracle_proto_depIdxs = []int32{
+	0, // 0: priceoraclerpc.QueryAskPriceRequest.asset_specifier:type_name -> priceoraclerpc.AssetSpecifier
+	2, // 1: priceoraclerpc.QueryAskPriceResponse.success:type_name -> priceoraclerpc.PriceQuote
+	1, // 2: priceoraclerpc.QueryAskPriceResponse.error:type_name -> priceoraclerpc.OracleError
+	0, // 3: priceoraclerpc.QueryBidPriceRequest.asset_specifier:type_name -> priceoraclerpc.AssetSpecifier
+	2, // 4: priceoraclerpc.QueryBidPriceResponse.success:type_name -> priceoraclerpc.PriceQuote
+	1, // 5: priceoraclerpc.QueryBidPriceResponse.error:type_name -> priceoraclerpc.OracleError
+	3, // 6: priceoraclerpc.PriceOracle.QueryAskPrice:input_type -> priceoraclerpc.QueryAskPriceRequest
+	5, // 7: priceoraclerpc.PriceOracle.QueryBidPrice:input_type -> priceoraclerpc.QueryBidPriceRequest
+	4, // 8: priceoraclerpc.PriceOracle.QueryAskPrice:output_type -> priceoraclerpc.QueryAskPriceResponse
+	6, // 9: priceoraclerpc.PriceOracle.QueryBidPrice:output_type -> priceoraclerpc.QueryBidPriceResponse
+	8, // [8:10] is the sub-list for method output_type
+	6, // [6:8] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_priceoraclerpc_price_oracle_proto_init() }
+func file_priceoraclerpc_price_oracle_proto_init() {
+	if File_priceoraclerpc_price_oracle_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_priceoraclerpc_price_oracle_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssetSpecifier); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_priceoraclerpc_price_oracle_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OracleError); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_priceoraclerpc_price_oracle_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PriceQuote); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_priceoraclerpc_price_oracle_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryAskPriceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_priceoraclerpc_price_oracle_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryAskPriceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_priceoraclerpc_price_oracle_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryBidPriceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_priceoraclerpc_price_oracle_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryBidPriceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_priceoraclerpc_price_oracle_proto_msgTypes[0].OneofWrappers = []interface{}{
+		(*AssetSpecifier_AssetId)(nil),
+		(*AssetSpecifier_AssetIdStr)(nil),
+		(*AssetSpecifier_GroupKey)(nil),
+		(*AssetSpecifier_GroupKeyStr)(nil),
+	}
+	file_priceoraclerpc_price_oracle_proto_msgTypes[4].OneofWrappers = []interface{}{
+		(*QueryAskPriceResponse_Success)(nil),
+		(*QueryAskPriceResponse_Error)(nil),
+	}
+	file_priceoraclerpc_price_oracle_proto_msgTypes[6].OneofWrappers = []interface{}{
+		(*QueryBidPriceResponse_Success)(nil),
+		(*QueryBidPriceResponse_Error)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_priceoraclerpc_price_oracle_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_priceoraclerpc_price_oracle_proto_goTypes,
+		DependencyIndexes: file_priceoraclerpc_price_oracle_proto_depIdxs,
+		MessageInfos:      file_priceoraclerpc_price_oracle_proto_msgTypes,
+	}.Build()
+	File_priceoraclerpc_price_oracle_proto = out.File
+	file_priceoraclerpc_price_oracle_proto_rawDesc = nil
+	file_priceoraclerpc_price_oracle_proto_goTypes = nil
+	file_priceoraclerpc_price_oracle_proto_depIdxs = nil
+}
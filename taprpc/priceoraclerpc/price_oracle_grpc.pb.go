@@ -0,0 +1,145 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package priceoraclerpc
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// PriceOracleClient is the client API for PriceOracle service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PriceOracleClient interface {
+	// QueryAskPrice returns the asking price (in millisatoshi) that the oracle
+	// suggests for the given amount of an asset.
+	QueryAskPrice(ctx context.Context, in *QueryAskPriceRequest, opts ...grpc.CallOption) (*QueryAskPriceResponse, error)
+	// QueryBidPrice returns the bid price (in millisatoshi) that the oracle
+	// suggests for the given amount of an asset.
+	QueryBidPrice(ctx context.Context, in *QueryBidPriceRequest, opts ...grpc.CallOption) (*QueryBidPriceResponse, error)
+}
+
+type priceOracleClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPriceOracleClient(cc grpc.ClientConnInterface) PriceOracleClient {
+	return &priceOracleClient{cc}
+}
+
+func (c *priceOracleClient) QueryAskPrice(ctx context.Context, in *QueryAskPriceRequest, opts ...grpc.CallOption) (*QueryAskPriceResponse, error) {
+	out := new(QueryAskPriceResponse)
+	err := c.cc.Invoke(ctx, "/priceoraclerpc.PriceOracle/QueryAskPrice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *priceOracleClient) QueryBidPrice(ctx context.Context, in *QueryBidPriceRequest, opts ...grpc.CallOption) (*QueryBidPriceResponse, error) {
+	out := new(QueryBidPriceResponse)
+	err := c.cc.Invoke(ctx, "/priceoraclerpc.PriceOracle/QueryBidPrice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PriceOracleServer is the server API for PriceOracle service.
+// All implementations must embed UnimplementedPriceOracleServer
+// for forward compatibility
+type PriceOracleServer interface {
+	// QueryAskPrice returns the asking price (in millisatoshi) that the oracle
+	// suggests for the given amount of an asset.
+	QueryAskPrice(context.Context, *QueryAskPriceRequest) (*QueryAskPriceResponse, error)
+	// QueryBidPrice returns the bid price (in millisatoshi) that the oracle
+	// suggests for the given amount of an asset.
+	QueryBidPrice(context.Context, *QueryBidPriceRequest) (*QueryBidPriceResponse, error)
+	mustEmbedUnimplementedPriceOracleServer()
+}
+
+// UnimplementedPriceOracleServer must be embedded to have forward compatible implementations.
+type UnimplementedPriceOracleServer struct {
+}
+
+func (UnimplementedPriceOracleServer) QueryAskPrice(context.Context, *QueryAskPriceRequest) (*QueryAskPriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryAskPrice not implemented")
+}
+func (UnimplementedPriceOracleServer) QueryBidPrice(context.Context, *QueryBidPriceRequest) (*QueryBidPriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryBidPrice not implemented")
+}
+func (UnimplementedPriceOracleServer) mustEmbedUnimplementedPriceOracleServer() {}
+
+// UnsafePriceOracleServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PriceOracleServer will
+// result in compilation errors.
+type UnsafePriceOracleServer interface {
+	mustEmbedUnimplementedPriceOracleServer()
+}
+
+func RegisterPriceOracleServer(s grpc.ServiceRegistrar, srv PriceOracleServer) {
+	s.RegisterService(&PriceOracle_ServiceDesc, srv)
+}
+
+func _PriceOracle_QueryAskPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAskPriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PriceOracleServer).QueryAskPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/priceoraclerpc.PriceOracle/QueryAskPrice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PriceOracleServer).QueryAskPrice(ctx, req.(*QueryAskPriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PriceOracle_QueryBidPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBidPriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PriceOracleServer).QueryBidPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/priceoraclerpc.PriceOracle/QueryBidPrice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PriceOracleServer).QueryBidPrice(ctx, req.(*QueryBidPriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PriceOracle_ServiceDesc is the grpc.ServiceDesc for PriceOracle service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PriceOracle_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "priceoraclerpc.PriceOracle",
+	HandlerType: (*PriceOracleServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "QueryAskPrice",
+			Handler:    _PriceOracle_QueryAskPrice_Handler,
+		},
+		{
+			MethodName: "QueryBidPrice",
+			Handler:    _PriceOracle_QueryBidPrice_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "priceoraclerpc/price_oracle.proto",
+}
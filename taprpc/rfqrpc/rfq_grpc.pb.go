@@ -37,12 +37,21 @@ type RfqClient interface {
 	// A buy offer is used by the node to selectively accept or reject incoming
 	// asset sell quote requests before price is considered.
 	AddAssetBuyOffer(ctx context.Context, in *AddAssetBuyOfferRequest, opts ...grpc.CallOption) (*AddAssetBuyOfferResponse, error)
-	// tapcli: `rfq peeracceptedquotes`
+	// tapcli: `rfq acceptedquotes`
 	// QueryPeerAcceptedQuotes is used to query for quotes that were requested by
 	// our node and have been accepted our peers.
 	QueryPeerAcceptedQuotes(ctx context.Context, in *QueryPeerAcceptedQuotesRequest, opts ...grpc.CallOption) (*QueryPeerAcceptedQuotesResponse, error)
+	// tapcli: `rfq events`
 	// SubscribeRfqEventNtfns is used to subscribe to RFQ events.
 	SubscribeRfqEventNtfns(ctx context.Context, in *SubscribeRfqEventNtfnsRequest, opts ...grpc.CallOption) (Rfq_SubscribeRfqEventNtfnsClient, error)
+	// tapcli: `rfq quotes`
+	// ListQuotes lists the quote ledger. The ledger contains all quotes that were
+	// requested by our node or by our peers, together with their current status.
+	ListQuotes(ctx context.Context, in *ListQuotesRequest, opts ...grpc.CallOption) (*ListQuotesResponse, error)
+	// tapcli: `rfq trades`
+	// ListTrades lists the HTLCs that were accepted by our node against the
+	// quotes that it issued to its peers.
+	ListTrades(ctx context.Context, in *ListTradesRequest, opts ...grpc.CallOption) (*ListTradesResponse, error)
 }
 
 type rfqClient struct {
@@ -130,6 +139,24 @@ func (x *rfqSubscribeRfqEventNtfnsClient) Recv() (*RfqEvent, error) {
 	return m, nil
 }
 
+func (c *rfqClient) ListQuotes(ctx context.Context, in *ListQuotesRequest, opts ...grpc.CallOption) (*ListQuotesResponse, error) {
+	out := new(ListQuotesResponse)
+	err := c.cc.Invoke(ctx, "/rfqrpc.Rfq/ListQuotes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rfqClient) ListTrades(ctx context.Context, in *ListTradesRequest, opts ...grpc.CallOption) (*ListTradesResponse, error) {
+	out := new(ListTradesResponse)
+	err := c.cc.Invoke(ctx, "/rfqrpc.Rfq/ListTrades", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RfqServer is the server API for Rfq service.
 // All implementations must embed UnimplementedRfqServer
 // for forward compatibility
@@ -153,12 +180,21 @@ type RfqServer interface {
 	// A buy offer is used by the node to selectively accept or reject incoming
 	// asset sell quote requests before price is considered.
 	AddAssetBuyOffer(context.Context, *AddAssetBuyOfferRequest) (*AddAssetBuyOfferResponse, error)
-	// tapcli: `rfq peeracceptedquotes`
+	// tapcli: `rfq acceptedquotes`
 	// QueryPeerAcceptedQuotes is used to query for quotes that were requested by
 	// our node and have been accepted our peers.
 	QueryPeerAcceptedQuotes(context.Context, *QueryPeerAcceptedQuotesRequest) (*QueryPeerAcceptedQuotesResponse, error)
+	// tapcli: `rfq events`
 	// SubscribeRfqEventNtfns is used to subscribe to RFQ events.
 	SubscribeRfqEventNtfns(*SubscribeRfqEventNtfnsRequest, Rfq_SubscribeRfqEventNtfnsServer) error
+	// tapcli: `rfq quotes`
+	// ListQuotes lists the quote ledger. The ledger contains all quotes that were
+	// requested by our node or by our peers, together with their current status.
+	ListQuotes(context.Context, *ListQuotesRequest) (*ListQuotesResponse, error)
+	// tapcli: `rfq trades`
+	// ListTrades lists the HTLCs that were accepted by our node against the
+	// quotes that it issued to its peers.
+	ListTrades(context.Context, *ListTradesRequest) (*ListTradesResponse, error)
 	mustEmbedUnimplementedRfqServer()
 }
 
@@ -184,6 +220,12 @@ func (UnimplementedRfqServer) QueryPeerAcceptedQuotes(context.Context, *QueryPee
 func (UnimplementedRfqServer) SubscribeRfqEventNtfns(*SubscribeRfqEventNtfnsRequest, Rfq_SubscribeRfqEventNtfnsServer) error {
 	return status.Errorf(codes.Unimplemented, "method SubscribeRfqEventNtfns not implemented")
 }
+func (UnimplementedRfqServer) ListQuotes(context.Context, *ListQuotesRequest) (*ListQuotesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListQuotes not implemented")
+}
+func (UnimplementedRfqServer) ListTrades(context.Context, *ListTradesRequest) (*ListTradesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTrades not implemented")
+}
 func (UnimplementedRfqServer) mustEmbedUnimplementedRfqServer() {}
 
 // UnsafeRfqServer may be embedded to opt out of forward compatibility for this service.
@@ -308,6 +350,42 @@ func (x *rfqSubscribeRfqEventNtfnsServer) Send(m *RfqEvent) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _Rfq_ListQuotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListQuotesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RfqServer).ListQuotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rfqrpc.Rfq/ListQuotes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RfqServer).ListQuotes(ctx, req.(*ListQuotesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Rfq_ListTrades_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTradesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RfqServer).ListTrades(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rfqrpc.Rfq/ListTrades",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RfqServer).ListTrades(ctx, req.(*ListTradesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Rfq_ServiceDesc is the grpc.ServiceDesc for Rfq service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -335,6 +413,14 @@ var Rfq_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "QueryPeerAcceptedQuotes",
 			Handler:    _Rfq_QueryPeerAcceptedQuotes_Handler,
 		},
+		{
+			MethodName: "ListQuotes",
+			Handler:    _Rfq_ListQuotes_Handler,
+		},
+		{
+			MethodName: "ListTrades",
+			Handler:    _Rfq_ListTrades_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -43,6 +43,12 @@ type UniverseClient interface {
 	// the asset within the Taproot Asset commitment for the given asset_id or
 	// group_key.
 	AssetLeafKeys(ctx context.Context, in *AssetLeafKeysRequest, opts ...grpc.CallOption) (*AssetLeafKeyResponse, error)
+	// AssetTreeNodes queries for a set of nodes within the MS-SMT of the Universe
+	// identified by the given asset_id or group_key. Each node is identified by
+	// its depth below the root and the key prefix that leads to it. This allows
+	// a caller to compare the Universe tree against a local copy from the root
+	// down, only descending into the branches that differ.
+	AssetTreeNodes(ctx context.Context, in *AssetTreeNodesRequest, opts ...grpc.CallOption) (*AssetTreeNodesResponse, error)
 	// tapcli: `universe leaves`
 	// AssetLeaves queries for the set of asset leaves (the values in the Universe
 	// MS-SMT tree) for a given asset_id or group_key. These represents either
@@ -50,6 +56,12 @@ type UniverseClient interface {
 	// took place on chain. The leaves contain a normal Taproot Asset proof, as
 	// well as details for the asset.
 	AssetLeaves(ctx context.Context, in *ID, opts ...grpc.CallOption) (*AssetLeafResponse, error)
+	// tapcli: `universe subscribe`
+	// SubscribeLeaves allows a caller to subscribe to the leaves that are
+	// inserted into the Universe, optionally filtered by asset ID, group key or
+	// proof type. Each leaf carries a cursor that can be used to resume the
+	// subscription after a reconnect without missing any leaves.
+	SubscribeLeaves(ctx context.Context, in *SubscribeLeavesRequest, opts ...grpc.CallOption) (Universe_SubscribeLeavesClient, error)
 	// tapcli: `universe proofs query`
 	// QueryProof attempts to query for an issuance or transfer proof for a given
 	// asset based on its UniverseKey. A UniverseKey is composed of the Universe
@@ -58,12 +70,32 @@ type UniverseClient interface {
 	// to the known Universe root, as well as a Taproot Asset state transition or
 	// issuance proof for the said asset.
 	QueryProof(ctx context.Context, in *UniverseKey, opts ...grpc.CallOption) (*AssetProofResponse, error)
+	// tapcli: `universe proofs query-batch`
+	// QueryProofs attempts to query for the issuance or transfer proofs of a
+	// batch of leaves within a single Universe. All leaves are proven against
+	// the same Universe root with a single MS-SMT multi-proof, which is much
+	// more compact than an inclusion proof per leaf. Leaf keys that aren't
+	// included in the Universe are returned without an asset leaf, and the
+	// multi-proof proves their non-inclusion.
+	QueryProofs(ctx context.Context, in *QueryProofsRequest, opts ...grpc.CallOption) (*QueryProofsResponse, error)
 	// tapcli: `universe proofs insert`
 	// InsertProof attempts to insert a new issuance or transfer proof into the
 	// Universe tree specified by the UniverseKey. If valid, then the proof is
 	// inserted into the database, with a new Universe root returned for the
 	// updated asset_id/group_key.
 	InsertProof(ctx context.Context, in *AssetProof, opts ...grpc.CallOption) (*AssetProofResponse, error)
+	// tapcli: `universe export`
+	// ExportSnapshot exports a snapshot of the specified set of Universes, or of
+	// all known Universes if none are specified. The snapshot contains the root
+	// of each Universe, followed by the keys and proofs of all its leaves, and
+	// is streamed back in chunks of raw bytes.
+	ExportSnapshot(ctx context.Context, in *ExportSnapshotRequest, opts ...grpc.CallOption) (Universe_ExportSnapshotClient, error)
+	// tapcli: `universe import`
+	// ImportSnapshot imports a snapshot that was created by ExportSnapshot. The
+	// snapshot is streamed in as chunks of raw bytes. All proofs are fully
+	// verified before they're inserted, and the resulting root of each Universe
+	// must match the root recorded in the snapshot.
+	ImportSnapshot(ctx context.Context, opts ...grpc.CallOption) (Universe_ImportSnapshotClient, error)
 	// tapcli: `universe info`
 	// Info returns a set of information about the current state of the Universe.
 	Info(ctx context.Context, in *InfoRequest, opts ...grpc.CallOption) (*InfoResponse, error)
@@ -77,7 +109,9 @@ type UniverseClient interface {
 	// tapcli: `universe federation list`
 	// ListFederationServers lists the set of servers that make up the federation
 	// of the local Universe server. This servers are used to push out new proofs,
-	// and also periodically call sync new proofs from the remote server.
+	// and also periodically call sync new proofs from the remote server. The
+	// health of each server is included, servers that keep failing are
+	// quarantined and skipped for an exponentially increasing amount of time.
 	ListFederationServers(ctx context.Context, in *ListFederationServersRequest, opts ...grpc.CallOption) (*ListFederationServersResponse, error)
 	// tapcli: `universe federation add`
 	// AddFederationServer adds a new server to the federation of the local
@@ -88,6 +122,18 @@ type UniverseClient interface {
 	// DeleteFederationServer removes a server from the federation of the local
 	// Universe server.
 	DeleteFederationServer(ctx context.Context, in *DeleteFederationServerRequest, opts ...grpc.CallOption) (*DeleteFederationServerResponse, error)
+	// tapcli: `universe federation pushqueue`
+	// ListFederationPushQueue lists the proof pushes to federation servers that
+	// haven't completed yet. Failed pushes are retried in the background with an
+	// exponential backoff until they succeed or the maximum number of attempts
+	// is reached.
+	ListFederationPushQueue(ctx context.Context, in *ListFederationPushQueueRequest, opts ...grpc.CallOption) (*ListFederationPushQueueResponse, error)
+	// tapcli: `universe federation divergences`
+	// QueryDivergences lists the universes whose local root still didn't match
+	// the root of a federation server after syncing with that server, most
+	// recent first. This is caused by leaves of the server that couldn't be
+	// synced, which can indicate an equivocating or corrupted server.
+	QueryDivergences(ctx context.Context, in *QueryDivergencesRequest, opts ...grpc.CallOption) (*QueryDivergencesResponse, error)
 	// tapcli: `universe stats`
 	// UniverseStats returns a set of aggregate statistics for the current state
 	// of the Universe. Stats returned include: total number of syncs, total
@@ -110,6 +156,29 @@ type UniverseClient interface {
 	// QueryFederationSyncConfig queries the universe federation sync configuration
 	// settings.
 	QueryFederationSyncConfig(ctx context.Context, in *QueryFederationSyncConfigRequest, opts ...grpc.CallOption) (*QueryFederationSyncConfigResponse, error)
+	// tapcli: `universe policy set`
+	// SetAdmissionPolicy replaces the admission policy for proofs that remote
+	// clients insert into the universe, either directly or through the universe
+	// proof courier. The policy consists of allow and deny rules for assets and
+	// asset groups, a maximum proof size, and a maximum insertion rate per
+	// client. The rules and the maximum proof size also apply to proofs that
+	// are synced from the federation or imported from a snapshot.
+	SetAdmissionPolicy(ctx context.Context, in *SetAdmissionPolicyRequest, opts ...grpc.CallOption) (*SetAdmissionPolicyResponse, error)
+	// tapcli: `universe policy info`
+	// QueryAdmissionPolicy returns the current admission policy for proofs that
+	// remote clients insert into the universe.
+	QueryAdmissionPolicy(ctx context.Context, in *QueryAdmissionPolicyRequest, opts ...grpc.CallOption) (*QueryAdmissionPolicyResponse, error)
+	// tapcli: `universe proofs committed`
+	// QueryCommittedProof attempts to query for an issuance proof for a given
+	// asset based on its UniverseKey, tied to the most recent on-chain commitment
+	// of the issuance multiverse root that includes the current root of the
+	// asset's universe. The multiverse inclusion proof of the returned proof is
+	// relative to the committed multiverse root.
+	QueryCommittedProof(ctx context.Context, in *UniverseKey, opts ...grpc.CallOption) (*CommittedProofResponse, error)
+	// tapcli: `universe commitments`
+	// ListCommitments lists the on-chain commitments of the issuance multiverse
+	// root, most recent first.
+	ListCommitments(ctx context.Context, in *ListCommitmentsRequest, opts ...grpc.CallOption) (*ListCommitmentsResponse, error)
 }
 
 type universeClient struct {
@@ -165,6 +234,15 @@ func (c *universeClient) AssetLeafKeys(ctx context.Context, in *AssetLeafKeysReq
 	return out, nil
 }
 
+func (c *universeClient) AssetTreeNodes(ctx context.Context, in *AssetTreeNodesRequest, opts ...grpc.CallOption) (*AssetTreeNodesResponse, error) {
+	out := new(AssetTreeNodesResponse)
+	err := c.cc.Invoke(ctx, "/universerpc.Universe/AssetTreeNodes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *universeClient) AssetLeaves(ctx context.Context, in *ID, opts ...grpc.CallOption) (*AssetLeafResponse, error) {
 	out := new(AssetLeafResponse)
 	err := c.cc.Invoke(ctx, "/universerpc.Universe/AssetLeaves", in, out, opts...)
@@ -174,6 +252,38 @@ func (c *universeClient) AssetLeaves(ctx context.Context, in *ID, opts ...grpc.C
 	return out, nil
 }
 
+func (c *universeClient) SubscribeLeaves(ctx context.Context, in *SubscribeLeavesRequest, opts ...grpc.CallOption) (Universe_SubscribeLeavesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Universe_ServiceDesc.Streams[0], "/universerpc.Universe/SubscribeLeaves", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &universeSubscribeLeavesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Universe_SubscribeLeavesClient interface {
+	Recv() (*UniverseLeafEvent, error)
+	grpc.ClientStream
+}
+
+type universeSubscribeLeavesClient struct {
+	grpc.ClientStream
+}
+
+func (x *universeSubscribeLeavesClient) Recv() (*UniverseLeafEvent, error) {
+	m := new(UniverseLeafEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *universeClient) QueryProof(ctx context.Context, in *UniverseKey, opts ...grpc.CallOption) (*AssetProofResponse, error) {
 	out := new(AssetProofResponse)
 	err := c.cc.Invoke(ctx, "/universerpc.Universe/QueryProof", in, out, opts...)
@@ -183,6 +293,15 @@ func (c *universeClient) QueryProof(ctx context.Context, in *UniverseKey, opts .
 	return out, nil
 }
 
+func (c *universeClient) QueryProofs(ctx context.Context, in *QueryProofsRequest, opts ...grpc.CallOption) (*QueryProofsResponse, error) {
+	out := new(QueryProofsResponse)
+	err := c.cc.Invoke(ctx, "/universerpc.Universe/QueryProofs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *universeClient) InsertProof(ctx context.Context, in *AssetProof, opts ...grpc.CallOption) (*AssetProofResponse, error) {
 	out := new(AssetProofResponse)
 	err := c.cc.Invoke(ctx, "/universerpc.Universe/InsertProof", in, out, opts...)
@@ -192,6 +311,72 @@ func (c *universeClient) InsertProof(ctx context.Context, in *AssetProof, opts .
 	return out, nil
 }
 
+func (c *universeClient) ExportSnapshot(ctx context.Context, in *ExportSnapshotRequest, opts ...grpc.CallOption) (Universe_ExportSnapshotClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Universe_ServiceDesc.Streams[1], "/universerpc.Universe/ExportSnapshot", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &universeExportSnapshotClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Universe_ExportSnapshotClient interface {
+	Recv() (*SnapshotChunk, error)
+	grpc.ClientStream
+}
+
+type universeExportSnapshotClient struct {
+	grpc.ClientStream
+}
+
+func (x *universeExportSnapshotClient) Recv() (*SnapshotChunk, error) {
+	m := new(SnapshotChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *universeClient) ImportSnapshot(ctx context.Context, opts ...grpc.CallOption) (Universe_ImportSnapshotClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Universe_ServiceDesc.Streams[2], "/universerpc.Universe/ImportSnapshot", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &universeImportSnapshotClient{stream}
+	return x, nil
+}
+
+type Universe_ImportSnapshotClient interface {
+	Send(*SnapshotChunk) error
+	CloseAndRecv() (*ImportSnapshotResponse, error)
+	grpc.ClientStream
+}
+
+type universeImportSnapshotClient struct {
+	grpc.ClientStream
+}
+
+func (x *universeImportSnapshotClient) Send(m *SnapshotChunk) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *universeImportSnapshotClient) CloseAndRecv() (*ImportSnapshotResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(ImportSnapshotResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *universeClient) Info(ctx context.Context, in *InfoRequest, opts ...grpc.CallOption) (*InfoResponse, error) {
 	out := new(InfoResponse)
 	err := c.cc.Invoke(ctx, "/universerpc.Universe/Info", in, out, opts...)
@@ -237,6 +422,24 @@ func (c *universeClient) DeleteFederationServer(ctx context.Context, in *DeleteF
 	return out, nil
 }
 
+func (c *universeClient) ListFederationPushQueue(ctx context.Context, in *ListFederationPushQueueRequest, opts ...grpc.CallOption) (*ListFederationPushQueueResponse, error) {
+	out := new(ListFederationPushQueueResponse)
+	err := c.cc.Invoke(ctx, "/universerpc.Universe/ListFederationPushQueue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *universeClient) QueryDivergences(ctx context.Context, in *QueryDivergencesRequest, opts ...grpc.CallOption) (*QueryDivergencesResponse, error) {
+	out := new(QueryDivergencesResponse)
+	err := c.cc.Invoke(ctx, "/universerpc.Universe/QueryDivergences", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *universeClient) UniverseStats(ctx context.Context, in *StatsRequest, opts ...grpc.CallOption) (*StatsResponse, error) {
 	out := new(StatsResponse)
 	err := c.cc.Invoke(ctx, "/universerpc.Universe/UniverseStats", in, out, opts...)
@@ -282,6 +485,42 @@ func (c *universeClient) QueryFederationSyncConfig(ctx context.Context, in *Quer
 	return out, nil
 }
 
+func (c *universeClient) SetAdmissionPolicy(ctx context.Context, in *SetAdmissionPolicyRequest, opts ...grpc.CallOption) (*SetAdmissionPolicyResponse, error) {
+	out := new(SetAdmissionPolicyResponse)
+	err := c.cc.Invoke(ctx, "/universerpc.Universe/SetAdmissionPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *universeClient) QueryAdmissionPolicy(ctx context.Context, in *QueryAdmissionPolicyRequest, opts ...grpc.CallOption) (*QueryAdmissionPolicyResponse, error) {
+	out := new(QueryAdmissionPolicyResponse)
+	err := c.cc.Invoke(ctx, "/universerpc.Universe/QueryAdmissionPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *universeClient) QueryCommittedProof(ctx context.Context, in *UniverseKey, opts ...grpc.CallOption) (*CommittedProofResponse, error) {
+	out := new(CommittedProofResponse)
+	err := c.cc.Invoke(ctx, "/universerpc.Universe/QueryCommittedProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *universeClient) ListCommitments(ctx context.Context, in *ListCommitmentsRequest, opts ...grpc.CallOption) (*ListCommitmentsResponse, error) {
+	out := new(ListCommitmentsResponse)
+	err := c.cc.Invoke(ctx, "/universerpc.Universe/ListCommitments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UniverseServer is the server API for Universe service.
 // All implementations must embed UnimplementedUniverseServer
 // for forward compatibility
@@ -311,6 +550,12 @@ type UniverseServer interface {
 	// the asset within the Taproot Asset commitment for the given asset_id or
 	// group_key.
 	AssetLeafKeys(context.Context, *AssetLeafKeysRequest) (*AssetLeafKeyResponse, error)
+	// AssetTreeNodes queries for a set of nodes within the MS-SMT of the Universe
+	// identified by the given asset_id or group_key. Each node is identified by
+	// its depth below the root and the key prefix that leads to it. This allows
+	// a caller to compare the Universe tree against a local copy from the root
+	// down, only descending into the branches that differ.
+	AssetTreeNodes(context.Context, *AssetTreeNodesRequest) (*AssetTreeNodesResponse, error)
 	// tapcli: `universe leaves`
 	// AssetLeaves queries for the set of asset leaves (the values in the Universe
 	// MS-SMT tree) for a given asset_id or group_key. These represents either
@@ -318,6 +563,12 @@ type UniverseServer interface {
 	// took place on chain. The leaves contain a normal Taproot Asset proof, as
 	// well as details for the asset.
 	AssetLeaves(context.Context, *ID) (*AssetLeafResponse, error)
+	// tapcli: `universe subscribe`
+	// SubscribeLeaves allows a caller to subscribe to the leaves that are
+	// inserted into the Universe, optionally filtered by asset ID, group key or
+	// proof type. Each leaf carries a cursor that can be used to resume the
+	// subscription after a reconnect without missing any leaves.
+	SubscribeLeaves(*SubscribeLeavesRequest, Universe_SubscribeLeavesServer) error
 	// tapcli: `universe proofs query`
 	// QueryProof attempts to query for an issuance or transfer proof for a given
 	// asset based on its UniverseKey. A UniverseKey is composed of the Universe
@@ -326,12 +577,32 @@ type UniverseServer interface {
 	// to the known Universe root, as well as a Taproot Asset state transition or
 	// issuance proof for the said asset.
 	QueryProof(context.Context, *UniverseKey) (*AssetProofResponse, error)
+	// tapcli: `universe proofs query-batch`
+	// QueryProofs attempts to query for the issuance or transfer proofs of a
+	// batch of leaves within a single Universe. All leaves are proven against
+	// the same Universe root with a single MS-SMT multi-proof, which is much
+	// more compact than an inclusion proof per leaf. Leaf keys that aren't
+	// included in the Universe are returned without an asset leaf, and the
+	// multi-proof proves their non-inclusion.
+	QueryProofs(context.Context, *QueryProofsRequest) (*QueryProofsResponse, error)
 	// tapcli: `universe proofs insert`
 	// InsertProof attempts to insert a new issuance or transfer proof into the
 	// Universe tree specified by the UniverseKey. If valid, then the proof is
 	// inserted into the database, with a new Universe root returned for the
 	// updated asset_id/group_key.
 	InsertProof(context.Context, *AssetProof) (*AssetProofResponse, error)
+	// tapcli: `universe export`
+	// ExportSnapshot exports a snapshot of the specified set of Universes, or of
+	// all known Universes if none are specified. The snapshot contains the root
+	// of each Universe, followed by the keys and proofs of all its leaves, and
+	// is streamed back in chunks of raw bytes.
+	ExportSnapshot(*ExportSnapshotRequest, Universe_ExportSnapshotServer) error
+	// tapcli: `universe import`
+	// ImportSnapshot imports a snapshot that was created by ExportSnapshot. The
+	// snapshot is streamed in as chunks of raw bytes. All proofs are fully
+	// verified before they're inserted, and the resulting root of each Universe
+	// must match the root recorded in the snapshot.
+	ImportSnapshot(Universe_ImportSnapshotServer) error
 	// tapcli: `universe info`
 	// Info returns a set of information about the current state of the Universe.
 	Info(context.Context, *InfoRequest) (*InfoResponse, error)
@@ -345,7 +616,9 @@ type UniverseServer interface {
 	// tapcli: `universe federation list`
 	// ListFederationServers lists the set of servers that make up the federation
 	// of the local Universe server. This servers are used to push out new proofs,
-	// and also periodically call sync new proofs from the remote server.
+	// and also periodically call sync new proofs from the remote server. The
+	// health of each server is included, servers that keep failing are
+	// quarantined and skipped for an exponentially increasing amount of time.
 	ListFederationServers(context.Context, *ListFederationServersRequest) (*ListFederationServersResponse, error)
 	// tapcli: `universe federation add`
 	// AddFederationServer adds a new server to the federation of the local
@@ -356,6 +629,18 @@ type UniverseServer interface {
 	// DeleteFederationServer removes a server from the federation of the local
 	// Universe server.
 	DeleteFederationServer(context.Context, *DeleteFederationServerRequest) (*DeleteFederationServerResponse, error)
+	// tapcli: `universe federation pushqueue`
+	// ListFederationPushQueue lists the proof pushes to federation servers that
+	// haven't completed yet. Failed pushes are retried in the background with an
+	// exponential backoff until they succeed or the maximum number of attempts
+	// is reached.
+	ListFederationPushQueue(context.Context, *ListFederationPushQueueRequest) (*ListFederationPushQueueResponse, error)
+	// tapcli: `universe federation divergences`
+	// QueryDivergences lists the universes whose local root still didn't match
+	// the root of a federation server after syncing with that server, most
+	// recent first. This is caused by leaves of the server that couldn't be
+	// synced, which can indicate an equivocating or corrupted server.
+	QueryDivergences(context.Context, *QueryDivergencesRequest) (*QueryDivergencesResponse, error)
 	// tapcli: `universe stats`
 	// UniverseStats returns a set of aggregate statistics for the current state
 	// of the Universe. Stats returned include: total number of syncs, total
@@ -378,6 +663,29 @@ type UniverseServer interface {
 	// QueryFederationSyncConfig queries the universe federation sync configuration
 	// settings.
 	QueryFederationSyncConfig(context.Context, *QueryFederationSyncConfigRequest) (*QueryFederationSyncConfigResponse, error)
+	// tapcli: `universe policy set`
+	// SetAdmissionPolicy replaces the admission policy for proofs that remote
+	// clients insert into the universe, either directly or through the universe
+	// proof courier. The policy consists of allow and deny rules for assets and
+	// asset groups, a maximum proof size, and a maximum insertion rate per
+	// client. The rules and the maximum proof size also apply to proofs that
+	// are synced from the federation or imported from a snapshot.
+	SetAdmissionPolicy(context.Context, *SetAdmissionPolicyRequest) (*SetAdmissionPolicyResponse, error)
+	// tapcli: `universe policy info`
+	// QueryAdmissionPolicy returns the current admission policy for proofs that
+	// remote clients insert into the universe.
+	QueryAdmissionPolicy(context.Context, *QueryAdmissionPolicyRequest) (*QueryAdmissionPolicyResponse, error)
+	// tapcli: `universe proofs committed`
+	// QueryCommittedProof attempts to query for an issuance proof for a given
+	// asset based on its UniverseKey, tied to the most recent on-chain commitment
+	// of the issuance multiverse root that includes the current root of the
+	// asset's universe. The multiverse inclusion proof of the returned proof is
+	// relative to the committed multiverse root.
+	QueryCommittedProof(context.Context, *UniverseKey) (*CommittedProofResponse, error)
+	// tapcli: `universe commitments`
+	// ListCommitments lists the on-chain commitments of the issuance multiverse
+	// root, most recent first.
+	ListCommitments(context.Context, *ListCommitmentsRequest) (*ListCommitmentsResponse, error)
 	mustEmbedUnimplementedUniverseServer()
 }
 
@@ -400,15 +708,30 @@ func (UnimplementedUniverseServer) DeleteAssetRoot(context.Context, *DeleteRootQ
 func (UnimplementedUniverseServer) AssetLeafKeys(context.Context, *AssetLeafKeysRequest) (*AssetLeafKeyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AssetLeafKeys not implemented")
 }
+func (UnimplementedUniverseServer) AssetTreeNodes(context.Context, *AssetTreeNodesRequest) (*AssetTreeNodesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssetTreeNodes not implemented")
+}
 func (UnimplementedUniverseServer) AssetLeaves(context.Context, *ID) (*AssetLeafResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AssetLeaves not implemented")
 }
+func (UnimplementedUniverseServer) SubscribeLeaves(*SubscribeLeavesRequest, Universe_SubscribeLeavesServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeLeaves not implemented")
+}
 func (UnimplementedUniverseServer) QueryProof(context.Context, *UniverseKey) (*AssetProofResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QueryProof not implemented")
 }
+func (UnimplementedUniverseServer) QueryProofs(context.Context, *QueryProofsRequest) (*QueryProofsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryProofs not implemented")
+}
 func (UnimplementedUniverseServer) InsertProof(context.Context, *AssetProof) (*AssetProofResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InsertProof not implemented")
 }
+func (UnimplementedUniverseServer) ExportSnapshot(*ExportSnapshotRequest, Universe_ExportSnapshotServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportSnapshot not implemented")
+}
+func (UnimplementedUniverseServer) ImportSnapshot(Universe_ImportSnapshotServer) error {
+	return status.Errorf(codes.Unimplemented, "method ImportSnapshot not implemented")
+}
 func (UnimplementedUniverseServer) Info(context.Context, *InfoRequest) (*InfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Info not implemented")
 }
@@ -424,6 +747,12 @@ func (UnimplementedUniverseServer) AddFederationServer(context.Context, *AddFede
 func (UnimplementedUniverseServer) DeleteFederationServer(context.Context, *DeleteFederationServerRequest) (*DeleteFederationServerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteFederationServer not implemented")
 }
+func (UnimplementedUniverseServer) ListFederationPushQueue(context.Context, *ListFederationPushQueueRequest) (*ListFederationPushQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFederationPushQueue not implemented")
+}
+func (UnimplementedUniverseServer) QueryDivergences(context.Context, *QueryDivergencesRequest) (*QueryDivergencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryDivergences not implemented")
+}
 func (UnimplementedUniverseServer) UniverseStats(context.Context, *StatsRequest) (*StatsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UniverseStats not implemented")
 }
@@ -439,6 +768,18 @@ func (UnimplementedUniverseServer) SetFederationSyncConfig(context.Context, *Set
 func (UnimplementedUniverseServer) QueryFederationSyncConfig(context.Context, *QueryFederationSyncConfigRequest) (*QueryFederationSyncConfigResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QueryFederationSyncConfig not implemented")
 }
+func (UnimplementedUniverseServer) SetAdmissionPolicy(context.Context, *SetAdmissionPolicyRequest) (*SetAdmissionPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAdmissionPolicy not implemented")
+}
+func (UnimplementedUniverseServer) QueryAdmissionPolicy(context.Context, *QueryAdmissionPolicyRequest) (*QueryAdmissionPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryAdmissionPolicy not implemented")
+}
+func (UnimplementedUniverseServer) QueryCommittedProof(context.Context, *UniverseKey) (*CommittedProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryCommittedProof not implemented")
+}
+func (UnimplementedUniverseServer) ListCommitments(context.Context, *ListCommitmentsRequest) (*ListCommitmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCommitments not implemented")
+}
 func (UnimplementedUniverseServer) mustEmbedUnimplementedUniverseServer() {}
 
 // UnsafeUniverseServer may be embedded to opt out of forward compatibility for this service.
@@ -542,6 +883,24 @@ func _Universe_AssetLeafKeys_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Universe_AssetTreeNodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssetTreeNodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UniverseServer).AssetTreeNodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/universerpc.Universe/AssetTreeNodes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UniverseServer).AssetTreeNodes(ctx, req.(*AssetTreeNodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Universe_AssetLeaves_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ID)
 	if err := dec(in); err != nil {
@@ -560,6 +919,27 @@ func _Universe_AssetLeaves_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Universe_SubscribeLeaves_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeLeavesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(UniverseServer).SubscribeLeaves(m, &universeSubscribeLeavesServer{stream})
+}
+
+type Universe_SubscribeLeavesServer interface {
+	Send(*UniverseLeafEvent) error
+	grpc.ServerStream
+}
+
+type universeSubscribeLeavesServer struct {
+	grpc.ServerStream
+}
+
+func (x *universeSubscribeLeavesServer) Send(m *UniverseLeafEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _Universe_QueryProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UniverseKey)
 	if err := dec(in); err != nil {
@@ -578,6 +958,24 @@ func _Universe_QueryProof_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Universe_QueryProofs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProofsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UniverseServer).QueryProofs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/universerpc.Universe/QueryProofs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UniverseServer).QueryProofs(ctx, req.(*QueryProofsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Universe_InsertProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AssetProof)
 	if err := dec(in); err != nil {
@@ -596,6 +994,53 @@ func _Universe_InsertProof_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Universe_ExportSnapshot_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportSnapshotRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(UniverseServer).ExportSnapshot(m, &universeExportSnapshotServer{stream})
+}
+
+type Universe_ExportSnapshotServer interface {
+	Send(*SnapshotChunk) error
+	grpc.ServerStream
+}
+
+type universeExportSnapshotServer struct {
+	grpc.ServerStream
+}
+
+func (x *universeExportSnapshotServer) Send(m *SnapshotChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Universe_ImportSnapshot_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(UniverseServer).ImportSnapshot(&universeImportSnapshotServer{stream})
+}
+
+type Universe_ImportSnapshotServer interface {
+	SendAndClose(*ImportSnapshotResponse) error
+	Recv() (*SnapshotChunk, error)
+	grpc.ServerStream
+}
+
+type universeImportSnapshotServer struct {
+	grpc.ServerStream
+}
+
+func (x *universeImportSnapshotServer) SendAndClose(m *ImportSnapshotResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *universeImportSnapshotServer) Recv() (*SnapshotChunk, error) {
+	m := new(SnapshotChunk)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func _Universe_Info_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(InfoRequest)
 	if err := dec(in); err != nil {
@@ -686,6 +1131,42 @@ func _Universe_DeleteFederationServer_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Universe_ListFederationPushQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFederationPushQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UniverseServer).ListFederationPushQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/universerpc.Universe/ListFederationPushQueue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UniverseServer).ListFederationPushQueue(ctx, req.(*ListFederationPushQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Universe_QueryDivergences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDivergencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UniverseServer).QueryDivergences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/universerpc.Universe/QueryDivergences",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UniverseServer).QueryDivergences(ctx, req.(*QueryDivergencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Universe_UniverseStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(StatsRequest)
 	if err := dec(in); err != nil {
@@ -776,6 +1257,78 @@ func _Universe_QueryFederationSyncConfig_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Universe_SetAdmissionPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAdmissionPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UniverseServer).SetAdmissionPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/universerpc.Universe/SetAdmissionPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UniverseServer).SetAdmissionPolicy(ctx, req.(*SetAdmissionPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Universe_QueryAdmissionPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAdmissionPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UniverseServer).QueryAdmissionPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/universerpc.Universe/QueryAdmissionPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UniverseServer).QueryAdmissionPolicy(ctx, req.(*QueryAdmissionPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Universe_QueryCommittedProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UniverseKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UniverseServer).QueryCommittedProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/universerpc.Universe/QueryCommittedProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UniverseServer).QueryCommittedProof(ctx, req.(*UniverseKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Universe_ListCommitments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCommitmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UniverseServer).ListCommitments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/universerpc.Universe/ListCommitments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UniverseServer).ListCommitments(ctx, req.(*ListCommitmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Universe_ServiceDesc is the grpc.ServiceDesc for Universe service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -803,6 +1356,10 @@ var Universe_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AssetLeafKeys",
 			Handler:    _Universe_AssetLeafKeys_Handler,
 		},
+		{
+			MethodName: "AssetTreeNodes",
+			Handler:    _Universe_AssetTreeNodes_Handler,
+		},
 		{
 			MethodName: "AssetLeaves",
 			Handler:    _Universe_AssetLeaves_Handler,
@@ -811,6 +1368,10 @@ var Universe_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "QueryProof",
 			Handler:    _Universe_QueryProof_Handler,
 		},
+		{
+			MethodName: "QueryProofs",
+			Handler:    _Universe_QueryProofs_Handler,
+		},
 		{
 			MethodName: "InsertProof",
 			Handler:    _Universe_InsertProof_Handler,
@@ -835,6 +1396,14 @@ var Universe_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteFederationServer",
 			Handler:    _Universe_DeleteFederationServer_Handler,
 		},
+		{
+			MethodName: "ListFederationPushQueue",
+			Handler:    _Universe_ListFederationPushQueue_Handler,
+		},
+		{
+			MethodName: "QueryDivergences",
+			Handler:    _Universe_QueryDivergences_Handler,
+		},
 		{
 			MethodName: "UniverseStats",
 			Handler:    _Universe_UniverseStats_Handler,
@@ -855,7 +1424,39 @@ var Universe_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "QueryFederationSyncConfig",
 			Handler:    _Universe_QueryFederationSyncConfig_Handler,
 		},
+		{
+			MethodName: "SetAdmissionPolicy",
+			Handler:    _Universe_SetAdmissionPolicy_Handler,
+		},
+		{
+			MethodName: "QueryAdmissionPolicy",
+			Handler:    _Universe_QueryAdmissionPolicy_Handler,
+		},
+		{
+			MethodName: "QueryCommittedProof",
+			Handler:    _Universe_QueryCommittedProof_Handler,
+		},
+		{
+			MethodName: "ListCommitments",
+			Handler:    _Universe_ListCommitments_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeLeaves",
+			Handler:       _Universe_SubscribeLeaves_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ExportSnapshot",
+			Handler:       _Universe_ExportSnapshot_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ImportSnapshot",
+			Handler:       _Universe_ImportSnapshot_Handler,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "universerpc/universe.proto",
 }
@@ -0,0 +1,441 @@
+package universe
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"golang.org/x/time/rate"
+)
+
+const (
+	// maxTrackedClients is the number of clients we keep a rate limiter
+	// for before we start to prune the limiters of idle clients.
+	maxTrackedClients = 10_000
+)
+
+var (
+	// ErrProofNotAdmitted is returned when a proof inserted by a remote
+	// client is rejected by the admission policy of the universe.
+	ErrProofNotAdmitted = errors.New("proof not admitted by universe " +
+		"admission policy")
+
+	// ErrInsertRateExceeded is returned when a remote client inserts
+	// proofs at a higher rate than the admission policy allows.
+	ErrInsertRateExceeded = errors.New("proof insertion rate exceeded")
+)
+
+// AdmissionRuleType is the type of an admission rule.
+type AdmissionRuleType uint8
+
+const (
+	// AdmissionRuleAllow is a rule that allows the proofs of an asset. If
+	// any allow rule exists, then only the proofs of allowed assets are
+	// admitted.
+	AdmissionRuleAllow AdmissionRuleType = iota
+
+	// AdmissionRuleDeny is a rule that denies the proofs of an asset.
+	AdmissionRuleDeny
+)
+
+// String returns a human-readable string for the admission rule type.
+func (t AdmissionRuleType) String() string {
+	switch t {
+	case AdmissionRuleAllow:
+		return "allow"
+
+	case AdmissionRuleDeny:
+		return "deny"
+
+	default:
+		return fmt.Sprintf("unknown(%d)", t)
+	}
+}
+
+// ParseStrAdmissionRuleType parses a string into an admission rule type.
+func ParseStrAdmissionRuleType(s string) (AdmissionRuleType, error) {
+	switch s {
+	case AdmissionRuleAllow.String():
+		return AdmissionRuleAllow, nil
+
+	case AdmissionRuleDeny.String():
+		return AdmissionRuleDeny, nil
+
+	default:
+		return 0, fmt.Errorf("unknown admission rule type: %v", s)
+	}
+}
+
+// AdmissionRule allows or denies the proofs of either a single asset ID or
+// all the assets of a group.
+type AdmissionRule struct {
+	// Type is the type of the rule.
+	Type AdmissionRuleType
+
+	// AssetID is the asset ID the rule applies to. Either the asset ID or
+	// the group key is set.
+	AssetID *asset.ID
+
+	// GroupKey is the group key the rule applies to.
+	GroupKey *btcec.PublicKey
+}
+
+// targetKey returns a unique key for the asset ID or group key the rule
+// applies to.
+func (r *AdmissionRule) targetKey() string {
+	if r.GroupKey != nil {
+		groupKey := schnorr.SerializePubKey(r.GroupKey)
+		return fmt.Sprintf("group-%x", groupKey)
+	}
+
+	return fmt.Sprintf("asset-%v", r.AssetID)
+}
+
+// matches returns true if the rule applies to the given asset. Like universe
+// identifiers, group keys are compared by their x-coordinate only.
+func (r *AdmissionRule) matches(a *asset.Asset) bool {
+	if r.GroupKey != nil {
+		return a.GroupKey != nil && bytes.Equal(
+			schnorr.SerializePubKey(r.GroupKey),
+			schnorr.SerializePubKey(&a.GroupKey.GroupPubKey),
+		)
+	}
+
+	return *r.AssetID == a.ID()
+}
+
+// AdmissionPolicy is the policy that decides which proofs inserted by remote
+// clients are admitted to the universe. The rules and the maximum proof size
+// also apply to the proofs the universe syncs from its federation or imports
+// from a snapshot, while the insertion rate only applies to remote clients.
+// Proofs that are created locally aren't subject to the policy.
+type AdmissionPolicy struct {
+	// Rules is the set of allow and deny rules for individual assets and
+	// asset groups. Deny rules take precedence over allow rules.
+	Rules []AdmissionRule
+
+	// MaxProofSize is the maximum size in bytes of an inserted proof. A
+	// value of zero means there is no limit.
+	MaxProofSize uint64
+
+	// MaxInsertsPerMinute is the maximum number of proofs a single client
+	// can insert per minute. A value of zero means there is no limit.
+	MaxInsertsPerMinute uint32
+}
+
+// Validate makes sure that every rule targets exactly one asset ID or group
+// key, and that there's at most one rule per target.
+func (p *AdmissionPolicy) Validate() error {
+	targets := make(map[string]struct{}, len(p.Rules))
+	for _, rule := range p.Rules {
+		switch rule.Type {
+		case AdmissionRuleAllow, AdmissionRuleDeny:
+		default:
+			return fmt.Errorf("unknown admission rule type: %v",
+				rule.Type)
+		}
+
+		if (rule.AssetID == nil) == (rule.GroupKey == nil) {
+			return fmt.Errorf("admission rule must target either " +
+				"an asset ID or a group key")
+		}
+
+		target := rule.targetKey()
+		if _, ok := targets[target]; ok {
+			return fmt.Errorf("duplicate admission rule for %v",
+				target)
+		}
+		targets[target] = struct{}{}
+	}
+
+	return nil
+}
+
+// CheckLeaf returns an error wrapping ErrProofNotAdmitted if the given leaf
+// isn't admitted by the policy.
+func (p *AdmissionPolicy) CheckLeaf(leaf *Leaf) error {
+	if p.MaxProofSize != 0 && uint64(len(leaf.RawProof)) > p.MaxProofSize {
+		return fmt.Errorf("%w: proof size %d exceeds maximum of %d "+
+			"bytes", ErrProofNotAdmitted, len(leaf.RawProof),
+			p.MaxProofSize)
+	}
+
+	var hasAllowRules, isAllowed bool
+	for i := range p.Rules {
+		rule := &p.Rules[i]
+		if rule.Type == AdmissionRuleAllow {
+			hasAllowRules = true
+		}
+
+		if !rule.matches(leaf.Asset) {
+			continue
+		}
+
+		if rule.Type == AdmissionRuleDeny {
+			return fmt.Errorf("%w: asset %v is denied",
+				ErrProofNotAdmitted, leaf.Asset.ID())
+		}
+		isAllowed = true
+	}
+
+	if hasAllowRules && !isAllowed {
+		return fmt.Errorf("%w: asset %v is not allowed",
+			ErrProofNotAdmitted, leaf.Asset.ID())
+	}
+
+	return nil
+}
+
+// AdmissionPolicyStore is used to persist the admission policy of the
+// universe.
+type AdmissionPolicyStore interface {
+	// FetchAdmissionPolicy returns the current admission policy. An empty
+	// policy is returned if no policy was set yet.
+	FetchAdmissionPolicy(ctx context.Context) (*AdmissionPolicy, error)
+
+	// SetAdmissionPolicy replaces the current admission policy. The
+	// leaves that were rejected under the previous policy are forgotten.
+	SetAdmissionPolicy(ctx context.Context, policy *AdmissionPolicy) error
+
+	// RejectLeaves records that the synced leaves with the given keys were
+	// rejected by the current policy.
+	RejectLeaves(ctx context.Context, id Identifier,
+		leafKeys []LeafKey) error
+
+	// RejectedLeafKeys returns the subset of the given leaf keys whose
+	// leaves were rejected from the given universe by the current policy.
+	RejectedLeafKeys(ctx context.Context, id Identifier,
+		leafKeys []LeafKey) ([]LeafKey, error)
+}
+
+// admissionClientKey is the context key for the remote client that inserts a
+// proof.
+type admissionClientKey struct{}
+
+// WithAdmissionClient returns a context that marks the proofs inserted with
+// it as coming from the given remote client. Only these proofs are subject to
+// the admission policy.
+func WithAdmissionClient(ctx context.Context, clientID string) context.Context {
+	return context.WithValue(ctx, admissionClientKey{}, clientID)
+}
+
+// AdmissionClient returns the remote client of the proofs inserted with the
+// given context, if any.
+func AdmissionClient(ctx context.Context) (string, bool) {
+	clientID, ok := ctx.Value(admissionClientKey{}).(string)
+	return clientID, ok
+}
+
+// AdmissionController enforces the admission policy for the proofs inserted
+// by remote clients. The policy is cached in memory, and a rate limiter is
+// kept for each client.
+type AdmissionController struct {
+	store AdmissionPolicyStore
+
+	mu       sync.Mutex
+	policy   *AdmissionPolicy
+	limiters map[string]*rate.Limiter
+}
+
+// NewAdmissionController creates a new admission controller backed by the
+// given store.
+func NewAdmissionController(store AdmissionPolicyStore) *AdmissionController {
+	return &AdmissionController{
+		store:    store,
+		limiters: make(map[string]*rate.Limiter),
+	}
+}
+
+// Policy returns the current admission policy.
+func (c *AdmissionController) Policy(
+	ctx context.Context) (*AdmissionPolicy, error) {
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.fetchPolicy(ctx)
+}
+
+// fetchPolicy returns the cached admission policy, loading it from the store
+// if needed. The caller must hold the mutex.
+func (c *AdmissionController) fetchPolicy(
+	ctx context.Context) (*AdmissionPolicy, error) {
+
+	if c.policy != nil {
+		return c.policy, nil
+	}
+
+	policy, err := c.store.FetchAdmissionPolicy(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch admission policy: %w",
+			err)
+	}
+	c.policy = policy
+
+	return policy, nil
+}
+
+// SetPolicy validates and stores the given admission policy, which replaces
+// the current policy. The insertion allowance of all clients is reset, and the
+// leaves rejected under the previous policy are reconsidered by the next sync.
+func (c *AdmissionController) SetPolicy(ctx context.Context,
+	policy *AdmissionPolicy) error {
+
+	if err := policy.Validate(); err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.store.SetAdmissionPolicy(ctx, policy); err != nil {
+		return fmt.Errorf("unable to store admission policy: %w", err)
+	}
+
+	c.policy = policy
+	c.limiters = make(map[string]*rate.Limiter)
+
+	return nil
+}
+
+// AdmitProof checks whether the given proof leaf inserted by the given remote
+// client is admitted. Each call counts towards the insertion rate of the
+// client, whether the proof is admitted or not.
+func (c *AdmissionController) AdmitProof(ctx context.Context, clientID string,
+	leaf *Leaf) error {
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	policy, err := c.fetchPolicy(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !c.allowInsert(clientID, policy.MaxInsertsPerMinute) {
+		return fmt.Errorf("%w: client %v is limited to %d proofs per "+
+			"minute", ErrInsertRateExceeded, clientID,
+			policy.MaxInsertsPerMinute)
+	}
+
+	return policy.CheckLeaf(leaf)
+}
+
+// CheckLeaf checks whether the given proof leaf is admitted by the rules and
+// the maximum proof size of the current policy. Unlike AdmitProof, it doesn't
+// count towards the insertion rate of any client, so it's used for the proofs
+// that are synced from the federation or imported from a snapshot.
+func (c *AdmissionController) CheckLeaf(ctx context.Context,
+	leaf *Leaf) error {
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	policy, err := c.fetchPolicy(ctx)
+	if err != nil {
+		return err
+	}
+
+	return policy.CheckLeaf(leaf)
+}
+
+// AdmitSyncedItems checks the given items that were synced from a federation
+// server against the rules and the maximum proof size of the current policy,
+// and returns the admitted ones. The keys of the rejected leaves are recorded,
+// so later syncs skip them instead of fetching them again, until the policy is
+// replaced.
+func (c *AdmissionController) AdmitSyncedItems(ctx context.Context,
+	id Identifier, items []*Item) ([]*Item, error) {
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	policy, err := c.fetchPolicy(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		admitted     = make([]*Item, 0, len(items))
+		rejectedKeys []LeafKey
+	)
+	for _, item := range items {
+		err := policy.CheckLeaf(item.Leaf)
+		if err != nil {
+			log.Warnf("UniverseRoot(%v): Skipping leaf at "+
+				"outpoint %v: %v", id.String(),
+				item.Key.OutPoint, err)
+
+			rejectedKeys = append(rejectedKeys, item.Key)
+			continue
+		}
+
+		admitted = append(admitted, item)
+	}
+
+	if len(rejectedKeys) == 0 {
+		return admitted, nil
+	}
+
+	err = c.store.RejectLeaves(ctx, id, rejectedKeys)
+	if err != nil {
+		return nil, fmt.Errorf("unable to record rejected leaves: %w",
+			err)
+	}
+
+	return admitted, nil
+}
+
+// RejectedLeafKeys returns the subset of the given leaf keys whose leaves were
+// rejected from the given universe by the current policy during an earlier
+// sync.
+func (c *AdmissionController) RejectedLeafKeys(ctx context.Context,
+	id Identifier, leafKeys []LeafKey) ([]LeafKey, error) {
+
+	return c.store.RejectedLeafKeys(ctx, id, leafKeys)
+}
+
+// allowInsert reports whether the client is still within its insertion rate
+// and consumes one insertion of its allowance. The caller must hold the
+// mutex.
+func (c *AdmissionController) allowInsert(clientID string,
+	maxPerMinute uint32) bool {
+
+	if maxPerMinute == 0 {
+		return true
+	}
+
+	limiter, ok := c.limiters[clientID]
+	if !ok {
+		// Clients that have their full allowance again don't need
+		// their limiter anymore, so we drop them to bound our memory
+		// use.
+		if len(c.limiters) >= maxTrackedClients {
+			c.pruneLimiters(maxPerMinute)
+		}
+
+		limiter = rate.NewLimiter(
+			rate.Limit(float64(maxPerMinute)/time.Minute.Seconds()),
+			int(maxPerMinute),
+		)
+		c.limiters[clientID] = limiter
+	}
+
+	return limiter.Allow()
+}
+
+// pruneLimiters removes the rate limiters of all clients that have their full
+// allowance. The caller must hold the mutex.
+func (c *AdmissionController) pruneLimiters(maxPerMinute uint32) {
+	for clientID, limiter := range c.limiters {
+		if limiter.Tokens() >= float64(maxPerMinute) {
+			delete(c.limiters, clientID)
+		}
+	}
+}
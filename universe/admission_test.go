@@ -0,0 +1,280 @@
+package universe
+
+import (
+	"context"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/stretchr/testify/require"
+)
+
+// mockAdmissionPolicyStore is an in-memory admission policy store.
+type mockAdmissionPolicyStore struct {
+	policy AdmissionPolicy
+
+	// rejected is the set of rejected leaves, keyed by the universe
+	// namespace and the universe key of the leaf.
+	rejected map[string]map[[32]byte]struct{}
+}
+
+func (m *mockAdmissionPolicyStore) FetchAdmissionPolicy(
+	context.Context) (*AdmissionPolicy, error) {
+
+	policy := m.policy
+	return &policy, nil
+}
+
+func (m *mockAdmissionPolicyStore) SetAdmissionPolicy(_ context.Context,
+	policy *AdmissionPolicy) error {
+
+	m.policy = *policy
+	m.rejected = nil
+	return nil
+}
+
+func (m *mockAdmissionPolicyStore) RejectLeaves(_ context.Context,
+	id Identifier, leafKeys []LeafKey) error {
+
+	if m.rejected == nil {
+		m.rejected = make(map[string]map[[32]byte]struct{})
+	}
+
+	namespace := id.String()
+	if m.rejected[namespace] == nil {
+		m.rejected[namespace] = make(map[[32]byte]struct{})
+	}
+	for _, key := range leafKeys {
+		m.rejected[namespace][key.UniverseKey()] = struct{}{}
+	}
+
+	return nil
+}
+
+func (m *mockAdmissionPolicyStore) RejectedLeafKeys(_ context.Context,
+	id Identifier, leafKeys []LeafKey) ([]LeafKey, error) {
+
+	var rejectedKeys []LeafKey
+	for _, key := range leafKeys {
+		_, ok := m.rejected[id.String()][key.UniverseKey()]
+		if ok {
+			rejectedKeys = append(rejectedKeys, key)
+		}
+	}
+
+	return rejectedKeys, nil
+}
+
+// TestAdmissionPolicyCheckLeaf tests that the allow and deny rules and the
+// maximum proof size of an admission policy are enforced.
+func TestAdmissionPolicyCheckLeaf(t *testing.T) {
+	t.Parallel()
+
+	groupedAsset := asset.RandAsset(t, asset.Normal)
+	otherAsset := asset.RandAsset(t, asset.Normal)
+
+	groupedID := groupedAsset.ID()
+	otherID := otherAsset.ID()
+
+	// Group keys only match by their x-coordinate, so a rule for the
+	// x-only group key also matches.
+	xOnlyGroupKey, err := schnorr.ParsePubKey(schnorr.SerializePubKey(
+		&groupedAsset.GroupKey.GroupPubKey,
+	))
+	require.NoError(t, err)
+
+	groupedLeaf := &Leaf{
+		Asset:    groupedAsset,
+		RawProof: test.RandBytes(100),
+	}
+	otherLeaf := &Leaf{
+		Asset:    otherAsset,
+		RawProof: test.RandBytes(100),
+	}
+
+	testCases := []struct {
+		name           string
+		policy         AdmissionPolicy
+		groupedAllowed bool
+		otherAllowed   bool
+	}{{
+		name:           "empty policy",
+		groupedAllowed: true,
+		otherAllowed:   true,
+	}, {
+		name: "proof too large",
+		policy: AdmissionPolicy{
+			MaxProofSize: 99,
+		},
+	}, {
+		name: "allow asset ID",
+		policy: AdmissionPolicy{
+			Rules: []AdmissionRule{{
+				Type:    AdmissionRuleAllow,
+				AssetID: &otherID,
+			}},
+		},
+		otherAllowed: true,
+	}, {
+		name: "allow group key",
+		policy: AdmissionPolicy{
+			Rules: []AdmissionRule{{
+				Type:     AdmissionRuleAllow,
+				GroupKey: xOnlyGroupKey,
+			}},
+		},
+		groupedAllowed: true,
+	}, {
+		name: "deny asset ID",
+		policy: AdmissionPolicy{
+			Rules: []AdmissionRule{{
+				Type:    AdmissionRuleDeny,
+				AssetID: &otherID,
+			}},
+		},
+		groupedAllowed: true,
+	}, {
+		name: "deny takes precedence",
+		policy: AdmissionPolicy{
+			Rules: []AdmissionRule{{
+				Type:     AdmissionRuleAllow,
+				GroupKey: xOnlyGroupKey,
+			}, {
+				Type:    AdmissionRuleAllow,
+				AssetID: &otherID,
+			}, {
+				Type:    AdmissionRuleDeny,
+				AssetID: &groupedID,
+			}},
+		},
+		otherAllowed: true,
+	}}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			require.NoError(t, tc.policy.Validate())
+
+			checkLeaf := func(leaf *Leaf, allowed bool) {
+				err := tc.policy.CheckLeaf(leaf)
+				if allowed {
+					require.NoError(t, err)
+					return
+				}
+
+				require.ErrorIs(t, err, ErrProofNotAdmitted)
+			}
+
+			checkLeaf(groupedLeaf, tc.groupedAllowed)
+			checkLeaf(otherLeaf, tc.otherAllowed)
+		})
+	}
+}
+
+// TestAdmissionPolicyValidate tests that invalid admission policies are
+// rejected.
+func TestAdmissionPolicyValidate(t *testing.T) {
+	t.Parallel()
+
+	assetID := asset.RandID(t)
+	groupKey := test.RandPubKey(t)
+
+	invalidPolicies := []AdmissionPolicy{{
+		Rules: []AdmissionRule{{
+			Type: AdmissionRuleAllow,
+		}},
+	}, {
+		Rules: []AdmissionRule{{
+			Type:     AdmissionRuleAllow,
+			AssetID:  &assetID,
+			GroupKey: groupKey,
+		}},
+	}, {
+		Rules: []AdmissionRule{{
+			Type:    AdmissionRuleType(2),
+			AssetID: &assetID,
+		}},
+	}, {
+		Rules: []AdmissionRule{{
+			Type:     AdmissionRuleAllow,
+			GroupKey: groupKey,
+		}, {
+			Type:     AdmissionRuleDeny,
+			GroupKey: groupKey,
+		}},
+	}}
+
+	for _, policy := range invalidPolicies {
+		require.Error(t, policy.Validate())
+	}
+}
+
+// TestAdmissionControllerRateLimit tests that the admission controller limits
+// the insertion rate of each client separately, and that a new policy resets
+// the allowance of all clients.
+func TestAdmissionControllerRateLimit(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	store := &mockAdmissionPolicyStore{
+		policy: AdmissionPolicy{
+			MaxInsertsPerMinute: 2,
+		},
+	}
+	controller := NewAdmissionController(store)
+
+	leaf := &Leaf{
+		Asset:    asset.RandAsset(t, asset.Normal),
+		RawProof: test.RandBytes(100),
+	}
+
+	// The first client can insert two proofs, but not a third one.
+	require.NoError(t, controller.AdmitProof(ctx, "client-1", leaf))
+	require.NoError(t, controller.AdmitProof(ctx, "client-1", leaf))
+	require.ErrorIs(
+		t, controller.AdmitProof(ctx, "client-1", leaf),
+		ErrInsertRateExceeded,
+	)
+
+	// The rate of another client is limited separately.
+	require.NoError(t, controller.AdmitProof(ctx, "client-2", leaf))
+
+	// Setting a new policy stores it, and resets the allowance.
+	newPolicy := &AdmissionPolicy{
+		MaxInsertsPerMinute: 1,
+		MaxProofSize:        1_000,
+	}
+	require.NoError(t, controller.SetPolicy(ctx, newPolicy))
+	require.Equal(t, *newPolicy, store.policy)
+
+	policy, err := controller.Policy(ctx)
+	require.NoError(t, err)
+	require.Equal(t, newPolicy, policy)
+
+	require.NoError(t, controller.AdmitProof(ctx, "client-1", leaf))
+	require.ErrorIs(
+		t, controller.AdmitProof(ctx, "client-1", leaf),
+		ErrInsertRateExceeded,
+	)
+
+	// Checking a leaf against the policy doesn't count towards the
+	// insertion rate of any client.
+	require.NoError(t, controller.CheckLeaf(ctx, leaf))
+	require.ErrorIs(
+		t, controller.CheckLeaf(ctx, &Leaf{
+			Asset:    leaf.Asset,
+			RawProof: test.RandBytes(1_001),
+		}), ErrProofNotAdmitted,
+	)
+	require.NoError(t, controller.AdmitProof(ctx, "client-2", leaf))
+
+	// Invalid policies are rejected without replacing the current one.
+	err = controller.SetPolicy(ctx, &AdmissionPolicy{
+		Rules: []AdmissionRule{{
+			Type: AdmissionRuleDeny,
+		}},
+	})
+	require.Error(t, err)
+	require.Equal(t, *newPolicy, store.policy)
+}
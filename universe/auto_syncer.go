@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/davecgh/go-spew/spew"
@@ -17,8 +18,104 @@ const (
 	// DefaultTimeout is the default timeout we use for RPC and database
 	// operations.
 	DefaultTimeout = 30 * time.Second
+
+	// DefaultPushRetryCheckInterval is the default interval at which the
+	// queue of pending proof pushes is checked for pushes that are due to
+	// be retried.
+	DefaultPushRetryCheckInterval = 30 * time.Second
+
+	// DefaultPushRetryInitialBackoff is the default amount of time we wait
+	// before retrying a failed proof push for the first time.
+	DefaultPushRetryInitialBackoff = time.Minute
+
+	// DefaultPushRetryMaxBackoff is the default maximum amount of time we
+	// wait between two attempts to push a proof.
+	DefaultPushRetryMaxBackoff = 6 * time.Hour
+
+	// DefaultPushRetryMaxAttempts is the default number of attempts after
+	// which we give up pushing a proof to a server.
+	DefaultPushRetryMaxAttempts = 20
 )
 
+// PushRetryConfig configures how proof pushes to federation servers that
+// failed are retried in the background.
+type PushRetryConfig struct {
+	// CheckInterval is the interval at which the queue of pending proof
+	// pushes is checked for pushes that are due to be retried.
+	CheckInterval time.Duration
+
+	// InitialBackoff is the amount of time we wait after the first failed
+	// attempt before retrying a push. The backoff is doubled after every
+	// further failed attempt.
+	InitialBackoff time.Duration
+
+	// MaxBackoff is the maximum amount of time we wait between two
+	// attempts.
+	MaxBackoff time.Duration
+
+	// MaxAttempts is the number of attempts after which we give up
+	// pushing a proof to a server. A value of zero means that we never
+	// give up.
+	MaxAttempts int64
+}
+
+// DefaultPushRetryConfig returns the default proof push retry config.
+func DefaultPushRetryConfig() PushRetryConfig {
+	return PushRetryConfig{
+		CheckInterval:  DefaultPushRetryCheckInterval,
+		InitialBackoff: DefaultPushRetryInitialBackoff,
+		MaxBackoff:     DefaultPushRetryMaxBackoff,
+		MaxAttempts:    DefaultPushRetryMaxAttempts,
+	}
+}
+
+// Backoff returns the amount of time to wait before the next attempt after
+// the given number of retries have failed. The backoff starts out at the
+// initial backoff and doubles with every retry, up to the max backoff.
+func (c *PushRetryConfig) Backoff(numRetries int64) time.Duration {
+	backoff := c.InitialBackoff
+	for i := int64(0); i < numRetries && backoff < c.MaxBackoff; i++ {
+		backoff *= 2
+	}
+
+	if backoff > c.MaxBackoff {
+		return c.MaxBackoff
+	}
+
+	return backoff
+}
+
+// Exhausted returns true if no further attempts should be made for the given
+// pending proof push.
+func (c *PushRetryConfig) Exhausted(entry *ProofSyncLogEntry) bool {
+	// The attempt counter of a log entry is only bumped when a push is
+	// retried, so the initial attempt isn't included in it.
+	numAttempts := entry.AttemptCounter + 1
+
+	return c.MaxAttempts > 0 && numAttempts >= c.MaxAttempts
+}
+
+// NextAttempt returns the earliest time at which the given pending proof push
+// should be attempted again. The timestamp of a log entry is updated on every
+// attempt, so the backoff is counted from the last attempt.
+func (c *PushRetryConfig) NextAttempt(entry *ProofSyncLogEntry) time.Time {
+	return entry.Timestamp.Add(c.Backoff(entry.AttemptCounter))
+}
+
+// PendingProofPush is a proof push to a federation server that hasn't
+// completed yet, along with its retry state.
+type PendingProofPush struct {
+	*ProofSyncLogEntry
+
+	// NextAttempt is the earliest time at which the push is attempted
+	// again.
+	NextAttempt time.Time
+
+	// Exhausted is true if the maximum number of attempts was reached and
+	// the push won't be retried anymore.
+	Exhausted bool
+}
+
 // FederationConfig is a config that the FederationEnvoy will use to
 // synchronize new updates between the current set of federated Universe nodes.
 type FederationConfig struct {
@@ -56,6 +153,14 @@ type FederationConfig struct {
 	// ServerChecker is a function that can be used to check if a server is
 	// operational and not the local daemon.
 	ServerChecker func(ServerAddr) error
+
+	// PushRetry configures how failed proof pushes are retried.
+	PushRetry PushRetryConfig
+
+	// ServerHealth tracks the health of the federation servers, and
+	// quarantines the ones that keep failing. It's shared with the
+	// syncer, which records the round-trip time of its calls.
+	ServerHealth *ServerHealthTracker
 }
 
 // FederationPushReq is used to push out new updates to all or some members of
@@ -114,6 +219,14 @@ type FederationEnvoy struct {
 	// batchPushRequests is a channel that will be sent new requests to push
 	// out batch proof leaves to the federation.
 	batchPushRequests chan *FederationProofBatchPushReq
+
+	// serverHealth tracks the health of the federation servers, and
+	// quarantines the ones that keep failing.
+	serverHealth *ServerHealthTracker
+
+	// retryingPushes is true while the pending proof pushes are being
+	// retried in the background.
+	retryingPushes atomic.Bool
 }
 
 // A compile-time check to ensure that FederationEnvoy meets the
@@ -122,10 +235,17 @@ var _ address.AssetSyncer = (*FederationEnvoy)(nil)
 
 // NewFederationEnvoy creates a new federation envoy from the passed config.
 func NewFederationEnvoy(cfg FederationConfig) *FederationEnvoy {
+	if cfg.ServerHealth == nil {
+		cfg.ServerHealth = NewServerHealthTracker(
+			DefaultQuarantineConfig(),
+		)
+	}
+
 	return &FederationEnvoy{
 		cfg:               cfg,
 		pushRequests:      make(chan *FederationPushReq),
 		batchPushRequests: make(chan *FederationProofBatchPushReq),
+		serverHealth:      cfg.ServerHealth,
 		ContextGuard: &fn.ContextGuard{
 			DefaultTimeout: DefaultTimeout,
 			Quit:           make(chan struct{}),
@@ -140,22 +260,28 @@ func (f *FederationEnvoy) Start() error {
 
 		// Before we start the main goroutine, we'll add the set of
 		// static Universe servers.
-		addrs := f.cfg.StaticFederationMembers
-		serverAddrs := fn.Map(addrs, NewServerAddrFromStr)
+		var serverAddrs []ServerAddr
+		for _, addrStr := range f.cfg.StaticFederationMembers {
+			addr, err := NewServerAddrFromStr(addrStr)
+			if err != nil {
+				log.Warnf("Not adding server to federation: %v",
+					err)
+
+				continue
+			}
 
-		serverAddrs = fn.Filter(serverAddrs, func(a ServerAddr) bool {
 			// Before we add the server as a federation member, we
 			// check that we can actually connect to it and that it
 			// isn't ourselves.
-			if err := f.cfg.ServerChecker(a); err != nil {
+			if err := f.cfg.ServerChecker(addr); err != nil {
 				log.Warnf("Not adding server to federation: %v",
 					err)
 
-				return false
+				continue
 			}
 
-			return true
-		})
+			serverAddrs = append(serverAddrs, addr)
+		}
 
 		err := f.AddServer(serverAddrs...)
 		// On restart, we'll get an error for universe servers already
@@ -206,6 +332,7 @@ func (f *FederationEnvoy) syncServerState(ctx context.Context,
 	diff, err := f.cfg.UniverseSyncer.SyncUniverse(
 		ctx, addr, SyncFull, syncConfigs,
 	)
+	f.recordServerHealth(ctx, addr, err)
 	if err != nil {
 		return err
 	}
@@ -236,12 +363,38 @@ func (f *FederationEnvoy) syncServerState(ctx context.Context,
 	return nil
 }
 
+// recordServerHealth records the outcome of an interaction with the given
+// server. Interactions that were aborted because we're shutting down don't
+// count as failures of the server.
+func (f *FederationEnvoy) recordServerHealth(ctx context.Context,
+	addr ServerAddr, err error) {
+
+	switch {
+	// A proof that was rejected by the admission policy of the server
+	// still means that the server is up and running.
+	case err == nil, errors.Is(err, ErrProofNotAdmitted),
+		errors.Is(err, ErrInsertRateExceeded):
+
+		f.serverHealth.RecordSuccess(addr)
+
+	case ctx.Err() == nil:
+		f.serverHealth.RecordFailure(addr, err)
+	}
+}
+
+// ServerHealth returns the health of the given federation server, as observed
+// since start up.
+func (f *FederationEnvoy) ServerHealth(addr ServerAddr) ServerHealth {
+	return f.serverHealth.Health(addr)
+}
+
 // pushProofToServer attempts to push out a new proof to the target server.
 func (f *FederationEnvoy) pushProofToServer(ctx context.Context,
 	uniID Identifier, key LeafKey, leaf *Leaf, addr ServerAddr) error {
 
 	remoteUniverseServer, err := f.cfg.NewRemoteRegistrar(addr)
 	if err != nil {
+		f.recordServerHealth(ctx, addr, err)
 		return fmt.Errorf("cannot push proof unable to connect "+
 			"to remote server(%v): %w", addr.HostStr(), err)
 	}
@@ -251,6 +404,7 @@ func (f *FederationEnvoy) pushProofToServer(ctx context.Context,
 	_, err = remoteUniverseServer.UpsertProofLeaf(
 		ctx, uniID, key, leaf,
 	)
+	f.recordServerHealth(ctx, addr, err)
 	if err != nil {
 		return fmt.Errorf("cannot push proof to remote "+
 			"server(%v): %w", addr.HostStr(), err)
@@ -277,9 +431,16 @@ func (f *FederationEnvoy) pushProofToServerLogged(ctx context.Context,
 			err)
 	}
 
-	// Push the proof to the remote server.
+	// Push the proof to the remote server. If the admission policy of the
+	// server doesn't allow the proof, then it won't be accepted on a retry
+	// either, so we treat the push as complete.
 	err = f.pushProofToServer(ctx, uniID, key, leaf, addr)
-	if err != nil {
+	switch {
+	case errors.Is(err, ErrProofNotAdmitted):
+		log.Warnf("Proof not admitted by remote server(%v), not "+
+			"retrying push: %v", addr.HostStr(), err)
+
+	case err != nil:
 		return fmt.Errorf("cannot push proof to remote server(%v): %w",
 			addr.HostStr(), err)
 	}
@@ -336,9 +497,12 @@ func (f *FederationEnvoy) pushProofToFederation(ctx context.Context,
 
 	// To conclude, we'll attempt to push the new proof to all the universe
 	// servers in parallel.
+	//
+	// NOTE: Logged pushes that fail remain pending in the proof sync log
+	// and are retried in the background with an exponential backoff by
+	// handlePushRetryTick.
 	err := fn.ParSlice(ctx, fedServers, pushNewProof)
 	if err != nil {
-		// TODO(roasbeef): retry in the background until successful?
 		log.Errorf("unable to push proof to federation: %v", err)
 		return
 	}
@@ -408,6 +572,9 @@ func (f *FederationEnvoy) syncer() {
 	syncTicker := time.NewTicker(f.cfg.SyncInterval)
 	defer syncTicker.Stop()
 
+	pushRetryTicker := time.NewTicker(f.cfg.PushRetry.CheckInterval)
+	defer pushRetryTicker.Stop()
+
 	for {
 		select {
 		// Handle a new sync tick event.
@@ -422,6 +589,10 @@ func (f *FederationEnvoy) syncer() {
 					err)
 			}
 
+		// Retry any failed proof pushes that are due.
+		case <-pushRetryTicker.C:
+			f.handlePushRetryTick()
+
 		// Handle a new push request.
 		case pushReq := <-f.pushRequests:
 			log.Debug("Federation envoy handling push request")
@@ -463,32 +634,62 @@ func (f *FederationEnvoy) handleTickEvent() error {
 			"%w", err)
 	}
 
-	log.Infof("Synchronizing with %v federation members", len(fedServers))
-	err = f.SyncServers(fedServers)
+	// Servers that keep failing are skipped until their quarantine is
+	// over, so they don't slow down every sync.
+	activeServers := fn.Filter(fedServers, func(a ServerAddr) bool {
+		return !f.serverHealth.Quarantined(a)
+	})
+	if len(activeServers) < len(fedServers) {
+		log.Infof("Skipping %d quarantined federation members",
+			len(fedServers)-len(activeServers))
+	}
+
+	log.Infof("Synchronizing with %v federation members",
+		len(activeServers))
+	err = f.SyncServers(activeServers)
 	if err != nil {
 		return fmt.Errorf("unable to sync with federation server: %w",
 			err)
 	}
 
-	// After we've synced with the federation, we'll attempt to push out any
-	// pending proofs that we haven't yet completed.
+	return nil
+}
+
+// handlePushRetryTick is called each time the push retry ticker fires. It
+// retries the pending proof pushes that are due in the background, so that
+// slow federation servers don't block the main event loop. If the pushes that
+// were due on an earlier tick are still being retried, then the tick is
+// skipped.
+func (f *FederationEnvoy) handlePushRetryTick() {
+	if !f.retryingPushes.CompareAndSwap(false, true) {
+		log.Debugf("Still retrying pending proof pushes, skipping tick")
+		return
+	}
+
+	f.Wg.Add(1)
+	go func() {
+		defer f.Wg.Done()
+		defer f.retryingPushes.Store(false)
+
+		err := f.retryPendingPushes()
+		if err != nil {
+			log.Warnf("Unable to retry pending proof pushes: %v",
+				err)
+		}
+	}()
+}
+
+// retryPendingPushes retries all pending proof pushes that are due according
+// to their backoff and that haven't reached the maximum number of attempts
+// yet. Pushes that reached the maximum number of attempts are marked as
+// failed.
+func (f *FederationEnvoy) retryPendingPushes() error {
 	ctx, cancel := f.WithCtxQuitNoTimeout()
 	defer cancel()
 
-	syncDirection := SyncDirectionPush
-	db := f.cfg.FederationDB
-
-	logEntries, err := db.FetchPendingProofsSyncLog(
-		ctx, &syncDirection,
-	)
+	pending, err := f.PendingProofPushes(ctx)
 	if err != nil {
-		return fmt.Errorf("unable to query pending push sync log: %w",
-			err)
-	}
-
-	if len(logEntries) > 0 {
-		log.Debugf("Handling pending proof sync log entries "+
-			"(entries_count=%d)", len(logEntries))
+		return err
 	}
 
 	// TODO(ffranr): Take account of any new servers that have been added
@@ -498,36 +699,143 @@ func (f *FederationEnvoy) handleTickEvent() error {
 	//  server is added, then we should create a new log entry for the new
 	//  server.
 
-	// We'll use a timeout that's slightly less than the sync interval to
-	// help avoid ticking into a new sync event before the previous event
-	// has finished.
-	syncContextTimeout := f.cfg.SyncInterval - 1*time.Second
-	if syncContextTimeout < 0 {
-		// If the sync interval is less than a second, then we'll use
-		// the sync interval as the timeout.
-		syncContextTimeout = f.cfg.SyncInterval
-	}
+	// Pushes to quarantined servers are postponed without using up an
+	// attempt, as they'd most likely fail anyway. Pending pushes that
+	// already reached the maximum number of attempts, for example because
+	// the maximum was lowered since, are marked as failed right away.
+	var (
+		now = time.Now()
+		due []PendingProofPush
+	)
+	for _, push := range pending {
+		switch {
+		case push.SyncStatus == ProofSyncStatusFailed:
+			continue
+
+		case push.Exhausted:
+			entry := push.ProofSyncLogEntry
+			err := f.markProofPushFailed(ctx, entry)
+			if err != nil {
+				return err
+			}
 
-	for idx := range logEntries {
-		entry := logEntries[idx]
+		case !push.NextAttempt.After(now) &&
+			!f.serverHealth.Quarantined(push.ServerAddr):
 
-		servers := []ServerAddr{
-			entry.ServerAddr,
+			due = append(due, push)
 		}
+	}
+	if len(due) == 0 {
+		return nil
+	}
 
-		ctxPush, cancelPush := f.CtxBlockingCustomTimeout(
-			syncContextTimeout,
-		)
-		f.pushProofToFederation(
-			ctxPush, entry.UniID, entry.LeafKey, &entry.Leaf,
-			servers, true,
-		)
-		cancelPush()
+	log.Debugf("Retrying pending proof pushes (due=%d, pending=%d)",
+		len(due), len(pending))
+
+	// Each retry dials a remote server, so we'll retry the pushes in
+	// parallel, with a bounded number of them in flight at a time. A
+	// failed retry doesn't stop the others.
+	retry := func(_ context.Context, push PendingProofPush) error {
+		f.retryProofPush(push.ProofSyncLogEntry)
+		return nil
+	}
+
+	return fn.ParSlice(ctx, due, retry)
+}
+
+// retryProofPush retries the given pending proof push once. If the retry fails
+// and that was the last attempt, then the push is marked as failed.
+func (f *FederationEnvoy) retryProofPush(entry *ProofSyncLogEntry) {
+	ctx, cancel := f.WithCtxQuit()
+	defer cancel()
+
+	err := f.pushProofToServerLogged(
+		ctx, entry.UniID, entry.LeafKey, &entry.Leaf, entry.ServerAddr,
+	)
+	if err == nil {
+		return
+	}
+
+	// The attempt we just made was counted, so we'll check whether that
+	// was the last one.
+	retried := *entry
+	retried.AttemptCounter++
+	if !f.cfg.PushRetry.Exhausted(&retried) {
+		log.Warnf("Unable to retry proof push: %v", err)
+		return
+	}
+
+	log.Warnf("Giving up pushing proof to server %v after %d attempts: %v",
+		entry.ServerAddr.HostStr(), retried.AttemptCounter+1, err)
+
+	err = f.markProofPushFailed(ctx, &retried)
+	if err != nil {
+		log.Errorf("Unable to mark proof push as failed: %v", err)
+	}
+}
+
+// markProofPushFailed marks the given pending proof push as failed, so it's
+// no longer retried, even across restarts.
+func (f *FederationEnvoy) markProofPushFailed(ctx context.Context,
+	entry *ProofSyncLogEntry) error {
+
+	_, err := f.cfg.FederationDB.UpsertFederationProofSyncLog(
+		ctx, entry.UniID, entry.LeafKey, entry.ServerAddr,
+		SyncDirectionPush, ProofSyncStatusFailed, false,
+	)
+	if err != nil {
+		return fmt.Errorf("unable to log proof push as failed: %w",
+			err)
 	}
 
 	return nil
 }
 
+// PendingProofPushes returns all proof pushes to federation servers that
+// haven't completed yet, along with their retry state. This includes the
+// pushes that failed, as they reached the maximum number of attempts.
+func (f *FederationEnvoy) PendingProofPushes(
+	ctx context.Context) ([]PendingProofPush, error) {
+
+	syncDirection := SyncDirectionPush
+	logEntries, err := f.cfg.FederationDB.FetchPendingProofsSyncLog(
+		ctx, &syncDirection,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to query pending push sync log: "+
+			"%w", err)
+	}
+
+	failedEntries, err := f.cfg.FederationDB.FetchFailedProofsSyncLog(
+		ctx, &syncDirection,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to query failed push sync log: "+
+			"%w", err)
+	}
+
+	retryCfg := f.cfg.PushRetry
+	pending := make(
+		[]PendingProofPush, 0, len(logEntries)+len(failedEntries),
+	)
+	for idx := range logEntries {
+		entry := logEntries[idx]
+		pending = append(pending, PendingProofPush{
+			ProofSyncLogEntry: entry,
+			NextAttempt:       retryCfg.NextAttempt(entry),
+			Exhausted:         retryCfg.Exhausted(entry),
+		})
+	}
+	for idx := range failedEntries {
+		pending = append(pending, PendingProofPush{
+			ProofSyncLogEntry: failedEntries[idx],
+			Exhausted:         true,
+		})
+	}
+
+	return pending, nil
+}
+
 // handlePushRequest is called each time a new push request is received. It will
 // perform an asynchronous registration with the local Universe registrar, then
 // push the proof leaf out in an async manner to the federation members.
@@ -0,0 +1,52 @@
+package universe
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestPushRetryConfig tests the backoff and the attempt cap that are used to
+// retry failed proof pushes to federation servers.
+func TestPushRetryConfig(t *testing.T) {
+	t.Parallel()
+
+	cfg := PushRetryConfig{
+		InitialBackoff: time.Minute,
+		MaxBackoff:     10 * time.Minute,
+		MaxAttempts:    5,
+	}
+
+	// The backoff should double with every retry, until the max backoff
+	// is reached.
+	require.Equal(t, time.Minute, cfg.Backoff(0))
+	require.Equal(t, 2*time.Minute, cfg.Backoff(1))
+	require.Equal(t, 4*time.Minute, cfg.Backoff(2))
+	require.Equal(t, 8*time.Minute, cfg.Backoff(3))
+	require.Equal(t, 10*time.Minute, cfg.Backoff(4))
+	require.Equal(t, 10*time.Minute, cfg.Backoff(1000))
+
+	// The next attempt is counted from the last attempt.
+	lastAttempt := time.Unix(1_700_000_000, 0)
+	entry := &ProofSyncLogEntry{
+		Timestamp:      lastAttempt,
+		AttemptCounter: 2,
+	}
+	require.Equal(
+		t, lastAttempt.Add(4*time.Minute), cfg.NextAttempt(entry),
+	)
+
+	// The initial attempt isn't included in the attempt counter, so the
+	// fifth attempt is the last one.
+	entry.AttemptCounter = 3
+	require.False(t, cfg.Exhausted(entry))
+
+	entry.AttemptCounter = 4
+	require.True(t, cfg.Exhausted(entry))
+
+	// Without an attempt cap, a push is retried indefinitely.
+	cfg.MaxAttempts = 0
+	entry.AttemptCounter = 1000
+	require.False(t, cfg.Exhausted(entry))
+}
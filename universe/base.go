@@ -44,6 +44,10 @@ type ArchiveConfig struct {
 	// external/internal queries to the base universe instance.
 	UniverseStats Telemetry
 
+	// Admission enforces the admission policy for proofs that are
+	// inserted by remote clients.
+	Admission *AdmissionController
+
 	// TODO(roasbeef): query re genesis asset known?
 
 	// TODO(roasbeef): load all at once, or lazy load dynamic?
@@ -241,6 +245,15 @@ func (a *Archive) UpsertProofLeaf(ctx context.Context, id Identifier,
 		return nil, err
 	}
 
+	// Proofs of remote clients need to pass our admission policy before we
+	// spend any resources on decoding and verifying them.
+	if clientID, ok := AdmissionClient(ctx); ok {
+		err := a.cfg.Admission.AdmitProof(ctx, clientID, leaf)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// We need to decode the new proof now.
 	var newProof proof.Proof
 	if err := newProof.Decode(bytes.NewReader(leaf.RawProof)); err != nil {
@@ -635,6 +648,25 @@ func (a *Archive) FetchProofLeaf(ctx context.Context, id Identifier,
 	return a.cfg.Multiverse.FetchProofLeaf(ctx, id, key)
 }
 
+// FetchProofLeaves attempts to fetch a multi-proof for the leaves at the
+// target leaf keys, given a universe identifier (assetID/groupKey).
+func (a *Archive) FetchProofLeaves(ctx context.Context, id Identifier,
+	keys []LeafKey) (*MultiProof, error) {
+
+	log.Tracef("Retrieving Universe multi-proof for: id=%v, num_keys=%v",
+		id.StringForLog(), len(keys))
+
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("no leaf keys specified")
+	}
+	if len(keys) > MaxPageSize {
+		return nil, fmt.Errorf("too many leaf keys: %d, max is %d",
+			len(keys), MaxPageSize)
+	}
+
+	return a.cfg.Multiverse.FetchProofLeaves(ctx, id, keys)
+}
+
 type UniverseLeafKeysQuery struct {
 	Id            Identifier
 	SortDirection SortDirection
@@ -652,6 +684,16 @@ func (a *Archive) UniverseLeafKeys(ctx context.Context,
 	return a.cfg.Multiverse.UniverseLeafKeys(ctx, q)
 }
 
+// UniverseTreeNodes returns the nodes of the universe tree at the given paths.
+func (a *Archive) UniverseTreeNodes(ctx context.Context, id Identifier,
+	paths []TreeNodePath) ([]TreeNode, error) {
+
+	log.Debugf("Retrieving %d tree nodes for Universe: id=%v", len(paths),
+		id.StringForLog())
+
+	return a.cfg.Multiverse.UniverseTreeNodes(ctx, id, paths)
+}
+
 // MintingLeaves returns the set of minting leaves known for the specified base
 // universe.
 func (a *Archive) MintingLeaves(ctx context.Context,
@@ -681,3 +723,71 @@ func (a *Archive) DeleteRoot(ctx context.Context,
 
 	return uniStr, err
 }
+
+// FetchLeafEvents returns the leaves that were inserted after the cursor of the
+// given query and that match its filters, in insertion order.
+func (a *Archive) FetchLeafEvents(ctx context.Context,
+	q LeafEventQuery) ([]*LeafEvent, error) {
+
+	return a.cfg.Multiverse.FetchLeafEvents(ctx, q)
+}
+
+// LatestLeafCursor returns the cursor of the most recently inserted leaf, or
+// zero if no leaves were inserted yet.
+func (a *Archive) LatestLeafCursor(ctx context.Context) (uint64, error) {
+	return a.cfg.Multiverse.LatestLeafCursor(ctx)
+}
+
+// RegisterSubscriber adds a new subscriber for receiving events. The
+// deliverExisting boolean indicates whether already existing items should be
+// sent to the NewItemCreated channel when the subscription is started. An
+// optional deliverFrom can be specified to indicate from which timestamp/index/
+// marker onward existing items should be delivered on startup. If deliverFrom
+// is nil/zero/empty then all existing items will be delivered.
+//
+// NOTE: Leaves that are inserted while existing leaves are being delivered may
+// be delivered twice. Subscribers can use the cursor of the events to skip
+// them.
+func (a *Archive) RegisterSubscriber(receiver *fn.EventReceiver[*LeafEvent],
+	deliverExisting bool, deliverFrom LeafEventQuery) error {
+
+	a.cfg.Multiverse.RegisterLeafSubscriber(receiver)
+
+	// No delivery of existing items requested, we're done here.
+	if !deliverExisting {
+		return nil
+	}
+
+	ctx := context.Background()
+	query := deliverFrom
+	query.Limit = MaxPageSize
+	for {
+		events, err := a.cfg.Multiverse.FetchLeafEvents(ctx, query)
+		if err != nil {
+			return fmt.Errorf("error fetching existing leaf "+
+				"events: %w", err)
+		}
+
+		for idx := range events {
+			receiver.NewItemCreated.ChanIn() <- events[idx]
+		}
+
+		if len(events) < MaxPageSize {
+			return nil
+		}
+
+		query.AfterCursor = events[len(events)-1].Cursor
+	}
+}
+
+// RemoveSubscriber removes the given subscriber and also stops it from
+// processing events.
+func (a *Archive) RemoveSubscriber(
+	subscriber *fn.EventReceiver[*LeafEvent]) error {
+
+	return a.cfg.Multiverse.RemoveLeafSubscriber(subscriber)
+}
+
+// A compile-time assertion to ensure that the archive meets the
+// fn.EventPublisher interface for leaf events.
+var _ fn.EventPublisher[*LeafEvent, LeafEventQuery] = (*Archive)(nil)
@@ -0,0 +1,564 @@
+package universe
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/mssmt"
+)
+
+var (
+	// ErrNoCommitment is returned when no chain commitment of the
+	// multiverse root is found.
+	ErrNoCommitment = errors.New("no universe commitment found")
+)
+
+// NewCommitmentScript returns the tapscript leaf script that commits to the
+// given universe root. The script begins with OP_RETURN, so the leaf can
+// never be used to spend the commitment output.
+func NewCommitmentScript(root mssmt.Node) ([]byte, error) {
+	rootHash := root.NodeHash()
+
+	var rootSum [8]byte
+	binary.BigEndian.PutUint64(rootSum[:], root.NodeSum())
+
+	return txscript.NewScriptBuilder().
+		AddOp(txscript.OP_RETURN).
+		AddData(rootHash[:]).
+		AddData(rootSum[:]).
+		Script()
+}
+
+// NewCommitmentTapscriptRoot returns the root of the tapscript tree that has
+// the commitment script of the given universe root as its only leaf.
+func NewCommitmentTapscriptRoot(root mssmt.Node) (chainhash.Hash, error) {
+	commitmentScript, err := NewCommitmentScript(root)
+	if err != nil {
+		return chainhash.Hash{}, fmt.Errorf("unable to create "+
+			"commitment script: %w", err)
+	}
+
+	tapLeaf := txscript.NewBaseTapLeaf(commitmentScript)
+	tapTree := txscript.AssembleTaprootScriptTree(tapLeaf)
+
+	return tapTree.RootNode.TapHash(), nil
+}
+
+// NewCommitmentOutputScript returns the pkScript of a P2TR output that commits
+// to the given universe root. The output key commits to a tapscript tree with
+// the commitment script as its only leaf, so the output can only be spent
+// with the internal key.
+func NewCommitmentOutputScript(internalKey *btcec.PublicKey,
+	root mssmt.Node) ([]byte, error) {
+
+	tapRoot, err := NewCommitmentTapscriptRoot(root)
+	if err != nil {
+		return nil, err
+	}
+
+	outputKey := txscript.ComputeTaprootOutputKey(internalKey, tapRoot[:])
+
+	return txscript.PayToTaprootScript(outputKey)
+}
+
+// Verify checks that the commitment transaction is included in the block with
+// the commitment's block header, and that its anchor output commits to the
+// universe root. It is up to the caller to check that the block header is part
+// of the main chain at the commitment's block height.
+func (c *Commitment) Verify() error {
+	switch {
+	case c.Tx == nil:
+		return fmt.Errorf("commitment transaction missing")
+
+	case c.MerkleProof == nil:
+		return fmt.Errorf("commitment merkle proof missing")
+
+	case c.InternalKey.PubKey == nil:
+		return fmt.Errorf("commitment internal key missing")
+
+	case c.UniverseRoot == nil:
+		return fmt.Errorf("commitment universe root missing")
+	}
+
+	if !c.MerkleProof.Verify(c.Tx, c.BlockHeader.MerkleRoot) {
+		return fmt.Errorf("invalid commitment transaction merkle proof")
+	}
+
+	if int(c.OutputIndex) >= len(c.Tx.TxOut) {
+		return fmt.Errorf("commitment output index %d out of range",
+			c.OutputIndex)
+	}
+
+	expectedPkScript, err := NewCommitmentOutputScript(
+		c.InternalKey.PubKey, c.UniverseRoot,
+	)
+	if err != nil {
+		return err
+	}
+
+	pkScript := c.Tx.TxOut[c.OutputIndex].PkScript
+	if !bytes.Equal(pkScript, expectedPkScript) {
+		return fmt.Errorf("commitment output does not commit to " +
+			"universe root")
+	}
+
+	return nil
+}
+
+// NewMultiverseLeafNode returns the multiverse tree leaf node of the universe
+// with the given ID and root.
+func NewMultiverseLeafNode(id Identifier,
+	universeRoot mssmt.Node) *mssmt.LeafNode {
+
+	rootHash := universeRoot.NodeHash()
+
+	// The leaves of the issuance multiverse tree all have a sum of one,
+	// so that the multiverse root sum is the number of universes.
+	leafSum := universeRoot.NodeSum()
+	if id.ProofType == ProofTypeIssuance {
+		leafSum = 1
+	}
+
+	return mssmt.NewLeafNode(rootHash[:], leafSum)
+}
+
+// Verify checks that the issuance proof of the universe with the given ID is
+// included in the universe root that was committed to on chain.
+func (p *CommittedIssuanceProof) Verify(id Identifier) error {
+	if p.ChainProof == nil || p.TaprootAssetProof == nil {
+		return fmt.Errorf("incomplete committed issuance proof")
+	}
+
+	if err := p.ChainProof.Verify(); err != nil {
+		return fmt.Errorf("invalid chain proof: %w", err)
+	}
+
+	uniProof := p.TaprootAssetProof
+	if uniProof.Leaf == nil || uniProof.UniverseRoot == nil ||
+		uniProof.UniverseInclusionProof == nil ||
+		uniProof.MultiverseInclusionProof == nil {
+
+		return fmt.Errorf("incomplete universe proof")
+	}
+
+	if !uniProof.VerifyRoot(uniProof.UniverseRoot) {
+		return fmt.Errorf("leaf not included in universe root")
+	}
+
+	multiverseRoot := uniProof.MultiverseInclusionProof.Root(
+		id.Bytes(), NewMultiverseLeafNode(id, uniProof.UniverseRoot),
+	)
+	if !mssmt.IsEqualNode(multiverseRoot, p.ChainProof.UniverseRoot) {
+		return fmt.Errorf("universe root not included in committed " +
+			"multiverse root")
+	}
+
+	return nil
+}
+
+// CommitmentStore is a persistent store for the chain commitments of the
+// issuance multiverse root. Along with the latest commitment, the multiverse
+// leaves that make up the committed root are stored, so that inclusion proofs
+// for the committed root can be created after the multiverse has changed.
+type CommitmentStore interface {
+	// InsertPendingCommitment stores a commitment whose transaction is
+	// about to be published.
+	InsertPendingCommitment(ctx context.Context,
+		pending *PendingCommitment) error
+
+	// FetchPendingCommitments returns the commitments whose transactions
+	// were published, but haven't confirmed yet, oldest first.
+	FetchPendingCommitments(
+		ctx context.Context) ([]*PendingCommitment, error)
+
+	// InsertCommitment stores a confirmed chain commitment along with the
+	// multiverse leaves that its universe root commits to, and removes
+	// the pending commitment of its transaction. If leaves are given, the
+	// leaves stored for all earlier commitments are removed.
+	InsertCommitment(ctx context.Context, commitment *Commitment,
+		leaves []MultiverseLeaf) error
+
+	// LatestCommitment returns the most recent chain commitment. If there
+	// is none, ErrNoCommitment is returned.
+	LatestCommitment(ctx context.Context) (*Commitment, error)
+
+	// QueryCommitments returns all chain commitments, most recent first.
+	QueryCommitments(ctx context.Context) ([]*Commitment, error)
+
+	// FetchLeafCommitment returns the most recent chain commitment whose
+	// stored leaves include the given multiverse leaf, along with all the
+	// multiverse leaves of that commitment. If there is none,
+	// ErrNoCommitment is returned.
+	FetchLeafCommitment(ctx context.Context,
+		leaf MultiverseLeaf) (*Commitment, []MultiverseLeaf, error)
+}
+
+// CanonicalConfig is the config for the canonical universe.
+type CanonicalConfig struct {
+	// Multiverse is the multiverse archive whose issuance root is
+	// committed to on chain.
+	Multiverse MultiverseArchive
+
+	// CommitmentStore is used to store the chain commitments.
+	CommitmentStore CommitmentStore
+
+	// ChainCommitter is used to periodically commit the multiverse root
+	// to the chain.
+	ChainCommitter ChainCommitter
+
+	// CommitInterval is the interval at which the multiverse root is
+	// committed to the chain. The root is only committed again if it has
+	// changed since the last commitment. A zero interval disables the
+	// periodic commitments.
+	CommitInterval time.Duration
+}
+
+// CanonicalUniverse anchors the issuance multiverse root in the chain and
+// serves issuance proofs that are tied to an on-chain commitment. This allows
+// clients to check that a universe server doesn't rewrite its history.
+type CanonicalUniverse struct {
+	cfg CanonicalConfig
+
+	// commitMtx makes sure that only one chain commitment is created at a
+	// time.
+	commitMtx sync.Mutex
+
+	startOnce sync.Once
+	stopOnce  sync.Once
+
+	// ContextGuard provides a wait group and main quit channel that can be
+	// used to create guarded contexts.
+	*fn.ContextGuard
+}
+
+// NewCanonicalUniverse creates a new canonical universe.
+func NewCanonicalUniverse(cfg CanonicalConfig) *CanonicalUniverse {
+	return &CanonicalUniverse{
+		cfg: cfg,
+		ContextGuard: &fn.ContextGuard{
+			DefaultTimeout: DefaultTimeout,
+			Quit:           make(chan struct{}),
+		},
+	}
+}
+
+// Start starts the periodic chain commitments of the multiverse root.
+func (c *CanonicalUniverse) Start() error {
+	c.startOnce.Do(func() {
+		if c.cfg.CommitInterval == 0 || c.cfg.ChainCommitter == nil {
+			return
+		}
+
+		log.Infof("Starting canonical universe, committing "+
+			"multiverse root every %v", c.cfg.CommitInterval)
+
+		c.Wg.Add(1)
+		go c.commitLoop()
+	})
+
+	return nil
+}
+
+// Stop stops the periodic chain commitments.
+func (c *CanonicalUniverse) Stop() error {
+	c.stopOnce.Do(func() {
+		log.Infof("Stopping canonical universe")
+
+		close(c.Quit)
+		c.Wg.Wait()
+	})
+
+	return nil
+}
+
+// commitLoop periodically commits the multiverse root to the chain.
+//
+// NOTE: This MUST be run as a goroutine.
+func (c *CanonicalUniverse) commitLoop() {
+	defer c.Wg.Done()
+
+	commitTicker := time.NewTicker(c.cfg.CommitInterval)
+	defer commitTicker.Stop()
+
+	for {
+		select {
+		case <-commitTicker.C:
+			ctx, cancel := c.WithCtxQuitNoTimeout()
+			_, err := c.UpdateChainCommitment(
+				ctx, c.cfg.ChainCommitter,
+			)
+			cancel()
+			if err != nil {
+				log.Errorf("Unable to commit multiverse root: "+
+					"%v", err)
+			}
+
+		case <-c.Quit:
+			return
+		}
+	}
+}
+
+// multiverseTree builds an in-memory multiverse tree from the given leaves.
+func multiverseTree(ctx context.Context,
+	leaves []MultiverseLeaf) (*mssmt.CompactedTree, error) {
+
+	tree := mssmt.NewCompactedTree(mssmt.NewDefaultStore())
+	for _, leaf := range leaves {
+		_, err := tree.Insert(ctx, leaf.ID.Bytes(), leaf.LeafNode)
+		if err != nil {
+			return nil, fmt.Errorf("unable to insert multiverse "+
+				"leaf: %w", err)
+		}
+	}
+
+	return tree, nil
+}
+
+// Query returns the issuance proof for the target leaf key of the given
+// universe, along with the most recent chain commitment that includes the
+// current root of the universe. The multiverse inclusion proof of the returned
+// issuance proof is relative to the committed multiverse root. If the current
+// universe root hasn't been committed to yet, ErrNoCommitment is returned.
+func (c *CanonicalUniverse) Query(ctx context.Context, id Identifier,
+	key LeafKey) (*CommittedIssuanceProof, error) {
+
+	if id.ProofType != ProofTypeIssuance {
+		return nil, fmt.Errorf("only issuance proofs are committed " +
+			"to on chain")
+	}
+
+	proofs, err := c.cfg.Multiverse.FetchProofLeaf(ctx, id, key)
+	if err != nil {
+		return nil, err
+	}
+	if len(proofs) != 1 {
+		return nil, fmt.Errorf("leaf key must identify exactly one "+
+			"leaf, found %d", len(proofs))
+	}
+
+	uniProof := *proofs[0]
+	multiverseLeaf := MultiverseLeaf{
+		ID:       id,
+		LeafNode: NewMultiverseLeafNode(id, uniProof.UniverseRoot),
+	}
+
+	commitment, leaves, err := c.cfg.CommitmentStore.FetchLeafCommitment(
+		ctx, multiverseLeaf,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// The multiverse might have changed since the commitment, so we'll
+	// create the multiverse inclusion proof from the committed leaves.
+	tree, err := multiverseTree(ctx, leaves)
+	if err != nil {
+		return nil, err
+	}
+
+	uniProof.MultiverseInclusionProof, err = tree.MerkleProof(
+		ctx, id.Bytes(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create multiverse inclusion "+
+			"proof: %w", err)
+	}
+	uniProof.MultiverseRoot = commitment.UniverseRoot
+
+	return &CommittedIssuanceProof{
+		ChainProof:        commitment,
+		TaprootAssetProof: &uniProof,
+	}, nil
+}
+
+// LatestCommitment returns the latest chain commitment.
+func (c *CanonicalUniverse) LatestCommitment(
+	ctx context.Context) (*Commitment, error) {
+
+	return c.cfg.CommitmentStore.LatestCommitment(ctx)
+}
+
+// ListCommitments returns all chain commitments, most recent first.
+func (c *CanonicalUniverse) ListCommitments(
+	ctx context.Context) ([]*Commitment, error) {
+
+	return c.cfg.CommitmentStore.QueryCommitments(ctx)
+}
+
+// UpdateChainCommitment commits the current issuance multiverse root to the
+// chain with each of the given chain committers, and returns the last of the
+// new commitments. Each new commitment spends the anchor output of the
+// previous one. If the root hasn't changed since the latest commitment, no new
+// commitment is created and the latest commitment is returned.
+func (c *CanonicalUniverse) UpdateChainCommitment(ctx context.Context,
+	chainCommits ...ChainCommitter) (*Commitment, error) {
+
+	if len(chainCommits) == 0 {
+		return nil, fmt.Errorf("no chain committer specified")
+	}
+
+	c.commitMtx.Lock()
+	defer c.commitMtx.Unlock()
+
+	// We build the root from a snapshot of the multiverse leaves, so that
+	// the stored leaves are guaranteed to match the committed root.
+	leaves, err := c.cfg.Multiverse.FetchLeaves(
+		ctx, nil, ProofTypeIssuance,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch multiverse leaves: %w",
+			err)
+	}
+	if len(leaves) == 0 {
+		return nil, fmt.Errorf("multiverse is empty")
+	}
+
+	tree, err := multiverseTree(ctx, leaves)
+	if err != nil {
+		return nil, err
+	}
+	rootNode, err := tree.Root(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// A commitment that we published before a restart needs to confirm
+	// first, as the next commitment spends its anchor output.
+	err = c.resumePendingCommitments(
+		ctx, chainCommits[0], rootNode, leaves,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	latest, err := c.cfg.CommitmentStore.LatestCommitment(ctx)
+	switch {
+	case err == nil && mssmt.IsEqualNode(latest.UniverseRoot, rootNode):
+		log.Debugf("Multiverse root %x already committed at height %d",
+			rootNode.NodeHash(), latest.BlockHeight)
+
+		return latest, nil
+
+	// Without any previous commitment, the first commitment is only
+	// funded by the wallet.
+	case errors.Is(err, ErrNoCommitment):
+		latest = nil
+
+	case err != nil:
+		return nil, err
+	}
+
+	root := MultiverseRoot{
+		ProofType: ProofTypeIssuance,
+		Node:      rootNode,
+	}
+
+	for _, chainCommit := range chainCommits {
+		pending, err := chainCommit.CreateCommitment(ctx, root, latest)
+		if err != nil {
+			return nil, fmt.Errorf("unable to create commitment: "+
+				"%w", err)
+		}
+
+		// We persist the transaction before publishing it, so that we
+		// don't lose track of the anchor output if we go down before
+		// the transaction confirms.
+		err = c.cfg.CommitmentStore.InsertPendingCommitment(
+			ctx, pending,
+		)
+		if err != nil {
+			// The transaction is never published, so the wallet
+			// inputs that fund it must be released again.
+			abandonErr := chainCommit.AbandonCommitment(
+				ctx, pending,
+			)
+			if abandonErr != nil {
+				log.Errorf("Unable to abandon commitment tx "+
+					"%v: %v", pending.Tx.TxHash(),
+					abandonErr)
+			}
+
+			return nil, fmt.Errorf("unable to store pending "+
+				"commitment: %w", err)
+		}
+
+		latest, err = c.confirmCommitment(
+			ctx, chainCommit, pending, leaves,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return latest, nil
+}
+
+// resumePendingCommitments publishes the pending commitments that were stored
+// before a restart again, and waits for them to confirm. The multiverse leaves
+// are only stored along with a commitment if they still make up its root.
+func (c *CanonicalUniverse) resumePendingCommitments(ctx context.Context,
+	chainCommit ChainCommitter, rootNode mssmt.Node,
+	leaves []MultiverseLeaf) error {
+
+	pendings, err := c.cfg.CommitmentStore.FetchPendingCommitments(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to fetch pending commitments: %w",
+			err)
+	}
+
+	for _, pending := range pendings {
+		log.Infof("Resuming pending universe commitment tx %v",
+			pending.Tx.TxHash())
+
+		var commitLeaves []MultiverseLeaf
+		if mssmt.IsEqualNode(pending.UniverseRoot, rootNode) {
+			commitLeaves = leaves
+		}
+
+		_, err := c.confirmCommitment(
+			ctx, chainCommit, pending, commitLeaves,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// confirmCommitment publishes the transaction of the given pending commitment
+// and stores the commitment once it has confirmed.
+func (c *CanonicalUniverse) confirmCommitment(ctx context.Context,
+	chainCommit ChainCommitter, pending *PendingCommitment,
+	leaves []MultiverseLeaf) (*Commitment, error) {
+
+	commitment, err := chainCommit.PublishCommitment(ctx, pending)
+	if err != nil {
+		return nil, fmt.Errorf("unable to publish commitment: %w", err)
+	}
+
+	err = c.cfg.CommitmentStore.InsertCommitment(ctx, commitment, leaves)
+	if err != nil {
+		return nil, fmt.Errorf("unable to store commitment: %w", err)
+	}
+
+	log.Infof("Committed multiverse root %x in tx %v at height %d",
+		commitment.UniverseRoot.NodeHash(), commitment.Tx.TxHash(),
+		commitment.BlockHeight)
+
+	return commitment, nil
+}
+
+// A compile-time assertion to ensure that CanonicalUniverse meets the
+// Canonical interface.
+var _ Canonical = (*CanonicalUniverse)(nil)
@@ -0,0 +1,371 @@
+package universe
+
+import (
+	"context"
+	"testing"
+
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/mssmt"
+	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestCommitment returns a commitment to the given root, confirmed in a
+// block that only contains a coinbase and the commitment transaction. The
+// anchor output is the second output of the commitment transaction.
+func newTestCommitment(t *testing.T, root mssmt.Node) *Commitment {
+	internalKey := test.PubToKeyDesc(test.RandPubKey(t))
+	pkScript, err := NewCommitmentOutputScript(internalKey.PubKey, root)
+	require.NoError(t, err)
+
+	coinbaseTx := wire.NewMsgTx(2)
+	coinbaseTx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: wire.OutPoint{Index: wire.MaxPrevOutIndex},
+		SignatureScript:  test.RandBytes(8),
+	})
+	coinbaseTx.AddTxOut(&wire.TxOut{Value: 50})
+
+	commitmentTx := wire.NewMsgTx(2)
+	commitmentTx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: test.RandOp(t),
+	})
+	commitmentTx.AddTxOut(&wire.TxOut{
+		Value:    1_000,
+		PkScript: test.RandBytes(34),
+	})
+	commitmentTx.AddTxOut(&wire.TxOut{
+		Value:    1_000,
+		PkScript: pkScript,
+	})
+
+	txs := []*wire.MsgTx{coinbaseTx, commitmentTx}
+	block := btcutil.NewBlock(&wire.MsgBlock{Transactions: txs})
+	merkleRoot := blockchain.CalcMerkleRoot(block.Transactions(), false)
+
+	merkleProof, err := proof.NewTxMerkleProof(txs, 1)
+	require.NoError(t, err)
+
+	return &Commitment{
+		BlockHeight: 100,
+		BlockHeader: wire.BlockHeader{
+			MerkleRoot: merkleRoot,
+		},
+		MerkleProof:  merkleProof,
+		Tx:           commitmentTx,
+		OutputIndex:  1,
+		InternalKey:  internalKey,
+		UniverseRoot: root,
+	}
+}
+
+// randLeaf returns a random issuance leaf along with its key.
+func randLeaf(t *testing.T) (LeafKey, *Leaf) {
+	genesisAsset := randGenesisAsset(t)
+	scriptKey := asset.NewScriptKey(test.RandPubKey(t))
+
+	return LeafKey{
+		OutPoint:  test.RandOp(t),
+		ScriptKey: &scriptKey,
+	}, &Leaf{
+		RawProof: test.RandBytes(64),
+		Asset:    &genesisAsset,
+		Amt:      genesisAsset.Amount,
+	}
+}
+
+// newTestCommittedProof returns the committed issuance proof of a random leaf
+// within a universe that is part of a committed multiverse with another
+// universe.
+func newTestCommittedProof(t *testing.T) (Identifier,
+	*CommittedIssuanceProof) {
+
+	ctx := context.Background()
+
+	// We first create a universe with two leaves.
+	leafKey, leaf := randLeaf(t)
+	otherKey, otherLeaf := randLeaf(t)
+
+	uniTree := mssmt.NewCompactedTree(mssmt.NewDefaultStore())
+	_, err := uniTree.Insert(ctx, leafKey.UniverseKey(), leaf.SmtLeafNode())
+	require.NoError(t, err)
+	_, err = uniTree.Insert(
+		ctx, otherKey.UniverseKey(), otherLeaf.SmtLeafNode(),
+	)
+	require.NoError(t, err)
+
+	uniRoot, err := uniTree.Root(ctx)
+	require.NoError(t, err)
+	uniProof, err := uniTree.MerkleProof(ctx, leafKey.UniverseKey())
+	require.NoError(t, err)
+
+	// The universe is then added to a multiverse along with another
+	// universe, and the multiverse root is committed to.
+	id := Identifier{
+		AssetID:   leaf.Asset.ID(),
+		ProofType: ProofTypeIssuance,
+	}
+	otherID := Identifier{
+		AssetID:   otherLeaf.Asset.ID(),
+		ProofType: ProofTypeIssuance,
+	}
+
+	otherRoot := otherLeaf.SmtLeafNode()
+	multiverse, err := multiverseTree(ctx, []MultiverseLeaf{
+		{
+			ID:       id,
+			LeafNode: NewMultiverseLeafNode(id, uniRoot),
+		},
+		{
+			ID:       otherID,
+			LeafNode: NewMultiverseLeafNode(otherID, otherRoot),
+		},
+	})
+	require.NoError(t, err)
+
+	multiverseRoot, err := multiverse.Root(ctx)
+	require.NoError(t, err)
+	multiverseProof, err := multiverse.MerkleProof(ctx, id.Bytes())
+	require.NoError(t, err)
+
+	return id, &CommittedIssuanceProof{
+		ChainProof: newTestCommitment(t, multiverseRoot),
+		TaprootAssetProof: &Proof{
+			Leaf:                     leaf,
+			LeafKey:                  leafKey,
+			UniverseRoot:             uniRoot,
+			UniverseInclusionProof:   uniProof,
+			MultiverseRoot:           multiverseRoot,
+			MultiverseInclusionProof: multiverseProof,
+		},
+	}
+}
+
+// TestCommitmentVerify tests that a commitment only verifies if its anchor
+// output commits to the universe root and its transaction is included in the
+// block.
+func TestCommitmentVerify(t *testing.T) {
+	t.Parallel()
+
+	root := mssmt.NewLeafNode(test.RandBytes(32), 10)
+	commitment := newTestCommitment(t, root)
+	require.NoError(t, commitment.Verify())
+
+	testCases := []struct {
+		name   string
+		modify func(c *Commitment)
+		errStr string
+	}{
+		{
+			name: "wrong root hash",
+			modify: func(c *Commitment) {
+				c.UniverseRoot = mssmt.NewLeafNode(
+					test.RandBytes(32), 10,
+				)
+			},
+			errStr: "does not commit to universe root",
+		},
+		{
+			name: "wrong root sum",
+			modify: func(c *Commitment) {
+				c.UniverseRoot = mssmt.NewComputedNode(
+					root.NodeHash(), root.NodeSum()+1,
+				)
+			},
+			errStr: "does not commit to universe root",
+		},
+		{
+			name: "wrong output",
+			modify: func(c *Commitment) {
+				c.OutputIndex = 0
+			},
+			errStr: "does not commit to universe root",
+		},
+		{
+			name: "output index out of range",
+			modify: func(c *Commitment) {
+				c.OutputIndex = 2
+			},
+			errStr: "out of range",
+		},
+		{
+			name: "wrong internal key",
+			modify: func(c *Commitment) {
+				c.InternalKey = test.PubToKeyDesc(
+					test.RandPubKey(t),
+				)
+			},
+			errStr: "does not commit to universe root",
+		},
+		{
+			name: "tampered transaction",
+			modify: func(c *Commitment) {
+				c.Tx = c.Tx.Copy()
+				c.Tx.TxOut[0].Value++
+			},
+			errStr: "invalid commitment transaction merkle proof",
+		},
+		{
+			name: "wrong block",
+			modify: func(c *Commitment) {
+				c.BlockHeader.MerkleRoot[0] ^= 1
+			},
+			errStr: "invalid commitment transaction merkle proof",
+		},
+		{
+			name: "missing merkle proof",
+			modify: func(c *Commitment) {
+				c.MerkleProof = nil
+			},
+			errStr: "merkle proof missing",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tampered := *commitment
+			tc.modify(&tampered)
+
+			require.ErrorContains(t, tampered.Verify(), tc.errStr)
+		})
+	}
+}
+
+// TestCommittedIssuanceProofVerify tests that a committed issuance proof only
+// verifies if the leaf is included in the universe, and the universe is
+// included in the multiverse root of a valid commitment.
+func TestCommittedIssuanceProofVerify(t *testing.T) {
+	t.Parallel()
+
+	id, committedProof := newTestCommittedProof(t)
+	require.NoError(t, committedProof.Verify(id))
+
+	testCases := []struct {
+		name   string
+		modify func(p *CommittedIssuanceProof)
+		id     func() Identifier
+		errStr string
+	}{
+		{
+			name: "tampered leaf proof",
+			modify: func(p *CommittedIssuanceProof) {
+				leaf := *p.TaprootAssetProof.Leaf
+				leaf.RawProof = test.RandBytes(64)
+				p.TaprootAssetProof.Leaf = &leaf
+			},
+			errStr: "leaf not included in universe root",
+		},
+		{
+			name: "tampered leaf amount",
+			modify: func(p *CommittedIssuanceProof) {
+				leaf := *p.TaprootAssetProof.Leaf
+				leaf.Amt++
+				p.TaprootAssetProof.Leaf = &leaf
+			},
+			errStr: "leaf not included in universe root",
+		},
+		{
+			name: "wrong leaf key",
+			modify: func(p *CommittedIssuanceProof) {
+				p.TaprootAssetProof.LeafKey.OutPoint =
+					test.RandOp(t)
+			},
+			errStr: "leaf not included in universe root",
+		},
+		{
+			name: "wrong universe root sum",
+			modify: func(p *CommittedIssuanceProof) {
+				uniRoot := p.TaprootAssetProof.UniverseRoot
+				p.TaprootAssetProof.UniverseRoot =
+					mssmt.NewComputedNode(
+						uniRoot.NodeHash(),
+						uniRoot.NodeSum()+1,
+					)
+			},
+			errStr: "leaf not included in universe root",
+		},
+		{
+			name: "wrong committed root sum",
+			modify: func(p *CommittedIssuanceProof) {
+				root := p.ChainProof.UniverseRoot
+				p.ChainProof.UniverseRoot =
+					mssmt.NewComputedNode(
+						root.NodeHash(),
+						root.NodeSum()+1,
+					)
+			},
+			errStr: "invalid chain proof",
+		},
+		{
+			name: "wrong commitment output",
+			modify: func(p *CommittedIssuanceProof) {
+				p.ChainProof.OutputIndex = 0
+			},
+			errStr: "invalid chain proof",
+		},
+		{
+			name: "commitment to another root",
+			modify: func(p *CommittedIssuanceProof) {
+				p.ChainProof = newTestCommitment(
+					t, mssmt.NewLeafNode(
+						test.RandBytes(32), 2,
+					),
+				)
+			},
+			errStr: "universe root not included in committed " +
+				"multiverse root",
+		},
+		{
+			name: "wrong universe",
+			id: func() Identifier {
+				otherID := id
+				otherID.AssetID[0] ^= 1
+
+				return otherID
+			},
+			errStr: "universe root not included in committed " +
+				"multiverse root",
+		},
+		{
+			name: "missing multiverse proof",
+			modify: func(p *CommittedIssuanceProof) {
+				p.TaprootAssetProof.MultiverseInclusionProof =
+					nil
+			},
+			errStr: "incomplete universe proof",
+		},
+		{
+			name: "missing chain proof",
+			modify: func(p *CommittedIssuanceProof) {
+				p.ChainProof = nil
+			},
+			errStr: "incomplete committed issuance proof",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			chainProof := *committedProof.ChainProof
+			uniProof := *committedProof.TaprootAssetProof
+			tampered := &CommittedIssuanceProof{
+				ChainProof:        &chainProof,
+				TaprootAssetProof: &uniProof,
+			}
+			if tc.modify != nil {
+				tc.modify(tampered)
+			}
+
+			verifyID := id
+			if tc.id != nil {
+				verifyID = tc.id()
+			}
+
+			require.ErrorContains(
+				t, tampered.Verify(verifyID), tc.errStr,
+			)
+		})
+	}
+}
@@ -1,12 +1,15 @@
 package universe
 
 import (
+	"bytes"
 	"context"
 	"crypto/sha256"
 	"encoding/hex"
 	"fmt"
+	"math"
 	"net"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/btcsuite/btcd/btcec/v2"
@@ -16,6 +19,8 @@ import (
 	"github.com/lightninglabs/taproot-assets/fn"
 	"github.com/lightninglabs/taproot-assets/mssmt"
 	"github.com/lightninglabs/taproot-assets/proof"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/tor"
 )
 
 var (
@@ -310,6 +315,60 @@ func (i *Proof) VerifyRoot(expectedRoot mssmt.Node) bool {
 		mssmt.IsEqualNode(reconstructedRoot, expectedRoot)
 }
 
+// MultiProof proves a set of leaves of a single universe. Instead of a full
+// inclusion proof per leaf, all leaves share a single MS-SMT multi-proof
+// against the universe root.
+type MultiProof struct {
+	// LeafKeys are the keys of the proven leaves.
+	LeafKeys []LeafKey
+
+	// Leaves are the leaves found at the leaf keys, in the same order. A
+	// leaf is nil if the universe doesn't have a leaf at its key, in which
+	// case the multi-proof proves the key's non-inclusion.
+	Leaves []*Leaf
+
+	// UniverseRoot is the root of the universe that the leaves are
+	// located within.
+	UniverseRoot mssmt.Node
+
+	// UniverseInclusionProof is the multi-proof for all leaves within the
+	// universe tree.
+	UniverseInclusionProof *mssmt.MultiProof
+
+	// MultiverseRoot is the root of the multiverse tree that the universe
+	// is located within.
+	MultiverseRoot mssmt.Node
+
+	// MultiverseInclusionProof is the inclusion proof for the universe
+	// root within the multiverse tree.
+	MultiverseInclusionProof *mssmt.Proof
+}
+
+// VerifyRoot verifies that the multi-proof for the leaves matches the
+// specified root.
+func (p *MultiProof) VerifyRoot(expectedRoot mssmt.Node) bool {
+	if len(p.LeafKeys) != len(p.Leaves) {
+		return false
+	}
+
+	keys := make([][32]byte, len(p.LeafKeys))
+	leafNodes := make([]*mssmt.LeafNode, len(p.Leaves))
+	for i := range p.LeafKeys {
+		keys[i] = p.LeafKeys[i].UniverseKey()
+
+		leafNodes[i] = mssmt.EmptyLeafNode
+		if p.Leaves[i] != nil {
+			leafNodes[i] = p.Leaves[i].SmtLeafNode()
+		}
+	}
+
+	return mssmt.IsEqualNode(p.UniverseRoot, expectedRoot) &&
+		mssmt.VerifyMultiProof(
+			keys, leafNodes, p.UniverseInclusionProof,
+			expectedRoot,
+		)
+}
+
 // BaseBackend is the backend storage interface for a base universe. The
 // backend can be used to store issuance profs, retrieve them, and also fetch
 // the set of keys and leaves stored within the universe.
@@ -331,7 +390,8 @@ type BaseBackend interface {
 	// the minting outpoint will be returned. If neither are specified,
 	// then proofs for all the inserted leaves will be returned.
 	//
-	// TODO(roasbeef): can eventually do multi-proofs for the SMT
+	// NOTE: Multiple leaves can be proven at once with a multi-proof
+	// through MultiverseArchive.FetchProofLeaves.
 	FetchIssuanceProof(ctx context.Context,
 		key LeafKey) ([]*Proof, error)
 
@@ -416,6 +476,12 @@ type MultiverseArchive interface {
 	FetchProofLeaf(ctx context.Context, id Identifier,
 		key LeafKey) ([]*Proof, error)
 
+	// FetchProofLeaves returns a multi-proof for the leaves at the given
+	// keys, which must all have a script key specified. Keys that don't
+	// have a leaf in the universe are proven to not be included.
+	FetchProofLeaves(ctx context.Context, id Identifier,
+		keys []LeafKey) (*MultiProof, error)
+
 	// DeleteUniverse deletes all leaves, and the root, for given universe.
 	DeleteUniverse(ctx context.Context, id Identifier) (string, error)
 
@@ -428,6 +494,11 @@ type MultiverseArchive interface {
 	UniverseLeafKeys(ctx context.Context,
 		q UniverseLeafKeysQuery) ([]LeafKey, error)
 
+	// UniverseTreeNodes returns the nodes of the given universe tree at
+	// the given paths, in the same order as the paths.
+	UniverseTreeNodes(ctx context.Context, id Identifier,
+		paths []TreeNodePath) ([]TreeNode, error)
+
 	// FetchLeaves returns the set of multiverse leaves that satisfy the set
 	// of universe targets. If the set of targets is empty, all leaves for
 	// the given proof type will be returned.
@@ -438,6 +509,24 @@ type MultiverseArchive interface {
 	// proof type.
 	MultiverseRootNode(ctx context.Context,
 		proofType ProofType) (fn.Option[MultiverseRoot], error)
+
+	// FetchLeafEvents returns the leaves that were inserted after the
+	// cursor of the given query and that match its filters, in insertion
+	// order.
+	FetchLeafEvents(ctx context.Context,
+		q LeafEventQuery) ([]*LeafEvent, error)
+
+	// LatestLeafCursor returns the cursor of the most recently inserted
+	// leaf, or zero if no leaves were inserted yet.
+	LatestLeafCursor(ctx context.Context) (uint64, error)
+
+	// RegisterLeafSubscriber registers a subscriber that is notified about
+	// every leaf that is inserted into a universe.
+	RegisterLeafSubscriber(receiver *fn.EventReceiver[*LeafEvent])
+
+	// RemoveLeafSubscriber removes the given leaf subscriber and stops it
+	// from processing events.
+	RemoveLeafSubscriber(receiver *fn.EventReceiver[*LeafEvent]) error
 }
 
 // Registrar is an interface that allows a caller to upsert a proof leaf in a
@@ -473,6 +562,74 @@ type Item struct {
 	LogProofSync bool
 }
 
+// LeafEvent is emitted when a proof leaf is inserted into a universe.
+type LeafEvent struct {
+	// Cursor is the position of the leaf in the order in which leaves
+	// were inserted across all universes. A subscriber can resume from a
+	// cursor to receive all leaves inserted after it.
+	Cursor uint64
+
+	// ID identifies the universe the leaf was inserted into.
+	ID Identifier
+
+	// Key is the key the leaf is stored at.
+	Key LeafKey
+
+	// Leaf is the inserted proof leaf.
+	Leaf *Leaf
+}
+
+// LeafEventQuery is used to select the leaf events a subscriber is interested
+// in.
+type LeafEventQuery struct {
+	// AfterCursor, if non-zero, selects only the leaves that were inserted
+	// after the leaf with the given cursor.
+	AfterCursor uint64
+
+	// AssetID, if set, selects only the leaves of the asset with the given
+	// ID, regardless of whether the asset is part of a group.
+	AssetID *asset.ID
+
+	// GroupKey, if set, selects only the leaves of the universe of the
+	// given asset group.
+	GroupKey *btcec.PublicKey
+
+	// ProofType, if specified, selects only the leaves of the universes
+	// of the given proof type.
+	ProofType ProofType
+
+	// Limit is the maximum number of leaf events to return.
+	Limit int32
+}
+
+// Matches returns true if the given leaf event is selected by the query.
+func (q *LeafEventQuery) Matches(event *LeafEvent) bool {
+	if event.Cursor <= q.AfterCursor {
+		return false
+	}
+
+	if q.AssetID != nil && event.Leaf.ID() != *q.AssetID {
+		return false
+	}
+
+	// Universes are keyed by the x-only group key, so we'll only compare
+	// that part of the key.
+	if q.GroupKey != nil && (event.ID.GroupKey == nil ||
+		!bytes.Equal(schnorr.SerializePubKey(event.ID.GroupKey),
+			schnorr.SerializePubKey(q.GroupKey))) {
+
+		return false
+	}
+
+	if q.ProofType != ProofTypeUnspecified &&
+		event.ID.ProofType != q.ProofType {
+
+		return false
+	}
+
+	return true
+}
+
 // BatchRegistrar is an interface that allows a caller to register a batch of
 // proof items within a universe.
 type BatchRegistrar interface {
@@ -490,16 +647,17 @@ const (
 	DefaultUniverseRPCPort = 10029
 )
 
-// resolveUniverseAddr maps an RPC universe host (of the form 'host' or
-// 'host:port') into a net.Addr.
-func resolverUniverseAddr(uniAddr string) (net.Addr, error) {
+// splitUniverseAddr splits an RPC universe host (of the form 'host' or
+// 'host:port') into its host and port, using the default universe RPC port if
+// no port is specified. Onion hosts are validated.
+func splitUniverseAddr(uniAddr string) (string, int, error) {
 	var (
 		host string
 		port int
 	)
 
 	if len(uniAddr) == 0 {
-		return nil, fmt.Errorf("universe host cannot be empty")
+		return "", 0, fmt.Errorf("universe host cannot be empty")
 	}
 
 	// Split the address into its host and port components.
@@ -514,12 +672,40 @@ func resolverUniverseAddr(uniAddr string) (net.Addr, error) {
 		host = h
 		portNum, err := strconv.Atoi(p)
 		if err != nil {
-			return nil, err
+			return "", 0, err
 		}
 		port = portNum
 	}
 
-	// TODO(roasbeef): add tor support
+	if port <= 0 || port > math.MaxUint16 {
+		return "", 0, fmt.Errorf("invalid universe port: %d", port)
+	}
+
+	// Anything that looks like an onion host must be a valid v2 or v3
+	// onion service address, so a typo doesn't end up as a clearnet DNS
+	// lookup.
+	if strings.HasSuffix(host, tor.OnionSuffix) && !tor.IsOnionHost(host) {
+		return "", 0, fmt.Errorf("invalid onion address: %v", host)
+	}
+
+	return host, port, nil
+}
+
+// resolveUniverseAddr maps an RPC universe host (of the form 'host' or
+// 'host:port') into a net.Addr. Onion hosts can't be resolved, so they're
+// returned as a tor.OnionAddr.
+func resolverUniverseAddr(uniAddr string) (net.Addr, error) {
+	host, port, err := splitUniverseAddr(uniAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	if tor.IsOnionHost(host) {
+		return &tor.OnionAddr{
+			OnionService: host,
+			Port:         port,
+		}, nil
+	}
 
 	hostPort := net.JoinHostPort(host, strconv.Itoa(port))
 	return net.ResolveTCPAddr("tcp", hostPort)
@@ -542,11 +728,17 @@ type ServerAddr struct {
 }
 
 // NewServerAddrFromStr creates a new server address from a string that is the
-// host name of the remote universe server.
-func NewServerAddrFromStr(s string) ServerAddr {
+// host name of the remote universe server. The host may be an onion address,
+// in which case it must be a valid onion service address.
+func NewServerAddrFromStr(s string) (ServerAddr, error) {
+	if _, _, err := splitUniverseAddr(s); err != nil {
+		return ServerAddr{}, fmt.Errorf("invalid universe server "+
+			"address %v: %w", s, err)
+	}
+
 	return ServerAddr{
 		addrStr: s,
-	}
+	}, nil
 }
 
 // NewServerAddr creates a new server address from both the universe addr ID
@@ -578,6 +770,18 @@ func (s *ServerAddr) HostStr() string {
 	return s.addrStr
 }
 
+// HostPort returns the host and port of the remote universe server in the
+// form 'host:port', without resolving the host. This allows the host to be
+// resolved by a proxy, which is required for onion hosts.
+func (s *ServerAddr) HostPort() (string, error) {
+	host, port, err := splitUniverseAddr(s.addrStr)
+	if err != nil {
+		return "", err
+	}
+
+	return net.JoinHostPort(host, strconv.Itoa(port)), nil
+}
+
 // SyncType is an enum that describes the type of sync that should be performed
 // between a local and remote universe.
 type SyncType uint8
@@ -615,10 +819,56 @@ type AssetSyncDiff struct {
 	// Universe.
 	NewLeafProofs []*Leaf
 
+	// Divergence is set if the local root still didn't match the remote
+	// root after the sync, because the remote has leaves that we couldn't
+	// sync.
+	Divergence *Divergence
+
 	// TODO(roasbeef): ability to return if things failed?
 	//  * can used a sealed interface to return the error
 }
 
+// Divergence describes a universe whose local root doesn't match the root of
+// a remote server after syncing with that server. As a sync only ever adds
+// leaves, this means that the remote server either serves leaves that don't
+// match its root, or that it has a different leaf for a key that we already
+// know. Leaves that are only known locally don't cause a divergence.
+type Divergence struct {
+	// ID is the identifier of the universe that diverged.
+	ID Identifier
+
+	// ServerHost is the host of the remote server.
+	ServerHost string
+
+	// LocalRoot is the local root of the universe after the sync.
+	LocalRoot mssmt.Node
+
+	// RemoteRoot is the root of the universe on the remote server.
+	RemoteRoot mssmt.Node
+
+	// ConflictingKeys are the keys of the remote leaves that are either
+	// still missing locally or that have a different value locally. This
+	// may be empty if the remote server doesn't allow us to identify the
+	// conflicting leaves.
+	ConflictingKeys []LeafKey
+
+	// DetectedAt is the time the divergence was detected.
+	DetectedAt time.Time
+}
+
+// DivergenceQuery is used to query for divergences.
+type DivergenceQuery struct {
+	// ServerHost, if set, only returns divergences for the given server.
+	ServerHost string
+
+	// Offset is the offset into the list of divergences, which are sorted
+	// from the most recent to the oldest.
+	Offset int32
+
+	// Limit is the maximum number of divergences to return.
+	Limit int32
+}
+
 // Syncer is used to synchronize the state of two Universe instances: a local
 // instance and a remote instance. As a Universe is a tree based structure,
 // tree based bisection can be used to find the point of divergence with
@@ -632,6 +882,50 @@ type Syncer interface {
 		idsToSync ...Identifier) ([]AssetSyncDiff, error)
 }
 
+// TreeNodePath identifies a node within a universe MS-SMT by the path that
+// leads from the root of the tree to the node.
+type TreeNodePath struct {
+	// Depth is the number of levels between the root of the tree and the
+	// node. The root itself is at depth zero, while the leaves are at
+	// depth mssmt.MaxTreeLevels.
+	Depth uint16
+
+	// Prefix holds the path to the node. Only the first Depth bits of the
+	// prefix are significant, using the same bit order as the MS-SMT uses
+	// for its keys.
+	Prefix [32]byte
+}
+
+// Children returns the paths of the left and right child of the node.
+//
+// NOTE: This must only be called for paths above the leaf level.
+func (p TreeNodePath) Children() (TreeNodePath, TreeNodePath) {
+	left := TreeNodePath{
+		Depth:  p.Depth + 1,
+		Prefix: p.Prefix,
+	}
+
+	right := left
+	right.Prefix[p.Depth/8] |= 1 << (p.Depth % 8)
+
+	return left, right
+}
+
+// TreeNode is a node within a universe MS-SMT. Tree nodes can be used to
+// compare two universe trees from the root down, in order to find the subtrees
+// in which they differ.
+type TreeNode struct {
+	// Path is the path of the node within the tree.
+	Path TreeNodePath
+
+	// Node is the root of the subtree at the path.
+	Node mssmt.Node
+
+	// LeafKey is the key of the leaf if the subtree at the path contains
+	// exactly one leaf.
+	LeafKey *LeafKey
+}
+
 // DiffEngine is a Universe diff engine that can be used to compare the state
 // of two universes and find the set of assets that are different between them.
 type DiffEngine interface {
@@ -654,6 +948,13 @@ type DiffEngine interface {
 	FetchProofLeaf(ctx context.Context, id Identifier,
 		key LeafKey) ([]*Proof, error)
 
+	// UniverseTreeNodes returns the nodes of the universe tree at the
+	// given paths, in the same order as the paths. If the engine doesn't
+	// support fetching tree nodes, then ErrTreeNodesUnsupported is
+	// returned.
+	UniverseTreeNodes(ctx context.Context, id Identifier,
+		paths []TreeNodePath) ([]TreeNode, error)
+
 	// Close is used to shutdown the active diff engine instance.
 	Close() error
 }
@@ -672,10 +973,54 @@ type Commitment struct {
 	// anchor output was included.
 	MerkleProof *proof.TxMerkleProof
 
+	// Tx is the transaction that anchors the universe root.
+	Tx *wire.MsgTx
+
+	// OutputIndex is the index of the anchor output within Tx.
+	OutputIndex uint32
+
+	// InternalKey is the internal key of the anchor output. The output
+	// key is derived from the internal key and a tapscript tree which
+	// commits to the universe root.
+	InternalKey keychain.KeyDescriptor
+
 	// UniverseRoot is the full Universe root for this commitment.
 	UniverseRoot mssmt.Node
 }
 
+// OutPoint returns the outpoint of the anchor output of the commitment.
+func (c *Commitment) OutPoint() wire.OutPoint {
+	return wire.OutPoint{
+		Hash:  c.Tx.TxHash(),
+		Index: c.OutputIndex,
+	}
+}
+
+// PendingCommitment is a universe commitment whose transaction was signed,
+// but hasn't confirmed yet.
+type PendingCommitment struct {
+	// Tx is the signed transaction that anchors the universe root.
+	Tx *wire.MsgTx
+
+	// OutputIndex is the index of the anchor output within Tx.
+	OutputIndex uint32
+
+	// InternalKey is the internal key of the anchor output.
+	InternalKey keychain.KeyDescriptor
+
+	// UniverseRoot is the full Universe root for this commitment.
+	UniverseRoot mssmt.Node
+
+	// HeightHint is the block height at which the transaction was
+	// created. The transaction can't confirm before this height.
+	HeightHint uint32
+
+	// LockedInputs are the wallet outputs that were locked to fund the
+	// transaction. They are only known for a newly created commitment
+	// and aren't persisted.
+	LockedInputs []wire.OutPoint
+}
+
 // CommittedIssuanceProof couples together a Bitcoin level merkle proof
 // commitment with an issuance proof. This allows remote callers to verify that
 // their responses re actually committed to within the chain.
@@ -684,33 +1029,50 @@ type CommittedIssuanceProof struct {
 	// been stamped in the chain.
 	ChainProof *Commitment
 
-	// TaprootAssetProof is a proof of new asset issuance.
+	// TaprootAssetProof is a proof of new asset issuance. Its multiverse
+	// inclusion proof is relative to the universe root of the chain
+	// proof.
 	TaprootAssetProof *Proof
 }
 
 // ChainCommitter is used to commit a Universe backend in the chain.
 type ChainCommitter interface {
-	// CommitUniverse takes a Universe and returns a new commitment to that
-	// Universe in the main chain.
-	CommitUniverse(universe BaseBackend) (*Commitment, error)
+	// CreateCommitment funds and signs a new transaction that anchors the
+	// given multiverse root, without publishing it. If a previous
+	// commitment is given, the new transaction spends its anchor output,
+	// so that the commitments form a chain.
+	CreateCommitment(ctx context.Context, root MultiverseRoot,
+		prev *Commitment) (*PendingCommitment, error)
+
+	// PublishCommitment publishes the transaction of the given pending
+	// commitment and returns the commitment once the transaction has
+	// confirmed. It is safe to publish a transaction again after a
+	// restart.
+	PublishCommitment(ctx context.Context,
+		pending *PendingCommitment) (*Commitment, error)
+
+	// AbandonCommitment releases the wallet inputs that were locked to
+	// fund the transaction of the given pending commitment. It must only
+	// be called for a commitment that was never published.
+	AbandonCommitment(ctx context.Context,
+		pending *PendingCommitment) error
 }
 
 // Canonical is an interface that allows a caller to query for the latest
 // canonical Universe information related to an asset.
-//
-// TODO(roasbeef): sync methods too, divide into read/write?
 type Canonical interface {
-	BaseBackend
-
-	// Query returns a fully proved response for the target base key.
-	Query(context.Context, LeafKey) (*CommittedIssuanceProof, error)
+	// Query returns a fully proved response for the target leaf key of
+	// the given issuance universe.
+	Query(ctx context.Context, id Identifier,
+		key LeafKey) (*CommittedIssuanceProof, error)
 
 	// LatestCommitment returns the latest chain commitment.
-	LatestCommitment() (*Commitment, error)
+	LatestCommitment(ctx context.Context) (*Commitment, error)
 
 	// UpdateChainCommitment takes in a series of chain commitments and
 	// updates the commitment on chain.
-	UpdateChainCommitment(chainCommits ...ChainCommitter) (*Commitment, error)
+	UpdateChainCommitment(ctx context.Context,
+		chainCommits ...ChainCommitter) (*Commitment, error)
 }
 
 // FederationLog is used to keep track of the set Universe servers that
@@ -868,6 +1230,10 @@ const (
 
 	// ProofSyncStatusComplete indicates that the sync is complete.
 	ProofSyncStatusComplete ProofSyncStatus = "complete"
+
+	// ProofSyncStatusFailed indicates that the sync reached the maximum
+	// number of attempts and won't be retried anymore.
+	ProofSyncStatusFailed ProofSyncStatus = "failed"
 )
 
 // ParseStrProofSyncStatus parses a string into a ProofSyncStatus.
@@ -877,6 +1243,8 @@ func ParseStrProofSyncStatus(s string) (ProofSyncStatus, error) {
 		return ProofSyncStatusPending, nil
 	case string(ProofSyncStatusComplete):
 		return ProofSyncStatusComplete, nil
+	case string(ProofSyncStatusFailed):
+		return ProofSyncStatusFailed, nil
 	default:
 		return "", fmt.Errorf("unknown proof sync status: %v", s)
 	}
@@ -931,17 +1299,35 @@ type FederationProofSyncLog interface {
 	FetchPendingProofsSyncLog(ctx context.Context,
 		syncDirection *SyncDirection) ([]*ProofSyncLogEntry, error)
 
+	// FetchFailedProofsSyncLog queries the federation proof sync log and
+	// returns all log entries with sync status failed.
+	FetchFailedProofsSyncLog(ctx context.Context,
+		syncDirection *SyncDirection) ([]*ProofSyncLogEntry, error)
+
 	// DeleteProofsSyncLogEntries deletes proof sync log entries.
 	DeleteProofsSyncLogEntries(ctx context.Context,
 		servers ...ServerAddr) error
 }
 
+// FederationDivergenceLog is used to record and query the divergences that
+// were detected while syncing with federation servers.
+type FederationDivergenceLog interface {
+	// LogDivergence records a new divergence.
+	LogDivergence(ctx context.Context, divergence *Divergence) error
+
+	// QueryDivergences returns the recorded divergences that match the
+	// given query, starting with the most recent one.
+	QueryDivergences(ctx context.Context,
+		q DivergenceQuery) ([]*Divergence, error)
+}
+
 // FederationDB is used for CRUD operations related to federation logs and
 // configuration.
 type FederationDB interface {
 	FederationLog
 	FederationProofSyncLog
 	FederationSyncConfigDB
+	FederationDivergenceLog
 }
 
 // SyncStatsSort is an enum used to specify the sort order of the returned sync
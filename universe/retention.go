@@ -0,0 +1,288 @@
+package universe
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/fn"
+)
+
+const (
+	// DefaultTransferPruneInterval is the default interval at which
+	// superseded transfer leaves are pruned, if a retention policy is
+	// configured.
+	DefaultTransferPruneInterval = time.Hour
+)
+
+// TransferRetentionPolicy defines how long superseded transfer leaves are
+// kept in the transfer universes. A transfer leaf is superseded once the asset
+// output it proves was spent by a later transfer in the same universe. The
+// leaves of unspent outputs and all issuance leaves are always kept.
+type TransferRetentionPolicy struct {
+	// MaxDepth is the number of blocks a superseded transfer leaf can be
+	// anchored below the chain tip before it is pruned. A value of zero
+	// means leaves aren't pruned based on their depth.
+	MaxDepth uint32
+
+	// MaxAge is the maximum age of the anchor block of a superseded
+	// transfer leaf before it is pruned. A value of zero means leaves
+	// aren't pruned based on their age.
+	MaxAge time.Duration
+}
+
+// Enabled returns true if the policy prunes any transfer leaves.
+func (p *TransferRetentionPolicy) Enabled() bool {
+	return p.MaxDepth != 0 || p.MaxAge != 0
+}
+
+// TransferPruneCutoff selects the superseded transfer leaves that are pruned.
+// A leaf is pruned if it matches either of the cutoffs.
+type TransferPruneCutoff struct {
+	// MaxBlockHeight prunes the leaves anchored at or below this height.
+	MaxBlockHeight fn.Option[uint32]
+
+	// MinBlockTime prunes the leaves anchored in a block with an earlier
+	// timestamp.
+	MinBlockTime fn.Option[time.Time]
+}
+
+// TransferPruneResult is the result of pruning superseded transfer leaves.
+type TransferPruneResult struct {
+	// LeavesPruned is the number of transfer leaves that were removed.
+	LeavesPruned uint64
+
+	// NodesRemoved is the number of MS-SMT nodes that were no longer
+	// referenced by the pruned trees and were removed.
+	NodesRemoved uint64
+}
+
+// TransferPruneStore is used to prune superseded transfer leaves.
+type TransferPruneStore interface {
+	// PruneTransferLeaves removes all superseded transfer leaves that
+	// match the given cutoff, and updates the affected universe and
+	// multiverse trees. Issuance leaves are never removed.
+	PruneTransferLeaves(ctx context.Context,
+		cutoff TransferPruneCutoff) (*TransferPruneResult, error)
+}
+
+// PrunedLeafIndex is used to look up the transfer leaves that were pruned from
+// the local universes. The pruned leaves are still part of the trees of
+// federation servers that don't prune them, so they must not be synced again.
+type PrunedLeafIndex interface {
+	// PrunedLeafKeys returns the subset of the given leaf keys whose
+	// leaves were pruned from the given universe.
+	PrunedLeafKeys(ctx context.Context, id Identifier,
+		leafKeys []LeafKey) ([]LeafKey, error)
+}
+
+// TransferPrunerConfig is the config for the transfer pruner.
+type TransferPrunerConfig struct {
+	// Store is used to prune the transfer leaves.
+	Store TransferPruneStore
+
+	// CurrentHeight returns the height of the current chain tip.
+	CurrentHeight func(ctx context.Context) (uint32, error)
+
+	// Policy is the retention policy for superseded transfer leaves.
+	Policy TransferRetentionPolicy
+
+	// PruneInterval is the interval at which the transfer leaves are
+	// pruned.
+	PruneInterval time.Duration
+}
+
+// TransferPrunerStats are the statistics of the transfer pruner since it was
+// started.
+type TransferPrunerStats struct {
+	// Runs is the number of completed prune runs.
+	Runs uint64
+
+	// FailedRuns is the number of prune runs that failed.
+	FailedRuns uint64
+
+	// LeavesPruned is the total number of pruned transfer leaves.
+	LeavesPruned uint64
+
+	// NodesRemoved is the total number of removed MS-SMT nodes.
+	NodesRemoved uint64
+
+	// LastRun is the time the last prune run completed.
+	LastRun time.Time
+
+	// LastRunDuration is the duration of the last completed prune run.
+	LastRunDuration time.Duration
+}
+
+// TransferPruner periodically prunes the superseded transfer leaves of the
+// transfer universes according to a retention policy. This keeps the disk
+// usage of a busy universe server bounded.
+type TransferPruner struct {
+	cfg TransferPrunerConfig
+
+	// statsMtx guards the stats.
+	statsMtx sync.Mutex
+	stats    TransferPrunerStats
+
+	startOnce sync.Once
+	stopOnce  sync.Once
+
+	// ContextGuard provides a wait group and main quit channel that can be
+	// used to create guarded contexts.
+	*fn.ContextGuard
+}
+
+// NewTransferPruner creates a new transfer pruner.
+func NewTransferPruner(cfg TransferPrunerConfig) *TransferPruner {
+	return &TransferPruner{
+		cfg: cfg,
+		ContextGuard: &fn.ContextGuard{
+			DefaultTimeout: DefaultTimeout,
+			Quit:           make(chan struct{}),
+		},
+	}
+}
+
+// Start starts pruning the transfer leaves periodically, if the retention
+// policy prunes any leaves.
+func (p *TransferPruner) Start() error {
+	p.startOnce.Do(func() {
+		if !p.cfg.Policy.Enabled() {
+			return
+		}
+
+		log.Infof("Starting transfer pruner, pruning superseded "+
+			"transfer leaves every %v (max_depth=%d, max_age=%v)",
+			p.cfg.PruneInterval, p.cfg.Policy.MaxDepth,
+			p.cfg.Policy.MaxAge)
+
+		p.Wg.Add(1)
+		go p.pruneLoop()
+	})
+
+	return nil
+}
+
+// Stop stops pruning the transfer leaves.
+func (p *TransferPruner) Stop() error {
+	p.stopOnce.Do(func() {
+		log.Infof("Stopping transfer pruner")
+
+		close(p.Quit)
+		p.Wg.Wait()
+	})
+
+	return nil
+}
+
+// pruneLoop periodically prunes the superseded transfer leaves.
+//
+// NOTE: This MUST be run as a goroutine.
+func (p *TransferPruner) pruneLoop() {
+	defer p.Wg.Done()
+
+	pruneTicker := time.NewTicker(p.cfg.PruneInterval)
+	defer pruneTicker.Stop()
+
+	for {
+		select {
+		case <-pruneTicker.C:
+			ctx, cancel := p.WithCtxQuitNoTimeout()
+			_, err := p.Prune(ctx)
+			cancel()
+			if err != nil {
+				log.Errorf("Unable to prune transfer leaves: "+
+					"%v", err)
+			}
+
+		case <-p.Quit:
+			return
+		}
+	}
+}
+
+// cutoff returns the prune cutoff of the retention policy at the given time.
+func (p *TransferPruner) cutoff(ctx context.Context,
+	now time.Time) (TransferPruneCutoff, error) {
+
+	var cutoff TransferPruneCutoff
+
+	policy := p.cfg.Policy
+	if policy.MaxDepth != 0 {
+		currentHeight, err := p.cfg.CurrentHeight(ctx)
+		if err != nil {
+			return cutoff, fmt.Errorf("unable to fetch current "+
+				"height: %w", err)
+		}
+
+		// If the chain isn't deep enough yet, no leaf can be buried
+		// deep enough to be pruned.
+		if currentHeight > policy.MaxDepth {
+			cutoff.MaxBlockHeight = fn.Some(
+				currentHeight - policy.MaxDepth,
+			)
+		}
+	}
+
+	if policy.MaxAge != 0 {
+		cutoff.MinBlockTime = fn.Some(now.Add(-policy.MaxAge))
+	}
+
+	return cutoff, nil
+}
+
+// Prune prunes the superseded transfer leaves according to the retention
+// policy once.
+func (p *TransferPruner) Prune(
+	ctx context.Context) (*TransferPruneResult, error) {
+
+	start := time.Now()
+
+	result, err := p.prune(ctx, start)
+
+	p.statsMtx.Lock()
+	defer p.statsMtx.Unlock()
+
+	if err != nil {
+		p.stats.FailedRuns++
+		return nil, err
+	}
+
+	p.stats.Runs++
+	p.stats.LeavesPruned += result.LeavesPruned
+	p.stats.NodesRemoved += result.NodesRemoved
+	p.stats.LastRun = time.Now()
+	p.stats.LastRunDuration = p.stats.LastRun.Sub(start)
+
+	log.Infof("Pruned %d superseded transfer leaves and %d MS-SMT "+
+		"nodes in %v", result.LeavesPruned, result.NodesRemoved,
+		p.stats.LastRunDuration)
+
+	return result, nil
+}
+
+// prune prunes the superseded transfer leaves with the cutoff of the
+// retention policy at the given time.
+func (p *TransferPruner) prune(ctx context.Context,
+	now time.Time) (*TransferPruneResult, error) {
+
+	cutoff, err := p.cutoff(ctx, now)
+	if err != nil {
+		return nil, err
+	}
+
+	if cutoff.MaxBlockHeight.IsNone() && cutoff.MinBlockTime.IsNone() {
+		return &TransferPruneResult{}, nil
+	}
+
+	return p.cfg.Store.PruneTransferLeaves(ctx, cutoff)
+}
+
+// Stats returns the statistics of the transfer pruner.
+func (p *TransferPruner) Stats() TransferPrunerStats {
+	p.statsMtx.Lock()
+	defer p.statsMtx.Unlock()
+
+	return p.stats
+}
@@ -0,0 +1,93 @@
+package universe
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/stretchr/testify/require"
+)
+
+// mockTransferPruneStore is a transfer prune store that records the cutoffs it
+// is called with.
+type mockTransferPruneStore struct {
+	cutoffs []TransferPruneCutoff
+	err     error
+}
+
+func (m *mockTransferPruneStore) PruneTransferLeaves(_ context.Context,
+	cutoff TransferPruneCutoff) (*TransferPruneResult, error) {
+
+	if m.err != nil {
+		return nil, m.err
+	}
+
+	m.cutoffs = append(m.cutoffs, cutoff)
+
+	return &TransferPruneResult{
+		LeavesPruned: 3,
+		NodesRemoved: 10,
+	}, nil
+}
+
+// TestTransferPrunerCutoff tests that the transfer pruner derives the prune
+// cutoff from its retention policy, and that it keeps track of its stats.
+func TestTransferPrunerCutoff(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	now := time.Unix(1_700_000_000, 0)
+
+	var currentHeight uint32
+	store := &mockTransferPruneStore{}
+	pruner := NewTransferPruner(TransferPrunerConfig{
+		Store: store,
+		CurrentHeight: func(context.Context) (uint32, error) {
+			return currentHeight, nil
+		},
+		Policy: TransferRetentionPolicy{
+			MaxDepth: 100,
+		},
+	})
+
+	// As long as the chain isn't deeper than the max depth, nothing can be
+	// pruned, so the store isn't called.
+	currentHeight = 100
+	result, err := pruner.prune(ctx, now)
+	require.NoError(t, err)
+	require.Zero(t, result.LeavesPruned)
+	require.Empty(t, store.cutoffs)
+
+	// Once it is, we prune all leaves buried deeper than the max depth.
+	currentHeight = 150
+	result, err = pruner.Prune(ctx)
+	require.NoError(t, err)
+	require.EqualValues(t, 3, result.LeavesPruned)
+	require.Equal(t, []TransferPruneCutoff{{
+		MaxBlockHeight: fn.Some(uint32(50)),
+	}}, store.cutoffs)
+
+	// With a max age, the leaves with an older anchor block are pruned as
+	// well.
+	pruner.cfg.Policy.MaxAge = 24 * time.Hour
+	_, err = pruner.prune(ctx, now)
+	require.NoError(t, err)
+	require.Equal(t, TransferPruneCutoff{
+		MaxBlockHeight: fn.Some(uint32(50)),
+		MinBlockTime:   fn.Some(now.Add(-24 * time.Hour)),
+	}, store.cutoffs[1])
+
+	// Failed runs are counted separately.
+	store.err = errors.New("database is locked")
+	_, err = pruner.Prune(ctx)
+	require.ErrorIs(t, err, store.err)
+
+	stats := pruner.Stats()
+	require.EqualValues(t, 1, stats.Runs)
+	require.EqualValues(t, 1, stats.FailedRuns)
+	require.EqualValues(t, 3, stats.LeavesPruned)
+	require.EqualValues(t, 10, stats.NodesRemoved)
+	require.False(t, stats.LastRun.IsZero())
+}
@@ -0,0 +1,225 @@
+package universe
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultQuarantineThreshold is the default number of consecutive
+	// failed interactions with a federation server after which the server
+	// is quarantined.
+	DefaultQuarantineThreshold = 3
+
+	// DefaultQuarantineInitialBackoff is the default amount of time a
+	// federation server is quarantined for once it reaches the failure
+	// threshold.
+	DefaultQuarantineInitialBackoff = 10 * time.Minute
+
+	// DefaultQuarantineMaxBackoff is the default maximum amount of time a
+	// federation server is quarantined for.
+	DefaultQuarantineMaxBackoff = 24 * time.Hour
+
+	// latencyAvgWeight is the inverse of the weight a new round-trip time
+	// sample gets in the moving average latency of a server.
+	latencyAvgWeight = 5
+)
+
+// QuarantineConfig configures when federation servers that keep failing are
+// quarantined, and for how long.
+type QuarantineConfig struct {
+	// FailureThreshold is the number of consecutive failed interactions
+	// after which a server is quarantined. A value of zero disables the
+	// quarantine.
+	FailureThreshold uint32
+
+	// InitialBackoff is the amount of time a server is quarantined for
+	// once it reaches the failure threshold. The backoff is doubled for
+	// every further failure.
+	InitialBackoff time.Duration
+
+	// MaxBackoff is the maximum amount of time a server is quarantined
+	// for.
+	MaxBackoff time.Duration
+}
+
+// DefaultQuarantineConfig returns the default federation server quarantine
+// config.
+func DefaultQuarantineConfig() QuarantineConfig {
+	return QuarantineConfig{
+		FailureThreshold: DefaultQuarantineThreshold,
+		InitialBackoff:   DefaultQuarantineInitialBackoff,
+		MaxBackoff:       DefaultQuarantineMaxBackoff,
+	}
+}
+
+// Backoff returns the quarantine duration after the given number of
+// consecutive failures, or zero if the server shouldn't be quarantined.
+func (c *QuarantineConfig) Backoff(consecutiveFailures uint32) time.Duration {
+	if c.FailureThreshold == 0 || consecutiveFailures < c.FailureThreshold {
+		return 0
+	}
+
+	backoff := c.InitialBackoff
+	numDoublings := consecutiveFailures - c.FailureThreshold
+	for i := uint32(0); i < numDoublings && backoff < c.MaxBackoff; i++ {
+		backoff *= 2
+	}
+
+	if backoff > c.MaxBackoff {
+		return c.MaxBackoff
+	}
+
+	return backoff
+}
+
+// ServerHealth is the health of a single federation server, as observed by
+// the syncs and proof pushes we've done with it since start up.
+type ServerHealth struct {
+	// LastSuccess is the time of the last successful interaction with the
+	// server.
+	LastSuccess time.Time
+
+	// LastFailure is the time of the last failed interaction with the
+	// server.
+	LastFailure time.Time
+
+	// LastError is the error of the last failed interaction with the
+	// server.
+	LastError string
+
+	// ConsecutiveFailures is the number of failed interactions with the
+	// server since the last successful one.
+	ConsecutiveFailures uint32
+
+	// AvgLatency is the moving average of the round-trip time of single
+	// calls to the server. Only the calls that fetch universe roots are
+	// measured, so the average isn't skewed by the amount of data that is
+	// synced, or by the time the server takes to verify pushed proofs.
+	AvgLatency time.Duration
+
+	// QuarantinedUntil is the time until which the server is skipped. It
+	// is zero if the server was never quarantined.
+	QuarantinedUntil time.Time
+}
+
+// Quarantined returns true if the server is quarantined at the given time.
+func (h *ServerHealth) Quarantined(now time.Time) bool {
+	return now.Before(h.QuarantinedUntil)
+}
+
+// ServerHealthTracker keeps track of the health of the federation servers.
+type ServerHealthTracker struct {
+	cfg QuarantineConfig
+
+	// now returns the current time, it can be overridden in tests.
+	now func() time.Time
+
+	mu      sync.Mutex
+	servers map[string]*ServerHealth
+}
+
+// NewServerHealthTracker creates a new server health tracker.
+func NewServerHealthTracker(cfg QuarantineConfig) *ServerHealthTracker {
+	return &ServerHealthTracker{
+		cfg:     cfg,
+		now:     time.Now,
+		servers: make(map[string]*ServerHealth),
+	}
+}
+
+// serverHealth returns the health entry of the given server, creating it if
+// it doesn't exist yet.
+//
+// NOTE: The mutex must be held when calling this method.
+func (t *ServerHealthTracker) serverHealth(addr ServerAddr) *ServerHealth {
+	health, ok := t.servers[addr.HostStr()]
+	if !ok {
+		health = &ServerHealth{}
+		t.servers[addr.HostStr()] = health
+	}
+
+	return health
+}
+
+// RecordSuccess records a successful interaction with the given server. Any
+// quarantine of the server is lifted.
+func (t *ServerHealthTracker) RecordSuccess(addr ServerAddr) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	health := t.serverHealth(addr)
+	health.LastSuccess = t.now()
+	health.ConsecutiveFailures = 0
+	health.QuarantinedUntil = time.Time{}
+}
+
+// RecordRoundTrip records the round-trip time of a single successful call to
+// the given server.
+func (t *ServerHealthTracker) RecordRoundTrip(addr ServerAddr,
+	rtt time.Duration) {
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	health := t.serverHealth(addr)
+	if health.AvgLatency == 0 {
+		health.AvgLatency = rtt
+	} else {
+		health.AvgLatency += (rtt - health.AvgLatency) /
+			latencyAvgWeight
+	}
+}
+
+// RecordFailure records a failed interaction with the given server. If the
+// server reached the failure threshold, it is quarantined.
+func (t *ServerHealthTracker) RecordFailure(addr ServerAddr, err error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := t.now()
+	health := t.serverHealth(addr)
+	health.LastFailure = now
+	health.ConsecutiveFailures++
+	if err != nil {
+		health.LastError = err.Error()
+	}
+
+	backoff := t.cfg.Backoff(health.ConsecutiveFailures)
+	if backoff == 0 {
+		return
+	}
+
+	health.QuarantinedUntil = now.Add(backoff)
+
+	log.Warnf("Quarantining universe server %v for %v after %d "+
+		"consecutive failures: %v", addr.HostStr(), backoff,
+		health.ConsecutiveFailures, err)
+}
+
+// Quarantined returns true if the given server is currently quarantined.
+func (t *ServerHealthTracker) Quarantined(addr ServerAddr) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	health, ok := t.servers[addr.HostStr()]
+	if !ok {
+		return false
+	}
+
+	return health.Quarantined(t.now())
+}
+
+// Health returns the health of the given server. A zero value is returned for
+// servers that we haven't interacted with yet.
+func (t *ServerHealthTracker) Health(addr ServerAddr) ServerHealth {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	health, ok := t.servers[addr.HostStr()]
+	if !ok {
+		return ServerHealth{}
+	}
+
+	return *health
+}
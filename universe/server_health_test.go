@@ -0,0 +1,93 @@
+package universe
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestServerHealthTracker tests that servers that keep failing are quarantined
+// with an exponential backoff, and that a successful interaction resets their
+// health.
+func TestServerHealthTracker(t *testing.T) {
+	t.Parallel()
+
+	tracker := NewServerHealthTracker(QuarantineConfig{
+		FailureThreshold: 3,
+		InitialBackoff:   time.Minute,
+		MaxBackoff:       3 * time.Minute,
+	})
+
+	now := time.Unix(1_700_000_000, 0)
+	tracker.now = func() time.Time {
+		return now
+	}
+
+	addr := NewServerAddr(1, "universe.example.com:10029")
+	otherAddr := NewServerAddr(2, "other.example.com:10029")
+	errSync := errors.New("connection refused")
+
+	// A server we haven't interacted with yet is healthy.
+	require.False(t, tracker.Quarantined(addr))
+	require.Equal(t, ServerHealth{}, tracker.Health(addr))
+
+	// The server isn't quarantined before it reaches the failure
+	// threshold.
+	tracker.RecordFailure(addr, errSync)
+	tracker.RecordFailure(addr, errSync)
+	require.False(t, tracker.Quarantined(addr))
+
+	health := tracker.Health(addr)
+	require.EqualValues(t, 2, health.ConsecutiveFailures)
+	require.Equal(t, now, health.LastFailure)
+	require.Equal(t, errSync.Error(), health.LastError)
+
+	// Once it does, it is quarantined for the initial backoff.
+	tracker.RecordFailure(addr, errSync)
+	require.True(t, tracker.Quarantined(addr))
+	require.False(t, tracker.Quarantined(otherAddr))
+	require.Equal(
+		t, now.Add(time.Minute), tracker.Health(addr).QuarantinedUntil,
+	)
+
+	now = now.Add(time.Minute)
+	require.False(t, tracker.Quarantined(addr))
+
+	// Every further failure doubles the backoff, up to the max backoff.
+	tracker.RecordFailure(addr, errSync)
+	require.Equal(
+		t, now.Add(2*time.Minute),
+		tracker.Health(addr).QuarantinedUntil,
+	)
+
+	tracker.RecordFailure(addr, errSync)
+	require.Equal(
+		t, now.Add(3*time.Minute),
+		tracker.Health(addr).QuarantinedUntil,
+	)
+
+	// A successful interaction lifts the quarantine and resets the
+	// failures, but keeps the last error around.
+	tracker.RecordSuccess(addr)
+	tracker.RecordRoundTrip(addr, 100*time.Millisecond)
+	require.False(t, tracker.Quarantined(addr))
+
+	health = tracker.Health(addr)
+	require.Zero(t, health.ConsecutiveFailures)
+	require.Equal(t, now, health.LastSuccess)
+	require.Equal(t, errSync.Error(), health.LastError)
+	require.Equal(t, 100*time.Millisecond, health.AvgLatency)
+
+	// The average latency moves towards new samples.
+	tracker.RecordRoundTrip(addr, 600*time.Millisecond)
+	require.Equal(t, 200*time.Millisecond, tracker.Health(addr).AvgLatency)
+
+	// Without a failure threshold, servers are never quarantined.
+	tracker = NewServerHealthTracker(QuarantineConfig{})
+	for i := 0; i < 10; i++ {
+		tracker.RecordFailure(addr, errSync)
+	}
+	require.False(t, tracker.Quarantined(addr))
+}
@@ -0,0 +1,679 @@
+package universe
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/mssmt"
+	"github.com/lightninglabs/taproot-assets/proof"
+)
+
+const (
+	// SnapshotVersion is the current version of the universe snapshot
+	// format.
+	SnapshotVersion uint8 = 0
+
+	// MaxSnapshotUniverses is the maximum number of universes that can be
+	// contained in a single snapshot.
+	MaxSnapshotUniverses = 1_000_000
+
+	// DefaultSnapshotImportBatchSize is the default number of leaves that
+	// are verified and inserted at once when importing a snapshot.
+	DefaultSnapshotImportBatchSize = 200
+)
+
+var (
+	// snapshotMagic is the magic prefix of every universe snapshot.
+	snapshotMagic = [4]byte{'t', 'a', 'p', 's'}
+
+	// ErrSnapshotRootMismatch is returned when the root of a universe
+	// doesn't match the root recorded in a snapshot, either because the
+	// universe changed while it was exported, or because the imported
+	// leaves don't produce the recorded root.
+	ErrSnapshotRootMismatch = errors.New("universe root doesn't match " +
+		"snapshot root")
+)
+
+// SnapshotUniverse describes a single universe within a snapshot.
+type SnapshotUniverse struct {
+	// ID is the identifier of the universe.
+	ID Identifier
+
+	// Root is the root of the universe at the time the snapshot was
+	// created.
+	Root mssmt.Node
+}
+
+// SnapshotLeaf is a single leaf within a snapshot.
+type SnapshotLeaf struct {
+	// UniverseIndex is the index of the universe the leaf belongs to,
+	// within the header of the snapshot.
+	UniverseIndex uint32
+
+	// Key is the key of the leaf within its universe.
+	Key LeafKey
+
+	// RawProof is the raw proof of the leaf.
+	RawProof proof.Blob
+}
+
+// SnapshotImportResult is the result of importing a single universe from a
+// snapshot.
+type SnapshotImportResult struct {
+	SnapshotUniverse
+
+	// NumLeaves is the number of leaves that were imported.
+	NumLeaves uint64
+}
+
+// SnapshotWriter writes a universe snapshot. The header, which contains the
+// set of universes and their roots, is written first, followed by the leaves
+// of the universes, in order.
+type SnapshotWriter struct {
+	w         *bufio.Writer
+	universes []SnapshotUniverse
+}
+
+// NewSnapshotWriter creates a new snapshot writer and writes the snapshot
+// header for the given universes.
+func NewSnapshotWriter(w io.Writer,
+	universes []SnapshotUniverse) (*SnapshotWriter, error) {
+
+	if len(universes) > MaxSnapshotUniverses {
+		return nil, fmt.Errorf("too many universes in snapshot: %d",
+			len(universes))
+	}
+
+	s := &SnapshotWriter{
+		w:         bufio.NewWriter(w),
+		universes: universes,
+	}
+
+	if _, err := s.w.Write(snapshotMagic[:]); err != nil {
+		return nil, err
+	}
+	if err := s.w.WriteByte(SnapshotVersion); err != nil {
+		return nil, err
+	}
+
+	err := binary.Write(s.w, binary.BigEndian, uint32(len(universes)))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, uni := range universes {
+		if err := writeSnapshotUniverse(s.w, uni); err != nil {
+			return nil, fmt.Errorf("unable to write universe "+
+				"%v: %w", uni.ID.StringForLog(), err)
+		}
+	}
+
+	return s, nil
+}
+
+// WriteLeaf writes a single leaf to the snapshot.
+func (s *SnapshotWriter) WriteLeaf(leaf SnapshotLeaf) error {
+	if int(leaf.UniverseIndex) >= len(s.universes) {
+		return fmt.Errorf("invalid universe index: %d",
+			leaf.UniverseIndex)
+	}
+	if leaf.Key.ScriptKey == nil || leaf.Key.ScriptKey.PubKey == nil {
+		return fmt.Errorf("leaf key is missing script key")
+	}
+	if len(leaf.RawProof) > proof.FileMaxProofSizeBytes {
+		return fmt.Errorf("proof too large: %d bytes",
+			len(leaf.RawProof))
+	}
+
+	err := binary.Write(s.w, binary.BigEndian, leaf.UniverseIndex)
+	if err != nil {
+		return err
+	}
+	err = wire.WriteOutPoint(s.w, 0, 0, &leaf.Key.OutPoint)
+	if err != nil {
+		return err
+	}
+	_, err = s.w.Write(leaf.Key.ScriptKey.PubKey.SerializeCompressed())
+	if err != nil {
+		return err
+	}
+
+	err = binary.Write(s.w, binary.BigEndian, uint32(len(leaf.RawProof)))
+	if err != nil {
+		return err
+	}
+	_, err = s.w.Write(leaf.RawProof)
+
+	return err
+}
+
+// Flush writes any buffered data to the underlying writer.
+func (s *SnapshotWriter) Flush() error {
+	return s.w.Flush()
+}
+
+// writeSnapshotUniverse writes the header entry of a single universe.
+func writeSnapshotUniverse(w *bufio.Writer, uni SnapshotUniverse) error {
+	if uni.Root == nil {
+		return fmt.Errorf("universe root is missing")
+	}
+
+	if err := w.WriteByte(byte(uni.ID.ProofType)); err != nil {
+		return err
+	}
+	if _, err := w.Write(uni.ID.AssetID[:]); err != nil {
+		return err
+	}
+
+	var groupKey [schnorr.PubKeyBytesLen]byte
+	if uni.ID.GroupKey != nil {
+		copy(groupKey[:], schnorr.SerializePubKey(uni.ID.GroupKey))
+	}
+	if _, err := w.Write(groupKey[:]); err != nil {
+		return err
+	}
+
+	rootHash := uni.Root.NodeHash()
+	if _, err := w.Write(rootHash[:]); err != nil {
+		return err
+	}
+
+	return binary.Write(w, binary.BigEndian, uni.Root.NodeSum())
+}
+
+// SnapshotReader reads a universe snapshot that was written by a
+// SnapshotWriter.
+type SnapshotReader struct {
+	r *bufio.Reader
+
+	// Universes is the set of universes contained in the snapshot, as
+	// recorded in its header.
+	Universes []SnapshotUniverse
+}
+
+// NewSnapshotReader creates a new snapshot reader and reads the snapshot
+// header.
+func NewSnapshotReader(r io.Reader) (*SnapshotReader, error) {
+	s := &SnapshotReader{
+		r: bufio.NewReader(r),
+	}
+
+	var magic [4]byte
+	if _, err := io.ReadFull(s.r, magic[:]); err != nil {
+		return nil, fmt.Errorf("unable to read snapshot magic: %w", err)
+	}
+	if magic != snapshotMagic {
+		return nil, fmt.Errorf("not a universe snapshot")
+	}
+
+	version, err := s.r.ReadByte()
+	if err != nil {
+		return nil, fmt.Errorf("unable to read snapshot version: %w",
+			err)
+	}
+	if version != SnapshotVersion {
+		return nil, fmt.Errorf("unknown snapshot version: %d", version)
+	}
+
+	var numUniverses uint32
+	err = binary.Read(s.r, binary.BigEndian, &numUniverses)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read number of universes: %w",
+			err)
+	}
+	if numUniverses > MaxSnapshotUniverses {
+		return nil, fmt.Errorf("too many universes in snapshot: %d",
+			numUniverses)
+	}
+
+	s.Universes = make([]SnapshotUniverse, numUniverses)
+	for i := range s.Universes {
+		s.Universes[i], err = readSnapshotUniverse(s.r)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read universe %d: %w",
+				i, err)
+		}
+	}
+
+	return s, nil
+}
+
+// Next reads the next leaf from the snapshot. io.EOF is returned once all
+// leaves have been read.
+func (s *SnapshotReader) Next() (*SnapshotLeaf, error) {
+	var leaf SnapshotLeaf
+	err := binary.Read(s.r, binary.BigEndian, &leaf.UniverseIndex)
+	if err != nil {
+		// A clean EOF is only expected between two leaves.
+		return nil, err
+	}
+	if int(leaf.UniverseIndex) >= len(s.Universes) {
+		return nil, fmt.Errorf("invalid universe index: %d",
+			leaf.UniverseIndex)
+	}
+
+	// The outpoint is encoded the same way wire.WriteOutPoint does: the
+	// transaction hash followed by the little-endian output index.
+	_, err = io.ReadFull(s.r, leaf.Key.OutPoint.Hash[:])
+	if err != nil {
+		return nil, noEOF(err)
+	}
+	err = binary.Read(s.r, binary.LittleEndian, &leaf.Key.OutPoint.Index)
+	if err != nil {
+		return nil, noEOF(err)
+	}
+
+	var scriptKeyBytes [btcec.PubKeyBytesLenCompressed]byte
+	if _, err := io.ReadFull(s.r, scriptKeyBytes[:]); err != nil {
+		return nil, noEOF(err)
+	}
+	scriptKey, err := btcec.ParsePubKey(scriptKeyBytes[:])
+	if err != nil {
+		return nil, fmt.Errorf("invalid script key: %w", err)
+	}
+	leafScriptKey := asset.NewScriptKey(scriptKey)
+	leaf.Key.ScriptKey = &leafScriptKey
+
+	var proofLen uint32
+	err = binary.Read(s.r, binary.BigEndian, &proofLen)
+	if err != nil {
+		return nil, noEOF(err)
+	}
+	if proofLen > proof.FileMaxProofSizeBytes {
+		return nil, fmt.Errorf("proof too large: %d bytes", proofLen)
+	}
+
+	leaf.RawProof = make([]byte, proofLen)
+	if _, err := io.ReadFull(s.r, leaf.RawProof); err != nil {
+		return nil, noEOF(err)
+	}
+
+	return &leaf, nil
+}
+
+// readSnapshotUniverse reads the header entry of a single universe.
+func readSnapshotUniverse(r *bufio.Reader) (SnapshotUniverse, error) {
+	var uni SnapshotUniverse
+
+	proofType, err := r.ReadByte()
+	if err != nil {
+		return uni, err
+	}
+	uni.ID.ProofType = ProofType(proofType)
+	if uni.ID.ProofType != ProofTypeIssuance &&
+		uni.ID.ProofType != ProofTypeTransfer {
+
+		return uni, fmt.Errorf("invalid proof type: %v",
+			uni.ID.ProofType)
+	}
+
+	if _, err := io.ReadFull(r, uni.ID.AssetID[:]); err != nil {
+		return uni, noEOF(err)
+	}
+
+	var groupKey [schnorr.PubKeyBytesLen]byte
+	if _, err := io.ReadFull(r, groupKey[:]); err != nil {
+		return uni, noEOF(err)
+	}
+	if groupKey != [schnorr.PubKeyBytesLen]byte{} {
+		uni.ID.GroupKey, err = schnorr.ParsePubKey(groupKey[:])
+		if err != nil {
+			return uni, fmt.Errorf("invalid group key: %w", err)
+		}
+	}
+
+	var (
+		rootHash mssmt.NodeHash
+		rootSum  uint64
+	)
+	if _, err := io.ReadFull(r, rootHash[:]); err != nil {
+		return uni, noEOF(err)
+	}
+	if err := binary.Read(r, binary.BigEndian, &rootSum); err != nil {
+		return uni, noEOF(err)
+	}
+	uni.Root = mssmt.NewComputedNode(rootHash, rootSum)
+
+	return uni, nil
+}
+
+// noEOF converts an io.EOF into an io.ErrUnexpectedEOF, as the snapshot may
+// only end in between two leaves.
+func noEOF(err error) error {
+	if errors.Is(err, io.EOF) {
+		return io.ErrUnexpectedEOF
+	}
+
+	return err
+}
+
+// snapshotUniverses returns the universes that should be exported for the
+// given set of universe IDs. If no IDs are given, all known universes are
+// exported. Issuance universes are always ordered before transfer universes,
+// so that the previous assets of transfer proofs are known when the snapshot
+// is imported.
+func (a *Archive) snapshotUniverses(ctx context.Context,
+	ids []Identifier) ([]SnapshotUniverse, error) {
+
+	var universes []SnapshotUniverse
+	if len(ids) == 0 {
+		for offset := int32(0); ; offset += MaxPageSize {
+			roots, err := a.RootNodes(ctx, RootNodesQuery{
+				SortDirection: SortAscending,
+				Offset:        offset,
+				Limit:         MaxPageSize,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("unable to fetch "+
+					"universe roots: %w", err)
+			}
+
+			for _, root := range roots {
+				universes = append(universes, SnapshotUniverse{
+					ID:   root.ID,
+					Root: root.Node,
+				})
+			}
+
+			if len(roots) < MaxPageSize {
+				break
+			}
+		}
+	}
+
+	for _, id := range ids {
+		root, err := a.RootNode(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("unable to fetch root of "+
+				"universe %v: %w", id.StringForLog(), err)
+		}
+
+		universes = append(universes, SnapshotUniverse{
+			ID:   id,
+			Root: root.Node,
+		})
+	}
+
+	// The same universe may only be contained once, as its leaves are
+	// expected to be written consecutively.
+	seen := make(map[string]struct{}, len(universes))
+	uniqueUniverses := universes[:0]
+	for _, uni := range universes {
+		if _, ok := seen[uni.ID.String()]; ok {
+			continue
+		}
+		seen[uni.ID.String()] = struct{}{}
+		uniqueUniverses = append(uniqueUniverses, uni)
+	}
+	universes = uniqueUniverses
+
+	sort.SliceStable(universes, func(i, j int) bool {
+		return universes[i].ID.ProofType == ProofTypeIssuance &&
+			universes[j].ID.ProofType != ProofTypeIssuance
+	})
+
+	return universes, nil
+}
+
+// ExportSnapshot writes a snapshot of the given set of universes to the
+// given writer. If no universe IDs are given, all known universes are
+// exported. The snapshot contains the roots of the universes, followed by all
+// their leaf keys and proofs.
+func (a *Archive) ExportSnapshot(ctx context.Context, w io.Writer,
+	ids []Identifier) ([]SnapshotUniverse, error) {
+
+	universes, err := a.snapshotUniverses(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Infof("Exporting snapshot of %d universes", len(universes))
+
+	snapshot, err := NewSnapshotWriter(w, universes)
+	if err != nil {
+		return nil, fmt.Errorf("unable to write snapshot header: %w",
+			err)
+	}
+
+	for idx, uni := range universes {
+		err := a.exportSnapshotUniverse(ctx, snapshot, uint32(idx), uni)
+		if err != nil {
+			return nil, fmt.Errorf("unable to export universe "+
+				"%v: %w", uni.ID.StringForLog(), err)
+		}
+	}
+
+	if err := snapshot.Flush(); err != nil {
+		return nil, err
+	}
+
+	return universes, nil
+}
+
+// exportSnapshotUniverse writes all leaves of a single universe to the
+// snapshot, in the order in which they were inserted.
+func (a *Archive) exportSnapshotUniverse(ctx context.Context,
+	snapshot *SnapshotWriter, idx uint32, uni SnapshotUniverse) error {
+
+	for offset := int32(0); ; offset += MaxPageSize {
+		keys, err := a.UniverseLeafKeys(ctx, UniverseLeafKeysQuery{
+			Id:            uni.ID,
+			SortDirection: SortAscending,
+			Offset:        offset,
+			Limit:         MaxPageSize,
+		})
+		if err != nil {
+			return fmt.Errorf("unable to fetch leaf keys: %w", err)
+		}
+
+		for _, key := range keys {
+			proofs, err := a.FetchProofLeaf(ctx, uni.ID, key)
+			if err != nil {
+				return fmt.Errorf("unable to fetch proof for "+
+					"key %v: %w", key, err)
+			}
+			if len(proofs) != 1 {
+				return fmt.Errorf("expected one proof for key "+
+					"%v, got %d", key, len(proofs))
+			}
+
+			// If the universe changed since we've read its root,
+			// then the leaves we've already written might not
+			// match the root in the header anymore.
+			leafProof := proofs[0]
+			uniRoot := leafProof.UniverseRoot
+			if !mssmt.IsEqualNode(uniRoot, uni.Root) {
+				return fmt.Errorf("%w: universe changed "+
+					"during export",
+					ErrSnapshotRootMismatch)
+			}
+
+			err = snapshot.WriteLeaf(SnapshotLeaf{
+				UniverseIndex: idx,
+				Key:           key,
+				RawProof:      leafProof.Leaf.RawProof,
+			})
+			if err != nil {
+				return fmt.Errorf("unable to write leaf: %w",
+					err)
+			}
+		}
+
+		if len(keys) < MaxPageSize {
+			return nil
+		}
+	}
+}
+
+// ImportSnapshot reads a snapshot from the given reader and inserts all its
+// leaves into the archive, verifying all proofs. Once all leaves of a
+// universe are inserted, the resulting universe root is checked against the
+// root recorded in the snapshot.
+func (a *Archive) ImportSnapshot(ctx context.Context, r io.Reader,
+	batchSize int) ([]SnapshotImportResult, error) {
+
+	if batchSize <= 0 {
+		batchSize = DefaultSnapshotImportBatchSize
+	}
+
+	snapshot, err := NewSnapshotReader(r)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Infof("Importing snapshot of %d universes",
+		len(snapshot.Universes))
+
+	results := make([]SnapshotImportResult, len(snapshot.Universes))
+	for idx, uni := range snapshot.Universes {
+		results[idx].SnapshotUniverse = uni
+	}
+
+	var (
+		batch      []*Item
+		currentIdx = -1
+		done       = make([]bool, len(snapshot.Universes))
+	)
+
+	// flushBatch verifies and inserts the current batch of leaves.
+	flushBatch := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+
+		if err := a.UpsertProofLeafBatch(ctx, batch); err != nil {
+			return fmt.Errorf("unable to insert leaves: %w", err)
+		}
+		results[currentIdx].NumLeaves += uint64(len(batch))
+		batch = nil
+
+		return nil
+	}
+
+	// finishUniverse inserts the remaining leaves of the current universe
+	// and checks its root against the snapshot.
+	finishUniverse := func() error {
+		if currentIdx < 0 {
+			return nil
+		}
+		if err := flushBatch(); err != nil {
+			return err
+		}
+
+		uni := snapshot.Universes[currentIdx]
+		root, err := a.RootNode(ctx, uni.ID)
+		if err != nil {
+			return fmt.Errorf("unable to fetch root of universe "+
+				"%v: %w", uni.ID.StringForLog(), err)
+		}
+		if !mssmt.IsEqualNode(root.Node, uni.Root) {
+			return fmt.Errorf("%w: universe %v has root %v, "+
+				"expected %v", ErrSnapshotRootMismatch,
+				uni.ID.StringForLog(), root.NodeHash(),
+				uni.Root.NodeHash())
+		}
+
+		done[currentIdx] = true
+
+		return nil
+	}
+
+	for {
+		leaf, err := snapshot.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("unable to read snapshot "+
+				"leaf: %w", err)
+		}
+
+		// The leaves of a universe are written consecutively, so once
+		// we see a new universe, the previous one is complete.
+		idx := int(leaf.UniverseIndex)
+		if idx != currentIdx {
+			if done[idx] {
+				return nil, fmt.Errorf("leaves of universe %d "+
+					"aren't consecutive", idx)
+			}
+			if err := finishUniverse(); err != nil {
+				return nil, err
+			}
+			currentIdx = idx
+		}
+
+		uniLeaf, err := leafFromRawProof(leaf.RawProof)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode leaf %v: %w",
+				leaf.Key, err)
+		}
+
+		// The leaves must be admitted by our admission policy. As the
+		// root of each universe is checked against the snapshot, we
+		// can't skip a leaf, so we reject the whole import instead.
+		if a.cfg.Admission != nil {
+			err := a.cfg.Admission.CheckLeaf(ctx, uniLeaf)
+			if err != nil {
+				return nil, fmt.Errorf("unable to import leaf "+
+					"%v: %w", leaf.Key, err)
+			}
+		}
+
+		batch = append(batch, &Item{
+			ID:   snapshot.Universes[idx].ID,
+			Key:  leaf.Key,
+			Leaf: uniLeaf,
+		})
+		if len(batch) >= batchSize {
+			if err := flushBatch(); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if err := finishUniverse(); err != nil {
+		return nil, err
+	}
+
+	// Every universe in the header must have had at least one leaf, as
+	// there's no root for an empty universe.
+	for idx, uni := range snapshot.Universes {
+		if !done[idx] {
+			return nil, fmt.Errorf("snapshot contains no leaves "+
+				"for universe %v", uni.ID.StringForLog())
+		}
+	}
+
+	return results, nil
+}
+
+// leafFromRawProof creates a universe leaf from the given raw proof, pulling
+// the asset details from the proof itself.
+func leafFromRawProof(rawProof proof.Blob) (*Leaf, error) {
+	var proofAsset asset.Asset
+	assetRecord := proof.AssetLeafRecord(&proofAsset)
+	err := proof.SparseDecode(bytes.NewReader(rawProof), assetRecord)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Leaf{
+		GenesisWithGroup: GenesisWithGroup{
+			Genesis:  proofAsset.Genesis,
+			GroupKey: proofAsset.GroupKey,
+		},
+		RawProof: rawProof,
+		Asset:    &proofAsset,
+		Amt:      proofAsset.Amount,
+	}, nil
+}
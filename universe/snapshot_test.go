@@ -0,0 +1,102 @@
+package universe
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/internal/test"
+	"github.com/lightninglabs/taproot-assets/mssmt"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSnapshotEncoding tests that a universe snapshot can be written and read
+// back, and that a truncated snapshot is rejected.
+func TestSnapshotEncoding(t *testing.T) {
+	t.Parallel()
+
+	universes := []SnapshotUniverse{{
+		ID: Identifier{
+			AssetID:   asset.RandID(t),
+			ProofType: ProofTypeIssuance,
+		},
+		Root: mssmt.NewComputedNode(
+			mssmt.NodeHash(test.RandHash()), 1000,
+		),
+	}, {
+		ID: Identifier{
+			GroupKey:  test.RandPubKey(t),
+			ProofType: ProofTypeTransfer,
+		},
+		Root: mssmt.NewComputedNode(
+			mssmt.NodeHash(test.RandHash()), 42,
+		),
+	}}
+
+	var leaves []SnapshotLeaf
+	for i := 0; i < 5; i++ {
+		key, leaf := randLeaf(t)
+		leaves = append(leaves, SnapshotLeaf{
+			UniverseIndex: uint32(i % len(universes)),
+			Key:           key,
+			RawProof:      leaf.RawProof,
+		})
+	}
+
+	var buf bytes.Buffer
+	writer, err := NewSnapshotWriter(&buf, universes)
+	require.NoError(t, err)
+	for _, leaf := range leaves {
+		require.NoError(t, writer.WriteLeaf(leaf))
+	}
+	require.NoError(t, writer.Flush())
+
+	// A leaf can't reference a universe that isn't in the header.
+	err = writer.WriteLeaf(SnapshotLeaf{
+		UniverseIndex: uint32(len(universes)),
+		Key:           leaves[0].Key,
+	})
+	require.ErrorContains(t, err, "invalid universe index")
+
+	snapshotBytes := buf.Bytes()
+	reader, err := NewSnapshotReader(bytes.NewReader(snapshotBytes))
+	require.NoError(t, err)
+	require.Len(t, reader.Universes, len(universes))
+
+	for i, uni := range universes {
+		readUni := reader.Universes[i]
+		require.Equal(t, uni.ID.String(), readUni.ID.String())
+		require.Equal(t, uni.ID.ProofType, readUni.ID.ProofType)
+		require.True(t, mssmt.IsEqualNode(uni.Root, readUni.Root))
+	}
+
+	for _, leaf := range leaves {
+		readLeaf, err := reader.Next()
+		require.NoError(t, err)
+		require.Equal(t, leaf.UniverseIndex, readLeaf.UniverseIndex)
+		require.Equal(
+			t, leaf.Key.UniverseKey(), readLeaf.Key.UniverseKey(),
+		)
+		require.Equal(t, leaf.RawProof, readLeaf.RawProof)
+	}
+
+	_, err = reader.Next()
+	require.ErrorIs(t, err, io.EOF)
+
+	// A snapshot that ends within a leaf is invalid.
+	reader, err = NewSnapshotReader(
+		bytes.NewReader(snapshotBytes[:len(snapshotBytes)-1]),
+	)
+	require.NoError(t, err)
+	for i := 0; i < len(leaves)-1; i++ {
+		_, err = reader.Next()
+		require.NoError(t, err)
+	}
+	_, err = reader.Next()
+	require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+
+	// Anything that isn't a snapshot should be rejected.
+	_, err = NewSnapshotReader(bytes.NewReader(test.RandBytes(64)))
+	require.ErrorContains(t, err, "not a universe snapshot")
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"sort"
 	"sync/atomic"
+	"time"
 
 	"github.com/davecgh/go-spew/spew"
 	"github.com/lightninglabs/taproot-assets/fn"
@@ -23,6 +24,12 @@ var (
 	// ErrUnsupportedSync is returned when a syncer is asked to async in a
 	// way that it does not support.
 	ErrUnsupportedSync = fmt.Errorf("unsupported sync type")
+
+	// ErrTreeNodesUnsupported is returned by a diff engine that isn't able
+	// to return the individual nodes of a universe tree, for example
+	// because the remote server doesn't support the RPC yet.
+	ErrTreeNodesUnsupported = errors.New("universe tree nodes not " +
+		"supported")
 )
 
 // SimpleSyncCfg contains all the configuration needed to create a new
@@ -43,10 +50,31 @@ type SimpleSyncCfg struct {
 
 	// SyncBatchSize is the number of items to sync in a single batch.
 	SyncBatchSize int
+
+	// DivergenceLog is used to record universes whose local root still
+	// doesn't match the remote root after a sync.
+	DivergenceLog FederationDivergenceLog
+
+	// PrunedLeaves is used to skip the remote leaves that were pruned
+	// locally under the transfer retention policy. These leaves are
+	// neither synced again nor reported as a divergence.
+	PrunedLeaves PrunedLeafIndex
+
+	// Admission, if set, is used to skip the remote leaves that aren't
+	// admitted by the rules or the maximum proof size of our admission
+	// policy. Rejected leaves are neither fetched again by later syncs
+	// nor reported as a divergence.
+	Admission *AdmissionController
+
+	// ServerHealth, if set, is used to record the round-trip time of the
+	// calls that fetch the roots of the remote universes.
+	ServerHealth *ServerHealthTracker
 }
 
-// SimpleSyncer is a simple implementation of the Syncer interface. It's based
-// on a set difference operation between the local and remote Universe.
+// SimpleSyncer is a simple implementation of the Syncer interface. It finds
+// the leaves that are missing locally by bisecting the local and remote
+// Universe trees, or by a set difference operation between the leaf keys of
+// both Universes if the remote doesn't support bisection.
 type SimpleSyncer struct {
 	cfg SimpleSyncCfg
 
@@ -237,31 +265,25 @@ func (s *SimpleSyncer) syncRoot(ctx context.Context, remoteRoot Root,
 		return fmt.Errorf("unable to fetch local root: %w", err)
 	}
 
-	log.Infof("UniverseRoot(%v) diverges, performing leaf diff...",
+	log.Infof("UniverseRoot(%v) diverges, bisecting universe tree...",
 		uniID.String())
 
-	// Otherwise, we'll need to perform a diff operation to find the set of
-	// keys we need to fetch. We'll start by fetching the set of keys from
-	// both the local and remote Universe.
-	var (
-		remoteUniKeys []LeafKey
-		localUniKeys  []LeafKey
+	// Otherwise, we'll walk down both trees to find the set of keys we
+	// need to fetch. Servers that don't support fetching tree nodes yet
+	// require us to diff the full set of keys instead.
+	keysToFetch, err := s.bisectLeafKeys(
+		ctx, diffEngine, uniID, s.missingLeafKeys,
 	)
+	if errors.Is(err, ErrTreeNodesUnsupported) {
+		log.Infof("UniverseRoot(%v): remote doesn't support tree "+
+			"bisection, performing leaf diff...", uniID.String())
 
-	remoteUniKeys, err = s.fetchAllLeafKeys(ctx, diffEngine, uniID)
-	if err != nil {
-		return err
+		keysToFetch, err = s.diffLeafKeys(ctx, diffEngine, uniID)
 	}
-
-	localUniKeys, err = s.fetchAllLeafKeys(ctx, s.cfg.LocalDiffEngine, uniID)
 	if err != nil {
 		return err
 	}
 
-	// With the set of keys fetched, we can now find the set of keys that
-	// need to be synced.
-	keysToFetch := fn.SetDiff(remoteUniKeys, localUniKeys)
-
 	log.Infof("UniverseRoot(%v): diff_size=%v", uniID.String(),
 		len(keysToFetch))
 	log.Tracef("UniverseRoot(%v): diff_size=%v, diff=%v", uniID.String(),
@@ -378,7 +400,14 @@ func (s *SimpleSyncer) syncRoot(ctx context.Context, remoteRoot Root,
 	log.Infof("Universe sync for UniverseRoot(%v) complete, %d "+
 		"new leaves inserted", uniID.String(), len(keysToFetch))
 
-	// TODO(roabseef): sanity check local and remote roots match now?
+	// Now that we have all the remote leaves, our root should match the
+	// remote root, unless we have additional leaves. A failure to verify
+	// doesn't invalidate the leaves we just inserted, so we only warn.
+	divergence, err := s.verifyRoot(ctx, diffEngine, remoteRoot)
+	if err != nil {
+		log.Warnf("UniverseRoot(%v): unable to verify root after "+
+			"sync: %v", uniID.String(), err)
+	}
 
 	// To wrap up, we'll collect the set of leaves then convert them into a
 	// final sync diff.
@@ -386,6 +415,7 @@ func (s *SimpleSyncer) syncRoot(ctx context.Context, remoteRoot Root,
 		OldUniverseRoot: localRoot,
 		NewUniverseRoot: remoteRoot,
 		NewLeafProofs:   newLeafProofs,
+		Divergence:      divergence,
 	}
 
 	log.Infof("Sync for UniverseRoot(%v) complete!", uniID.String())
@@ -395,6 +425,375 @@ func (s *SimpleSyncer) syncRoot(ctx context.Context, remoteRoot Root,
 	return nil
 }
 
+// diffLeafKeys fetches the full set of keys from both the local and remote
+// Universe and returns the keys that are only known to the remote Universe.
+func (s *SimpleSyncer) diffLeafKeys(ctx context.Context, diffEngine DiffEngine,
+	uniID Identifier) ([]LeafKey, error) {
+
+	remoteUniKeys, err := s.fetchAllLeafKeys(ctx, diffEngine, uniID)
+	if err != nil {
+		return nil, err
+	}
+
+	localUniKeys, err := s.fetchAllLeafKeys(
+		ctx, s.cfg.LocalDiffEngine, uniID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.syncableLeafKeys(
+		ctx, uniID, fn.SetDiff(remoteUniKeys, localUniKeys),
+	)
+}
+
+// syncableLeafKeys returns the subset of the given remote leaf keys whose
+// leaves we want to sync. Leaves that were pruned from the local Universe, or
+// that our admission policy rejected during an earlier sync, are skipped.
+func (s *SimpleSyncer) syncableLeafKeys(ctx context.Context, uniID Identifier,
+	leafKeys []LeafKey) ([]LeafKey, error) {
+
+	if len(leafKeys) == 0 {
+		return leafKeys, nil
+	}
+
+	skippedKeys, err := s.cfg.PrunedLeaves.PrunedLeafKeys(
+		ctx, uniID, leafKeys,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch pruned leaves: %w", err)
+	}
+
+	if s.cfg.Admission != nil {
+		rejectedKeys, err := s.cfg.Admission.RejectedLeafKeys(
+			ctx, uniID, leafKeys,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to fetch rejected "+
+				"leaves: %w", err)
+		}
+
+		skippedKeys = append(skippedKeys, rejectedKeys...)
+	}
+
+	if len(skippedKeys) == 0 {
+		return leafKeys, nil
+	}
+
+	skippedSet := fn.NewSet(fn.Map(
+		skippedKeys, func(key LeafKey) [32]byte {
+			return key.UniverseKey()
+		},
+	)...)
+
+	return fn.Filter(leafKeys, func(key LeafKey) bool {
+		return !skippedSet.Contains(key.UniverseKey())
+	}), nil
+}
+
+// leafKeyFilter returns the subset of the given keys of remote leaves that
+// the caller of bisectLeafKeys is interested in.
+type leafKeyFilter func(ctx context.Context, uniID Identifier,
+	leafKeys []LeafKey) ([]LeafKey, error)
+
+// bisectLeafKeys walks down the remote and local Universe trees level by
+// level, starting at the root, and returns the keys of the remote leaves in
+// differing subtrees that pass the given filter. Subtrees with matching roots
+// are skipped, so the number of nodes we fetch is proportional to the size of
+// the difference rather than the size of the Universe.
+func (s *SimpleSyncer) bisectLeafKeys(ctx context.Context,
+	diffEngine DiffEngine, uniID Identifier,
+	filterKeys leafKeyFilter) ([]LeafKey, error) {
+
+	var (
+		keysToFetch []LeafKey
+		paths       = []TreeNodePath{{}}
+	)
+	for depth := 0; len(paths) > 0; depth++ {
+		remoteNodes, err := fetchTreeNodes(ctx, diffEngine, uniID, paths)
+		if err != nil {
+			return nil, err
+		}
+
+		localNodes, err := fetchTreeNodes(
+			ctx, s.cfg.LocalDiffEngine, uniID, paths,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		var (
+			nextPaths []TreeNodePath
+			leafKeys  []LeafKey
+		)
+		for i, remoteNode := range remoteNodes {
+			remoteHash := remoteNode.Node.NodeHash()
+			emptyHash := mssmt.EmptyTree[depth].NodeHash()
+
+			switch {
+			// If both subtrees match, or the remote subtree is
+			// empty, then there's nothing for us to fetch.
+			case remoteHash == localNodes[i].Node.NodeHash(),
+				remoteHash == emptyHash:
+
+				continue
+
+			// If the remote subtree only contains a single leaf,
+			// then we only need to check whether we have that leaf
+			// already.
+			case remoteNode.LeafKey != nil:
+				leafKeys = append(leafKeys, *remoteNode.LeafKey)
+
+			case depth == mssmt.MaxTreeLevels:
+				return nil, fmt.Errorf("missing leaf key for "+
+					"remote leaf %x", remoteNode.Path.Prefix)
+
+			default:
+				left, right := remoteNode.Path.Children()
+				nextPaths = append(nextPaths, left, right)
+			}
+		}
+
+		filteredKeys, err := filterKeys(ctx, uniID, leafKeys)
+		if err != nil {
+			return nil, err
+		}
+		keysToFetch = append(keysToFetch, filteredKeys...)
+
+		log.Debugf("UniverseRoot(%v): bisected depth=%d, "+
+			"num_branches=%d, num_leaves=%d, num_selected=%d",
+			uniID.String(), depth, len(nextPaths)/2, len(leafKeys),
+			len(filteredKeys))
+
+		paths = nextPaths
+	}
+
+	return keysToFetch, nil
+}
+
+// missingLeafKeys returns the subset of the given leaf keys that aren't found
+// in the local Universe tree, and that we want to sync.
+func (s *SimpleSyncer) missingLeafKeys(ctx context.Context, uniID Identifier,
+	leafKeys []LeafKey) ([]LeafKey, error) {
+
+	if len(leafKeys) == 0 {
+		return nil, nil
+	}
+
+	leafPaths := fn.Map(leafKeys, func(key LeafKey) TreeNodePath {
+		return TreeNodePath{
+			Depth:  mssmt.MaxTreeLevels,
+			Prefix: key.UniverseKey(),
+		}
+	})
+	localLeaves, err := fetchTreeNodes(
+		ctx, s.cfg.LocalDiffEngine, uniID, leafPaths,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var missingKeys []LeafKey
+	for i, localLeaf := range localLeaves {
+		if localLeaf.LeafKey == nil {
+			missingKeys = append(missingKeys, leafKeys[i])
+		}
+	}
+
+	return s.syncableLeafKeys(ctx, uniID, missingKeys)
+}
+
+// conflictingLeafKeys returns the subset of the given remote leaf keys that
+// are either missing in the local Universe tree or that have a different
+// value locally. Leaves that we don't want to sync aren't conflicting.
+func (s *SimpleSyncer) conflictingLeafKeys(ctx context.Context,
+	diffEngine DiffEngine, uniID Identifier,
+	leafKeys []LeafKey) ([]LeafKey, error) {
+
+	if len(leafKeys) == 0 {
+		return nil, nil
+	}
+
+	leafPaths := fn.Map(leafKeys, func(key LeafKey) TreeNodePath {
+		return TreeNodePath{
+			Depth:  mssmt.MaxTreeLevels,
+			Prefix: key.UniverseKey(),
+		}
+	})
+	remoteLeaves, err := fetchTreeNodes(ctx, diffEngine, uniID, leafPaths)
+	if err != nil {
+		return nil, err
+	}
+	localLeaves, err := fetchTreeNodes(
+		ctx, s.cfg.LocalDiffEngine, uniID, leafPaths,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var conflictingKeys []LeafKey
+	for i, localLeaf := range localLeaves {
+		remoteHash := remoteLeaves[i].Node.NodeHash()
+		if localLeaf.LeafKey == nil ||
+			localLeaf.Node.NodeHash() != remoteHash {
+
+			conflictingKeys = append(conflictingKeys, leafKeys[i])
+		}
+	}
+
+	return s.syncableLeafKeys(ctx, uniID, conflictingKeys)
+}
+
+// verifyRoot compares the local root of a Universe with the given remote root
+// after a sync. If the roots don't match because the remote has leaves that
+// we still don't have or that differ from ours, then a divergence is
+// returned. Leaves that are only known locally are expected, as we may have
+// synced them from other servers. Likewise, remote leaves that we don't want
+// to sync are expected.
+func (s *SimpleSyncer) verifyRoot(ctx context.Context, diffEngine DiffEngine,
+	remoteRoot Root) (*Divergence, error) {
+
+	uniID := remoteRoot.ID
+	localRoot, err := s.cfg.LocalDiffEngine.RootNode(ctx, uniID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch local root: %w", err)
+	}
+
+	if mssmt.IsEqualNode(localRoot, remoteRoot) {
+		return nil, nil
+	}
+
+	// The remote may have received new leaves while we were syncing, in
+	// which case the difference doesn't tell us anything.
+	currentRemoteRoot, err := diffEngine.RootNode(ctx, uniID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch remote root: %w", err)
+	}
+	if !mssmt.IsEqualNode(currentRemoteRoot, remoteRoot) {
+		log.Debugf("UniverseRoot(%v): remote root changed during "+
+			"sync, skipping verification", uniID.String())
+
+		return nil, nil
+	}
+
+	var (
+		conflictingKeys []LeafKey
+		unknownKeys     bool
+	)
+	conflictingKeys, err = s.bisectLeafKeys(
+		ctx, diffEngine, uniID, func(ctx context.Context,
+			uniID Identifier, keys []LeafKey) ([]LeafKey, error) {
+
+			return s.conflictingLeafKeys(
+				ctx, diffEngine, uniID, keys,
+			)
+		},
+	)
+	if errors.Is(err, ErrTreeNodesUnsupported) {
+		conflictingKeys, unknownKeys, err = s.diffLeafKeySets(
+			ctx, diffEngine, uniID,
+		)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if len(conflictingKeys) == 0 && !unknownKeys {
+		log.Debugf("UniverseRoot(%v): local root differs only by "+
+			"local leaves", uniID.String())
+
+		return nil, nil
+	}
+
+	return &Divergence{
+		ID:              uniID,
+		LocalRoot:       localRoot.Node,
+		RemoteRoot:      remoteRoot.Node,
+		ConflictingKeys: conflictingKeys,
+		DetectedAt:      time.Now().UTC(),
+	}, nil
+}
+
+// diffLeafKeySets compares the full set of leaf keys of the local and remote
+// Universe, for remotes that don't support fetching tree nodes. The keys that
+// are only known to the remote are returned as conflicting keys. Leaves that
+// differ in value can't be identified this way, so if both Universes have
+// exactly the same keys, then the conflicting leaves are unknown. Remote keys
+// of leaves that we don't want to sync aren't conflicting.
+func (s *SimpleSyncer) diffLeafKeySets(ctx context.Context,
+	diffEngine DiffEngine, uniID Identifier) ([]LeafKey, bool, error) {
+
+	remoteUniKeys, err := s.fetchAllLeafKeys(ctx, diffEngine, uniID)
+	if err != nil {
+		return nil, false, err
+	}
+
+	localUniKeys, err := s.fetchAllLeafKeys(
+		ctx, s.cfg.LocalDiffEngine, uniID,
+	)
+	if err != nil {
+		return nil, false, err
+	}
+
+	// The leaf keys are compared by their universe key, as the script
+	// keys are pointers.
+	localKeySet := fn.NewSet(fn.Map(
+		localUniKeys, func(key LeafKey) [32]byte {
+			return key.UniverseKey()
+		},
+	)...)
+
+	var missingKeys []LeafKey
+	for _, key := range remoteUniKeys {
+		if !localKeySet.Contains(key.UniverseKey()) {
+			missingKeys = append(missingKeys, key)
+		}
+	}
+
+	conflictingKeys, err := s.syncableLeafKeys(ctx, uniID, missingKeys)
+	if err != nil {
+		return nil, false, err
+	}
+
+	// If we skipped some of the remote leaves, then the roots are expected
+	// to differ, and we can't tell whether any other leaf differs as well.
+	numSkipped := len(missingKeys) - len(conflictingKeys)
+	unknownKeys := len(conflictingKeys) == 0 && numSkipped == 0 &&
+		len(localKeySet) == len(remoteUniKeys)
+
+	return conflictingKeys, unknownKeys, nil
+}
+
+// fetchTreeNodes fetches the tree nodes at the given paths from the diff
+// engine, splitting the paths into pages if required.
+func fetchTreeNodes(ctx context.Context, diffEngine DiffEngine,
+	uniID Identifier, paths []TreeNodePath) ([]TreeNode, error) {
+
+	nodes := make([]TreeNode, 0, len(paths))
+	for start := 0; start < len(paths); start += int(defaultPageSize) {
+		end := start + int(defaultPageSize)
+		if end > len(paths) {
+			end = len(paths)
+		}
+
+		pageNodes, err := diffEngine.UniverseTreeNodes(
+			ctx, uniID, paths[start:end],
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(pageNodes) != end-start {
+			return nil, fmt.Errorf("expected %d tree nodes, got %d",
+				end-start, len(pageNodes))
+		}
+
+		nodes = append(nodes, pageNodes...)
+	}
+
+	return nodes, nil
+}
+
 // batchStreamNewItems streams the set of new items to the local registrar in
 // batches and returns the new leaf proofs.
 func (s *SimpleSyncer) batchStreamNewItems(ctx context.Context,
@@ -410,11 +809,16 @@ func (s *SimpleSyncer) batchStreamNewItems(ctx context.Context,
 		func(ctx context.Context, batch []*Item) error {
 			numItems += len(batch)
 
+			batch, err := s.admittedItems(ctx, uniID, batch)
+			if err != nil {
+				return err
+			}
+
 			log.Debugf("UniverseRoot(%v): Inserting %d new leaves "+
 				"(%d of %d)", uniID.String(), len(batch),
 				numItems, numTotal)
 
-			err := s.cfg.LocalRegistrar.UpsertProofLeafBatch(
+			err = s.cfg.LocalRegistrar.UpsertProofLeafBatch(
 				ctx, batch,
 			)
 			if err != nil {
@@ -445,6 +849,19 @@ func (s *SimpleSyncer) batchStreamNewItems(ctx context.Context,
 	return newLeafProofs, nil
 }
 
+// admittedItems returns the items of the given batch that are admitted by our
+// admission policy. The leaves that aren't admitted are skipped, and recorded
+// so later syncs don't fetch them again.
+func (s *SimpleSyncer) admittedItems(ctx context.Context, uniID Identifier,
+	batch []*Item) ([]*Item, error) {
+
+	if s.cfg.Admission == nil {
+		return batch, nil
+	}
+
+	return s.cfg.Admission.AdmitSyncedItems(ctx, uniID, batch)
+}
+
 // SyncUniverse attempts to synchronize the local universe with the remote
 // universe, governed by the sync type and the set of universe IDs to sync.
 func (s *SimpleSyncer) SyncUniverse(ctx context.Context, host ServerAddr,
@@ -463,9 +880,88 @@ func (s *SimpleSyncer) SyncUniverse(ctx context.Context, host ServerAddr,
 	}
 	defer diffEngine.Close()
 
+	if s.cfg.ServerHealth != nil {
+		diffEngine = &roundTripDiffEngine{
+			DiffEngine: diffEngine,
+			host:       host,
+			health:     s.cfg.ServerHealth,
+		}
+	}
+
 	// With the engine created, we can now sync the local Universe with the
 	// remote instance.
-	return s.executeSync(ctx, diffEngine, syncType, syncConfigs, idsToSync)
+	syncDiffs, err := s.executeSync(
+		ctx, diffEngine, syncType, syncConfigs, idsToSync,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Finally, we'll record any divergence we found, so it can be
+	// inspected later on.
+	for _, syncDiff := range syncDiffs {
+		divergence := syncDiff.Divergence
+		if divergence == nil {
+			continue
+		}
+
+		divergence.ServerHost = host.HostStr()
+
+		log.Warnf("UniverseRoot(%v) diverges from server %v after "+
+			"sync: local_root=%x, remote_root=%x, "+
+			"num_conflicting_leaves=%d", divergence.ID.String(),
+			divergence.ServerHost,
+			divergence.LocalRoot.NodeHash(),
+			divergence.RemoteRoot.NodeHash(),
+			len(divergence.ConflictingKeys))
+
+		err := s.cfg.DivergenceLog.LogDivergence(ctx, divergence)
+		if err != nil {
+			return nil, fmt.Errorf("unable to log divergence: %w",
+				err)
+		}
+	}
+
+	return syncDiffs, nil
+}
+
+// roundTripDiffEngine is a remote diff engine that records the round-trip time
+// of the calls that fetch universe roots. Unlike the other calls, their
+// duration doesn't depend on the size of the universes, so they're a good
+// measure of the latency of the server.
+type roundTripDiffEngine struct {
+	DiffEngine
+
+	host   ServerAddr
+	health *ServerHealthTracker
+}
+
+// RootNode returns the root node of the remote universe with the given ID,
+// recording the round-trip time of the call.
+func (r *roundTripDiffEngine) RootNode(ctx context.Context,
+	id Identifier) (Root, error) {
+
+	start := time.Now()
+	root, err := r.DiffEngine.RootNode(ctx, id)
+	if err == nil {
+		r.health.RecordRoundTrip(r.host, time.Since(start))
+	}
+
+	return root, err
+}
+
+// RootNodes returns a page of the root nodes of the remote universes,
+// recording the round-trip time of the call.
+func (r *roundTripDiffEngine) RootNodes(ctx context.Context,
+	q RootNodesQuery) ([]Root, error) {
+
+	start := time.Now()
+	roots, err := r.DiffEngine.RootNodes(ctx, q)
+	if err == nil {
+		r.health.RecordRoundTrip(r.host, time.Since(start))
+	}
+
+	return roots, err
 }
 
 // fetchAllRoots fetches all the roots from the remote Universe. This function
@@ -0,0 +1,722 @@
+package universe
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/taproot-assets/asset"
+	"github.com/lightninglabs/taproot-assets/fn"
+	"github.com/lightninglabs/taproot-assets/mssmt"
+	"github.com/stretchr/testify/require"
+)
+
+// mockLeaf is a leaf stored in the mock diff engine.
+type mockLeaf struct {
+	key  LeafKey
+	leaf *Leaf
+}
+
+// mockDiffEngine is a diff engine and registrar that is backed by an
+// in-memory universe tree.
+type mockDiffEngine struct {
+	t *testing.T
+
+	// noTreeNodes indicates that the engine doesn't support fetching
+	// tree nodes, like an old universe server.
+	noTreeNodes bool
+
+	// rootDelay is the time it takes to return the root of the tree.
+	rootDelay time.Duration
+
+	mu     sync.Mutex
+	tree   *mssmt.CompactedTree
+	leaves map[[32]byte]mockLeaf
+	keys   []LeafKey
+	pruned map[[32]byte]struct{}
+
+	numTreeNodes  atomic.Int32
+	numKeyQueries atomic.Int32
+	numProofs     atomic.Int32
+}
+
+// newMockDiffEngine creates a new mock diff engine with an empty tree.
+func newMockDiffEngine(t *testing.T) *mockDiffEngine {
+	return &mockDiffEngine{
+		t:      t,
+		tree:   mssmt.NewCompactedTree(mssmt.NewDefaultStore()),
+		leaves: make(map[[32]byte]mockLeaf),
+		pruned: make(map[[32]byte]struct{}),
+	}
+}
+
+// insert inserts the given leaf into the tree.
+func (m *mockDiffEngine) insert(key LeafKey, leaf *Leaf) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	smtKey := key.UniverseKey()
+	_, err := m.tree.Insert(
+		context.Background(), smtKey, leaf.SmtLeafNode(),
+	)
+	require.NoError(m.t, err)
+
+	m.leaves[smtKey] = mockLeaf{key: key, leaf: leaf}
+	m.keys = append(m.keys, key)
+}
+
+// prune removes the leaf with the given key from the tree, and leaves a
+// tombstone for it.
+func (m *mockDiffEngine) prune(key LeafKey) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	smtKey := key.UniverseKey()
+	_, err := m.tree.Delete(context.Background(), smtKey)
+	require.NoError(m.t, err)
+
+	delete(m.leaves, smtKey)
+	m.keys = fn.Filter(m.keys, func(k LeafKey) bool {
+		return k.UniverseKey() != smtKey
+	})
+	m.pruned[smtKey] = struct{}{}
+}
+
+// root returns the root of the tree. Like a database backed tree, only the
+// hash and sum of the root are returned.
+func (m *mockDiffEngine) root() mssmt.Node {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	root, err := m.tree.Root(context.Background())
+	require.NoError(m.t, err)
+
+	return mssmt.NewComputedNode(root.NodeHash(), root.NodeSum())
+}
+
+// RootNode returns the root of the tree.
+func (m *mockDiffEngine) RootNode(_ context.Context,
+	id Identifier) (Root, error) {
+
+	time.Sleep(m.rootDelay)
+
+	return Root{ID: id, Node: m.root()}, nil
+}
+
+// RootNodes isn't used by the syncer for a single universe.
+func (m *mockDiffEngine) RootNodes(context.Context,
+	RootNodesQuery) ([]Root, error) {
+
+	return nil, nil
+}
+
+// UniverseLeafKeys returns a page of the inserted leaf keys.
+func (m *mockDiffEngine) UniverseLeafKeys(_ context.Context,
+	q UniverseLeafKeysQuery) ([]LeafKey, error) {
+
+	m.numKeyQueries.Add(1)
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if int(q.Offset) >= len(m.keys) {
+		return nil, nil
+	}
+
+	end := int(q.Offset + q.Limit)
+	if end > len(m.keys) {
+		end = len(m.keys)
+	}
+
+	return m.keys[q.Offset:end], nil
+}
+
+// FetchProofLeaf returns the leaf for the given key, along with its
+// inclusion proof.
+func (m *mockDiffEngine) FetchProofLeaf(ctx context.Context, _ Identifier,
+	key LeafKey) ([]*Proof, error) {
+
+	m.numProofs.Add(1)
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	smtKey := key.UniverseKey()
+	inclusionProof, err := m.tree.MerkleProof(ctx, smtKey)
+	require.NoError(m.t, err)
+
+	root, err := m.tree.Root(ctx)
+	require.NoError(m.t, err)
+
+	return []*Proof{{
+		LeafKey: key,
+		Leaf:    m.leaves[smtKey].leaf,
+		UniverseRoot: mssmt.NewComputedNode(
+			root.NodeHash(), root.NodeSum(),
+		),
+		UniverseInclusionProof: inclusionProof,
+	}}, nil
+}
+
+// UniverseTreeNodes returns the nodes of the tree at the given paths.
+func (m *mockDiffEngine) UniverseTreeNodes(ctx context.Context, _ Identifier,
+	paths []TreeNodePath) ([]TreeNode, error) {
+
+	if m.noTreeNodes {
+		return nil, ErrTreeNodesUnsupported
+	}
+
+	m.numTreeNodes.Add(int32(len(paths)))
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	nodes := make([]TreeNode, len(paths))
+	for i, path := range paths {
+		node, err := m.tree.SubtreeRoot(
+			ctx, int(path.Depth), path.Prefix,
+		)
+		require.NoError(m.t, err)
+
+		nodes[i] = TreeNode{
+			Path: path,
+			Node: mssmt.NewComputedNode(
+				node.NodeHash(), node.NodeSum(),
+			),
+		}
+
+		if compLeaf, ok := node.(*mssmt.CompactedLeafNode); ok {
+			leafKey := m.leaves[compLeaf.Key()].key
+			nodes[i].LeafKey = &leafKey
+		}
+	}
+
+	return nodes, nil
+}
+
+// UpsertProofLeaf inserts a single leaf into the tree.
+func (m *mockDiffEngine) UpsertProofLeaf(_ context.Context, _ Identifier,
+	key LeafKey, leaf *Leaf) (*Proof, error) {
+
+	m.insert(key, leaf)
+
+	return nil, nil
+}
+
+// UpsertProofLeafBatch inserts a batch of leaves into the tree.
+func (m *mockDiffEngine) UpsertProofLeafBatch(_ context.Context,
+	items []*Item) error {
+
+	for _, item := range items {
+		m.insert(item.Key, item.Leaf)
+	}
+
+	return nil
+}
+
+// PrunedLeafKeys returns the subset of the given keys whose leaves were
+// pruned.
+func (m *mockDiffEngine) PrunedLeafKeys(_ context.Context, _ Identifier,
+	leafKeys []LeafKey) ([]LeafKey, error) {
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return fn.Filter(leafKeys, func(key LeafKey) bool {
+		_, ok := m.pruned[key.UniverseKey()]
+		return ok
+	}), nil
+}
+
+// Close is a no-op.
+func (m *mockDiffEngine) Close() error {
+	return nil
+}
+
+// TestSyncRootBisection tests that a universe is synced by bisecting the
+// remote tree, and that the syncer falls back to diffing the leaf keys if the
+// remote doesn't support fetching tree nodes.
+func TestSyncRootBisection(t *testing.T) {
+	t.Parallel()
+
+	const (
+		numShared  = 100
+		numMissing = 5
+		numExtra   = 3
+	)
+
+	testCases := []struct {
+		name        string
+		noTreeNodes bool
+		numExtra    int
+	}{{
+		name: "missing leaves",
+	}, {
+		name:     "missing and extra leaves",
+		numExtra: numExtra,
+	}, {
+		name:        "fallback to leaf key diff",
+		noTreeNodes: true,
+		numExtra:    numExtra,
+	}}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			id := Identifier{
+				AssetID:   asset.RandID(t),
+				ProofType: ProofTypeIssuance,
+			}
+
+			remote := newMockDiffEngine(t)
+			remote.noTreeNodes = tc.noTreeNodes
+			local := newMockDiffEngine(t)
+
+			for i := 0; i < numShared; i++ {
+				key, leaf := randLeaf(t)
+				remote.insert(key, leaf)
+				local.insert(key, leaf)
+			}
+			for i := 0; i < numMissing; i++ {
+				remote.insert(randLeaf(t))
+			}
+			for i := 0; i < tc.numExtra; i++ {
+				local.insert(randLeaf(t))
+			}
+
+			syncer := NewSimpleSyncer(SimpleSyncCfg{
+				LocalDiffEngine: local,
+				LocalRegistrar:  local,
+				SyncBatchSize:   10,
+				PrunedLeaves:    local,
+			})
+
+			remoteRoot, err := remote.RootNode(ctx, id)
+			require.NoError(t, err)
+
+			result := make(chan AssetSyncDiff, 1)
+			err = syncer.syncRoot(ctx, remoteRoot, remote, result)
+			require.NoError(t, err)
+
+			// Only the missing leaves should have been fetched.
+			// Extra local leaves don't cause a divergence.
+			syncDiff := <-result
+			require.Len(t, syncDiff.NewLeafProofs, numMissing)
+			require.Nil(t, syncDiff.Divergence)
+			require.EqualValues(
+				t, numMissing, remote.numProofs.Load(),
+			)
+
+			// Without any extra local leaves, both trees should
+			// now be identical.
+			if tc.numExtra == 0 {
+				require.True(t, mssmt.IsEqualNode(
+					remote.root(), local.root(),
+				))
+			}
+
+			// The full set of keys should only have been fetched
+			// if the remote doesn't support bisection.
+			if tc.noTreeNodes {
+				require.NotZero(t, remote.numKeyQueries.Load())
+				return
+			}
+
+			require.Zero(t, remote.numKeyQueries.Load())
+			require.Zero(t, local.numKeyQueries.Load())
+
+			// We should only have looked at a fraction of the
+			// nodes of the remote tree.
+			require.Less(
+				t, int(remote.numTreeNodes.Load()),
+				2*(numShared+numMissing),
+			)
+		})
+	}
+}
+
+// TestSyncRootDivergence tests that a divergence is reported if the local root
+// doesn't match the remote root after a sync, because the remote has a
+// different leaf for a key we already know.
+func TestSyncRootDivergence(t *testing.T) {
+	t.Parallel()
+
+	const (
+		numShared      = 50
+		numMissing     = 3
+		numConflicting = 2
+	)
+
+	testCases := []struct {
+		name        string
+		noTreeNodes bool
+	}{{
+		name: "bisection",
+	}, {
+		name:        "leaf key diff",
+		noTreeNodes: true,
+	}}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			id := Identifier{
+				AssetID:   asset.RandID(t),
+				ProofType: ProofTypeIssuance,
+			}
+
+			remote := newMockDiffEngine(t)
+			remote.noTreeNodes = tc.noTreeNodes
+			local := newMockDiffEngine(t)
+
+			for i := 0; i < numShared; i++ {
+				key, leaf := randLeaf(t)
+				remote.insert(key, leaf)
+				local.insert(key, leaf)
+			}
+			for i := 0; i < numMissing; i++ {
+				remote.insert(randLeaf(t))
+			}
+
+			// The remote has a different leaf for some keys that
+			// we already know, so we'll never sync those.
+			conflictingKeys := make(map[[32]byte]struct{})
+			for i := 0; i < numConflicting; i++ {
+				key, leaf := randLeaf(t)
+				_, otherLeaf := randLeaf(t)
+
+				remote.insert(key, leaf)
+				local.insert(key, otherLeaf)
+
+				conflictingKeys[key.UniverseKey()] = struct{}{}
+			}
+
+			syncer := NewSimpleSyncer(SimpleSyncCfg{
+				LocalDiffEngine: local,
+				LocalRegistrar:  local,
+				SyncBatchSize:   10,
+				PrunedLeaves:    local,
+			})
+
+			remoteRoot, err := remote.RootNode(ctx, id)
+			require.NoError(t, err)
+
+			result := make(chan AssetSyncDiff, 1)
+			err = syncer.syncRoot(ctx, remoteRoot, remote, result)
+			require.NoError(t, err)
+
+			// The missing leaves are still synced, but the roots
+			// don't match afterwards.
+			syncDiff := <-result
+			require.Len(t, syncDiff.NewLeafProofs, numMissing)
+
+			divergence := syncDiff.Divergence
+			require.NotNil(t, divergence)
+			require.Equal(t, id, divergence.ID)
+			require.True(t, mssmt.IsEqualNode(
+				local.root(), divergence.LocalRoot,
+			))
+			require.True(t, mssmt.IsEqualNode(
+				remote.root(), divergence.RemoteRoot,
+			))
+
+			// Without bisection, we can't tell which of the leaves
+			// differ, as both sides have the same keys.
+			if tc.noTreeNodes {
+				require.Empty(t, divergence.ConflictingKeys)
+				return
+			}
+
+			require.Len(
+				t, divergence.ConflictingKeys, numConflicting,
+			)
+			for _, key := range divergence.ConflictingKeys {
+				require.Contains(
+					t, conflictingKeys, key.UniverseKey(),
+				)
+			}
+		})
+	}
+}
+
+// TestSyncRootPrunedLeaves tests that leaves that were pruned locally aren't
+// synced again from a remote that still has them, and that they aren't
+// reported as a divergence.
+func TestSyncRootPrunedLeaves(t *testing.T) {
+	t.Parallel()
+
+	const (
+		numShared  = 50
+		numPruned  = 5
+		numMissing = 3
+	)
+
+	testCases := []struct {
+		name        string
+		noTreeNodes bool
+	}{{
+		name: "bisection",
+	}, {
+		name:        "leaf key diff",
+		noTreeNodes: true,
+	}}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			id := Identifier{
+				AssetID:   asset.RandID(t),
+				ProofType: ProofTypeTransfer,
+			}
+
+			remote := newMockDiffEngine(t)
+			remote.noTreeNodes = tc.noTreeNodes
+			local := newMockDiffEngine(t)
+
+			for i := 0; i < numShared; i++ {
+				key, leaf := randLeaf(t)
+				remote.insert(key, leaf)
+				local.insert(key, leaf)
+			}
+
+			// We prune some of the shared leaves locally, while
+			// the remote keeps them.
+			for i := 0; i < numPruned; i++ {
+				key, leaf := randLeaf(t)
+				remote.insert(key, leaf)
+				local.insert(key, leaf)
+				local.prune(key)
+			}
+
+			for i := 0; i < numMissing; i++ {
+				remote.insert(randLeaf(t))
+			}
+
+			syncer := NewSimpleSyncer(SimpleSyncCfg{
+				LocalDiffEngine: local,
+				LocalRegistrar:  local,
+				SyncBatchSize:   10,
+				PrunedLeaves:    local,
+			})
+
+			remoteRoot, err := remote.RootNode(ctx, id)
+			require.NoError(t, err)
+
+			// Only the missing leaves should be fetched, and the
+			// pruned leaves don't cause a divergence.
+			result := make(chan AssetSyncDiff, 1)
+			err = syncer.syncRoot(ctx, remoteRoot, remote, result)
+			require.NoError(t, err)
+
+			syncDiff := <-result
+			require.Len(t, syncDiff.NewLeafProofs, numMissing)
+			require.Nil(t, syncDiff.Divergence)
+			require.EqualValues(
+				t, numMissing, remote.numProofs.Load(),
+			)
+
+			// Syncing again doesn't fetch the pruned leaves
+			// either.
+			err = syncer.syncRoot(ctx, remoteRoot, remote, result)
+			require.NoError(t, err)
+
+			syncDiff = <-result
+			require.Empty(t, syncDiff.NewLeafProofs)
+			require.Nil(t, syncDiff.Divergence)
+			require.EqualValues(
+				t, numMissing, remote.numProofs.Load(),
+			)
+			require.Len(t, local.keys, numShared+numMissing)
+		})
+	}
+}
+
+// TestSyncRootAdmission tests that remote leaves that aren't admitted by our
+// admission policy are skipped, while the other leaves are still synced. The
+// skipped leaves aren't fetched again by later syncs, and they aren't reported
+// as a divergence, until the policy is replaced.
+func TestSyncRootAdmission(t *testing.T) {
+	t.Parallel()
+
+	const (
+		numShared   = 20
+		numAdmitted = 4
+		numDenied   = 3
+	)
+
+	testCases := []struct {
+		name        string
+		noTreeNodes bool
+	}{{
+		name: "bisection",
+	}, {
+		name:        "leaf key diff",
+		noTreeNodes: true,
+	}}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			id := Identifier{
+				AssetID:   asset.RandID(t),
+				ProofType: ProofTypeIssuance,
+			}
+
+			remote := newMockDiffEngine(t)
+			remote.noTreeNodes = tc.noTreeNodes
+			local := newMockDiffEngine(t)
+
+			for i := 0; i < numShared; i++ {
+				key, leaf := randLeaf(t)
+				remote.insert(key, leaf)
+				local.insert(key, leaf)
+			}
+			for i := 0; i < numAdmitted; i++ {
+				remote.insert(randLeaf(t))
+			}
+
+			// The remote also has leaves of an asset that our
+			// policy denies.
+			deniedAsset := randGenesisAsset(t)
+			deniedID := deniedAsset.ID()
+			for i := 0; i < numDenied; i++ {
+				key, leaf := randLeaf(t)
+				leaf.Asset = &deniedAsset
+				remote.insert(key, leaf)
+			}
+
+			policyStore := &mockAdmissionPolicyStore{}
+			policyStore.policy.Rules = []AdmissionRule{{
+				Type:    AdmissionRuleDeny,
+				AssetID: &deniedID,
+			}}
+			admission := NewAdmissionController(policyStore)
+			syncer := NewSimpleSyncer(SimpleSyncCfg{
+				LocalDiffEngine: local,
+				LocalRegistrar:  local,
+				SyncBatchSize:   2,
+				PrunedLeaves:    local,
+				Admission:       admission,
+			})
+
+			remoteRoot, err := remote.RootNode(ctx, id)
+			require.NoError(t, err)
+
+			syncRoot := func() AssetSyncDiff {
+				result := make(chan AssetSyncDiff, 1)
+				err := syncer.syncRoot(
+					ctx, remoteRoot, remote, result,
+				)
+				require.NoError(t, err)
+
+				return <-result
+			}
+
+			// Only the admitted leaves are synced. The local
+			// universe still differs from the remote one, but
+			// only by the denied leaves, so that isn't a
+			// divergence.
+			syncDiff := syncRoot()
+			require.Len(t, syncDiff.NewLeafProofs, numAdmitted)
+			for _, leaf := range syncDiff.NewLeafProofs {
+				require.NotEqual(t, deniedID, leaf.Asset.ID())
+			}
+			require.Len(t, local.keys, numShared+numAdmitted)
+			require.Nil(t, syncDiff.Divergence)
+
+			// The denied leaves were recorded, so the next sync
+			// doesn't fetch them again.
+			remote.numProofs.Store(0)
+			syncDiff = syncRoot()
+			require.Empty(t, syncDiff.NewLeafProofs)
+			require.Nil(t, syncDiff.Divergence)
+			require.Zero(t, remote.numProofs.Load())
+
+			// Once the policy is replaced, the leaves are
+			// reconsidered, and the local universe catches up
+			// with the remote one.
+			err = admission.SetPolicy(ctx, &AdmissionPolicy{})
+			require.NoError(t, err)
+
+			syncDiff = syncRoot()
+			require.Len(t, syncDiff.NewLeafProofs, numDenied)
+			require.Nil(t, syncDiff.Divergence)
+			require.True(t, mssmt.IsEqualNode(
+				local.root(), remote.root(),
+			))
+		})
+	}
+}
+
+// TestSyncUniverseRoundTrip tests that a sync records the round-trip time of
+// the calls that fetch the remote roots, rather than the time the whole sync
+// took.
+func TestSyncUniverseRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	const (
+		numMissing = 20
+		rootDelay  = 50 * time.Millisecond
+	)
+
+	ctx := context.Background()
+	id := Identifier{
+		AssetID:   asset.RandID(t),
+		ProofType: ProofTypeIssuance,
+	}
+
+	remote := newMockDiffEngine(t)
+	remote.rootDelay = rootDelay
+	local := newMockDiffEngine(t)
+	for i := 0; i < numMissing; i++ {
+		remote.insert(randLeaf(t))
+	}
+
+	host := NewServerAddr(1, "localhost:10029")
+	health := NewServerHealthTracker(DefaultQuarantineConfig())
+	syncer := NewSimpleSyncer(SimpleSyncCfg{
+		LocalDiffEngine: local,
+		NewRemoteDiffEngine: func(ServerAddr) (DiffEngine, error) {
+			return remote, nil
+		},
+		LocalRegistrar: local,
+		SyncBatchSize:  2,
+		PrunedLeaves:   local,
+		ServerHealth:   health,
+	})
+
+	start := time.Now()
+	syncDiffs, err := syncer.SyncUniverse(
+		ctx, host, SyncFull, SyncConfigs{}, id,
+	)
+	require.NoError(t, err)
+	syncDuration := time.Since(start)
+
+	require.Len(t, syncDiffs, 1)
+	require.Len(t, syncDiffs[0].NewLeafProofs, numMissing)
+
+	// The remote root is fetched once before and once after the leaves
+	// are synced, and the average latency is in line with the time each
+	// of these calls took.
+	avgLatency := health.Health(host).AvgLatency
+	require.GreaterOrEqual(t, avgLatency, rootDelay)
+	require.Less(t, avgLatency, syncDuration)
+}
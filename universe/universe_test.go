@@ -107,3 +107,57 @@ func TestValidateProofUniverseType(t *testing.T) {
 		})
 	}
 }
+
+// TestNewServerAddrFromStr tests that universe server addresses are validated,
+// including onion addresses.
+func TestNewServerAddrFromStr(t *testing.T) {
+	t.Parallel()
+
+	const onionHost = "vww6ybal4bd7szmgncyruucpgfkqahzddi37ktceo3ah7ngmc" +
+		"opnpyyd.onion"
+
+	testCases := []struct {
+		addr         string
+		expectedHost string
+		expectErr    bool
+	}{{
+		addr:         "universe.example.com",
+		expectedHost: "universe.example.com:10029",
+	}, {
+		addr:         "universe.example.com:8443",
+		expectedHost: "universe.example.com:8443",
+	}, {
+		addr:         onionHost,
+		expectedHost: onionHost + ":10029",
+	}, {
+		addr:         onionHost + ":443",
+		expectedHost: onionHost + ":443",
+	}, {
+		addr:      "invalid.onion:10029",
+		expectErr: true,
+	}, {
+		addr:      "universe.example.com:99999",
+		expectErr: true,
+	}}
+
+	for _, tc := range testCases {
+		addr, err := NewServerAddrFromStr(tc.addr)
+		if tc.expectErr {
+			require.Error(t, err, tc.addr)
+			continue
+		}
+		require.NoError(t, err, tc.addr)
+
+		hostPort, err := addr.HostPort()
+		require.NoError(t, err)
+		require.Equal(t, tc.expectedHost, hostPort)
+	}
+
+	// Onion addresses are never resolved locally.
+	addr, err := NewServerAddrFromStr(onionHost)
+	require.NoError(t, err)
+
+	netAddr, err := addr.Addr()
+	require.NoError(t, err)
+	require.Equal(t, onionHost+":10029", netAddr.String())
+}
@@ -9,7 +9,10 @@ import (
 	"github.com/lightninglabs/taproot-assets/taprpc/universerpc"
 	unirpc "github.com/lightninglabs/taproot-assets/taprpc/universerpc"
 	"github.com/lightninglabs/taproot-assets/universe"
+	"github.com/lightningnetwork/lnd/tor"
 	"golang.org/x/exp/maps"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // RpcUniverseDiff is an implementation of the universe.DiffEngine interface
@@ -19,11 +22,11 @@ type RpcUniverseDiff struct {
 }
 
 // NewRpcUniverseDiff creates a new RpcUniverseDiff instance that dials out to
-// the target remote universe server address.
-func NewRpcUniverseDiff(
-	serverAddr universe.ServerAddr) (universe.DiffEngine, error) {
+// the target remote universe server address over the given network.
+func NewRpcUniverseDiff(serverAddr universe.ServerAddr,
+	dialNet tor.Net) (universe.DiffEngine, error) {
 
-	conn, err := ConnectUniverse(serverAddr)
+	conn, err := ConnectUniverse(serverAddr, dialNet)
 	if err != nil {
 		return nil, fmt.Errorf("unable to connect to universe RPC "+
 			"server: %w", err)
@@ -154,6 +157,75 @@ func (r *RpcUniverseDiff) UniverseLeafKeys(ctx context.Context,
 	return keys, nil
 }
 
+// UniverseTreeNodes returns the nodes of the universe tree at the given paths.
+// If the remote server doesn't know about the tree node RPC yet, then
+// universe.ErrTreeNodesUnsupported is returned.
+func (r *RpcUniverseDiff) UniverseTreeNodes(ctx context.Context,
+	id universe.Identifier,
+	paths []universe.TreeNodePath) ([]universe.TreeNode, error) {
+
+	uniID, err := MarshalUniID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcPaths := make([]*unirpc.TreeNodePath, len(paths))
+	for i, path := range paths {
+		rpcPaths[i] = marshalTreeNodePath(path)
+	}
+
+	resp, err := r.conn.AssetTreeNodes(ctx, &unirpc.AssetTreeNodesRequest{
+		Id:    uniID,
+		Paths: rpcPaths,
+	})
+	switch {
+	case status.Code(err) == codes.Unimplemented:
+		return nil, universe.ErrTreeNodesUnsupported
+
+	case err != nil:
+		return nil, err
+	}
+
+	if len(resp.Nodes) != len(paths) {
+		return nil, fmt.Errorf("expected %d tree nodes, got %d",
+			len(paths), len(resp.Nodes))
+	}
+
+	nodes := make([]universe.TreeNode, len(resp.Nodes))
+	for i, rpcNode := range resp.Nodes {
+		if rpcNode.Node == nil {
+			return nil, fmt.Errorf("missing tree node at index %d",
+				i)
+		}
+
+		path, err := unmarshalTreeNodePath(rpcNode.Path)
+		if err != nil {
+			return nil, err
+		}
+		if path != paths[i] {
+			return nil, fmt.Errorf("unexpected tree node path at "+
+				"index %d", i)
+		}
+
+		nodes[i] = universe.TreeNode{
+			Path: path,
+			Node: unmarshalMerkleSumNode(rpcNode.Node),
+		}
+
+		if rpcNode.LeafKey == nil {
+			continue
+		}
+
+		leafKey, err := unmarshalLeafKey(rpcNode.LeafKey)
+		if err != nil {
+			return nil, err
+		}
+		nodes[i].LeafKey = &leafKey
+	}
+
+	return nodes, nil
+}
+
 // FetchProofLeaf attempts to fetch a proof leaf for the target leaf key
 // and given a universe identifier (assetID/groupKey).
 //
@@ -5,14 +5,19 @@ import (
 	"context"
 	"crypto/tls"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/lightninglabs/taproot-assets/fn"
 	"github.com/lightninglabs/taproot-assets/mssmt"
+	"github.com/lightninglabs/taproot-assets/proof"
 	unirpc "github.com/lightninglabs/taproot-assets/taprpc/universerpc"
 	"github.com/lightninglabs/taproot-assets/universe"
+	"github.com/lightningnetwork/lnd/tor"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
 )
 
 // RpcUniverseRegistrar is an implementation of the universe.Registrar interface
@@ -22,11 +27,12 @@ type RpcUniverseRegistrar struct {
 }
 
 // NewRpcUniverseRegistrar creates a new RpcUniverseRegistrar instance that
-// dials out to the target remote universe server address.
-func NewRpcUniverseRegistrar(
-	serverAddr universe.ServerAddr) (universe.Registrar, error) {
+// dials out to the target remote universe server address over the given
+// network.
+func NewRpcUniverseRegistrar(serverAddr universe.ServerAddr,
+	dialNet tor.Net) (universe.Registrar, error) {
 
-	conn, err := ConnectUniverse(serverAddr)
+	conn, err := ConnectUniverse(serverAddr, dialNet)
 	if err != nil {
 		return nil, fmt.Errorf("unable to connect to universe RPC "+
 			"server: %w", err)
@@ -107,7 +113,7 @@ func (r *RpcUniverseRegistrar) UpsertProofLeaf(ctx context.Context,
 		AssetLeaf: assetLeaf,
 	})
 	if err != nil {
-		return nil, err
+		return nil, unmarshalAdmissionErr(err)
 	}
 
 	// Finally, we'll map the response back into the Proof we expect
@@ -115,6 +121,32 @@ func (r *RpcUniverseRegistrar) UpsertProofLeaf(ctx context.Context,
 	return unmarshalIssuanceProof(uniKey, proofResp)
 }
 
+// unmarshalAdmissionErr maps the error of a proof insertion that was rejected
+// by the admission policy of the remote Universe server to the corresponding
+// universe error. This allows callers to tell a rejection apart from a failure
+// of the server. Any other error is returned as is.
+func unmarshalAdmissionErr(err error) error {
+	var admissionErr error
+	switch status.Code(err) {
+	case codes.PermissionDenied:
+		admissionErr = universe.ErrProofNotAdmitted
+
+	case codes.ResourceExhausted:
+		admissionErr = universe.ErrInsertRateExceeded
+
+	default:
+		return err
+	}
+
+	// The server includes the reason for the rejection in the status
+	// message, prefixed by the error we already wrap.
+	reason := strings.TrimPrefix(
+		status.Convert(err).Message(), admissionErr.Error()+": ",
+	)
+
+	return fmt.Errorf("remote universe: %w: %v", admissionErr, reason)
+}
+
 // Close closes the underlying RPC connection to the remote Universe server.
 func (r *RpcUniverseRegistrar) Close() error {
 	if err := r.conn.Close(); err != nil {
@@ -133,12 +165,12 @@ var _ universe.Registrar = (*RpcUniverseRegistrar)(nil)
 // CheckFederationServer attempts to connect to the target server and ensure
 // that it is a valid federation server that isn't the local daemon.
 func CheckFederationServer(localRuntimeID int64, connectTimeout time.Duration,
-	server universe.ServerAddr) error {
+	server universe.ServerAddr, dialNet tor.Net) error {
 
 	srvrLog.Debugf("Attempting to connect to federation server %v",
 		server.HostStr())
 
-	conn, err := ConnectUniverse(server)
+	conn, err := ConnectUniverse(server, dialNet)
 	if err != nil {
 		return fmt.Errorf("error connecting to server %v: %w",
 			server.HostStr(), err)
@@ -171,9 +203,11 @@ type universeClientConn struct {
 }
 
 // ConnectUniverse connects to a remote Universe server using the provided
-// server address.
-func ConnectUniverse(
-	serverAddr universe.ServerAddr) (*universeClientConn, error) {
+// server address. If a network is given, the connection is established over
+// it, and the network resolves the server's host itself. This allows
+// connecting to onion hosts through a Tor proxy.
+func ConnectUniverse(serverAddr universe.ServerAddr,
+	dialNet tor.Net) (*universeClientConn, error) {
 
 	// TODO(roasbeef): all info is authenticated, but also want to allow
 	// brontide connect as well, can avoid TLS certs
@@ -186,13 +220,17 @@ func ConnectUniverse(
 		grpc.WithTransportCredentials(creds),
 		grpc.WithDefaultCallOptions(MaxMsgReceiveSize),
 	}
+	if dialNet != nil {
+		dialer := proof.NewContextDialer(dialNet)
+		opts = append(opts, grpc.WithContextDialer(dialer))
+	}
 
-	uniAddr, err := serverAddr.Addr()
+	uniAddr, err := serverAddr.HostPort()
 	if err != nil {
 		return nil, err
 	}
 
-	rawConn, err := grpc.Dial(uniAddr.String(), opts...)
+	rawConn, err := grpc.Dial(uniAddr, opts...)
 	if err != nil {
 		return nil, fmt.Errorf("unable to connect to RPC server: "+
 			"%w", err)